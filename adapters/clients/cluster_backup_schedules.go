@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+type ClusterBackupSchedules struct {
+	client *http.Client
+}
+
+func NewClusterBackupSchedules(httpClient *http.Client) *ClusterBackupSchedules {
+	return &ClusterBackupSchedules{client: httpClient}
+}
+
+func (c *ClusterBackupSchedules) OpenTransaction(ctx context.Context, host string,
+	tx *cluster.Transaction,
+) error {
+	path := "/backup-schedules/transactions/"
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: host, Path: path}
+
+	pl := txPayload{
+		Type:    tx.Type,
+		ID:      tx.ID,
+		Payload: tx.Payload,
+	}
+
+	jsonBytes, err := json.Marshal(pl)
+	if err != nil {
+		return errors.Wrap(err, "marshal transaction payload")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(jsonBytes))
+	if err != nil {
+		return errors.Wrap(err, "open http request")
+	}
+
+	req.Header.Set("content-type", "application/json")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusCreated {
+		if res.StatusCode == http.StatusConflict {
+			return cluster.ErrConcurrentTransaction
+		}
+
+		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
+			body)
+	}
+
+	// only read transactions return a payload
+	if len(body) == 0 {
+		return nil
+	}
+
+	var txRes txResponsePayload
+	if err := json.Unmarshal(body, &txRes); err != nil {
+		return errors.Wrap(err, "unmarshal tx response")
+	}
+
+	if tx.ID != txRes.ID {
+		return errors.Errorf("unexpected mismatch between outgoing and incoming tx ids: "+
+			"%s vs %s", tx.ID, txRes.ID)
+	}
+
+	tx.Payload = txRes.Payload
+
+	return nil
+}
+
+func (c *ClusterBackupSchedules) AbortTransaction(ctx context.Context, host string,
+	tx *cluster.Transaction,
+) error {
+	path := "/backup-schedules/transactions/" + tx.ID
+	method := http.MethodDelete
+	url := url.URL{Scheme: "http", Host: host, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return errors.Wrap(err, "open http request")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		return errors.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *ClusterBackupSchedules) CommitTransaction(ctx context.Context, host string,
+	tx *cluster.Transaction,
+) error {
+	path := "/backup-schedules/transactions/" + tx.ID + "/commit"
+	method := http.MethodPut
+	url := url.URL{Scheme: "http", Host: host, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return errors.Wrap(err, "open http request")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		return errors.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+type ClusterRaft struct {
+	client *http.Client
+}
+
+func NewClusterRaft(httpClient *http.Client) *ClusterRaft {
+	return &ClusterRaft{client: httpClient}
+}
+
+type raftIndex struct {
+	Index uint64 `json:"index"`
+}
+
+// Apply submits a schema command to the raft leader on host
+func (c *ClusterRaft) Apply(ctx context.Context, host string,
+	cmd []byte,
+) (uint64, error) {
+	res, err := c.do(ctx, http.MethodPost, host, "apply", cmd)
+	if err != nil {
+		return 0, err
+	}
+	return c.index(res)
+}
+
+// ReadIndex returns the applied index of the raft leader on host
+func (c *ClusterRaft) ReadIndex(ctx context.Context, host string) (uint64, error) {
+	res, err := c.do(ctx, http.MethodGet, host, "read_index", nil)
+	if err != nil {
+		return 0, err
+	}
+	return c.index(res)
+}
+
+// Join asks the raft leader on host to add node id with address addr
+func (c *ClusterRaft) Join(ctx context.Context, host, id, addr string) error {
+	body, err := json.Marshal(struct {
+		ID      string `json:"id"`
+		Address string `json:"address"`
+	}{id, addr})
+	if err != nil {
+		return errors.Wrap(err, "marshal join request")
+	}
+
+	res, err := c.do(ctx, http.MethodPost, host, "join", body)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		return c.responseError(res)
+	}
+	return nil
+}
+
+func (c *ClusterRaft) do(ctx context.Context, method, host, action string,
+	body []byte,
+) (*http.Response, error) {
+	url := url.URL{Scheme: "http", Host: host, Path: "/schema/raft/" + action}
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+	if body != nil {
+		req.Header.Set("content-type", "application/json")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+	return res, nil
+}
+
+func (c *ClusterRaft) index(res *http.Response) (uint64, error) {
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return 0, c.responseError(res)
+	}
+
+	var pl raftIndex
+	if err := json.NewDecoder(res.Body).Decode(&pl); err != nil {
+		return 0, errors.Wrap(err, "unmarshal response body")
+	}
+	return pl.Index, nil
+}
+
+func (c *ClusterRaft) responseError(res *http.Response) error {
+	body, _ := io.ReadAll(res.Body)
+	msg := strings.TrimSpace(string(body))
+	switch res.StatusCode {
+	case http.StatusUnprocessableEntity:
+		// rejected by the state machine
+		return errors.New(msg)
+	case http.StatusServiceUnavailable:
+		return errors.Wrap(cluster.ErrRaftNotReady, msg)
+	default:
+		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode, msg)
+	}
+}
@@ -406,10 +406,10 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, hostName, indexName,
 	shardName string, vector []float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
-	additional additional.Properties,
+	recommend *searchparams.Recommend, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	paramsBytes, err := clusterapi.IndicesPayloads.SearchParams.
-		Marshal(vector, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
+		Marshal(vector, limit, filters, keywordRanking, sort, cursor, groupBy, recommend, additional)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "marshal request payload")
 	}
@@ -552,6 +552,151 @@ func (c *RemoteIndex) FindDocIDs(ctx context.Context, hostName, indexName,
 	return docIDs, nil
 }
 
+func (c *RemoteIndex) Facets(ctx context.Context, hostName, indexName,
+	shardName string, filters *filters.LocalFilter, ids []strfmt.UUID,
+	params searchparams.Facets,
+) ([]search.Facet, error) {
+	paramsBytes, err := clusterapi.IndicesPayloads.FacetParams.Marshal(filters, ids, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal request payload")
+	}
+
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/_facets", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(paramsBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	clusterapi.IndicesPayloads.FacetParams.SetContentTypeHeaderReq(req)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return nil, errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
+			body)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.FacetResults.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, errors.Errorf("unexpected content type: %s", ct)
+	}
+
+	facets, err := clusterapi.IndicesPayloads.FacetResults.Unmarshal(resBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	return facets, nil
+}
+
+func (c *RemoteIndex) FindDuplicates(ctx context.Context, hostName, indexName,
+	shardName string, maxDistance float32,
+) ([]search.DuplicatePair, error) {
+	paramsBytes, err := clusterapi.IndicesPayloads.DuplicatesParams.Marshal(maxDistance)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal request payload")
+	}
+
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/_duplicates", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(paramsBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	clusterapi.IndicesPayloads.DuplicatesParams.SetContentTypeHeaderReq(req)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return nil, errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
+			body)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.DuplicatesResults.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, errors.Errorf("unexpected content type: %s", ct)
+	}
+
+	pairs, err := clusterapi.IndicesPayloads.DuplicatesResults.Unmarshal(resBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	return pairs, nil
+}
+
+func (c *RemoteIndex) SearchDuplicates(ctx context.Context, hostName, indexName,
+	shardName string, vectors [][]float32, maxDistance float32,
+) ([][]search.Duplicate, error) {
+	paramsBytes, err := clusterapi.IndicesPayloads.NeighborsParams.Marshal(vectors, maxDistance)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal request payload")
+	}
+
+	path := fmt.Sprintf("/indices/%s/shards/%s/objects/_neighbors", indexName, shardName)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(),
+		bytes.NewReader(paramsBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "open http request")
+	}
+
+	clusterapi.IndicesPayloads.NeighborsParams.SetContentTypeHeaderReq(req)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return nil, errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
+			body)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+
+	ct, ok := clusterapi.IndicesPayloads.NeighborsResults.CheckContentTypeHeader(res)
+	if !ok {
+		return nil, errors.Errorf("unexpected content type: %s", ct)
+	}
+
+	neighbors, err := clusterapi.IndicesPayloads.NeighborsResults.Unmarshal(resBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	return neighbors, nil
+}
+
 func (c *RemoteIndex) DeleteObjectBatch(ctx context.Context, hostName, indexName, shardName string,
 	docIDs []uint64, dryRun bool,
 ) objects.BatchSimpleObjects {
@@ -814,3 +959,69 @@ func (c *RemoteIndex) IncreaseReplicationFactor(ctx context.Context,
 	}
 	return c.retry(ctx, 34, try)
 }
+
+func (c *RemoteIndex) DecreaseReplicationFactor(ctx context.Context,
+	hostName, indexName string, dist scaler.ShardDist,
+) error {
+	path := fmt.Sprintf("/replicas/indices/%s/replication-factor:decrease", indexName)
+
+	method := http.MethodPut
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	body, err := clusterapi.IndicesPayloads.DecreaseReplicationFactor.Marshall(dist)
+	if err != nil {
+		return err
+	}
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), bytes.NewReader(body))
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusNoContent {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+	return c.retry(ctx, 34, try)
+}
+
+func (c *RemoteIndex) SyncShards(ctx context.Context,
+	hostName, indexName string, dist scaler.ShardDist,
+) error {
+	path := fmt.Sprintf("/replicas/indices/%s/replicas:sync", indexName)
+
+	method := http.MethodPut
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	body, err := clusterapi.IndicesPayloads.SyncReplicas.Marshall(dist)
+	if err != nil {
+		return err
+	}
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), bytes.NewReader(body))
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusNoContent {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+	return c.retry(ctx, 34, try)
+}
@@ -76,6 +76,24 @@ func (c *replicationClient) DigestObjects(ctx context.Context,
 	return resp, err
 }
 
+func (c *replicationClient) HashTreeLevel(ctx context.Context,
+	host, index, shard string, level int, nodes []int,
+) ([]uint64, error) {
+	var resp []uint64
+	body, err := clusterapi.IndicesPayloads.HashTreeLevel.Marshal(level, nodes)
+	if err != nil {
+		return nil, fmt.Errorf("encode request: %w", err)
+	}
+	req, err := newHttpReplicaRequest(
+		ctx, http.MethodGet, host, index, shard,
+		"", "_hashtree", bytes.NewReader(body))
+	if err != nil {
+		return resp, fmt.Errorf("create http request: %w", err)
+	}
+	err = c.do(c.timeoutUnit*20, req, body, &resp)
+	return resp, err
+}
+
 func (c *replicationClient) OverwriteObjects(ctx context.Context,
 	host, index, shard string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
@@ -37,6 +37,22 @@ const (
 	AggregateGroupedBy = "Indicates the group of returned data"
 )
 
+const (
+	AggregateHistogram         = "Count the property values in buckets of a fixed interval, other properties can be aggregated per bucket"
+	AggregateDateHistogram     = "Count the property values in buckets of a calendar interval, other properties can be aggregated per bucket"
+	AggregateHistogramInterval = "The width of each bucket"
+	AggregateHistogramTimezone = "The timezone in which buckets start, defaults to UTC"
+	AggregateHistogramKey      = "The lower bound of the bucket"
+)
+
+const (
+	AggregatePercentiles       = "Approximate the values below which the given percentages of all values fall"
+	AggregatePercentilesValues = "The percentiles to approximate, each between 0 and 100"
+	AggregatePercentile        = "The requested percentile"
+	AggregatePercentileValue   = "The approximate value at the percentile"
+	AggregateCardinality       = "Approximate the number of distinct values"
+)
+
 const AggregateNumericObj = "An object containing the %s of numeric properties"
 
 const AggregateCountObj = "An object containing countable properties"
@@ -28,3 +28,12 @@ const (
 	ID                   = "Concept identifier in the uuid format"
 	Beacon               = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
 )
+
+const (
+	Recommend                = "Search for objects similar to the positive and dissimilar to the negative examples"
+	RecommendPositive        = "IDs of objects to recommend similar objects to"
+	RecommendNegative        = "IDs of objects to recommend dissimilar objects to"
+	RecommendPositiveVectors = "Vectors to recommend similar objects to"
+	RecommendNegativeVectors = "Vectors to recommend dissimilar objects to"
+	RecommendStrategy        = "Specify how the examples are combined: centroid searches near the mean of the positives moved away from the mean of the negatives, bestScore ranks by the distance to the closest positive minus the distance to the closest negative. Defaults to centroid"
+)
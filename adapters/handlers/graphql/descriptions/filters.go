@@ -152,3 +152,23 @@ const (
 	GroupByGroups          = "Specify the number of groups to be created"
 	GroupByObjectsPerGroup = "Specify the number of max objects in group"
 )
+
+const (
+	Facets           = "Count the occurrences of the values of the given properties across all matched objects"
+	FacetsProperties = "Specify the properties to count the values of"
+	FacetsLimit      = "Specify the maximum number of values returned per property, ordered by count"
+)
+
+const (
+	Highlight             = "Snippets of the text properties in which the terms of the bm25 or hybrid query matched"
+	HighlightProperties   = "Specify the properties to highlight, defaults to the searched properties"
+	HighlightFragmentSize = "Specify the maximum number of characters of a snippet, defaults to 100"
+	HighlightPreTag       = "Specify the text inserted before every matched term, defaults to <em>"
+	HighlightPostTag      = "Specify the text inserted after every matched term, defaults to </em>"
+)
+
+const (
+	MMR           = "Re-rank the results of a vector search (e.g. nearVector, nearObject, nearText) or hybrid search to balance their relevance against their diversity (maximal marginal relevance)"
+	MMRLambda     = "Specify the weight of the relevance over the diversity, between 0 (most diverse) and 1 (most relevant), defaults to 0.5"
+	MMRFetchLimit = "Specify the number of candidates retrieved from the index to pick the results from, defaults to four times the limit"
+)
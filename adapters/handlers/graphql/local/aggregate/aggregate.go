@@ -40,7 +40,8 @@ func Build(dbSchema *schema.Schema, config config.Config,
 	var err error
 	var localAggregateObjects *graphql.Object
 	if len(dbSchema.Objects.Classes) > 0 {
-		localAggregateObjects, err = classFields(dbSchema.Objects.Classes, config, modulesProvider)
+		localAggregateObjects, err = classFields(dbSchema.Objects.Classes,
+			dbSchema.Objects.Aliases, config, modulesProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -56,7 +57,7 @@ func Build(dbSchema *schema.Schema, config config.Config,
 	return &field, nil
 }
 
-func classFields(databaseSchema []*models.Class,
+func classFields(databaseSchema []*models.Class, aliases map[string]string,
 	config config.Config, modulesProvider ModulesProvider,
 ) (*graphql.Object, error) {
 	fields := graphql.Fields{}
@@ -70,6 +71,13 @@ func classFields(databaseSchema []*models.Class,
 		fields[class.Class] = field
 	}
 
+	// an alias is queried exactly like the class it points to
+	for alias, class := range aliases {
+		if field, ok := fields[class]; ok {
+			fields[alias] = field
+		}
+	}
+
 	return graphql.NewObject(graphql.ObjectConfig{
 		Name:        "AggregateObjectsObj",
 		Fields:      fields,
@@ -82,17 +90,31 @@ func classField(class *models.Class, description string,
 ) (*graphql.Field, error) {
 	metaClassName := fmt.Sprintf("Aggregate%s", class.Class)
 
-	fields := graphql.ObjectConfig{
-		Name: metaClassName,
-		Fields: (graphql.FieldsThunk)(func() graphql.Fields {
-			fields, err := classPropertyFields(class)
-			if err != nil {
-				// we cannot return an error in this FieldsThunk and have to panic unfortunately
-				panic(fmt.Sprintf("Failed to assemble single Local Aggregate Class field: %s", err))
-			}
+	// the class fields are shared with the histogram buckets, so they must
+	// only be assembled once
+	var (
+		propertyFields graphql.Fields
+		histograms     *histogramTypes
+	)
+	classFieldsThunk := func() graphql.Fields {
+		if propertyFields != nil {
+			return propertyFields
+		}
+
+		fields, err := classPropertyFields(class, histograms)
+		if err != nil {
+			// we cannot return an error in this FieldsThunk and have to panic unfortunately
+			panic(fmt.Sprintf("Failed to assemble single Local Aggregate Class field: %s", err))
+		}
+
+		propertyFields = fields
+		return propertyFields
+	}
+	histograms = newHistogramTypes(class, classFieldsThunk)
 
-			return fields
-		}),
+	fields := graphql.ObjectConfig{
+		Name:        metaClassName,
+		Fields:      (graphql.FieldsThunk)(classFieldsThunk),
 		Description: description,
 	}
 
@@ -121,6 +143,7 @@ func classField(class *models.Class, description string,
 			},
 			"nearVector": nearVectorArgument(class.Class),
 			"nearObject": nearObjectArgument(class.Class),
+			"recommend":  recommendArgument(class.Class),
 			"objectLimit": &graphql.ArgumentConfig{
 				Description: descriptions.First,
 				Type:        graphql.Int,
@@ -139,7 +162,9 @@ func classField(class *models.Class, description string,
 	return fieldsField, nil
 }
 
-func classPropertyFields(class *models.Class) (graphql.Fields, error) {
+func classPropertyFields(class *models.Class,
+	histograms *histogramTypes,
+) (graphql.Fields, error) {
 	fields := graphql.Fields{}
 	for _, property := range class.Properties {
 		propertyType, err := schema.GetPropertyDataType(class, property.Name)
@@ -147,7 +172,8 @@ func classPropertyFields(class *models.Class) (graphql.Fields, error) {
 			return nil, fmt.Errorf("%s.%s: %s", class.Class, property.Name, err)
 		}
 
-		convertedDataType, err := classPropertyField(*propertyType, class, property)
+		convertedDataType, err := classPropertyField(*propertyType, class, property,
+			histograms)
 		if err != nil {
 			return nil, err
 		}
@@ -203,18 +229,21 @@ func metaObject(prefix string) *graphql.Object {
 	})
 }
 
-func classPropertyField(dataType schema.DataType, class *models.Class, property *models.Property) (*graphql.Field, error) {
+func classPropertyField(dataType schema.DataType, class *models.Class,
+	property *models.Property, histograms *histogramTypes,
+) (*graphql.Field, error) {
 	switch dataType {
 	case schema.DataTypeText:
 		return makePropertyField(class, property, stringPropertyFields)
-	case schema.DataTypeInt:
-		return makePropertyField(class, property, numericPropertyFields)
-	case schema.DataTypeNumber:
-		return makePropertyField(class, property, numericPropertyFields)
+	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeIntArray,
+		schema.DataTypeNumberArray:
+		return makeHistogramPropertyField(class, property, numericPropertyFields,
+			histogramFieldName, histograms.numericalField())
 	case schema.DataTypeBoolean:
 		return makePropertyField(class, property, booleanPropertyFields)
-	case schema.DataTypeDate:
-		return makePropertyField(class, property, datePropertyFields)
+	case schema.DataTypeDate, schema.DataTypeDateArray:
+		return makeHistogramPropertyField(class, property, datePropertyFields,
+			dateHistogramFieldName, histograms.dateField())
 	case schema.DataTypeCRef:
 		return makePropertyField(class, property, referencePropertyFields)
 	case schema.DataTypeGeoCoordinates:
@@ -223,19 +252,18 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 	case schema.DataTypePhoneNumber:
 		// skipping for now, see gh-1088 where it was outscoped
 		return nil, nil
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		// nested properties are aggregated through their dotted leaf paths,
+		// which are not part of the graphql schema
+		return nil, nil
 	case schema.DataTypeBlob:
 		return makePropertyField(class, property, stringPropertyFields)
 	case schema.DataTypeTextArray:
 		return makePropertyField(class, property, stringPropertyFields)
-	case schema.DataTypeIntArray, schema.DataTypeNumberArray:
-		return makePropertyField(class, property, numericPropertyFields)
 	case schema.DataTypeBooleanArray:
 		return makePropertyField(class, property, booleanPropertyFields)
-	case schema.DataTypeDateArray:
-		return makePropertyField(class, property, datePropertyFields)
 	case schema.DataTypeUUID, schema.DataTypeUUIDArray:
-		// not aggregatable
-		return nil, nil
+		return makePropertyField(class, property, stringPropertyFields)
 	default:
 		return nil, fmt.Errorf(schema.ErrorNoSuchDatatype+": %s", dataType)
 	}
@@ -268,6 +296,20 @@ func makePropertyField(class *models.Class, property *models.Property,
 	}, nil
 }
 
+// makeHistogramPropertyField extends a numerical or date property field by a
+// histogram over the values of that property
+func makeHistogramPropertyField(class *models.Class, property *models.Property,
+	fieldMaker propertyFieldMaker, histogramName string, histogram *graphql.Field,
+) (*graphql.Field, error) {
+	field, err := makePropertyField(class, property, fieldMaker)
+	if err != nil {
+		return nil, err
+	}
+
+	field.Type.(*graphql.Object).AddFieldConfig(histogramName, histogram)
+	return field, nil
+}
+
 func passThroughResolver(p graphql.ResolveParams) (interface{}, error) {
 	// bubble up root resolver
 	return p.Source, nil
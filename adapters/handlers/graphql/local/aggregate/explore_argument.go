@@ -23,3 +23,7 @@ func nearVectorArgument(className string) *graphql.ArgumentConfig {
 func nearObjectArgument(className string) *graphql.ArgumentConfig {
 	return common_filters.NearObjectArgument("AggregateObjects", className)
 }
+
+func recommendArgument(className string) *graphql.ArgumentConfig {
+	return common_filters.RecommendArgument("AggregateObjects", className)
+}
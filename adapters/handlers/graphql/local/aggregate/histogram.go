@@ -0,0 +1,212 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregate
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	histogramFieldName     = "histogram"
+	dateHistogramFieldName = "dateHistogram"
+	bucketKeyFieldName     = "key"
+)
+
+// histogramTypes holds the bucket types of a single class. A bucket exposes
+// the same fields as the class itself, so that every property can be
+// aggregated per bucket. As the class fields contain the histograms again,
+// the bucket fields can only be assembled lazily.
+type histogramTypes struct {
+	numericalBucket *graphql.Object
+	dateBucket      *graphql.Object
+	dateInterval    *graphql.Enum
+}
+
+func newHistogramTypes(class *models.Class,
+	classFields func() graphql.Fields,
+) *histogramTypes {
+	prefix := fmt.Sprintf("Aggregate%s", class.Class)
+	return &histogramTypes{
+		numericalBucket: graphql.NewObject(graphql.ObjectConfig{
+			Name:   fmt.Sprintf("%sHistogramBucket", prefix),
+			Fields: bucketFields(graphql.Float, classFields),
+		}),
+		dateBucket: graphql.NewObject(graphql.ObjectConfig{
+			Name:   fmt.Sprintf("%sDateHistogramBucket", prefix),
+			Fields: bucketFields(graphql.String, classFields),
+		}),
+		dateInterval: graphql.NewEnum(graphql.EnumConfig{
+			Name: fmt.Sprintf("%sDateHistogramInterval", prefix),
+			Values: graphql.EnumValueConfigMap{
+				string(aggregation.DateIntervalDay):   &graphql.EnumValueConfig{},
+				string(aggregation.DateIntervalWeek):  &graphql.EnumValueConfig{},
+				string(aggregation.DateIntervalMonth): &graphql.EnumValueConfig{},
+			},
+		}),
+	}
+}
+
+func bucketFields(keyType graphql.Output,
+	classFields func() graphql.Fields,
+) graphql.FieldsThunk {
+	return func() graphql.Fields {
+		fields := graphql.Fields{}
+		for name, field := range classFields() {
+			fields[name] = field
+		}
+		delete(fields, GroupedByFieldName)
+
+		fields[bucketKeyFieldName] = &graphql.Field{
+			Description: descriptions.AggregateHistogramKey,
+			Type:        keyType,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				bucket, ok := p.Source.(aggregation.Group)
+				if !ok {
+					return nil, fmt.Errorf("bucket key: expected aggregation.Group, got %T", p.Source)
+				}
+
+				return bucket.GroupedBy.Value, nil
+			},
+		}
+
+		return fields
+	}
+}
+
+func (h *histogramTypes) numericalField() *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.AggregateHistogram,
+		Type:        graphql.NewList(h.numericalBucket),
+		Args: graphql.FieldConfigArgument{
+			"interval": &graphql.ArgumentConfig{
+				Description: descriptions.AggregateHistogramInterval,
+				Type:        graphql.NewNonNull(graphql.Float),
+			},
+		},
+		Resolve: resolveBuckets,
+	}
+}
+
+func (h *histogramTypes) dateField() *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.AggregateDateHistogram,
+		Type:        graphql.NewList(h.dateBucket),
+		Args: graphql.FieldConfigArgument{
+			"interval": &graphql.ArgumentConfig{
+				Description: descriptions.AggregateHistogramInterval,
+				Type:        graphql.NewNonNull(h.dateInterval),
+			},
+			"timezone": &graphql.ArgumentConfig{
+				Description: descriptions.AggregateHistogramTimezone,
+				Type:        graphql.String,
+			},
+		},
+		Resolve: resolveBuckets,
+	}
+}
+
+func resolveBuckets(p graphql.ResolveParams) (interface{}, error) {
+	prop, ok := p.Source.(aggregation.Property)
+	if !ok {
+		return nil, fmt.Errorf("histogram: expected aggregation.Property, got %T", p.Source)
+	}
+
+	return prop.Buckets, nil
+}
+
+func isHistogramField(name string) bool {
+	return name == histogramFieldName || name == dateHistogramFieldName
+}
+
+func extractHistogram(field *ast.Field) (*aggregation.Histogram, error) {
+	histogram := &aggregation.Histogram{}
+
+	for _, arg := range field.Arguments {
+		value, ok := arg.Value.GetValue().(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: argument %s must be a literal",
+				field.Name.Value, arg.Name.Value)
+		}
+
+		switch {
+		case arg.Name.Value == "interval" && field.Name.Value == histogramFieldName:
+			interval, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("histogram: interval: %w", err)
+			}
+			histogram.Interval = interval
+		case arg.Name.Value == "interval":
+			interval, err := aggregation.ParseDateInterval(value)
+			if err != nil {
+				return nil, fmt.Errorf("dateHistogram: %w", err)
+			}
+			histogram.DateInterval = interval
+		case arg.Name.Value == "timezone":
+			histogram.Timezone = value
+		}
+	}
+
+	if field.SelectionSet == nil {
+		return histogram, nil
+	}
+
+	// the key of a bucket is not a property and doesn't need to be aggregated
+	selections := &ast.SelectionSet{}
+	for _, selection := range field.SelectionSet.Selections {
+		if selection.(*ast.Field).Name.Value == bucketKeyFieldName {
+			continue
+		}
+		selections.Selections = append(selections.Selections, selection)
+	}
+
+	properties, _, err := extractProperties(selections)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", field.Name.Value, err)
+	}
+	histogram.Properties = properties
+
+	return histogram, nil
+}
+
+// extractPropertyHistogram returns the histogram requested on a property, if
+// any
+func extractPropertyHistogram(selections *ast.SelectionSet) (*aggregation.Histogram, error) {
+	if selections == nil {
+		return nil, nil
+	}
+
+	var histogram *aggregation.Histogram
+	for _, selection := range selections.Selections {
+		field := selection.(*ast.Field)
+		if !isHistogramField(field.Name.Value) {
+			continue
+		}
+		if histogram != nil {
+			return nil, fmt.Errorf("only one histogram per property is supported")
+		}
+
+		h, err := extractHistogram(field)
+		if err != nil {
+			return nil, err
+		}
+		histogram = h
+	}
+
+	return histogram, nil
+}
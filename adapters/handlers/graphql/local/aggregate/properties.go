@@ -64,6 +64,8 @@ func numericPropertyFields(class *models.Class, property *models.Property, prefi
 			Type:        graphql.Int,
 			Resolve:     makeResolveNumericFieldAggregator("count"),
 		},
+		"percentiles": percentilesField(class, property, prefix, graphql.Float),
+		"cardinality": cardinalityField(class, property, prefix),
 		"type": &graphql.Field{
 			Name:        fmt.Sprintf("%s%s%sType", prefix, class.Class, property.Name),
 			Description: descriptions.AggregateCount,
@@ -120,6 +122,8 @@ func datePropertyFields(class *models.Class,
 			Type:        graphql.String,
 			Resolve:     makeResolveDateFieldAggregator("median"),
 		},
+		"percentiles": percentilesField(class, property, prefix, graphql.String),
+		"cardinality": cardinalityField(class, property, prefix),
 	}
 
 	return graphql.NewObject(graphql.ObjectConfig{
@@ -277,6 +281,7 @@ func stringPropertyFields(class *models.Class,
 				return text.Count, nil
 			}),
 		},
+		"cardinality": cardinalityField(class, property, prefix),
 		"type": &graphql.Field{
 			Name:        fmt.Sprintf("%s%s%sType", prefix, class.Class, property.Name),
 			Description: descriptions.AggregateCount,
@@ -48,7 +48,7 @@ type RequestsLog interface {
 
 func makeResolveClass(modulesProvider ModulesProvider, class *models.Class) graphql.FieldResolveFn {
 	return func(p graphql.ResolveParams) (interface{}, error) {
-		className := schema.ClassName(p.Info.FieldName)
+		className := schema.ClassName(class.Class)
 		source, ok := p.Source.(map[string]interface{})
 		if !ok {
 			return nil, fmt.Errorf("expected source to be a map, but was %t", p.Source)
@@ -70,7 +70,7 @@ func makeResolveClass(modulesProvider ModulesProvider, class *models.Class) grap
 			return nil, fmt.Errorf("could not extract properties for class '%s': %w", className, err)
 		}
 
-		groupBy, err := extractGroupBy(p.Args, p.Info.FieldName)
+		groupBy, err := extractGroupBy(p.Args, class.Class)
 		if err != nil {
 			return nil, fmt.Errorf("could not extract groupBy path: %w", err)
 		}
@@ -88,7 +88,7 @@ func makeResolveClass(modulesProvider ModulesProvider, class *models.Class) grap
 			return nil, fmt.Errorf("could not extract objectLimit: %w", err)
 		}
 
-		filters, err := common_filters.ExtractFilters(p.Args, p.Info.FieldName)
+		filters, err := common_filters.ExtractFilters(p.Args, class.Class)
 		if err != nil {
 			return nil, fmt.Errorf("could not extract filters: %w", err)
 		}
@@ -111,6 +111,15 @@ func makeResolveClass(modulesProvider ModulesProvider, class *models.Class) grap
 			nearObjectParams = &p
 		}
 
+		var recommendParams *searchparams.Recommend
+		if recommend, ok := p.Args["recommend"]; ok {
+			p, err := common_filters.ExtractRecommend(recommend.(map[string]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("failed to extract recommend params: %w", err)
+			}
+			recommendParams = &p
+		}
+
 		var moduleParams map[string]interface{}
 		if modulesProvider != nil {
 			extractedParams := modulesProvider.ExtractSearchParams(p.Args, class.Class)
@@ -141,6 +150,7 @@ func makeResolveClass(modulesProvider ModulesProvider, class *models.Class) grap
 			ObjectLimit:      objectLimit,
 			NearVector:       nearVectorParams,
 			NearObject:       nearObjectParams,
+			Recommend:        recommendParams,
 			ModuleParams:     moduleParams,
 			Hybrid:           hybridParams,
 		}
@@ -199,6 +209,19 @@ func extractProperties(selections *ast.SelectionSet) ([]aggregation.ParamPropert
 		}
 
 		property.Aggregators = aggregators
+
+		histogram, err := extractPropertyHistogram(field.SelectionSet)
+		if err != nil {
+			return nil, false, err
+		}
+		property.Histogram = histogram
+
+		percentiles, err := extractPercentiles(field.SelectionSet)
+		if err != nil {
+			return nil, false, err
+		}
+		property.Percentiles = percentiles
+
 		properties = append(properties, property)
 	}
 
@@ -213,7 +236,7 @@ func extractAggregators(selections *ast.SelectionSet) ([]aggregation.Aggregator,
 	for _, selection := range selections.Selections {
 		field := selection.(*ast.Field)
 		name := field.Name.Value
-		if name == "__typename" {
+		if name == "__typename" || isHistogramField(name) {
 			continue
 		}
 		property, err := aggregation.ParseAggregatorProp(name)
@@ -926,6 +926,161 @@ func Test_Resolve(t *testing.T) {
 				},
 			}},
 		},
+		testCase{
+			name: "with a histogram and sub-aggregations per bucket",
+			query: `{ Aggregate { Car { horsepower {
+				histogram(interval: 100) { key meta { count } weight { mean } }
+			} } } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name:        "horsepower",
+					Aggregators: []aggregation.Aggregator{},
+					Histogram: &aggregation.Histogram{
+						Interval: 100,
+						Properties: []aggregation.ParamProperty{
+							{
+								Name:        "weight",
+								Aggregators: []aggregation.Aggregator{aggregation.MeanAggregator},
+							},
+						},
+					},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					Properties: map[string]aggregation.Property{
+						"horsepower": {
+							Type: aggregation.PropertyTypeNumerical,
+							Buckets: []aggregation.Group{
+								{
+									GroupedBy: &aggregation.GroupedBy{Value: 100.0, Path: []string{"horsepower"}},
+									Count:     3,
+									Properties: map[string]aggregation.Property{
+										"weight": {
+											Type:                  aggregation.PropertyTypeNumerical,
+											NumericalAggregations: map[string]interface{}{"mean": 1500.0},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{
+							"histogram": []interface{}{
+								map[string]interface{}{
+									"key":    100.0,
+									"meta":   map[string]interface{}{"count": 3},
+									"weight": map[string]interface{}{"mean": 1500.0},
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
+		testCase{
+			name: "with a date histogram",
+			query: `{ Aggregate { Car { startOfProduction {
+				dateHistogram(interval: month, timezone: "Europe/Berlin") { key meta { count } }
+			} } } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name:        "startOfProduction",
+					Aggregators: []aggregation.Aggregator{},
+					Histogram: &aggregation.Histogram{
+						DateInterval: aggregation.DateIntervalMonth,
+						Timezone:     "Europe/Berlin",
+						Properties:   []aggregation.ParamProperty{},
+					},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					Properties: map[string]aggregation.Property{
+						"startOfProduction": {
+							Type: aggregation.PropertyTypeDate,
+							Buckets: []aggregation.Group{
+								{
+									GroupedBy: &aggregation.GroupedBy{
+										Value: "2023-03-01T00:00:00+01:00",
+										Path:  []string{"startOfProduction"},
+									},
+									Count: 7,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"startOfProduction": map[string]interface{}{
+							"dateHistogram": []interface{}{
+								map[string]interface{}{
+									"key":  "2023-03-01T00:00:00+01:00",
+									"meta": map[string]interface{}{"count": 7},
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
+		testCase{
+			name: "with percentiles and cardinality",
+			query: `{ Aggregate { Car { horsepower {
+				percentiles(values: [50, 99.9]) { percentile value } cardinality
+			} } } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name: "horsepower",
+					Aggregators: []aggregation.Aggregator{
+						aggregation.PercentilesAggregator,
+						aggregation.CardinalityAggregator,
+					},
+					Percentiles: []float64{50, 99.9},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					Properties: map[string]aggregation.Property{
+						"horsepower": {
+							Type: aggregation.PropertyTypeNumerical,
+							PercentilesAggregation: &aggregation.Percentiles{
+								Percentiles: []float64{50, 99.9},
+								Values: []aggregation.Percentile{
+									{Percentile: 50, Value: 120.0},
+									{Percentile: 99.9, Value: 400.0},
+								},
+							},
+							CardinalityAggregation: &aggregation.Cardinality{Value: 12},
+						},
+					},
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{
+							"percentiles": []interface{}{
+								map[string]interface{}{"percentile": 50.0, "value": 120.0},
+								map[string]interface{}{"percentile": 99.9, "value": 400.0},
+							},
+							"cardinality": 12,
+						},
+					},
+				},
+			}},
+		},
 	}
 
 	tests.AssertExtraction(t, "Car")
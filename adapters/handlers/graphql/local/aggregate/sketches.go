@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregate
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const percentilesFieldName = "percentiles"
+
+func percentilesField(class *models.Class, property *models.Property,
+	prefix string, valueType graphql.Output,
+) *graphql.Field {
+	percentile := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%s%s%sPercentilesObj", prefix, class.Class, property.Name),
+		Fields: graphql.Fields{
+			"percentile": &graphql.Field{
+				Description: descriptions.AggregatePercentile,
+				Type:        graphql.Float,
+				Resolve: percentileResolver(func(p aggregation.Percentile) interface{} {
+					return p.Percentile
+				}),
+			},
+			"value": &graphql.Field{
+				Description: descriptions.AggregatePercentileValue,
+				Type:        valueType,
+				Resolve: percentileResolver(func(p aggregation.Percentile) interface{} {
+					return p.Value
+				}),
+			},
+		},
+		Description: descriptions.AggregatePercentiles,
+	})
+
+	return &graphql.Field{
+		Name:        fmt.Sprintf("%s%s%sPercentiles", prefix, class.Class, property.Name),
+		Description: descriptions.AggregatePercentiles,
+		Type:        graphql.NewList(percentile),
+		Args: graphql.FieldConfigArgument{
+			"values": &graphql.ArgumentConfig{
+				Description: descriptions.AggregatePercentilesValues,
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			prop, ok := p.Source.(aggregation.Property)
+			if !ok {
+				return nil, fmt.Errorf("percentiles: expected aggregation.Property, got %T", p.Source)
+			}
+
+			if prop.PercentilesAggregation == nil {
+				return nil, nil
+			}
+			return prop.PercentilesAggregation.Values, nil
+		},
+	}
+}
+
+func percentileResolver(extractor func(aggregation.Percentile) interface{},
+) func(p graphql.ResolveParams) (interface{}, error) {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		percentile, ok := p.Source.(aggregation.Percentile)
+		if !ok {
+			return nil, fmt.Errorf("percentile: expected aggregation.Percentile, got %T", p.Source)
+		}
+
+		return extractor(percentile), nil
+	}
+}
+
+func cardinalityField(class *models.Class, property *models.Property,
+	prefix string,
+) *graphql.Field {
+	return &graphql.Field{
+		Name:        fmt.Sprintf("%s%s%sCardinality", prefix, class.Class, property.Name),
+		Description: descriptions.AggregateCardinality,
+		Type:        graphql.Int,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			prop, ok := p.Source.(aggregation.Property)
+			if !ok {
+				return nil, fmt.Errorf("cardinality: expected aggregation.Property, got %T", p.Source)
+			}
+
+			if prop.CardinalityAggregation == nil {
+				return nil, nil
+			}
+			return prop.CardinalityAggregation.Value, nil
+		},
+	}
+}
+
+// extractPercentiles returns the percentiles requested on a property, if any
+func extractPercentiles(selections *ast.SelectionSet) ([]float64, error) {
+	if selections == nil {
+		return nil, nil
+	}
+
+	for _, selection := range selections.Selections {
+		field := selection.(*ast.Field)
+		if field.Name.Value != percentilesFieldName {
+			continue
+		}
+
+		for _, arg := range field.Arguments {
+			if arg.Name.Value != "values" {
+				continue
+			}
+
+			list, ok := arg.Value.(*ast.ListValue)
+			if !ok {
+				return nil, fmt.Errorf("percentiles: values must be a list")
+			}
+
+			percentiles := make([]float64, len(list.Values))
+			for i, value := range list.Values {
+				asString, ok := value.GetValue().(string)
+				if !ok {
+					return nil, fmt.Errorf("percentiles: values must be numbers")
+				}
+				percentile, err := strconv.ParseFloat(asString, 64)
+				if err != nil {
+					return nil, fmt.Errorf("percentiles: %w", err)
+				}
+				if percentile < 0 || percentile > 100 {
+					return nil, fmt.Errorf("percentiles: %v is not between 0 and 100",
+						percentile)
+				}
+				percentiles[i] = percentile
+			}
+
+			return percentiles, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/tailor-inc/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/searchparams"
 )
 
 func NearVectorArgument(argumentPrefix, className string) *graphql.ArgumentConfig {
@@ -80,3 +81,55 @@ func nearObjectFields(prefix string) graphql.InputObjectConfigFieldMap {
 		},
 	}
 }
+
+func RecommendArgument(argumentPrefix, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("%s%s", argumentPrefix, className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.Recommend,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sRecommendInpObj", prefix),
+				Fields: recommendFields(prefix),
+			},
+		),
+	}
+}
+
+func recommendFields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"positive": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RecommendPositive,
+			Type:        graphql.NewList(graphql.String),
+		},
+		"negative": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RecommendNegative,
+			Type:        graphql.NewList(graphql.String),
+		},
+		"positiveVectors": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RecommendPositiveVectors,
+			Type:        graphql.NewList(graphql.NewList(graphql.Float)),
+		},
+		"negativeVectors": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RecommendNegativeVectors,
+			Type:        graphql.NewList(graphql.NewList(graphql.Float)),
+		},
+		"strategy": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RecommendStrategy,
+			Type: graphql.NewEnum(graphql.EnumConfig{
+				Name: fmt.Sprintf("%sRecommendInpObjStrategyEnum", prefix),
+				Values: graphql.EnumValueConfigMap{
+					searchparams.RecommendStrategyCentroid:  &graphql.EnumValueConfig{},
+					searchparams.RecommendStrategyBestScore: &graphql.EnumValueConfig{},
+				},
+			}),
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+		"distance": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Distance,
+			Type:        graphql.Float,
+		},
+	}
+}
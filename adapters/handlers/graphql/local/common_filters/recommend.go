@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// ExtractRecommend arguments, such as "positive" and "strategy"
+func ExtractRecommend(source map[string]interface{}) (searchparams.Recommend, error) {
+	var args searchparams.Recommend
+
+	args.Positive = extractStrings(source["positive"])
+	args.Negative = extractStrings(source["negative"])
+	args.PositiveVectors = extractVectors(source["positiveVectors"])
+	args.NegativeVectors = extractVectors(source["negativeVectors"])
+
+	if strategy, ok := source["strategy"].(string); ok {
+		args.Strategy = strategy
+	}
+
+	certainty, certaintyOK := source["certainty"]
+	if certaintyOK {
+		args.Certainty = certainty.(float64)
+	}
+
+	distance, distanceOK := source["distance"]
+	if distanceOK {
+		args.Distance = distance.(float64)
+		args.WithDistance = true
+	}
+
+	if certaintyOK && distanceOK {
+		return searchparams.Recommend{},
+			fmt.Errorf("cannot provide distance and certainty")
+	}
+
+	return args, nil
+}
+
+func extractStrings(source interface{}) []string {
+	list, ok := source.([]interface{})
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(list))
+	for _, value := range list {
+		if s, ok := value.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func extractVectors(source interface{}) [][]float32 {
+	list, ok := source.([]interface{})
+	if !ok {
+		return nil
+	}
+	out := make([][]float32, 0, len(list))
+	for _, value := range list {
+		values, ok := value.([]interface{})
+		if !ok {
+			continue
+		}
+		vector := make([]float32, len(values))
+		for i, v := range values {
+			f, _ := v.(float64)
+			vector[i] = float32(f)
+		}
+		out = append(out, vector)
+	}
+	return out
+}
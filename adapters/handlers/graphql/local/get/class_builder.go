@@ -75,6 +75,13 @@ func (b *classBuilder) kinds(kindSchema *models.Schema) (*graphql.Object, error)
 		classFields[class.Class] = classField
 	}
 
+	// an alias is queried exactly like the class it points to
+	for alias, class := range kindSchema.Aliases {
+		if classField, ok := classFields[class]; ok {
+			classFields[alias] = classField
+		}
+	}
+
 	classes := graphql.NewObject(graphql.ObjectConfig{
 		Name:        "GetObjectsObj",
 		Fields:      classFields,
@@ -119,6 +126,9 @@ func (b *classBuilder) classObject(class *models.Class) *graphql.Object {
 				if propertyType.IsPrimitive() {
 					classProperties[property.Name] = b.primitiveField(propertyType, property,
 						class.Class)
+				} else if propertyType.IsNested() {
+					classProperties[property.Name] = b.nestedField(propertyType, property,
+						class.Class)
 				} else {
 					classProperties[property.Name] = b.referenceField(propertyType, property,
 						class.Class)
@@ -145,6 +155,8 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
 	additionalProperties["group"] = b.additionalGroupField(classProperties, class)
+	additionalProperties["facets"] = b.additionalFacetsField(class)
+	additionalProperties["highlight"] = b.additionalHighlightField(class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = b.isConsistentField()
 	}
@@ -284,3 +296,24 @@ func (b *classBuilder) additionalGroupField(classProperties graphql.Fields, clas
 		}),
 	}
 }
+
+func (b *classBuilder) additionalFacetsField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.Facets,
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalFacets", class.Class),
+			Fields: graphql.Fields{
+				"property": &graphql.Field{Type: graphql.String},
+				"values": &graphql.Field{
+					Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+						Name: fmt.Sprintf("%sAdditionalFacetsValue", class.Class),
+						Fields: graphql.Fields{
+							"value": &graphql.Field{Type: graphql.String},
+							"count": &graphql.Field{Type: graphql.Int},
+						},
+					})),
+				},
+			},
+		})),
+	}
+}
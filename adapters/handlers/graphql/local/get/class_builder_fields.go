@@ -29,6 +29,8 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 )
 
 func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
@@ -137,6 +139,62 @@ func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
 	}
 }
 
+func (b *classBuilder) nestedField(propertyType schema.PropertyDataType,
+	property *models.Property, className string,
+) *graphql.Field {
+	return &graphql.Field{
+		Description: property.Description,
+		Name:        property.Name,
+		Type: b.nestedFieldType(propertyType.AsNested(),
+			className+cases.Title(language.Und, cases.NoLower).String(property.Name), property.NestedProperties, className),
+	}
+}
+
+// nestedFieldType builds the graphql type of an object or object[] property.
+// Each nested object gets its own type named after the class and the path of
+// the property, e.g. PersonAddressObject for Person.address.
+func (b *classBuilder) nestedFieldType(dataType schema.DataType, typeName string,
+	nestedProps []*models.NestedProperty, className string,
+) graphql.Output {
+	obj := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%sObject", typeName),
+		Fields: (graphql.FieldsThunk)(func() graphql.Fields {
+			fields := graphql.Fields{}
+			for _, nestedProp := range nestedProps {
+				property := &models.Property{
+					Name:        nestedProp.Name,
+					DataType:    nestedProp.DataType,
+					Description: nestedProp.Description,
+				}
+
+				propertyType, err := b.schema.FindPropertyDataType(nestedProp.DataType)
+				if err != nil {
+					// We can't return an error in this FieldsThunk function, so we need to panic
+					panic(fmt.Sprintf("buildGetClass: wrong nested propertyType for %s.%s; %s",
+						typeName, nestedProp.Name, err.Error()))
+				}
+
+				if propertyType.IsNested() {
+					fields[nestedProp.Name] = &graphql.Field{
+						Description: nestedProp.Description,
+						Name:        nestedProp.Name,
+						Type: b.nestedFieldType(propertyType.AsNested(),
+							typeName+cases.Title(language.Und, cases.NoLower).String(nestedProp.Name), nestedProp.NestedProperties, className),
+					}
+					continue
+				}
+				fields[nestedProp.Name] = b.primitiveField(propertyType, property, className)
+			}
+			return fields
+		}),
+	})
+
+	if dataType == schema.DataTypeObjectArray {
+		return graphql.NewList(obj)
+	}
+	return obj
+}
+
 func newGeoCoordinatesObject(className string, propertyName string) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
 		Description: "GeoCoordinates as latitude and longitude in decimal form",
@@ -223,9 +281,12 @@ func buildGetClassField(classObject *graphql.Object,
 			"sort":       sortArgument(class.Class),
 			"nearVector": nearVectorArgument(class.Class),
 			"nearObject": nearObjectArgument(class.Class),
+			"recommend":  recommendArgument(class.Class),
 			"where":      whereArgument(class.Class),
 			"group":      groupArgument(class.Class),
 			"groupBy":    groupByArgument(class.Class),
+			"facets":     facetsArgument(class.Class),
+			"mmr":        mmrArgument(class.Class),
 		},
 		Resolve: newResolver(modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -369,6 +430,15 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			nearObjectParams = &p
 		}
 
+		var recommendParams *searchparams.Recommend
+		if recommend, ok := p.Args["recommend"]; ok {
+			p, err := common_filters.ExtractRecommend(recommend.(map[string]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("failed to extract recommend params: %s", err)
+			}
+			recommendParams = &p
+		}
+
 		var moduleParams map[string]interface{}
 		if r.modulesProvider != nil {
 			extractedParams := r.modulesProvider.ExtractSearchParams(p.Args, className)
@@ -414,6 +484,16 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			groupByParams = &p
 		}
 
+		var facetsParams *searchparams.Facets
+		if facets, ok := p.Args["facets"]; ok {
+			facetsParams = extractFacets(facets.(map[string]interface{}))
+		}
+
+		var mmrParams *searchparams.MMR
+		if mmr, ok := p.Args["mmr"]; ok {
+			mmrParams = extractMMR(mmr.(map[string]interface{}))
+		}
+
 		params := dto.GetParams{
 			Filters:               filters,
 			ClassName:             className,
@@ -423,6 +503,7 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			Sort:                  sort,
 			NearVector:            nearVectorParams,
 			NearObject:            nearObjectParams,
+			Recommend:             recommendParams,
 			Group:                 group,
 			ModuleParams:          moduleParams,
 			AdditionalProperties:  addlProps,
@@ -430,6 +511,8 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			HybridSearch:          hybridParams,
 			ReplicationProperties: replProps,
 			GroupBy:               groupByParams,
+			Facets:                facetsParams,
+			MMR:                   mmrParams,
 		}
 
 		// need to perform vector search by distance
@@ -474,6 +557,12 @@ func setLimitBasedOnVectorSearchParams(params *dto.GetParams) {
 		return
 	}
 
+	if params.Recommend != nil &&
+		(params.Recommend.Certainty != 0 || params.Recommend.WithDistance) {
+		setLimit(params)
+		return
+	}
+
 	for _, param := range params.ModuleParams {
 		nearParam, ok := param.(modulecapabilities.NearParam)
 		if ok && nearParam.SimilarityMetricProvided() {
@@ -526,6 +615,25 @@ func isPrimitive(selectionSet *ast.SelectionSet) bool {
 	return false
 }
 
+// isNestedObject checks whether the selection set selects the fields of an
+// object or object[] property. Cross-refs only ever select inline fragments
+// and __typename directly.
+func isNestedObject(selectionSet *ast.SelectionSet) bool {
+	if selectionSet == nil {
+		return false
+	}
+
+	for _, subSelection := range selectionSet.Selections {
+		if subsectionField, ok := subSelection.(*ast.Field); ok {
+			if subsectionField.Name.Value != "__typename" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 type additionalCheck struct {
 	modulesProvider ModulesProvider
 }
@@ -535,7 +643,7 @@ func (ac *additionalCheck) isAdditional(name string) bool {
 		name == "distance" || name == "id" || name == "vector" ||
 		name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 		name == "score" || name == "explainScore" || name == "isConsistent" ||
-		name == "group" {
+		name == "group" || name == "facets" || name == "highlight" {
 		return true
 	}
 	if ac.isModuleAdditional(name) {
@@ -584,7 +692,8 @@ func extractProperties(className string, selections *ast.SelectionSet,
 		name := field.Name.Value
 		property := search.SelectProperty{Name: name}
 
-		property.IsPrimitive = isPrimitive(field.SelectionSet)
+		property.IsPrimitive = isPrimitive(field.SelectionSet) ||
+			(name != "_additional" && isNestedObject(field.SelectionSet))
 		if !property.IsPrimitive {
 			// We can interpret this property in different ways
 			for _, subSelection := range field.SelectionSet.Selections {
@@ -636,6 +745,10 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.IsConsistent = true
 							continue
 						}
+						if additionalProperty == "highlight" {
+							additionalProps.Highlight = extractHighlight(s.Arguments)
+							continue
+						}
 						if additionalProperty == "group" {
 							additionalProps.Group = true
 							additionalGroupHitProperties, err := extractGroupHitProperties(className, additionalProps, subSelection, fragments, modulesProvider)
@@ -28,6 +28,10 @@ func nearObjectArgument(className string) *graphql.ArgumentConfig {
 	return common_filters.NearObjectArgument("GetObjects", className)
 }
 
+func recommendArgument(className string) *graphql.ArgumentConfig {
+	return common_filters.RecommendArgument("GetObjects", className)
+}
+
 func nearTextFields(prefix string) graphql.InputObjectConfigFieldMap {
 	nearTextFields := graphql.InputObjectConfigFieldMap{
 		"concepts": &graphql.InputObjectFieldConfig{
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func facetsArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sFacetsInpObj", prefix),
+				Fields:      facetsFields(),
+				Description: descriptions.Facets,
+			},
+		),
+	}
+}
+
+func facetsFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"properties": &graphql.InputObjectFieldConfig{
+			Description: descriptions.FacetsProperties,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.String)),
+		},
+		"limit": &graphql.InputObjectFieldConfig{
+			Description: descriptions.FacetsLimit,
+			Type:        graphql.Int,
+		},
+	}
+}
+
+func extractFacets(source map[string]interface{}) *searchparams.Facets {
+	var args searchparams.Facets
+	if properties, ok := source["properties"].([]interface{}); ok {
+		for _, prop := range properties {
+			if name, ok := prop.(string); ok {
+				args.Properties = append(args.Properties, name)
+			}
+		}
+	}
+	if limit, ok := source["limit"].(int); ok {
+		args.Limit = limit
+	}
+	return &args
+}
@@ -2273,6 +2273,206 @@ func TestGroupBy(t *testing.T) {
 	}
 }
 
+func TestGetWithFacets(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("facets with a limit", func(t *testing.T) {
+		query := `{ Get {
+			SomeAction(
+				facets:{properties: ["intField", "uuidField"] limit: 5}
+			) {
+				_additional{facets{property values{value count}}}
+			} } }`
+
+		expectedParams := dto.GetParams{
+			ClassName: "SomeAction",
+			Facets:    &searchparams.Facets{Properties: []string{"intField", "uuidField"}, Limit: 5},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("facets without a limit", func(t *testing.T) {
+		query := `{ Get {
+			SomeAction(
+				facets:{properties: ["intField"]}
+			) {
+				_additional{facets{property values{value count}}}
+			} } }`
+
+		expectedParams := dto.GetParams{
+			ClassName: "SomeAction",
+			Facets:    &searchparams.Facets{Properties: []string{"intField"}},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
+func TestGetWithRecommend(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("recommend with ids and vectors", func(t *testing.T) {
+		query := `{ Get {
+			SomeAction(
+				recommend:{
+					positive: ["e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf"]
+					negativeVectors: [[0.1, 0.2]]
+					strategy: bestScore
+					distance: 0.4
+				}
+			) {
+				intField
+			} } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+			Recommend: &searchparams.Recommend{
+				Positive:        []string{"e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf"},
+				NegativeVectors: [][]float32{{0.1, 0.2}},
+				Strategy:        searchparams.RecommendStrategyBestScore,
+				Distance:        0.4,
+				WithDistance:    true,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("recommend with distance and certainty", func(t *testing.T) {
+		query := `{ Get {
+			SomeAction(
+				recommend:{positiveVectors: [[0.1, 0.2]] distance: 0.4 certainty: 0.6}
+			) {
+				intField
+			} } }`
+
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
+func TestGetWithMMR(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("mmr with all arguments", func(t *testing.T) {
+		query := `{ Get {
+			SomeAction(
+				nearVector:{vector: [0.1, 0.2]} limit: 5
+				mmr:{lambda: 0.8 fetchLimit: 50}
+			) {
+				intField
+			} } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{Limit: 5},
+			NearVector: &searchparams.NearVector{Vector: []float32{0.1, 0.2}},
+			MMR:        &searchparams.MMR{Lambda: 0.8, FetchLimit: 50},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("mmr without arguments", func(t *testing.T) {
+		query := `{ Get {
+			SomeAction(
+				nearVector:{vector: [0.1, 0.2]} limit: 5
+				mmr:{}
+			) {
+				intField
+			} } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{Limit: 5},
+			NearVector: &searchparams.NearVector{Vector: []float32{0.1, 0.2}},
+			MMR:        &searchparams.MMR{Lambda: searchparams.DefaultMMRLambda},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
+func TestGetWithHighlight(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("highlight with all arguments", func(t *testing.T) {
+		query := `{ Get {
+			SomeAction(
+				bm25:{query: "apple"}
+			) {
+				_additional{highlight(properties: ["name"], fragmentSize: 50, preTag: "[", postTag: "]"){property fragments}}
+			} } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:      "SomeAction",
+			KeywordRanking: &searchparams.KeywordRanking{Query: "apple", Type: "bm25"},
+			AdditionalProperties: additional.Properties{
+				Highlight: &additional.HighlightParams{
+					Properties:   []string{"name"},
+					FragmentSize: 50,
+					PreTag:       "[",
+					PostTag:      "]",
+				},
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("highlight without arguments", func(t *testing.T) {
+		query := `{ Get {
+			SomeAction(
+				bm25:{query: "apple"}
+			) {
+				_additional{highlight{property fragments}}
+			} } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:      "SomeAction",
+			KeywordRanking: &searchparams.KeywordRanking{Query: "apple", Type: "bm25"},
+			AdditionalProperties: additional.Properties{
+				Highlight: &additional.HighlightParams{},
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func ptFloat32(in float32) *float32 {
 	return &in
 }
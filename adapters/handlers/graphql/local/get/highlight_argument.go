@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func (b *classBuilder) additionalHighlightField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.Highlight,
+		Args: graphql.FieldConfigArgument{
+			"properties": &graphql.ArgumentConfig{
+				Description: descriptions.HighlightProperties,
+				Type:        graphql.NewList(graphql.String),
+			},
+			"fragmentSize": &graphql.ArgumentConfig{
+				Description: descriptions.HighlightFragmentSize,
+				Type:        graphql.Int,
+			},
+			"preTag": &graphql.ArgumentConfig{
+				Description: descriptions.HighlightPreTag,
+				Type:        graphql.String,
+			},
+			"postTag": &graphql.ArgumentConfig{
+				Description: descriptions.HighlightPostTag,
+				Type:        graphql.String,
+			},
+		},
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalHighlight", class.Class),
+			Fields: graphql.Fields{
+				"property":  &graphql.Field{Type: graphql.String},
+				"fragments": &graphql.Field{Type: graphql.NewList(graphql.String)},
+			},
+		})),
+	}
+}
+
+func extractHighlight(args []*ast.Argument) *additional.HighlightParams {
+	out := &additional.HighlightParams{}
+
+	for _, arg := range args {
+		switch arg.Name.Value {
+		case "properties":
+			if values, ok := arg.Value.GetValue().([]ast.Value); ok {
+				for _, value := range values {
+					if prop, ok := value.GetValue().(string); ok {
+						out.Properties = append(out.Properties, prop)
+					}
+				}
+			}
+		case "fragmentSize":
+			if asString, ok := arg.Value.GetValue().(string); ok {
+				out.FragmentSize, _ = strconv.Atoi(asString)
+			}
+		case "preTag":
+			out.PreTag, _ = arg.Value.GetValue().(string)
+		case "postTag":
+			out.PostTag, _ = arg.Value.GetValue().(string)
+		default:
+			// ignore what we don't recognize
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func mmrArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sMMRInpObj", prefix),
+				Fields:      mmrFields(),
+				Description: descriptions.MMR,
+			},
+		),
+	}
+}
+
+func mmrFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"lambda": &graphql.InputObjectFieldConfig{
+			Description: descriptions.MMRLambda,
+			Type:        graphql.Float,
+		},
+		"fetchLimit": &graphql.InputObjectFieldConfig{
+			Description: descriptions.MMRFetchLimit,
+			Type:        graphql.Int,
+		},
+	}
+}
+
+func extractMMR(source map[string]interface{}) *searchparams.MMR {
+	args := searchparams.MMR{Lambda: searchparams.DefaultMMRLambda}
+	if lambda, ok := source["lambda"].(float64); ok {
+		args.Lambda = lambda
+	}
+	if fetchLimit, ok := source["fetchLimit"].(int); ok {
+		args.FetchLimit = fetchLimit
+	}
+	return &args
+}
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/audit"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// auditVerbs maps the methods of the Weaviate service to the verbs used by
+// the authorizer
+var auditVerbs = map[string]string{
+	"Search":      "get",
+	"BatchSearch": "get",
+	"Export":      "list",
+	"Import":      "create",
+}
+
+// newAuditRecord starts the record of a call. The principal is resolved by
+// the handler itself and annotated through the context.
+func newAuditRecord(fullMethod string) *audit.Record {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	verb, ok := auditVerbs[method]
+	if !ok {
+		// unknown methods are treated as mutating, so they are always recorded
+		verb = "create"
+	}
+
+	return &audit.Record{
+		Time:      time.Now(),
+		Protocol:  audit.ProtocolGRPC,
+		Operation: method,
+		Verb:      verb,
+		Resource:  strings.TrimPrefix(fullMethod, "/"),
+	}
+}
+
+func finishAuditRecord(auditor *audit.Auditor, rec *audit.Record, err error) {
+	code := status.Code(err)
+	rec.Status = int(code)
+
+	var forbidden autherrs.Forbidden
+	switch {
+	case code == codes.OK:
+		rec.Result = audit.ResultSuccess
+	case code == codes.Unauthenticated, code == codes.PermissionDenied,
+		errors.As(err, &forbidden):
+		rec.Result = audit.ResultDenied
+	default:
+		rec.Result = audit.ResultFailure
+		rec.SetError(err.Error())
+	}
+	auditor.Log(rec)
+}
+
+func makeAuditUnaryInterceptor(auditor *audit.Auditor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		rec := newAuditRecord(info.FullMethod)
+		res, err := handler(audit.NewContext(ctx, rec), req)
+		finishAuditRecord(auditor, rec, err)
+		return res, err
+	}
+}
+
+func makeAuditStreamInterceptor(auditor *audit.Auditor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		rec := newAuditRecord(info.FullMethod)
+		err := handler(srv, &auditedStream{
+			ServerStream: ss,
+			ctx:          audit.NewContext(ss.Context(), rec),
+		})
+		finishAuditRecord(auditor, rec, err)
+		return err
+	}
+}
+
+// auditedStream carries the audit record in the context of the stream
+type auditedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *auditedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"google.golang.org/grpc"
+)
+
+func TestAuditUnaryInterceptor(t *testing.T) {
+	principal := &models.Principal{Username: "jane"}
+
+	tests := []struct {
+		name           string
+		method         string
+		handlerErr     error
+		expectedVerb   string
+		expectedResult string
+	}{
+		{
+			name:           "successful import",
+			method:         "/weaviategrpc.Weaviate/Import",
+			expectedVerb:   "create",
+			expectedResult: audit.ResultSuccess,
+		},
+		{
+			name:           "forbidden import",
+			method:         "/weaviategrpc.Weaviate/Import",
+			handlerErr:     autherrs.NewForbidden(principal, "create", "batch/objects/Article"),
+			expectedVerb:   "create",
+			expectedResult: audit.ResultDenied,
+		},
+		{
+			name:           "failed search",
+			method:         "/weaviategrpc.Weaviate/Search",
+			handlerErr:     errors.New("shard not found"),
+			expectedVerb:   "get",
+			expectedResult: audit.ResultFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := test.NewNullLogger()
+			sink := &fakeAuditSink{}
+			auditor := audit.New(sink, true, logger)
+
+			interceptor := makeAuditUnaryInterceptor(auditor)
+			_, err := interceptor(context.Background(), nil,
+				&grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					audit.AnnotatePrincipal(ctx, principal)
+					audit.AnnotateClasses(ctx, "Article")
+					return nil, tt.handlerErr
+				})
+			assert.Equal(t, tt.handlerErr, err)
+			require.Nil(t, auditor.Close())
+
+			require.Len(t, sink.records, 1)
+			rec := sink.records[0]
+			assert.Equal(t, audit.ProtocolGRPC, rec.Protocol)
+			assert.Equal(t, "jane", rec.Principal)
+			assert.Equal(t, tt.expectedVerb, rec.Verb)
+			assert.Equal(t, tt.method[1:], rec.Resource)
+			assert.Equal(t, []string{"Article"}, rec.Classes)
+			assert.Equal(t, tt.expectedResult, rec.Result)
+		})
+	}
+}
+
+type fakeAuditSink struct {
+	sync.Mutex
+	records []*audit.Record
+}
+
+func (f *fakeAuditSink) Write(rec *audit.Record) error {
+	f.Lock()
+	defer f.Unlock()
+	f.records = append(f.records, rec)
+	return nil
+}
+
+func (f *fakeAuditSink) Close() error {
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -25,6 +26,16 @@ import (
 // should be called from a central place. This way we can make sure it's
 // impossible to forget to add it to a new endpoint.
 func (s *Server) principalFromContext(ctx context.Context) (*models.Principal, error) {
+	principal, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	audit.AnnotatePrincipal(ctx, principal)
+	return principal, nil
+}
+
+func (s *Server) authenticate(ctx context.Context) (*models.Principal, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return s.tryAnonymous()
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// exportBatchSize is the number of objects sent per export reply
+const exportBatchSize = 100
+
+func (s *Server) Export(req *pb.ExportRequest, stream pb.Weaviate_ExportServer) error {
+	ctx := stream.Context()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	audit.AnnotateClasses(ctx, req.ClassName)
+
+	params := objects.ExportParams{
+		Class:         req.ClassName,
+		IncludeVector: req.IncludeVector == nil || *req.IncludeVector,
+	}
+	if req.Where != "" {
+		params.Where = &models.WhereFilter{}
+		if err := json.Unmarshal([]byte(req.Where), params.Where); err != nil {
+			return fmt.Errorf("invalid where filter: %w", err)
+		}
+	}
+
+	batch := make([]*pb.ExportedObject, 0, exportBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := stream.Send(&pb.ExportReply{Objects: batch})
+		batch = make([]*pb.ExportedObject, 0, exportBatchSize)
+		return err
+	}
+
+	rerr := s.objectsManager.Export(ctx, principal, params, func(obj *models.Object) error {
+		out, err := exportedObjectToProto(obj)
+		if err != nil {
+			return err
+		}
+		batch = append(batch, out)
+		if len(batch) == exportBatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if rerr != nil {
+		return rerr
+	}
+
+	return flush()
+}
+
+func (s *Server) Import(stream pb.Weaviate_ImportServer) error {
+	ctx := stream.Context()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("receive first import request: %w", err)
+	}
+
+	audit.AnnotateClasses(ctx, first.ClassName)
+
+	params := objects.ImportParams{
+		Class:           first.ClassName,
+		PropertyMapping: first.PropertyMapping,
+	}
+	if first.ConsistencyLevel != "" {
+		switch cl := replica.ConsistencyLevel(first.ConsistencyLevel); cl {
+		case replica.One, replica.Quorum, replica.All:
+			params.Replication = &additional.ReplicationProperties{
+				ConsistencyLevel: string(cl),
+			}
+		default:
+			return fmt.Errorf("unrecognized consistency level %q, "+
+				"try one of the following: ['ONE', 'QUORUM', 'ALL']", first.ConsistencyLevel)
+		}
+	}
+
+	pending := first.Objects
+	next := func() (*models.Object, error) {
+		for len(pending) == 0 {
+			req, err := stream.Recv()
+			if err != nil {
+				// io.EOF is passed on as is and ends the import
+				return nil, err
+			}
+			pending = req.Objects
+		}
+		obj := pending[0]
+		pending = pending[1:]
+		return exportedObjectFromProto(obj), nil
+	}
+
+	res, err := s.batchManager.Import(ctx, principal, params, next)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&pb.ImportReply{
+		ClassName: params.Class,
+		Imported:  res.Imported,
+		Failed:    res.Failed,
+		Errors:    res.Errors,
+	})
+}
+
+func exportedObjectToProto(obj *models.Object) (*pb.ExportedObject, error) {
+	// properties can hold non-JSON types such as geo coordinates or
+	// references, a JSON round trip turns them into their REST shape
+	propsJSON, err := json.Marshal(obj.Properties)
+	if err != nil {
+		return nil, fmt.Errorf("marshal properties of object %s: %w", obj.ID, err)
+	}
+	props := &structpb.Struct{}
+	if obj.Properties != nil {
+		if err := protojson.Unmarshal(propsJSON, props); err != nil {
+			return nil, fmt.Errorf("convert properties of object %s: %w", obj.ID, err)
+		}
+	}
+
+	return &pb.ExportedObject{
+		Id:                 obj.ID.String(),
+		ClassName:          obj.Class,
+		CreationTimeUnix:   obj.CreationTimeUnix,
+		LastUpdateTimeUnix: obj.LastUpdateTimeUnix,
+		Properties:         props,
+		Vector:             obj.Vector,
+	}, nil
+}
+
+func exportedObjectFromProto(in *pb.ExportedObject) *models.Object {
+	obj := &models.Object{
+		ID:                 strfmt.UUID(in.Id),
+		Class:              in.ClassName,
+		CreationTimeUnix:   in.CreationTimeUnix,
+		LastUpdateTimeUnix: in.LastUpdateTimeUnix,
+	}
+	if len(in.Vector) > 0 {
+		obj.Vector = in.Vector
+	}
+	if in.Properties != nil {
+		obj.Properties = in.Properties.AsMap()
+	}
+	return obj
+}
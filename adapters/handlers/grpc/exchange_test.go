@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestExportedObjectConversion(t *testing.T) {
+	obj := &models.Object{
+		ID:                 "7c8183ae-150d-433f-92b6-ed095b000001",
+		Class:              "Article",
+		CreationTimeUnix:   1000,
+		LastUpdateTimeUnix: 2000,
+		Properties: map[string]interface{}{
+			"title":     "first",
+			"wordCount": float64(120),
+			"location": &models.GeoCoordinates{
+				Latitude:  ptFloat32(1.5),
+				Longitude: ptFloat32(2.5),
+			},
+		},
+		Vector: []float32{0.1, 0.2},
+	}
+
+	out, err := exportedObjectToProto(obj)
+	require.Nil(t, err)
+
+	back := exportedObjectFromProto(out)
+	assert.Equal(t, obj.ID, back.ID)
+	assert.Equal(t, obj.Class, back.Class)
+	assert.Equal(t, obj.CreationTimeUnix, back.CreationTimeUnix)
+	assert.Equal(t, obj.LastUpdateTimeUnix, back.LastUpdateTimeUnix)
+	assert.Equal(t, obj.Vector, back.Vector)
+	assert.Equal(t, map[string]interface{}{
+		"title":     "first",
+		"wordCount": float64(120),
+		"location": map[string]interface{}{
+			"latitude":  float64(1.5),
+			"longitude": float64(2.5),
+		},
+	}, back.Properties)
+}
+
+func ptFloat32(in float32) *float32 {
+	return &in
+}
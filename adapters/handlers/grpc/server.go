@@ -23,11 +23,14 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	pb "github.com/weaviate/weaviate/grpc"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/traverser"
 	"google.golang.org/grpc"
 )
@@ -38,9 +41,17 @@ func StartAndListen(state *state.State) error {
 	if err != nil {
 		return err
 	}
-	s := grpc.NewServer()
+	var opts []grpc.ServerOption
+	if state.Auditor != nil {
+		opts = append(opts,
+			grpc.UnaryInterceptor(makeAuditUnaryInterceptor(state.Auditor)),
+			grpc.StreamInterceptor(makeAuditStreamInterceptor(state.Auditor)))
+	}
+	s := grpc.NewServer(opts...)
 	pb.RegisterWeaviateServer(s, &Server{
-		traverser: state.Traverser,
+		traverser:      state.Traverser,
+		objectsManager: state.ObjectsManager,
+		batchManager:   state.BatchObjectsManager,
 		authComposer: composer.New(
 			state.ServerConfig.Config.Authentication,
 			state.APIKey, state.OIDC),
@@ -59,6 +70,8 @@ func StartAndListen(state *state.State) error {
 type Server struct {
 	pb.UnimplementedWeaviateServer
 	traverser            *traverser.Traverser
+	objectsManager       *objects.Manager
+	batchManager         *objects.BatchManager
 	authComposer         composer.TokenFunc
 	allowAnonymousAccess bool
 }
@@ -83,6 +96,54 @@ func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchR
 	return searchResultsToProto(res, before, searchParams), nil
 }
 
+func (s *Server) BatchSearch(ctx context.Context, req *pb.BatchSearchRequest) (*pb.BatchSearchReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	if err := traverser.ValidateBatchGetSize(len(req.Requests)); err != nil {
+		return nil, err
+	}
+
+	out := &pb.BatchSearchReply{Results: make([]*pb.BatchSearchResult, len(req.Requests))}
+
+	// requests with invalid params fail on their own, the others are
+	// still executed
+	var batch []dto.GetParams
+	var positions []int
+	for i, searchReq := range req.Requests {
+		searchParams, err := searchParamsFromProto(searchReq)
+		if err != nil {
+			out.Results[i] = &pb.BatchSearchResult{Error: fmt.Sprintf("extract params: %v", err)}
+			continue
+		}
+		batch = append(batch, searchParams)
+		positions = append(positions, i)
+	}
+
+	if len(batch) > 0 {
+		res, err := s.traverser.BatchGetClass(ctx, principal, batch)
+		if err != nil {
+			return nil, err
+		}
+		for i, r := range res {
+			if r.Err != nil {
+				out.Results[positions[i]] = &pb.BatchSearchResult{Error: r.Err.Error()}
+				continue
+			}
+			out.Results[positions[i]] = &pb.BatchSearchResult{
+				Reply: searchResultsToProto(r.Results, before, batch[i]),
+			}
+		}
+	}
+
+	out.Took = float32(float64(time.Since(before)) / float64(time.Second))
+	return out, nil
+}
+
 func searchResultsToProto(res []any, start time.Time, searchParams dto.GetParams) *pb.SearchReply {
 	tookSeconds := float64(time.Since(start)) / float64(time.Second)
 	out := &pb.SearchReply{
@@ -128,9 +189,61 @@ func searchResultsToProto(res []any, start time.Time, searchParams dto.GetParams
 			result.AdditionalProperties.Id = idStrfmt.String()
 		}
 
+		if searchParams.AdditionalProperties.Highlight != nil {
+			result.AdditionalProperties.Highlights = highlightsToProto(asMap)
+		}
+
 		out.Results[i] = result
 	}
 
+	// facets are computed over the whole result set and attached to every
+	// result, so the first one is enough
+	if searchParams.Facets != nil && len(res) > 0 {
+		out.Facets = facetsToProto(res[0])
+	}
+
+	return out
+}
+
+func facetsToProto(raw any) []*pb.Facet {
+	asMap, ok := raw.(map[string]any)
+	if !ok {
+		return nil
+	}
+	additionalProps, ok := asMap["_additional"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	facets, ok := additionalProps["facets"].([]search.Facet)
+	if !ok {
+		return nil
+	}
+
+	out := make([]*pb.Facet, len(facets))
+	for i, facet := range facets {
+		values := make([]*pb.FacetValue, len(facet.Values))
+		for j, value := range facet.Values {
+			values[j] = &pb.FacetValue{Value: value.Value, Count: int64(value.Count)}
+		}
+		out[i] = &pb.Facet{Property: facet.Property, Values: values}
+	}
+	return out
+}
+
+func highlightsToProto(asMap map[string]any) []*pb.Highlight {
+	additionalProps, ok := asMap["_additional"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	highlights, ok := additionalProps["highlight"].([]additional.Highlight)
+	if !ok {
+		return nil
+	}
+
+	out := make([]*pb.Highlight, len(highlights))
+	for i, highlight := range highlights {
+		out[i] = &pb.Highlight{Property: highlight.Property, Fragments: highlight.Fragments}
+	}
 	return out
 }
 
@@ -206,6 +319,82 @@ func searchParamsFromProto(req *pb.SearchRequest) (dto.GetParams, error) {
 		}
 	}
 
+	if rec := req.Recommend; rec != nil {
+		out.Recommend = &searchparams.Recommend{
+			Positive:        rec.Positive,
+			Negative:        rec.Negative,
+			PositiveVectors: recommendVectorsFromProto(rec.PositiveVectors),
+			NegativeVectors: recommendVectorsFromProto(rec.NegativeVectors),
+			Strategy:        rec.Strategy,
+		}
+
+		if rec.Distance != nil && rec.Certainty != nil {
+			return out, fmt.Errorf("recommend: cannot provide distance and certainty")
+		}
+
+		if rec.Certainty != nil {
+			out.Recommend.Certainty = *rec.Certainty
+		}
+
+		if rec.Distance != nil {
+			out.Recommend.Distance = *rec.Distance
+			out.Recommend.WithDistance = true
+		}
+	}
+
+	if bm25 := req.Bm25Search; bm25 != nil {
+		out.KeywordRanking = &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Query:      bm25.Query,
+			Properties: bm25.Properties,
+		}
+	}
+
+	if hs := req.HybridSearch; hs != nil {
+		out.HybridSearch = &searchparams.HybridSearch{
+			Type:       "hybrid",
+			Query:      hs.Query,
+			Properties: hs.Properties,
+			Vector:     hs.Vector,
+			Alpha:      config.DefaultAlpha,
+		}
+		if hs.Alpha != nil {
+			if *hs.Alpha < 0 || *hs.Alpha > 1 {
+				return out, fmt.Errorf("hybrid_search: alpha should be between 0.0 and 1.0")
+			}
+			out.HybridSearch.Alpha = *hs.Alpha
+		}
+	}
+
+	if h := req.Highlight; h != nil {
+		out.AdditionalProperties.Highlight = &additional.HighlightParams{
+			Properties:   h.Properties,
+			FragmentSize: int(h.FragmentSize),
+			PreTag:       h.PreTag,
+			PostTag:      h.PostTag,
+		}
+	}
+
+	if m := req.Mmr; m != nil {
+		out.MMR = &searchparams.MMR{
+			Lambda:     searchparams.DefaultMMRLambda,
+			FetchLimit: int(m.FetchLimit),
+		}
+		if m.Lambda != nil {
+			out.MMR.Lambda = *m.Lambda
+		}
+	}
+
+	if f := req.Facets; f != nil {
+		if len(f.Properties) == 0 {
+			return out, fmt.Errorf("facets: at least one property is required")
+		}
+		out.Facets = &searchparams.Facets{
+			Properties: f.Properties,
+			Limit:      int(f.Limit),
+		}
+	}
+
 	out.Pagination = &filters.Pagination{}
 	if req.Limit > 0 {
 		out.Pagination.Limit = int(req.Limit)
@@ -213,6 +402,20 @@ func searchParamsFromProto(req *pb.SearchRequest) (dto.GetParams, error) {
 		// TODO: align default with other APIs
 		out.Pagination.Limit = 10
 	}
+	if out.HybridSearch != nil {
+		out.HybridSearch.Limit = out.Pagination.Limit
+	}
 
 	return out, nil
 }
+
+func recommendVectorsFromProto(in []*pb.RecommendVector) [][]float32 {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([][]float32, len(in))
+	for i, v := range in {
+		out[i] = v.Vector
+	}
+	return out
+}
@@ -0,0 +1,25 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import nodesUC "github.com/weaviate/weaviate/usecases/nodes"
+
+type decommissions struct {
+	txHandler
+}
+
+func NewDecommissions(manager txManager) *decommissions {
+	return &decommissions{txHandler{
+		manager:   manager,
+		unmarshal: nodesUC.UnmarshalNodeTransaction,
+	}}
+}
@@ -41,6 +41,9 @@ type indices struct {
 	regexpObjectsSearch       *regexp.Regexp
 	regexpObjectsFind         *regexp.Regexp
 	regexpObjectsAggregations *regexp.Regexp
+	regexpObjectsFacets       *regexp.Regexp
+	regexpObjectsDuplicates   *regexp.Regexp
+	regexpObjectsNeighbors    *regexp.Regexp
 	regexpObject              *regexp.Regexp
 	regexpReferences          *regexp.Regexp
 	regexpShardsStatus        *regexp.Regexp
@@ -62,6 +65,12 @@ const (
 		`\/shards\/([A-Za-z0-9]+)\/objects\/_find`
 	urlPatternObjectsAggregations = `\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects\/_aggregations`
+	urlPatternObjectsFacets = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects\/_facets`
+	urlPatternObjectsDuplicates = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects\/_duplicates`
+	urlPatternObjectsNeighbors = `\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects\/_neighbors`
 	urlPatternObject = `\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects\/([A-Za-z0-9_+-]+)`
 	urlPatternReferences = `\/indices\/([A-Za-z0-9_+-]+)` +
@@ -98,12 +107,19 @@ type shards interface {
 		vector []float32, distance float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
-		additional additional.Properties,
+		recommend *searchparams.Recommend, additional additional.Properties,
 	) ([]*storobj.Object, []float32, error)
 	Aggregate(ctx context.Context, indexName, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
 	FindDocIDs(ctx context.Context, indexName, shardName string,
 		filters *filters.LocalFilter) ([]uint64, error)
+	Facets(ctx context.Context, indexName, shardName string,
+		filters *filters.LocalFilter, ids []strfmt.UUID,
+		params searchparams.Facets) ([]search.Facet, error)
+	FindDuplicates(ctx context.Context, indexName, shardName string,
+		maxDistance float32) ([]search.DuplicatePair, error)
+	SearchDuplicates(ctx context.Context, indexName, shardName string,
+		vectors [][]float32, maxDistance float32) ([][]search.Duplicate, error)
 	DeleteObjectBatch(ctx context.Context, indexName, shardName string,
 		docIDs []uint64, dryRun bool) objects.BatchSimpleObjects
 	GetShardStatus(ctx context.Context, indexName, shardName string) (string, error)
@@ -135,6 +151,9 @@ func NewIndices(shards shards, db db) *indices {
 		regexpObjectsSearch:       regexp.MustCompile(urlPatternObjectsSearch),
 		regexpObjectsFind:         regexp.MustCompile(urlPatternObjectsFind),
 		regexpObjectsAggregations: regexp.MustCompile(urlPatternObjectsAggregations),
+		regexpObjectsFacets:       regexp.MustCompile(urlPatternObjectsFacets),
+		regexpObjectsDuplicates:   regexp.MustCompile(urlPatternObjectsDuplicates),
+		regexpObjectsNeighbors:    regexp.MustCompile(urlPatternObjectsNeighbors),
 		regexpObject:              regexp.MustCompile(urlPatternObject),
 		regexpReferences:          regexp.MustCompile(urlPatternReferences),
 		regexpShardsStatus:        regexp.MustCompile(urlPatternShardsStatus),
@@ -174,6 +193,30 @@ func (i *indices) Indices() http.Handler {
 
 			i.postAggregateObjects().ServeHTTP(w, r)
 			return
+		case i.regexpObjectsFacets.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postFacetObjects().ServeHTTP(w, r)
+			return
+		case i.regexpObjectsDuplicates.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postFindDuplicates().ServeHTTP(w, r)
+			return
+		case i.regexpObjectsNeighbors.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postSearchDuplicates().ServeHTTP(w, r)
+			return
 		case i.regexpObjectsOverwrite.MatchString(path):
 			if r.Method != http.MethodPut {
 				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
@@ -583,7 +626,7 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
-		vector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, err := IndicesPayloads.SearchParams.
+		vector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, recommend, additional, err := IndicesPayloads.SearchParams.
 			Unmarshal(reqPayload)
 		if err != nil {
 			http.Error(w, "unmarshal search params from json: "+err.Error(),
@@ -592,7 +635,7 @@ func (i *indices) postSearchObjects() http.Handler {
 		}
 
 		results, dists, err := i.shards.Search(r.Context(), index, shard,
-			vector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
+			vector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, recommend, additional)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -702,6 +745,154 @@ func (i *indices) postAggregateObjects() http.Handler {
 	})
 }
 
+func (i *indices) postFacetObjects() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsFacets.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.FacetParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		filters, ids, params, err := IndicesPayloads.FacetParams.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		facets, err := i.shards.Facets(r.Context(), index, shard, filters, ids, params)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		facetsBytes, err := IndicesPayloads.FacetResults.Marshal(facets)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.FacetResults.SetContentTypeHeader(w)
+		w.Write(facetsBytes)
+	})
+}
+
+func (i *indices) postFindDuplicates() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsDuplicates.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.DuplicatesParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		maxDistance, err := IndicesPayloads.DuplicatesParams.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		pairs, err := i.shards.FindDuplicates(r.Context(), index, shard, maxDistance)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		pairsBytes, err := IndicesPayloads.DuplicatesResults.Marshal(pairs)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.DuplicatesResults.SetContentTypeHeader(w)
+		w.Write(pairsBytes)
+	})
+}
+
+func (i *indices) postSearchDuplicates() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsNeighbors.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.NeighborsParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		vectors, maxDistance, err := IndicesPayloads.NeighborsParams.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		neighbors, err := i.shards.SearchDuplicates(r.Context(), index, shard,
+			vectors, maxDistance)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		neighborsBytes, err := IndicesPayloads.NeighborsResults.Marshal(neighbors)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.NeighborsResults.SetContentTypeHeader(w)
+		w.Write(neighborsBytes)
+	})
+}
+
 func (i *indices) postFindDocIDs() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpObjectsFind.FindStringSubmatch(r.URL.Path)
@@ -20,10 +20,12 @@ import (
 	"math"
 	"net/http"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -45,6 +47,12 @@ type indicesPayloads struct {
 	AggregationResult         aggregationResultPayload
 	FindDocIDsParams          findDocIDsParamsPayload
 	FindDocIDsResults         findDocIDsResultsPayload
+	FacetParams               facetParamsPayload
+	FacetResults              facetResultsPayload
+	DuplicatesParams          duplicatesParamsPayload
+	DuplicatesResults         duplicatesResultsPayload
+	NeighborsParams           neighborsParamsPayload
+	NeighborsResults          neighborsResultsPayload
 	BatchDeleteParams         batchDeleteParamsPayload
 	BatchDeleteResults        batchDeleteResultsPayload
 	GetShardStatusParams      getShardStatusParamsPayload
@@ -53,6 +61,9 @@ type indicesPayloads struct {
 	UpdateShardsStatusResults updateShardsStatusResultsPayload
 	ShardFiles                shardFilesPayload
 	IncreaseReplicationFactor increaseReplicationFactorPayload
+	DecreaseReplicationFactor decreaseReplicationFactorPayload
+	SyncReplicas              syncReplicasPayload
+	HashTreeLevel             hashTreeLevelPayload
 }
 
 type increaseReplicationFactorPayload struct{}
@@ -79,6 +90,42 @@ func (p increaseReplicationFactorPayload) Unmarshal(in []byte) (scaler.ShardDist
 	return pay.ShardDist, nil
 }
 
+// decreaseReplicationFactorPayload maps shards to their remaining replicas
+type decreaseReplicationFactorPayload struct {
+	increaseReplicationFactorPayload
+}
+
+// syncReplicasPayload maps shards to the nodes whose replicas are synced
+type syncReplicasPayload struct {
+	increaseReplicationFactorPayload
+}
+
+// hashTreeLevelPayload selects nodes of one level of a shard's hash tree
+type hashTreeLevelPayload struct{}
+
+func (p hashTreeLevelPayload) Marshal(level int, nodes []int) ([]byte, error) {
+	type payload struct {
+		Level int   `json:"level"`
+		Nodes []int `json:"nodes"`
+	}
+
+	return json.Marshal(payload{Level: level, Nodes: nodes})
+}
+
+func (p hashTreeLevelPayload) Unmarshal(in []byte) (int, []int, error) {
+	type payload struct {
+		Level int   `json:"level"`
+		Nodes []int `json:"nodes"`
+	}
+
+	pay := payload{}
+	if err := json.Unmarshal(in, &pay); err != nil {
+		return 0, nil, fmt.Errorf("unmarshal hash tree level payload: %w", err)
+	}
+
+	return pay.Level, pay.Nodes, nil
+}
+
 type errorListPayload struct{}
 
 func (e errorListPayload) MIME() string {
@@ -147,8 +194,11 @@ func (p singleObjectPayload) Marshal(in *storobj.Object) ([]byte, error) {
 	return in.MarshalBinary()
 }
 
+// Unmarshal leaves ambiguous property values, such as maps, as they are.
+// They are parsed according to the schema by the index the object is passed
+// to, see storobj.FromBinary.
 func (p singleObjectPayload) Unmarshal(in []byte) (*storobj.Object, error) {
-	return storobj.FromBinary(in)
+	return storobj.FromBinary(in, nil)
 }
 
 type objectListPayload struct{}
@@ -194,6 +244,8 @@ func (p objectListPayload) Marshal(in []*storobj.Object) ([]byte, error) {
 	return out, nil
 }
 
+// Unmarshal leaves ambiguous property values as they are, see
+// singleObjectPayload.Unmarshal
 func (p objectListPayload) Unmarshal(in []byte) ([]*storobj.Object, error) {
 	var out []*storobj.Object
 
@@ -215,7 +267,7 @@ func (p objectListPayload) Unmarshal(in []byte) ([]*storobj.Object, error) {
 			return nil, err
 		}
 
-		obj, err := storobj.FromBinary(payloadBytes)
+		obj, err := storobj.FromBinary(payloadBytes, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -343,7 +395,7 @@ type searchParamsPayload struct{}
 func (p searchParamsPayload) Marshal(vector []float32, limit int,
 	filter *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
-	addP additional.Properties,
+	recommend *searchparams.Recommend, addP additional.Properties,
 ) ([]byte, error) {
 	type params struct {
 		SearchVector   []float32                    `json:"searchVector"`
@@ -353,16 +405,18 @@ func (p searchParamsPayload) Marshal(vector []float32, limit int,
 		Sort           []filters.Sort               `json:"sort"`
 		Cursor         *filters.Cursor              `json:"cursor"`
 		GroupBy        *searchparams.GroupBy        `json:"groupBy"`
+		Recommend      *searchparams.Recommend      `json:"recommend"`
 		Additional     additional.Properties        `json:"additional"`
 	}
 
-	par := params{vector, limit, filter, keywordRanking, sort, cursor, groupBy, addP}
+	par := params{vector, limit, filter, keywordRanking, sort, cursor, groupBy, recommend, addP}
 	return json.Marshal(par)
 }
 
 func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, float32, int,
 	*filters.LocalFilter, *searchparams.KeywordRanking, []filters.Sort,
-	*filters.Cursor, *searchparams.GroupBy, additional.Properties, error,
+	*filters.Cursor, *searchparams.GroupBy, *searchparams.Recommend,
+	additional.Properties, error,
 ) {
 	type searchParametersPayload struct {
 		SearchVector   []float32                    `json:"searchVector"`
@@ -373,12 +427,13 @@ func (p searchParamsPayload) Unmarshal(in []byte) ([]float32, float32, int,
 		Sort           []filters.Sort               `json:"sort"`
 		Cursor         *filters.Cursor              `json:"cursor"`
 		GroupBy        *searchparams.GroupBy        `json:"groupBy"`
+		Recommend      *searchparams.Recommend      `json:"recommend"`
 		Additional     additional.Properties        `json:"additional"`
 	}
 	var par searchParametersPayload
 	err := json.Unmarshal(in, &par)
-	return par.SearchVector, par.Distance, par.Limit,
-		par.Filters, par.KeywordRanking, par.Sort, par.Cursor, par.GroupBy, par.Additional, err
+	return par.SearchVector, par.Distance, par.Limit, par.Filters, par.KeywordRanking,
+		par.Sort, par.Cursor, par.GroupBy, par.Recommend, par.Additional, err
 }
 
 func (p searchParamsPayload) MIME() string {
@@ -551,6 +606,177 @@ func (p aggregationResultPayload) Unmarshal(in []byte) (*aggregation.Result, err
 	return &out, err
 }
 
+type facetParamsPayload struct{}
+
+type facetParameters struct {
+	Filters *filters.LocalFilter `json:"filters"`
+	IDs     []strfmt.UUID        `json:"ids"`
+	Facets  searchparams.Facets  `json:"facets"`
+}
+
+func (p facetParamsPayload) Marshal(filter *filters.LocalFilter,
+	ids []strfmt.UUID, facets searchparams.Facets,
+) ([]byte, error) {
+	return json.Marshal(facetParameters{filter, ids, facets})
+}
+
+func (p facetParamsPayload) Unmarshal(in []byte) (*filters.LocalFilter,
+	[]strfmt.UUID, searchparams.Facets, error,
+) {
+	var par facetParameters
+	err := json.Unmarshal(in, &par)
+	return par.Filters, par.IDs, par.Facets, err
+}
+
+func (p facetParamsPayload) MIME() string {
+	return "application/vnd.weaviate.facets.params+json"
+}
+
+func (p facetParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p facetParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+type facetResultsPayload struct{}
+
+func (p facetResultsPayload) Marshal(in []search.Facet) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p facetResultsPayload) Unmarshal(in []byte) ([]search.Facet, error) {
+	var out []search.Facet
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p facetResultsPayload) MIME() string {
+	return "application/vnd.weaviate.facets.results+json"
+}
+
+func (p facetResultsPayload) CheckContentTypeHeader(res *http.Response) (string, bool) {
+	ct := res.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p facetResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+type duplicatesParamsPayload struct{}
+
+type duplicatesParameters struct {
+	MaxDistance float32 `json:"maxDistance"`
+}
+
+func (p duplicatesParamsPayload) Marshal(maxDistance float32) ([]byte, error) {
+	return json.Marshal(duplicatesParameters{maxDistance})
+}
+
+func (p duplicatesParamsPayload) Unmarshal(in []byte) (float32, error) {
+	var par duplicatesParameters
+	err := json.Unmarshal(in, &par)
+	return par.MaxDistance, err
+}
+
+func (p duplicatesParamsPayload) MIME() string {
+	return "application/vnd.weaviate.duplicates.params+json"
+}
+
+func (p duplicatesParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p duplicatesParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+type duplicatesResultsPayload struct{}
+
+func (p duplicatesResultsPayload) Marshal(in []search.DuplicatePair) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p duplicatesResultsPayload) Unmarshal(in []byte) ([]search.DuplicatePair, error) {
+	var out []search.DuplicatePair
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p duplicatesResultsPayload) MIME() string {
+	return "application/vnd.weaviate.duplicates.results+json"
+}
+
+func (p duplicatesResultsPayload) CheckContentTypeHeader(res *http.Response) (string, bool) {
+	ct := res.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p duplicatesResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+type neighborsParamsPayload struct{}
+
+type neighborsParameters struct {
+	Vectors     [][]float32 `json:"vectors"`
+	MaxDistance float32     `json:"maxDistance"`
+}
+
+func (p neighborsParamsPayload) Marshal(vectors [][]float32,
+	maxDistance float32,
+) ([]byte, error) {
+	return json.Marshal(neighborsParameters{vectors, maxDistance})
+}
+
+func (p neighborsParamsPayload) Unmarshal(in []byte) ([][]float32, float32, error) {
+	var par neighborsParameters
+	err := json.Unmarshal(in, &par)
+	return par.Vectors, par.MaxDistance, err
+}
+
+func (p neighborsParamsPayload) MIME() string {
+	return "application/vnd.weaviate.neighbors.params+json"
+}
+
+func (p neighborsParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p neighborsParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+type neighborsResultsPayload struct{}
+
+func (p neighborsResultsPayload) Marshal(in [][]search.Duplicate) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p neighborsResultsPayload) Unmarshal(in []byte) ([][]search.Duplicate, error) {
+	var out [][]search.Duplicate
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p neighborsResultsPayload) MIME() string {
+	return "application/vnd.weaviate.neighbors.results+json"
+}
+
+func (p neighborsResultsPayload) CheckContentTypeHeader(res *http.Response) (string, bool) {
+	ct := res.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p neighborsResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
 type findDocIDsParamsPayload struct{}
 
 func (p findDocIDsParamsPayload) Marshal(filter *filters.LocalFilter) ([]byte, error) {
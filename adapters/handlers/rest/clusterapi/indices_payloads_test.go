@@ -89,6 +89,17 @@ func Test_objectListPayload_Marshal(t *testing.T) {
 	received, err := payload.Unmarshal(b)
 	require.Nil(t, err)
 	assert.Len(t, received, 2)
+
+	// references are kept as they are until parsed with the class
+	class := &models.Class{
+		Class: "SomeClass",
+		Properties: []*models.Property{
+			{Name: "crossRef", DataType: []string{"OtherClass"}},
+		},
+	}
+	for _, obj := range received {
+		require.Nil(t, obj.EnrichSchemaTypes(class))
+	}
 	assert.EqualValues(t, objs[0].Object, received[0].Object)
 	assert.EqualValues(t, objs[0].ID(), received[0].ID())
 	assert.EqualValues(t, objs[2].Object, received[1].Object)
@@ -54,11 +54,17 @@ type replicator interface {
 		shardName string, ids []strfmt.UUID) ([]objects.Replica, error)
 	DigestObjects(ctx context.Context, class, shardName string,
 		ids []strfmt.UUID) (result []replica.RepairResponse, err error)
+	HashTreeLevel(ctx context.Context, class, shardName string,
+		level int, nodes []int) ([]uint64, error)
 }
 
 type localScaler interface {
 	LocalScaleOut(ctx context.Context, className string,
 		dist scaler.ShardDist) error
+	LocalScaleIn(ctx context.Context, className string,
+		dist scaler.ShardDist) error
+	LocalSyncShards(ctx context.Context, className string,
+		dist scaler.ShardDist) error
 }
 
 type replicatedIndices struct {
@@ -73,12 +79,18 @@ var (
 		`\/shards\/([A-Za-z0-9]+)\/objects/_overwrite`)
 	regxObjectsDigest = regexp.MustCompile(`\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects/_digest`)
+	regxHashTree = regexp.MustCompile(`\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/shards\/([A-Za-z0-9]+)\/objects/_hashtree`)
 	regxObjects = regexp.MustCompile(`\/replicas\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects`)
 	regxReferences = regexp.MustCompile(`\/replicas\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+)\/objects/references`)
 	regxIncreaseRepFactor = regexp.MustCompile(`\/replicas\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/replication-factor:increase`)
+	regxDecreaseRepFactor = regexp.MustCompile(`\/replicas\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/replication-factor:decrease`)
+	regxSyncReplicas = regexp.MustCompile(`\/replicas\/indices\/([A-Za-z0-9_+-]+)` +
+		`\/replicas:sync`)
 	regxCommitPhase = regexp.MustCompile(`\/replicas\/indices\/([A-Za-z0-9_+-]+)` +
 		`\/shards\/([A-Za-z0-9]+):(commit|abort)`)
 )
@@ -100,6 +112,14 @@ func (i *replicatedIndices) Indices() http.Handler {
 				return
 			}
 
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case regxHashTree.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getHashTreeLevel().ServeHTTP(w, r)
+				return
+			}
+
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 		case regxOverwriteObjects.MatchString(path):
@@ -163,6 +183,24 @@ func (i *replicatedIndices) Indices() http.Handler {
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
+		case regxDecreaseRepFactor.MatchString(path):
+			if r.Method == http.MethodPut {
+				i.decreaseReplicationFactor().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
+		case regxSyncReplicas.MatchString(path):
+			if r.Method == http.MethodPut {
+				i.syncReplicas().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
 		case regxCommitPhase.MatchString(path):
 			if r.Method == http.MethodPost {
 				i.executeCommitPhase().ServeHTTP(w, r)
@@ -252,6 +290,68 @@ func (i *replicatedIndices) increaseReplicationFactor() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) decreaseReplicationFactor() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxDecreaseRepFactor.FindStringSubmatch(r.URL.Path)
+		if len(args) != 2 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index := args[1]
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		dist, err := IndicesPayloads.DecreaseReplicationFactor.Unmarshal(bodyBytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if err := i.scaler.LocalScaleIn(r.Context(), index, dist); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (i *replicatedIndices) syncReplicas() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxSyncReplicas.FindStringSubmatch(r.URL.Path)
+		if len(args) != 2 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index := args[1]
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		dist, err := IndicesPayloads.SyncReplicas.Unmarshal(bodyBytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if err := i.scaler.LocalSyncShards(r.Context(), index, dist); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
 func (i *replicatedIndices) postObject() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxObjects.FindStringSubmatch(r.URL.Path)
@@ -373,6 +473,46 @@ func (i *replicatedIndices) getObjectsDigest() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) getHashTreeLevel() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxHashTree.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		level, nodes, err := IndicesPayloads.HashTreeLevel.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		results, err := i.shards.HashTreeLevel(r.Context(), index, shard, level, nodes)
+		if err != nil {
+			http.Error(w, "hash tree level: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := json.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(resBytes)
+	})
+}
+
 func (i *replicatedIndices) putOverwriteObjects() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxOverwriteObjects.FindStringSubmatch(r.URL.Path)
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+type raftNode interface {
+	Apply(ctx context.Context, cmd []byte) (uint64, error)
+	ReadIndex(ctx context.Context) (uint64, error)
+	Join(ctx context.Context, id, addr string) error
+}
+
+type schemaRaft struct {
+	node raftNode
+}
+
+func NewSchemaRaft(node raftNode) *schemaRaft {
+	return &schemaRaft{node: node}
+}
+
+func (s *schemaRaft) Raft() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		switch path {
+		case "apply":
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			s.apply().ServeHTTP(w, r)
+		case "read_index":
+			if r.Method != http.MethodGet {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			s.readIndex().ServeHTTP(w, r)
+		case "join":
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			s.join().ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func (s *schemaRaft) apply() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		cmd, err := io.ReadAll(r.Body)
+		if err != nil {
+			status := http.StatusInternalServerError
+			http.Error(w, fmt.Errorf("read request body: %w", err).Error(), status)
+			return
+		}
+
+		index, err := s.node.Apply(r.Context(), cmd)
+		if err != nil {
+			// an error with an index has been returned by the state machine
+			status := http.StatusUnprocessableEntity
+			if index == 0 {
+				status = raftErrorStatus(err)
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+
+		s.writeIndex(w, index)
+	})
+}
+
+func (s *schemaRaft) readIndex() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		index, err := s.node.ReadIndex(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), raftErrorStatus(err))
+			return
+		}
+
+		s.writeIndex(w, index)
+	})
+}
+
+func (s *schemaRaft) join() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			status := http.StatusInternalServerError
+			http.Error(w, fmt.Errorf("read request body: %w", err).Error(), status)
+			return
+		}
+
+		var req struct {
+			ID      string `json:"id"`
+			Address string `json:"address"`
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			status := http.StatusBadRequest
+			http.Error(w, fmt.Errorf("unmarshal request: %w", err).Error(), status)
+			return
+		}
+
+		if err := s.node.Join(r.Context(), req.ID, req.Address); err != nil {
+			http.Error(w, err.Error(), raftErrorStatus(err))
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *schemaRaft) writeIndex(w http.ResponseWriter, index uint64) {
+	b, err := json.Marshal(struct {
+		Index uint64 `json:"index"`
+	}{index})
+	if err != nil {
+		status := http.StatusInternalServerError
+		http.Error(w, fmt.Errorf("marshal response: %w", err).Error(), status)
+		return
+	}
+
+	w.Header().Set("content-type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(b)
+}
+
+func raftErrorStatus(err error) int {
+	if errors.Is(err, cluster.ErrRaftNotReady) ||
+		errors.Is(err, cluster.ErrNoRaftLeader) {
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
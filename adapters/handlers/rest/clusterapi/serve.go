@@ -30,8 +30,14 @@ func Serve(appState *state.State) {
 	indices := NewIndices(appState.RemoteIndexIncoming, appState.DB)
 	replicatedIndices := NewReplicatedIndices(appState.RemoteReplicaIncoming, appState.Scaler)
 	classifications := NewClassifications(appState.ClassificationRepo.TxManager())
+	clusterings := NewClusterings(appState.ClusteringRepo.TxManager())
+	deduplications := NewDeduplications(appState.DeduplicationRepo.TxManager())
 	nodes := NewNodes(appState.RemoteNodeIncoming)
 	backups := NewBackups(appState.BackupManager)
+	backupSchedules := NewBackupSchedules(appState.BackupScheduleRepo.TxManager())
+	roles := NewRoles(appState.RoleRepo.TxManager())
+	decommissions := NewDecommissions(appState.Decommissioner.TxManager())
+	migrations := NewMigrations(appState.Migrations.TxManager())
 
 	mux := http.NewServeMux()
 	mux.Handle("/schema/transactions/",
@@ -39,6 +45,27 @@ func Serve(appState *state.State) {
 	mux.Handle("/classifications/transactions/",
 		http.StripPrefix("/classifications/transactions/",
 			classifications.Transactions()))
+	mux.Handle("/clusterings/transactions/",
+		http.StripPrefix("/clusterings/transactions/",
+			clusterings.Transactions()))
+	mux.Handle("/deduplications/transactions/",
+		http.StripPrefix("/deduplications/transactions/",
+			deduplications.Transactions()))
+	mux.Handle("/backup-schedules/transactions/",
+		http.StripPrefix("/backup-schedules/transactions/",
+			backupSchedules.Transactions()))
+	mux.Handle("/roles/transactions/",
+		http.StripPrefix("/roles/transactions/", roles.Transactions()))
+	mux.Handle("/decommissions/transactions/",
+		http.StripPrefix("/decommissions/transactions/", decommissions.Transactions()))
+	mux.Handle("/migrations/transactions/",
+		http.StripPrefix("/migrations/transactions/", migrations.Transactions()))
+
+	if appState.SchemaRaft != nil {
+		schemaRaft := NewSchemaRaft(appState.SchemaRaft)
+		mux.Handle("/schema/raft/",
+			http.StripPrefix("/schema/raft/", schemaRaft.Raft()))
+	}
 
 	mux.Handle("/nodes/", nodes.Nodes())
 	mux.Handle("/indices/", indices.Indices())
@@ -38,6 +38,9 @@ type txPayload struct {
 
 type txHandler struct {
 	manager txManager
+	// unmarshal decodes the payload of incoming transactions, defaults to
+	// the schema transactions if not set
+	unmarshal func(txType cluster.TransactionType, payload json.RawMessage) (interface{}, error)
 }
 
 func (h *txHandler) Transactions() http.Handler {
@@ -99,7 +102,12 @@ func (h *txHandler) incomingTransaction() http.Handler {
 			return
 		}
 
-		txPayload, err := schemauc.UnmarshalTransaction(payload.Type, payload.Payload)
+		unmarshal := h.unmarshal
+		if unmarshal == nil {
+			unmarshal = schemauc.UnmarshalTransaction
+		}
+
+		txPayload, err := unmarshal(payload.Type, payload.Payload)
 		if err != nil {
 			http.Error(w, errors.Wrap(err, "decode tx payload").Error(),
 				http.StatusInternalServerError)
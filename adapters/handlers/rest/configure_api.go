@@ -18,6 +18,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	goruntime "runtime"
 	"strings"
 	"time"
@@ -33,10 +34,14 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/adapters/repos/backupschedules"
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
+	"github.com/weaviate/weaviate/adapters/repos/clusterings"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/deduplications"
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
+	"github.com/weaviate/weaviate/adapters/repos/roles"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/moduletools"
@@ -47,6 +52,7 @@ import (
 	modstggcs "github.com/weaviate/weaviate/modules/backup-gcs"
 	modstgs3 "github.com/weaviate/weaviate/modules/backup-s3"
 	modgenerativecohere "github.com/weaviate/weaviate/modules/generative-cohere"
+	modgenerativelocal "github.com/weaviate/weaviate/modules/generative-local"
 	modgenerativeopenai "github.com/weaviate/weaviate/modules/generative-openai"
 	modgenerativepalm "github.com/weaviate/weaviate/modules/generative-palm"
 	modimage "github.com/weaviate/weaviate/modules/img2vec-neural"
@@ -60,16 +66,23 @@ import (
 	modcohere "github.com/weaviate/weaviate/modules/text2vec-cohere"
 	modcontextionary "github.com/weaviate/weaviate/modules/text2vec-contextionary"
 	modhuggingface "github.com/weaviate/weaviate/modules/text2vec-huggingface"
+	modtext2veclocal "github.com/weaviate/weaviate/modules/text2vec-local"
 	modopenai "github.com/weaviate/weaviate/modules/text2vec-openai"
 	modtext2vecpalm "github.com/weaviate/weaviate/modules/text2vec-palm"
 	modtransformers "github.com/weaviate/weaviate/modules/text2vec-transformers"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/clustering"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/deduplication"
+	"github.com/weaviate/weaviate/usecases/migrations"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	nodesUC "github.com/weaviate/weaviate/usecases/nodes"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
@@ -93,7 +106,9 @@ type vectorRepo interface {
 	objects.BatchVectorRepo
 	traverser.VectorSearcher
 	classification.VectorRepo
-	scaler.BackUpper
+	clustering.VectorRepo
+	deduplication.VectorRepo
+	scaler.Source
 	SetSchemaGetter(schemaUC.SchemaGetter)
 	WaitForStartup(ctx context.Context) error
 	Shutdown(ctx context.Context) error
@@ -142,6 +157,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	api.ServeError = openapierrors.ServeError
 
 	api.JSONConsumer = runtime.JSONConsumer()
+	api.BinConsumer = runtime.ByteStreamConsumer()
+	api.ApplicationVndApacheParquetConsumer = runtime.ByteStreamConsumer()
+	api.ApplicationVndApacheParquetProducer = runtime.ByteStreamProducer()
 
 	api.OidcAuth = composer.New(
 		appState.ServerConfig.Config.Authentication,
@@ -183,6 +201,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		MaxImportGoroutinesFactor: appState.ServerConfig.Config.MaxImportGoroutinesFactor,
 		TrackVectorDimensions:     appState.ServerConfig.Config.TrackVectorDimensions,
 		ResourceUsage:             appState.ServerConfig.Config.ResourceUsage,
+		HintedHandoff:             appState.ServerConfig.Config.HintedHandoff,
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, replicationClient, appState.Metrics) // TODO client
 	if err != nil {
 		appState.Logger.
@@ -191,10 +210,20 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	}
 
 	appState.DB = repo
+	if cfg := appState.ServerConfig.Config.AntiEntropy; cfg.Enabled {
+		go repo.AntiEntropy(context.Background(),
+			time.Duration(cfg.IntervalSeconds)*time.Second, cfg.MaxBytesPerSecond)
+	}
+	if cfg := appState.ServerConfig.Config.HintedHandoff; cfg.Enabled {
+		go repo.HintedHandoff(context.Background(),
+			time.Duration(cfg.ReplayIntervalSeconds)*time.Second)
+	}
 	vectorMigrator = db.NewMigrator(repo, appState.Logger)
 	vectorRepo = repo
 	migrator = vectorMigrator
-	explorer = traverser.NewExplorer(repo, appState.Logger, appState.Modules, traverser.NewMetrics(appState.Metrics))
+	e := traverser.NewExplorer(repo, appState.Logger, appState.Modules, traverser.NewMetrics(appState.Metrics))
+	e.SetQueryMaximumResults(appState.ServerConfig.Config.QueryMaximumResults)
+	explorer = e
 	schemaRepo, err = schemarepo.NewRepo(
 		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
 	if err != nil {
@@ -219,16 +248,44 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Cluster, localClassifierRepo, appState.Logger)
 	appState.ClassificationRepo = classifierRepo
 
-	scaler := scaler.New(appState.Cluster, vectorRepo,
+	localClusteringRepo, err := clusterings.NewRepo(
+		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize clusterings repo")
+		os.Exit(1)
+	}
+
+	clusteringsTxClient := clients.NewClusterClusterings(clusterHttpClient)
+	clusteringRepo := clusterings.NewDistributeRepo(clusteringsTxClient,
+		appState.Cluster, localClusteringRepo, appState.Logger)
+	appState.ClusteringRepo = clusteringRepo
+
+	localDeduplicationRepo, err := deduplications.NewRepo(
+		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize deduplications repo")
+		os.Exit(1)
+	}
+
+	deduplicationsTxClient := clients.NewClusterDeduplications(clusterHttpClient)
+	deduplicationRepo := deduplications.NewDistributeRepo(deduplicationsTxClient,
+		appState.Cluster, localDeduplicationRepo, appState.Logger)
+	appState.DeduplicationRepo = deduplicationRepo
+
+	replicaScaler := scaler.New(appState.Cluster, vectorRepo,
 		remoteIndexClient, appState.Logger, appState.ServerConfig.Config.Persistence.DataPath)
-	appState.Scaler = scaler
+	appState.Scaler = replicaScaler
 
 	// TODO: configure http transport for efficient intra-cluster comm
 	schemaTxClient := clients.NewClusterSchema(clusterHttpClient)
 	schemaManager, err := schemaUC.NewManager(migrator, schemaRepo,
 		appState.Logger, appState.Authorizer, appState.ServerConfig.Config,
 		enthnsw.ParseAndValidateConfig, appState.Modules, inverted.ValidateConfig,
-		appState.Modules, appState.Cluster, schemaTxClient, scaler,
+		appState.Modules, appState.Cluster, schemaTxClient, replicaScaler,
 	)
 	if err != nil {
 		appState.Logger.
@@ -254,6 +311,64 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		schemaManager, repo, appState.Modules)
 	appState.BackupManager = backupManager
 
+	localBackupScheduleRepo, err := backupschedules.NewRepo(
+		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize backup schedules repo")
+		os.Exit(1)
+	}
+	backupScheduleRepo := backupschedules.NewDistributedRepo(
+		clients.NewClusterBackupSchedules(clusterHttpClient),
+		appState.Cluster, localBackupScheduleRepo, appState.Logger)
+	appState.BackupScheduleRepo = backupScheduleRepo
+	if err := backupScheduleRepo.StartupClusterSync(context.Background()); err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not sync backup schedules with the cluster")
+		os.Exit(1)
+	}
+	backupScheduleManager := backup.NewScheduleManager(appState.Authorizer,
+		backupScheduleRepo, backupScheduler, appState.Cluster, appState.Logger)
+	go backupScheduleManager.Run(context.Background())
+
+	localRoleRepo, err := roles.NewRepo(
+		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not initialize roles repo")
+		os.Exit(1)
+	}
+	if rbacAuthorizer, ok := appState.Authorizer.(*rbac.Authorizer); ok {
+		rbacAuthorizer.SetRoleSource(localRoleRepo)
+	}
+	roleRepo := roles.NewDistributedRepo(clients.NewClusterRoles(clusterHttpClient),
+		appState.Cluster, localRoleRepo, appState.Logger)
+	appState.RoleRepo = roleRepo
+	roleManager := rbac.NewManager(appState.Authorizer, roleRepo)
+
+	shardMover := scaler.NewMover(appState.Scaler, appState.Authorizer, appState.Logger)
+	if cfg := appState.ServerConfig.Config.ShardRebalancer; cfg.Enabled {
+		go shardMover.Rebalance(context.Background(),
+			time.Duration(cfg.IntervalSeconds)*time.Second)
+	}
+	appState.Decommissioner = nodesUC.NewDecommissioner(
+		clients.NewClusterDecommissions(clusterHttpClient), appState.Cluster,
+		appState.Authorizer, appState.Cluster, schemaManager, shardMover,
+		appState.Logger)
+	appState.Migrations = migrations.NewManager(
+		clients.NewClusterMigrations(clusterHttpClient), appState.Cluster,
+		appState.Authorizer, schemaManager, appState.Logger)
+
+	if appState.ServerConfig.Config.Cluster.RaftEnabled {
+		appState.SchemaRaft = cluster.NewRaft(appState.Cluster,
+			clients.NewClusterRaft(clusterHttpClient),
+			appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+		schemaManager.SetSchemaLog(appState.SchemaRaft)
+	}
+
 	go clusterapi.Serve(appState)
 
 	vectorRepo.SetSchemaGetter(schemaManager)
@@ -269,6 +384,17 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		os.Exit(1)
 	}
 
+	if appState.SchemaRaft != nil {
+		// commands may only be applied once the db is ready
+		if err := appState.SchemaRaft.Open(schemaManager); err != nil {
+			appState.Logger.
+				WithError(err).
+				WithField("action", "startup").
+				Fatal("could not start schema raft")
+			os.Exit(1)
+		}
+	}
+
 	objectsManager := objects.NewManager(appState.Locks,
 		schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, vectorRepo, appState.Modules,
@@ -276,15 +402,32 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	batchObjectsManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.Metrics)
+	appState.Migrations.SetObjectsManagers(objectsManager, batchObjectsManager)
+	objectsManager.SetClassRouter(appState.Migrations)
+	batchObjectsManager.SetClassRouter(appState.Migrations)
+
+	var resultsCache *traverser.ResultsCache
+	if cacheCfg := appState.ServerConfig.Config.QueryResultsCache; cacheCfg.Enabled {
+		resultsCache = traverser.NewResultsCache(repo,
+			int64(cacheCfg.MaxSizeMB)*1024*1024, traverser.NewMetrics(appState.Metrics))
+	}
 
 	objectsTraverser := traverser.NewTraverser(appState.ServerConfig, appState.Locks,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
 		appState.Modules, traverser.NewMetrics(appState.Metrics),
-		appState.ServerConfig.Config.MaximumConcurrentGetRequests)
+		appState.ServerConfig.Config.MaximumConcurrentGetRequests, resultsCache)
 	appState.Traverser = objectsTraverser
+	appState.ObjectsManager = objectsManager
+	appState.BatchObjectsManager = batchObjectsManager
 
 	classifier := classification.New(schemaManager, classifierRepo, vectorRepo, appState.Authorizer,
 		appState.Logger, appState.Modules)
+	vectorJobsTimeout := time.Duration(
+		appState.ServerConfig.Config.VectorJobs.TimeoutSeconds) * time.Second
+	clusterer := clustering.New(schemaManager, clusteringRepo, vectorRepo,
+		objectsManager, appState.Authorizer, vectorJobsTimeout, appState.Logger)
+	deduplicator := deduplication.New(schemaManager, deduplicationRepo, vectorRepo,
+		objectsManager, appState.Authorizer, vectorJobsTimeout, appState.Logger)
 
 	updateSchemaCallback := makeUpdateSchemaCall(appState.Logger, appState, objectsTraverser)
 	schemaManager.RegisterSchemaUpdateCallback(updateSchemaCallback)
@@ -292,10 +435,18 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	setupSchemaHandlers(api, schemaManager)
 	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger, appState.Modules)
 	setupObjectBatchHandlers(api, batchObjectsManager)
+	setupBatchSearchHandlers(api, objectsTraverser,
+		int(appState.ServerConfig.Config.QueryDefaults.Limit))
+	setupExchangeHandlers(api, objectsManager, batchObjectsManager, appState.Logger)
 	setupGraphQLHandlers(api, appState, schemaManager)
 	setupMiscHandlers(api, appState.ServerConfig, schemaManager, appState.Modules)
 	setupClassificationHandlers(api, classifier)
-	setupBackupHandlers(api, backupScheduler)
+	setupClusteringHandlers(api, clusterer)
+	setupDeduplicationHandlers(api, deduplicator)
+	setupBackupHandlers(api, backupScheduler, backupScheduleManager)
+	setupAuthzHandlers(api, roleManager)
+	setupShardMoveHandlers(api, shardMover)
+	setupMigrationHandlers(api, appState.Migrations)
 	setupNodesHandlers(api, schemaManager, repo, appState)
 
 	err = migrator.AdjustFilterablePropSettings(ctx)
@@ -336,10 +487,43 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
+		if appState.SchemaRaft != nil {
+			if err := appState.SchemaRaft.Shutdown(); err != nil {
+				appState.Logger.WithError(err).
+					WithField("action", "shutdown").
+					Error("could not stop schema raft")
+			}
+		}
+
 		if err := repo.Shutdown(ctx); err != nil {
 			panic(err)
 		}
+
+		if appState.Auditor != nil {
+			if err := appState.Auditor.Close(); err != nil {
+				appState.Logger.WithError(err).
+					WithField("action", "shutdown").
+					Error("could not close audit log")
+			}
+		}
 	}
+
+	if auditCfg := appState.ServerConfig.Config.AuditLog; auditCfg.Enabled {
+		path := auditCfg.Path
+		if path == "" {
+			path = filepath.Join(appState.ServerConfig.Config.Persistence.DataPath,
+				"audit.jsonl")
+		}
+		sink, err := audit.NewFileSink(path, auditCfg.MaxSizeMB, auditCfg.MaxBackups)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not initialize audit log")
+			os.Exit(1)
+		}
+		appState.Auditor = audit.New(sink, auditCfg.IncludeReads, appState.Logger)
+	}
+
 	configureServer = makeConfigureServer(appState)
 	setupMiddlewares := makeSetupMiddlewares(appState)
 	setupGlobalMiddleware := makeSetupGlobalMiddleware(appState)
@@ -659,6 +843,22 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules[modgenerativelocal.Name]; ok {
+		appState.Modules.Register(modgenerativelocal.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modgenerativelocal.Name).
+			Debug("enabled module")
+	}
+
+	if _, ok := enabledModules[modtext2veclocal.Name]; ok {
+		appState.Modules.Register(modtext2veclocal.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modtext2veclocal.Name).
+			Debug("enabled module")
+	}
+
 	appState.Logger.
 		WithField("action", "startup").
 		Debug("completed registering modules")
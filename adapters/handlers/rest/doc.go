@@ -22,11 +22,16 @@
 //	Contact: Weaviate<hello@weaviate.io> https://github.com/weaviate
 //
 //	Consumes:
+//	  - application/vnd.apache.parquet
+//	  - application/octet-stream
 //	  - application/json
+//	  - application/x-ndjson
 //	  - application/yaml
 //
 //	Produces:
+//	  - application/vnd.apache.parquet
 //	  - application/json
+//	  - application/x-ndjson
 //
 // swagger:meta
 package rest
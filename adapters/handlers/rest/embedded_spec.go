@@ -147,35 +147,21 @@ func init() {
         }
       }
     },
-    "/backups/{backend}": {
-      "post": {
-        "description": "Starts a process of creating a backup for a set of classes",
+    "/authz/roles": {
+      "get": {
+        "description": "Lists all roles of the cluster",
         "tags": [
-          "backups"
-        ],
-        "operationId": "backups.create",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
-            }
-          }
+          "authz"
         ],
+        "operationId": "authz.roles.list",
         "responses": {
           "200": {
-            "description": "Backup create process successfully started.",
+            "description": "Successfully listed the roles.",
             "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Role"
+              }
             }
           },
           "401": {
@@ -187,12 +173,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup creation attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -201,38 +181,30 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/backups/{backend}/{id}": {
-      "get": {
-        "description": "Returns status of backup creation attempt for a set of classes",
+      },
+      "post": {
+        "description": "Creates or replaces a role. The role is replicated to all nodes of the cluster and takes effect immediately.",
         "tags": [
-          "backups"
+          "authz"
         ],
-        "operationId": "backups.create.status",
+        "operationId": "authz.roles.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup creation status successfully returned",
+            "description": "Role successfully created.",
             "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
+              "$ref": "#/definitions/Role"
             }
           },
           "401": {
@@ -244,14 +216,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Invalid role.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -264,38 +230,31 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/backups/{backend}/{id}/restore": {
+    "/authz/roles/{name}": {
       "get": {
-        "description": "Returns status of a backup restoration attempt for a set of classes",
+        "description": "Returns a single role",
         "tags": [
-          "backups"
+          "authz"
         ],
-        "operationId": "backups.restore.status",
+        "operationId": "authz.roles.get",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
+            "description": "The name of the role.",
+            "name": "name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration status successfully returned",
+            "description": "Successfully retrieved the role.",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreStatusResponse"
+              "$ref": "#/definitions/Role"
             }
           },
           "401": {
@@ -308,7 +267,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Not Found - Role does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -321,45 +280,27 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.manipulate.meta"
         ]
       },
-      "post": {
-        "description": "Starts a process of restoring a backup for a set of classes",
+      "delete": {
+        "description": "Deletes a role. Users and groups the role was assigned to lose its permissions immediately.",
         "tags": [
-          "backups"
+          "authz"
         ],
-        "operationId": "backups.restore",
+        "operationId": "authz.roles.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
+            "description": "The name of the role.",
+            "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BackupRestoreRequest"
-            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Backup restoration process successfully started.",
-            "schema": {
-              "$ref": "#/definitions/BackupRestoreResponse"
-            }
+          "204": {
+            "description": "Role successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -371,13 +312,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid backup restoration attempt.",
+            "description": "Not Found - Role does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -390,71 +325,27 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/batch/objects": {
-      "post": {
-        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+    "/backup-schedules": {
+      "get": {
+        "description": "Lists all backup schedules of the cluster",
         "tags": [
-          "batch",
-          "objects"
-        ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          }
+          "backups"
         ],
+        "operationId": "backups.schedules.list",
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Successfully listed the backup schedules.",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
+                "$ref": "#/definitions/BackupSchedule"
               }
             }
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -464,12 +355,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -477,44 +362,31 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.backup"
         ]
       },
-      "delete": {
-        "description": "Delete Objects in bulk that match a certain filter.",
+      "post": {
+        "description": "Creates or replaces a schedule to periodically create backups of a set of classes. Backups created by the schedule are deleted according to its retention policy.",
         "tags": [
-          "batch",
-          "objects"
+          "backups"
         ],
-        "summary": "Deletes Objects based on a match filter as a batch.",
-        "operationId": "batch.objects.delete",
+        "operationId": "backups.schedules.create",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BatchDelete"
+              "$ref": "#/definitions/BackupSchedule"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Backup schedule successfully created.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupSchedule"
             }
           },
           "401": {
@@ -527,7 +399,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup schedule.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -539,53 +411,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/batch/references": {
-      "post": {
-        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+    "/backup-schedules/{id}": {
+      "get": {
+        "description": "Returns a backup schedule including the backups it created which have not expired yet",
         "tags": [
-          "batch",
-          "references"
+          "backups"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+        "operationId": "backups.schedules.get",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "type": "string",
+            "description": "The ID of the backup schedule.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Backup schedule successfully returned.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupSchedule"
             }
           },
           "401": {
@@ -597,63 +448,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.add"
-        ]
-      }
-    },
-    "/classifications/": {
-      "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
-        "tags": [
-          "classifications"
-        ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
-        "parameters": [
-          {
-            "description": "parameters to start a classification",
-            "name": "params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
+          "404": {
+            "description": "Not Found - Backup schedule does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -666,33 +462,27 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/classifications/{id}": {
-      "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+      },
+      "delete": {
+        "description": "Deletes a backup schedule. Backups which were already created by the schedule are kept.",
         "tags": [
-          "classifications"
+          "backups"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "operationId": "backups.schedules.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "classification id",
+            "description": "The ID of the backup schedule.",
             "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Found the classification, returned as body",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
+          "204": {
+            "description": "Backup schedule successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -704,7 +494,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Classification does not exist"
+            "description": "Not Found - Backup schedule does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -714,34 +507,39 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.get"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/graphql": {
+    "/backups/{backend}": {
       "post": {
-        "description": "Get an object based on GraphQL",
+        "description": "Starts a process of creating a backup for a set of classes",
         "tags": [
-          "graphql"
+          "backups"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "operationId": "backups.create",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/BackupCreateRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Backup create process successfully started.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/BackupCreateResponse"
             }
           },
           "401": {
@@ -754,7 +552,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup creation attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -766,40 +564,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of backup creation attempt for a set of classes",
         "tags": [
-          "graphql"
+          "backups"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "operationId": "backups.create.status",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Backup creation status successfully returned",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
+              "$ref": "#/definitions/BackupCreateStatusResponse"
             }
           },
           "401": {
@@ -811,8 +608,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -824,29 +627,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/meta": {
+    "/backups/{backend}/{id}/restore": {
       "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+        "description": "Returns status of a backup restoration attempt for a set of classes",
         "tags": [
-          "meta"
+          "backups"
+        ],
+        "operationId": "backups.restore.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Backup restoration status successfully returned",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "$ref": "#/definitions/BackupRestoreStatusResponse"
             }
           },
           "401": {
@@ -858,6 +671,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -865,25 +684,45 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/nodes": {
-      "get": {
-        "description": "Returns status of Weaviate DB.",
+      },
+      "post": {
+        "description": "Starts a process of restoring a backup for a set of classes",
         "tags": [
-          "nodes"
+          "backups"
+        ],
+        "operationId": "backups.restore",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreRequest"
+            }
+          }
         ],
-        "operationId": "nodes.get",
         "responses": {
           "200": {
-            "description": "Nodes status successfully returned",
+            "description": "Backup restoration process successfully started.",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/BackupRestoreResponse"
             }
           },
           "401": {
@@ -902,7 +741,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Invalid backup restoration attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -915,46 +754,63 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/objects": {
-      "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+    "/batch/objects": {
+      "post": {
+        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
         "tags": [
+          "batch",
           "objects"
         ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonAfterParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonOffsetParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonLimitParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonSortParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonOrderParameterQuery"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
+                }
+              }
+            }
           },
           {
-            "$ref": "#/parameters/CommonClassParameterQuery"
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
             }
           },
           "400": {
@@ -972,9 +828,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -991,23 +844,24 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.add"
         ]
       },
-      "post": {
-        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
+      "delete": {
+        "description": "Delete Objects in bulk that match a certain filter.",
         "tags": [
+          "batch",
           "objects"
         ],
-        "summary": "Create Objects between two Objects (object and subject).",
-        "operationId": "objects.create",
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/BatchDelete"
             }
           },
           {
@@ -1016,9 +870,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Object created.",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/BatchDeleteResponse"
             }
           },
           "400": {
@@ -1052,31 +906,51 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/objects/validate": {
+    "/batch/references": {
       "post": {
-        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+        "description": "Register cross-references between any class items (objects or objects) in bulk.",
         "tags": [
-          "objects"
+          "batch",
+          "references"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
         "parameters": [
           {
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully validated."
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1103,54 +977,35 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.add"
         ]
       }
     },
-    "/objects/{className}/{id}": {
-      "get": {
-        "description": "Get a single data object",
+    "/batch/search": {
+      "post": {
+        "description": "Run several independent searches in a single request. The searches are executed concurrently with a shared deadline and every search gets its own result or error.",
         "tags": [
-          "objects"
+          "batch",
+          "search"
         ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
+        "summary": "Run several independent searches in a single request.",
+        "operationId": "batch.search",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonNodeNameParameterQuery"
+            "description": "The searches to run.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchSearchRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Request Successful. Warning: A successful request does not guarantee that every query succeeded. Inspect the response body to see which queries succeeded and which failed.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BatchSearchResponse"
             }
           },
           "401": {
@@ -1162,8 +1017,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1177,62 +1035,45 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.query"
         ]
-      },
-      "put": {
-        "description": "Update an individual data object based on its class and uuid.",
+      }
+    },
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
         "tags": [
-          "objects"
+          "classifications"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "description": "parameters to start a classification",
+            "name": "params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Classification"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully received.",
+          "201": {
+            "description": "Successfully started classification.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Classification"
             }
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
+          "400": {
+            "description": "Incorrect request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1244,46 +1085,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.classifications.post"
         ]
-      },
-      "delete": {
-        "description": "Delete a single data object.",
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "objects"
+          "classifications"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "classification id",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "Found the classification, returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Classification"
             }
           },
           "401": {
@@ -1296,7 +1124,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Classification does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1305,42 +1133,27 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.classifications.get"
         ]
-      },
-      "head": {
-        "description": "Checks if a data object exists without retrieving it.",
+      }
+    },
+    "/cluster/shard-moves": {
+      "get": {
+        "description": "Lists the shard moves started on this node, including moves started by the automatic rebalancer",
         "tags": [
-          "objects"
-        ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          }
+          "cluster"
         ],
+        "operationId": "cluster.shardMoves.list",
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Successfully listed the shard moves.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ShardMove"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1351,9 +1164,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Object doesn't exist."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1361,53 +1171,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       },
-      "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "post": {
+        "description": "Starts moving a shard of a class from one node to another. The shard is copied to the target node, catches up with writes which happened in the meantime and is then removed from the source node. The move runs in the background, its progress can be followed through its status.",
         "tags": [
-          "objects"
+          "cluster"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
+        "operationId": "cluster.shardMoves.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ShardMove"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
+          "202": {
+            "description": "Shard move successfully started.",
+            "schema": {
+              "$ref": "#/definitions/ShardMove"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1418,11 +1207,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Invalid shard move.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1434,64 +1220,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Update all references of a property of a data object.",
+    "/cluster/shard-moves/{id}": {
+      "get": {
+        "description": "Returns the status of a shard move",
         "tags": [
-          "objects"
+          "cluster"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "operationId": "cluster.shardMoves.get",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The ID of the shard move.",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MultipleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Shard move successfully returned.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ShardMove"
             }
           },
           "401": {
@@ -1504,10 +1258,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Not Found - Shard move does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1519,60 +1270,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
+      }
+    },
+    "/clusterings/": {
       "post": {
-        "description": "Add a single reference to a class-property.",
+        "description": "Trigger a clustering based on the specified params. Clusterings will run in the background, use GET /clusterings/\u003cid\u003e to retrieve the status of your clustering.",
         "tags": [
-          "objects"
+          "clusterings"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "summary": "Starts a clustering.",
+        "operationId": "clusterings.post",
         "parameters": [
           {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
+            "description": "parameters to start a clustering",
+            "name": "params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Clustering"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully added the reference."
+          "201": {
+            "description": "Successfully started clustering.",
+            "schema": {
+              "$ref": "#/definitions/Clustering"
+            }
           },
           "400": {
-            "description": "Malformed request.",
+            "description": "Incorrect request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1586,15 +1316,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Source object doesn't exist."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1602,62 +1323,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.clusterings.post"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
+      }
+    },
+    "/clusterings/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created clustering",
         "tags": [
-          "objects"
+          "clusterings"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
+        "summary": "View previously created clustering",
+        "operationId": "clusterings.get",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "clustering id",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "Found the clustering, returned as body",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Clustering"
             }
           },
           "401": {
@@ -1670,16 +1362,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Not Found - Clustering does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1688,44 +1371,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.clusterings.get"
         ]
       }
     },
-    "/objects/{id}": {
-      "get": {
-        "description": "Lists Objects.",
+    "/deduplications/": {
+      "post": {
+        "description": "Trigger a deduplication based on the specified params. Deduplications will run in the background, use GET /deduplications/\u003cid\u003e to retrieve the status of your deduplication.",
         "tags": [
-          "objects"
+          "deduplications"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
+        "summary": "Starts a deduplication.",
+        "operationId": "deduplications.post",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "description": "parameters to start a deduplication",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Deduplication"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful response.",
+          "201": {
+            "description": "Successfully started deduplication.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Deduplication"
             }
           },
           "400": {
-            "description": "Malformed request.",
+            "description": "Incorrect request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1739,9 +1417,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1749,46 +1424,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.deduplications.post"
         ]
-      },
-      "put": {
-        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/deduplications/{id}": {
+      "get": {
+        "description": "Get status and metadata of a previously created deduplication",
         "tags": [
-          "objects"
+          "deduplications"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
-        "deprecated": true,
+        "summary": "View previously created deduplication",
+        "operationId": "deduplications.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "deduplication id",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Found the deduplication, returned as body",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Deduplication"
             }
           },
           "401": {
@@ -1801,13 +1463,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Not Found - Deduplication does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1816,36 +1472,34 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.deduplications.get"
         ]
-      },
-      "delete": {
-        "description": "Deletes an Object from the system.",
+      }
+    },
+    "/deduplications/{id}/result": {
+      "get": {
+        "description": "Get the groups of duplicates found by a previously completed deduplication",
         "tags": [
-          "objects"
+          "deduplications"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
+        "summary": "View the groups of duplicates of a deduplication",
+        "operationId": "deduplications.result.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "deduplication id",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Found the result of the deduplication, returned as body",
+            "schema": {
+              "$ref": "#/definitions/DeduplicationResult"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1857,7 +1511,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Deduplication does not exist or has not completed"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -1866,94 +1520,69 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.deduplications.result.get"
         ]
-      },
-      "head": {
-        "description": "Checks if an Object exists in the system.",
+      }
+    },
+    "/export/{className}": {
+      "get": {
+        "description": "Export all objects of a class as a stream in NDJSON or Parquet format. Objects are returned in the order of their ids and can optionally be restricted by a where filter.",
+        "produces": [
+          "application/x-ndjson",
+          "application/vnd.apache.parquet",
+          "application/json"
+        ],
         "tags": [
           "objects"
         ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
+        "summary": "Export the objects of a class.",
+        "operationId": "objects.export",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "description": "The name of the class",
+            "name": "className",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Object exists."
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Object doesn't exist."
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
-        "x-serviceIds": [
-          "weaviate.local.manipulate"
-        ]
-      },
-      "patch": {
-        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
-        "parameters": [
           {
+            "enum": [
+              "ndjson",
+              "parquet"
+            ],
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "default": "ndjson",
+            "description": "The format of the exported objects, either 'ndjson' (one JSON object per line) or 'parquet'. Default value is 'ndjson'.",
+            "name": "format",
+            "in": "query"
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "type": "string",
+            "description": "A JSON encoded where filter restricting the exported objects",
+            "name": "where",
+            "in": "query"
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "type": "boolean",
+            "default": true,
+            "description": "Whether to include the vectors of the objects. Default value is true.",
+            "name": "includeVector",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
+          "200": {
+            "description": "Stream of all matching objects in the requested format.",
+            "schema": {
+              "type": "file"
+            }
           },
           "400": {
-            "description": "The patch-JSON is malformed."
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1968,7 +1597,7 @@ func init() {
             "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1983,47 +1612,35 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       }
     },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+    "/graphql": {
+      "post": {
+        "description": "Get an object based on GraphQL",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
-        "deprecated": true,
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/GraphQLQuery"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2035,7 +1652,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2050,45 +1667,38 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
+      }
+    },
+    "/graphql/batch": {
       "post": {
-        "description": "Add a single reference to a class-property.",
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "objects"
+          "graphql"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
-        "deprecated": true,
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The GraphQL queries.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/GraphQLQueries"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponses"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2100,7 +1710,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2115,30 +1725,31 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      }
+    },
+    "/import/{className}": {
+      "post": {
+        "description": "Import a stream of objects in NDJSON or Parquet format, as produced by the export endpoint, into a class. All objects are moved to the target class and their properties can be renamed. Objects are added through the batch import, so ids and vectors are preserved.",
+        "consumes": [
+          "application/octet-stream",
+          "application/x-ndjson",
+          "application/vnd.apache.parquet"
+        ],
         "tags": [
           "objects"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
-        "deprecated": true,
+        "summary": "Import objects into a class.",
+        "operationId": "objects.import",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
+            "description": "The name of the class the objects are imported into",
+            "name": "className",
             "in": "path",
             "required": true
           },
@@ -2147,13 +1758,47 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "type": "string",
+              "format": "binary"
             }
+          },
+          {
+            "enum": [
+              "ndjson",
+              "parquet"
+            ],
+            "type": "string",
+            "default": "ndjson",
+            "description": "The format of the uploaded objects, either 'ndjson' (one JSON object per line) or 'parquet'. Default value is 'ndjson'.",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Renames properties of the imported objects, each entry has the form 'source=target'",
+            "name": "propertyMapping",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Import finished, see the response body for the number of imported and failed objects.",
+            "schema": {
+              "$ref": "#/definitions/ImportResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2165,7 +1810,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2180,22 +1828,23 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.add"
         ]
       }
     },
-    "/schema": {
+    "/meta": {
       "get": {
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "schema"
+          "meta"
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Schema"
+              "$ref": "#/definitions/Meta"
             }
           },
           "401": {
@@ -2214,31 +1863,25 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
           "weaviate.local.query.meta"
         ]
-      },
-      "post": {
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Returns status of Weaviate DB.",
         "tags": [
-          "schema"
-        ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
-        "parameters": [
-          {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          }
+          "nodes"
         ],
+        "operationId": "nodes.get",
         "responses": {
           "200": {
-            "description": "Added the new Object class to the schema.",
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -2250,8 +1893,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Object class",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2264,30 +1913,31 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.nodes.status.get"
         ]
       }
     },
-    "/schema/{className}": {
-      "get": {
+    "/nodes/{name}/decommission": {
+      "post": {
+        "description": "Starts decommissioning a node. Every shard replica the node holds is moved to another node, or re-replicated from another replica if the node is not reachable anymore. No new shards are assigned to the node while it is being drained. Once it holds no shards anymore, the node leaves the cluster. The progress is reported as part of the node status.",
         "tags": [
-          "schema"
+          "nodes"
         ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
+        "operationId": "nodes.decommission",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "The name of the node to decommission.",
+            "name": "name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Found the Class, returned as body",
+          "202": {
+            "description": "Decommissioning successfully started.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/NodeDecommission"
             }
           },
           "401": {
@@ -2300,7 +1950,16 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist"
+            "description": "Not Found - Node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The node cannot be decommissioned, e.g. because it is the only node of the cluster or it is already being decommissioned.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -2310,37 +1969,52 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.nodes.decommission"
         ]
-      },
-      "put": {
-        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonAfterParameterQuery"
           },
           {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonLimitParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonSortParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonOrderParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonClassParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Class was updated successfully",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -2353,13 +2027,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Class to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2371,35 +2042,41 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query"
         ]
       },
-      "delete": {
+      "post": {
+        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
+        "summary": "Create Objects between two Objects (object and subject).",
+        "operationId": "objects.create",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           {
-            "type": "boolean",
-            "name": "force",
-            "in": "query"
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the Object class from the schema."
+            "description": "Object created.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "400": {
-            "description": "Could not delete the Object class.",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2413,6 +2090,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2420,40 +2103,34 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.add"
         ]
       }
     },
-    "/schema/{className}/properties": {
+    "/objects/validate": {
       "post": {
+        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/Object"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
+            "description": "Successfully validated."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2465,7 +2142,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2477,31 +2154,57 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/schema/{className}/shards": {
+    "/objects/{className}/{id}": {
       "get": {
+        "description": "Get a single data object",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonNodeNameParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the status of the shards, returned as body",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/ShardStatusList"
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -2514,10 +2217,7 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -2526,18 +2226,19 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/schema/{className}/shards/{shardName}": {
+      },
       "put": {
-        "description": "Update shard status of an Object Class",
+        "description": "Update an individual data object based on its class and uuid.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "operationId": "schema.objects.shards.update",
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
         "parameters": [
           {
             "type": "string",
@@ -2547,7 +2248,9 @@ func init() {
           },
           {
             "type": "string",
-            "name": "shardName",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
             "in": "path",
             "required": true
           },
@@ -2556,15 +2259,18 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status was updated successfully",
+            "description": "Successfully received.",
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -2577,13 +2283,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Shard to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2595,1826 +2298,6059 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "Additional Meta information about a single object object.",
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
-    },
-    "BM25Config": {
-      "description": "tuning parameters for the BM25 algorithm",
-      "type": "object",
-      "properties": {
-        "b": {
-          "description": "calibrates term-weight scaling based on the document length",
-          "type": "number",
-          "format": "float"
-        },
-        "k1": {
-          "description": "calibrates term-weight scaling based on the term frequency within a document",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "BackupCreateRequest": {
-      "description": "Request body for creating a backup of a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup creation process",
-          "type": "object"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup creation process",
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "delete": {
+        "description": "Delete a single data object.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "include": {
-          "description": "List of classes to include in the backup creation process",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "BackupCreateResponse": {
-      "description": "The definition of a backup create response body",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
         },
-        "classes": {
-          "description": "The list of classes for which the backup creation process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "head": {
+        "description": "Checks if a data object exists without retrieving it.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Object exists."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Object doesn't exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.class.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Update all references of a property of a data object.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{id}": {
+      "get": {
+        "description": "Lists Objects.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Deletes an Object from the system.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "head": {
+        "description": "Checks if an Object exists in the system.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Object exists."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Object doesn't exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "patch": {
+        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "post": {
+        "description": "Add a single reference to a class-property.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added the reference."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "tags": [
+          "objects"
+        ],
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
+      }
+    },
+    "/schema": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
+        "responses": {
+          "200": {
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
+        "parameters": [
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the new Object class to the schema.",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Object class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
+      }
+    },
+    "/schema/aliases": {
+      "get": {
+        "description": "Lists all aliases and the classes they point to.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.aliases.list",
+        "responses": {
+          "200": {
+            "description": "Successfully listed the aliases.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Alias"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "post": {
+        "description": "Creates an alias for a class. Objects of the class can be read and written using the alias wherever a class name is expected.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.aliases.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Alias successfully created.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias, e.g. the alias already exists or the class does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/aliases/{aliasName}": {
+      "put": {
+        "description": "Points an existing alias to another class. All reads and writes using the alias are applied to the new class from then on.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.aliases.update",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the alias.",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Alias successfully updated.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Alias does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias, e.g. the class does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "description": "Deletes an alias. The class it points to is not affected.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.aliases.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the alias.",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Alias successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Alias does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/migrations": {
+      "get": {
+        "description": "Lists the migrations started on this node",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.migrations.list",
+        "responses": {
+          "200": {
+            "description": "Successfully listed the migrations.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ClassMigration"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "post": {
+        "description": "Starts migrating a class to a new definition. A shadow class with the new definition is created and all objects are copied to it in the background, while writes to the class are also applied to the shadow class. Once all objects have been copied, the shadow class takes the place of the class under its name.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.migrations.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ClassMigration"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Migration successfully started.",
+            "schema": {
+              "$ref": "#/definitions/ClassMigration"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid migration.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/migrations/{id}": {
+      "get": {
+        "description": "Returns the status of a migration",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.migrations.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the migration.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Migration successfully returned.",
+            "schema": {
+              "$ref": "#/definitions/ClassMigration"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Migration does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "description": "Aborts a migration. Writes are no longer applied to the shadow class and the shadow class is deleted. A migration cannot be aborted once the shadow class is taking the place of the class.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.migrations.abort",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the migration.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Migration successfully aborted.",
+            "schema": {
+              "$ref": "#/definitions/ClassMigration"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Migration does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The migration cannot be aborted anymore.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/migrations/{id}/pause": {
+      "post": {
+        "description": "Pauses copying objects to the shadow class. Writes to the class are still applied to the shadow class.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.migrations.pause",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the migration.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Migration successfully paused.",
+            "schema": {
+              "$ref": "#/definitions/ClassMigration"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Migration does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The migration is not copying objects.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/migrations/{id}/resume": {
+      "post": {
+        "description": "Resumes copying objects to the shadow class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.migrations.resume",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the migration.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Migration successfully resumed.",
+            "schema": {
+              "$ref": "#/definitions/ClassMigration"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Migration does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The migration is not paused.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the Class, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      },
+      "put": {
+        "description": "Use this endpoint to alter an existing class in the schema. Note that not all settings are mutable. If an error about immutable fields is returned and you still need to update this particular setting, you will have to delete the class (and the underlying data) and recreate. This endpoint cannot be used to modify properties. Instead use POST /v1/schema/{className}/properties. A typical use case for this endpoint is to update configuration, such as the vectorIndexConfig. Note that even in mutable sections, such as vectorIndexConfig, some fields may be immutable.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Class was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "force",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/properties": {
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid property.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards": {
+      "get": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the status of the shards, returned as body",
+            "schema": {
+              "$ref": "#/definitions/ShardStatusList"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "This class does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update shard status of an Object Class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.objects.shards.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "Additional Meta information about a single object object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "Alias": {
+      "description": "An alternative name of a class.",
+      "type": "object",
+      "properties": {
+        "alias": {
+          "description": "The name of the alias. It follows the same rules as class names.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The name of the class the alias points to.",
+          "type": "string"
+        }
+      }
+    },
+    "BM25Config": {
+      "description": "tuning parameters for the BM25 algorithm",
+      "type": "object",
+      "properties": {
+        "b": {
+          "description": "calibrates term-weight scaling based on the document length",
+          "type": "number",
+          "format": "float"
+        },
+        "k1": {
+          "description": "calibrates term-weight scaling based on the term frequency within a document",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes",
+      "properties": {
+        "baseId": {
+          "description": "The ID of an earlier backup on the same backend to base this backup on. Only files which are not already part of the base backup are uploaded, restoring the backup fetches the remaining files from the base backup and its own bases.",
+          "type": "string"
+        },
+        "config": {
+          "description": "Custom configuration for the backup creation process",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of classes to include in the backup creation process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "BackupCreateResponse": {
+      "description": "The definition of a backup create response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "baseId": {
+          "description": "The ID of the backup this backup is based on, if it is incremental",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup creation process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupCreateStatusResponse": {
+      "description": "The definition of a backup create metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupRestoreRequest": {
+      "description": "Request body for restoring a backup for a set of classes",
+      "properties": {
+        "config": {
+          "description": "Custom configuration for the backup restoration process",
+          "type": "object"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "include": {
+          "description": "List of classes to include in the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "BackupRestoreResponse": {
+      "description": "The definition of a backup restore response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup restoration process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupRestoreStatusResponse": {
+      "description": "The definition of a backup restore metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
           "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
+        "path": {
+          "description": "destination path of backup files proper to selected backup backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupSchedule": {
+      "description": "A schedule to periodically create backups of a set of classes",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "backups": {
+          "description": "Backups created by the schedule which have not been deleted by the retention policy yet, oldest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BackupScheduleBackup"
+          },
+          "readOnly": true
+        },
+        "creationTimeUnix": {
+          "description": "Timestamp of the creation of the schedule in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "cron": {
+          "description": "Cron expression in UTC with the five fields minute, hour, day of month, month and day of week, e.g. ` + "`" + `0 3 * * *` + "`" + `. Descriptors such as ` + "`" + `@daily` + "`" + ` or ` + "`" + `@every 6h` + "`" + ` are supported as well.",
+          "type": "string"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backups",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the schedule. Backups created by the schedule use it as prefix of their IDs. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of classes to include in the backups",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "lastError": {
+          "description": "Error of the last run of the schedule, if any",
+          "type": "string",
+          "readOnly": true
+        },
+        "lastRunTimeUnix": {
+          "description": "Timestamp of the last time a backup was started by the schedule in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "retention": {
+          "$ref": "#/definitions/BackupScheduleRetention"
+        }
+      }
+    },
+    "BackupScheduleBackup": {
+      "description": "A backup created by a schedule",
+      "properties": {
+        "id": {
+          "description": "The ID of the backup",
+          "type": "string"
+        },
+        "startTimeUnix": {
+          "description": "Timestamp of the start of the backup in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "Phase of the backup creation process",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupScheduleRetention": {
+      "description": "Determines which backups created by a schedule are kept. Backups retained by any of the rules are kept, all others are deleted from the backend. If no rule is set, all backups are kept.",
+      "properties": {
+        "keepDailyDays": {
+          "description": "Number of days, including today, for which the most recent successful backup of each day is kept",
+          "type": "integer",
+          "format": "int64"
+        },
+        "keepLast": {
+          "description": "Number of most recent successful backups to keep",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BatchDelete": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        }
+      }
+    },
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific Object.",
+                "format": "object",
+                "properties": {
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  },
+                  "id": {
+                    "description": "ID of the Object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  }
+                }
+              }
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
+    "BatchReference": {
+      "properties": {
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+        },
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "BatchSearchQuery": {
+      "description": "A single search of a batch. At most one of nearVector, nearObject, bm25 and hybrid can be set.",
+      "type": "object",
+      "required": [
+        "class"
+      ],
+      "properties": {
+        "additional": {
+          "description": "Additional properties to return for every object, such as \"id\", \"vector\", \"distance\", \"certainty\" or \"score\".",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "bm25": {
+          "description": "Search by keyword ranking with bm25.",
+          "type": "object",
+          "properties": {
+            "properties": {
+              "description": "The properties to search in. Defaults to all text properties.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "query": {
+              "description": "The query to rank the objects with.",
+              "type": "string"
+            }
+          }
+        },
+        "class": {
+          "description": "Class (name) to search in.",
+          "type": "string",
+          "example": "City"
+        },
+        "hybrid": {
+          "description": "Search by combining bm25 and vector search.",
+          "type": "object",
+          "properties": {
+            "alpha": {
+              "description": "The weight of the vector search between 0 and 1. Defaults to 0.75.",
+              "type": "number",
+              "format": "double",
+              "x-nullable": true
+            },
+            "properties": {
+              "description": "The properties to search in with bm25. Defaults to all text properties.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "query": {
+              "description": "The query to rank the objects with.",
+              "type": "string"
+            },
+            "vector": {
+              "description": "The vector to search with. Defaults to the vector of the query.",
+              "type": "array",
+              "items": {
+                "type": "number",
+                "format": "float"
+              }
+            }
+          }
+        },
+        "limit": {
+          "description": "The maximum number of objects to return.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "nearObject": {
+          "description": "Search for objects near the given object.",
+          "type": "object",
+          "properties": {
+            "certainty": {
+              "description": "The minimal certainty of the results.",
+              "type": "number",
+              "format": "double",
+              "x-nullable": true
+            },
+            "distance": {
+              "description": "The maximal distance of the results.",
+              "type": "number",
+              "format": "double",
+              "x-nullable": true
+            },
+            "id": {
+              "description": "The ID of the object to search near.",
+              "type": "string",
+              "format": "uuid"
+            }
+          }
+        },
+        "nearVector": {
+          "description": "Search for objects near the given vector.",
+          "type": "object",
+          "properties": {
+            "certainty": {
+              "description": "The minimal certainty of the results.",
+              "type": "number",
+              "format": "double",
+              "x-nullable": true
+            },
+            "distance": {
+              "description": "The maximal distance of the results.",
+              "type": "number",
+              "format": "double",
+              "x-nullable": true
+            },
+            "vector": {
+              "description": "The vector to search near.",
+              "type": "array",
+              "items": {
+                "type": "number",
+                "format": "float"
+              }
+            }
+          }
+        },
+        "offset": {
+          "description": "The number of objects to skip.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "description": "Properties to return for every object. If empty, no properties are returned.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "where": {
+          "description": "Filter to limit the objects to search in.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchSearchRequest": {
+      "description": "Several independent searches which are executed concurrently.",
+      "type": "object",
+      "required": [
+        "queries"
+      ],
+      "properties": {
+        "queries": {
+          "description": "The searches to run. Every search gets its own result or error.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchSearchQuery"
+          }
+        },
+        "timeout": {
+          "description": "Deadline in seconds shared by all searches of the batch. Searches which have not completed by then fail.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BatchSearchResponse": {
+      "description": "The results of a batch search.",
+      "type": "object",
+      "properties": {
+        "results": {
+          "description": "One result per search, in the order of the searches in the request.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchSearchResult"
+          }
+        }
+      }
+    },
+    "BatchSearchResult": {
+      "description": "The result of a single search of a batch.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "Set instead of objects if the search failed.",
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "objects": {
+          "description": "The objects found by the search, in the same shape as in a GraphQL Get query.",
+          "type": "array",
+          "items": {
+            "type": "object"
+          }
+        }
+      }
+    },
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+      "properties": {
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
+        },
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "distance": {
+            "type": "number",
+            "format": "float"
+          },
+          "word": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "C11yVector": {
+      "description": "A Vector in the Contextionary",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classProps": {
+            "description": "Vectorized properties.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "object",
+              "properties": {
+                "propsVectors": {
+                  "type": "array",
+                  "items": {
+                    "type": "number",
+                    "format": "float"
+                  }
+                },
+                "value": {
+                  "description": "String with valuename.",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "classVectors": {
+            "description": "Vectorized classname.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          }
+        }
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
+      "properties": {
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
+          "type": "object",
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
+            }
+          }
+        },
+        "individualWords": {
+          "description": "Weighted results for per individual word",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "info": {
+                "type": "object",
+                "properties": {
+                  "nearestNeighbors": {
+                    "$ref": "#/definitions/C11yNearestNeighbors"
+                  },
+                  "vector": {
+                    "$ref": "#/definitions/C11yVector"
+                  }
+                }
+              },
+              "present": {
+                "type": "boolean"
+              },
+              "word": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "Class": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "description": {
+          "description": "Description of the class.",
+          "type": "string"
+        },
+        "invertedIndexConfig": {
+          "$ref": "#/definitions/InvertedIndexConfig"
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "properties": {
+          "description": "The properties of the class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Property"
+          }
+        },
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
+        },
+        "shardingConfig": {
+          "description": "Manage how the index should be sharded and distributed in the cluster",
+          "type": "object"
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+          "type": "string"
+        }
+      }
+    },
+    "ClassMigration": {
+      "description": "Migration of a populated class to a new class definition. The objects are copied to a shadow class with the new definition, which then takes the place of the class.",
+      "properties": {
+        "class": {
+          "description": "The class to migrate. Once the migration has finished, this name refers to the migrated class.",
+          "type": "string"
+        },
+        "completionTimeUnix": {
+          "description": "Timestamp of the completion of the migration in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "definition": {
+          "description": "The new definition of the class. Its class name is ignored. Properties with a different data type are converted where possible, properties which are not part of the new definition are dropped.",
+          "$ref": "#/definitions/Class"
+        },
+        "error": {
+          "description": "The reason the migration failed, if any",
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "description": "The ID of the migration",
+          "type": "string",
+          "readOnly": true
+        },
+        "objectsCopied": {
+          "description": "The number of objects copied to the shadow class so far",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "propertyRenames": {
+          "description": "Properties which are renamed, mapped from their current name to their name in the new definition.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "shadowClass": {
+          "description": "The class the objects are copied to",
+          "type": "string",
+          "readOnly": true
+        },
+        "startTimeUnix": {
+          "description": "Timestamp of the start of the migration in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "status": {
+          "description": "Phase of the migration",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "COPYING",
+            "PAUSED",
+            "SWAPPING",
+            "SUCCESS",
+            "FAILED",
+            "ABORTED"
+          ],
+          "readOnly": true
+        }
+      }
+    },
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
+      "properties": {
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "description"
+          ]
+        },
+        "class": {
+          "description": "class (name) which is used in this classification",
+          "type": "string",
+          "example": "City"
+        },
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCountry"
+          ]
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
+        },
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "settings": {
+          "description": "classification-type specific settings",
+          "type": "object"
+        },
+        "status": {
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "description": "which algorithm to use for classifications",
+          "type": "string"
+        }
+      }
+    },
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "Clustering": {
+      "description": "Cluster the vectors of a class with k-means, and view the status and result of past clusterings.",
+      "type": "object",
+      "properties": {
+        "batchSize": {
+          "description": "number of vectors sampled per iteration if method is miniBatchKmeans, defaults to 1024",
+          "type": "integer"
+        },
+        "class": {
+          "description": "class (name) whose vectors are clustered",
+          "type": "string",
+          "example": "City"
+        },
+        "clusters": {
+          "description": "the clusters found, set once status == completed",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClusteringCluster"
+          }
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "cluster xzy: something went wrong"
+        },
+        "id": {
+          "description": "ID to uniquely identify this clustering run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "k": {
+          "description": "number of clusters",
+          "type": "integer",
+          "example": 8
+        },
+        "maxIterations": {
+          "description": "maximum number of iterations, defaults to 50",
+          "type": "integer"
+        },
+        "meta": {
+          "description": "additional meta information about the clustering",
+          "type": "object",
+          "$ref": "#/definitions/ClusteringMeta"
+        },
+        "method": {
+          "description": "which algorithm to use, defaults to kmeans",
+          "type": "string",
+          "enum": [
+            "kmeans",
+            "miniBatchKmeans"
+          ]
+        },
+        "representatives": {
+          "description": "number of objects closest to the centroid to return per cluster, defaults to 1",
+          "type": "integer"
+        },
+        "status": {
+          "description": "status of this clustering",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "where": {
+          "description": "limit the clustering to the objects matching this filter",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "writeProperty": {
+          "description": "int property to write the cluster ID of every object to",
+          "type": "string",
+          "example": "cluster"
+        }
+      }
+    },
+    "ClusteringCluster": {
+      "description": "A single cluster of a clustering",
+      "type": "object",
+      "properties": {
+        "centroid": {
+          "description": "the center of the cluster",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        },
+        "id": {
+          "description": "ID of the cluster, the value written to the writeProperty",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "representatives": {
+          "description": "IDs of the objects closest to the centroid, closest first",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "size": {
+          "description": "number of objects in the cluster",
+          "type": "integer",
+          "x-omitempty": false
+        }
+      }
+    },
+    "ClusteringMeta": {
+      "description": "Additional information to a specific clustering",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this clustering finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were clustered",
+          "type": "integer",
+          "example": 147
+        },
+        "started": {
+          "description": "time when this clustering was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "Deduplication": {
+      "description": "Find objects of a class whose vectors are within a distance of each other, and view the status of past deduplications.",
+      "type": "object",
+      "required": [
+        "distance"
+      ],
+      "properties": {
+        "action": {
+          "description": "what to do with the duplicates of every group: keep them, delete all but the oldest object, or reference the oldest object from all others, defaults to none",
+          "type": "string",
+          "enum": [
+            "none",
+            "delete",
+            "reference"
+          ]
+        },
+        "class": {
+          "description": "class (name) whose objects are deduplicated",
+          "type": "string",
+          "example": "Article"
+        },
+        "distance": {
+          "description": "maximum distance between the vectors of two objects to consider them duplicates",
+          "type": "number",
+          "example": 0.01
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "deduplicate xzy: something went wrong"
+        },
+        "id": {
+          "description": "ID to uniquely identify this deduplication run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "meta": {
+          "description": "additional meta information about the deduplication",
+          "type": "object",
+          "$ref": "#/definitions/DeduplicationMeta"
+        },
+        "referenceProperty": {
+          "description": "reference property which is set to the oldest object of the group on all duplicates if action is reference",
+          "type": "string",
+          "example": "duplicateOf"
+        },
+        "status": {
+          "description": "status of this deduplication",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        }
+      }
+    },
+    "DeduplicationGroup": {
+      "description": "A group of objects whose vectors are within the distance of the oldest object of the group",
+      "type": "object",
+      "properties": {
+        "duplicates": {
+          "description": "IDs of the other objects of the group, oldest first",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "keep": {
+          "description": "ID of the oldest object of the group, which is kept if action is delete",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "DeduplicationMeta": {
+      "description": "Additional information to a specific deduplication",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this deduplication finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "duplicates": {
+          "description": "number of objects which are duplicates of an older object",
+          "type": "integer",
+          "example": 12
+        },
+        "groups": {
+          "description": "number of groups of duplicates which were found",
+          "type": "integer",
+          "example": 7
+        },
+        "started": {
+          "description": "time when this deduplication was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "DeduplicationResult": {
+      "description": "The groups of duplicates found by a deduplication",
+      "type": "object",
+      "properties": {
+        "groups": {
+          "description": "the groups of duplicates",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DeduplicationGroup"
+          }
+        }
+      }
+    },
+    "Deprecation": {
+      "type": "object",
+      "properties": {
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
+          "type": "string"
+        },
+        "id": {
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
+          "type": "string"
+        },
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mitigation": {
+          "description": "User-required object to not be affected by the (planned) removal",
+          "type": "string"
+        },
+        "msg": {
+          "description": "What this deprecation is about",
+          "type": "string"
+        },
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+          "type": "string"
+        },
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
+          "type": "string",
+          "x-nullable": true
+        },
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string",
+          "format": "date-time"
+        },
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
+          "type": "string"
+        },
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+          "type": "string"
+        }
+      }
+    },
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "message": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "GeoCoordinates": {
+      "properties": {
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        },
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
+          "type": "number",
+          "format": "float",
+          "x-nullable": true
+        }
+      }
+    },
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
+      "properties": {
+        "locations": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "column": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "line": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
+        },
+        "message": {
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLQuery"
+      }
+    },
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
+        },
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
+        }
+      }
+    },
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+      "properties": {
+        "data": {
+          "description": "GraphQL data object.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
+        },
+        "errors": {
+          "description": "Array with errors.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "ImportResponse": {
+      "description": "Result of an import of objects into a class.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class the objects were imported into.",
+          "type": "string"
+        },
+        "errors": {
+          "description": "Errors of the objects that failed to be imported. Only the first 100 errors are reported.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failed": {
+          "description": "How many objects failed to be imported.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "imported": {
+          "description": "How many objects were imported successfully.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "InvertedIndexConfig": {
+      "description": "Configure the inverted index built into Weaviate",
+      "type": "object",
+      "properties": {
+        "bm25": {
+          "$ref": "#/definitions/BM25Config"
+        },
+        "cleanupIntervalSeconds": {
+          "description": "Asynchronous index clean up happens every n seconds",
+          "type": "number",
+          "format": "int"
+        },
+        "indexNullState": {
+          "description": "Index each object with the null state",
+          "type": "boolean"
+        },
+        "indexPropertyLength": {
+          "description": "Index length of properties",
+          "type": "boolean"
+        },
+        "indexTimestamps": {
+          "description": "Index each object by its internal timestamps",
+          "type": "boolean"
+        },
+        "stopwords": {
+          "$ref": "#/definitions/StopwordConfig"
+        }
+      }
+    },
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Link": {
+      "type": "object",
+      "properties": {
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
+          "type": "string"
+        },
+        "href": {
+          "description": "target of the link",
+          "type": "string"
+        },
+        "name": {
+          "description": "human readable name of the resource group",
+          "type": "string"
+        },
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+          "type": "string"
+        }
+      }
+    },
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
+      "type": "object",
+      "properties": {
+        "hostname": {
+          "description": "The url of the host.",
+          "type": "string",
+          "format": "url"
+        },
+        "modules": {
+          "description": "Module-specific meta information",
+          "type": "object"
+        },
+        "version": {
+          "description": "Version of weaviate you are currently running",
+          "type": "string"
+        }
+      }
+    },
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
+    },
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "description": "A primitive data type, or \"object\" or \"object[]\" to nest further. References are not supported within nested objects.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the property.",
+          "type": "string"
+        },
+        "indexFilterable": {
+          "description": "Optional. Should this nested property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use it in where filters or sorting.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this nested property be indexed for bm25 and hybrid search. Defaults to true. Applicable only to nested properties of data type text and text[].",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the nested property. It is addressed by its dotted path (for example address.city) in filters and sorting.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field"
+          ]
+        }
+      }
+    },
+    "NodeDecommission": {
+      "description": "The progress of decommissioning a node",
+      "properties": {
+        "classes": {
+          "description": "Progress per class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeDecommissionClass"
+          }
+        },
+        "completionTimeUnix": {
+          "description": "Time when decommissioning completed or failed (unix timestamp in milliseconds).",
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "Error message if decommissioning failed.",
+          "type": "string"
+        },
+        "node": {
+          "description": "The name of the node.",
           "type": "string"
         },
+        "startTimeUnix": {
+          "description": "Time when decommissioning started (unix timestamp in milliseconds).",
+          "type": "integer",
+          "format": "int64"
+        },
         "status": {
-          "description": "phase of backup creation process",
+          "description": "Status of the decommissioning. A DRAINING node does not receive new shards, a DECOMMISSIONED node has left the cluster.",
           "type": "string",
-          "default": "STARTED",
           "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
+            "DRAINING",
+            "DECOMMISSIONED",
             "FAILED"
           ]
         }
       }
     },
-    "BackupCreateStatusResponse": {
-      "description": "The definition of a backup create metadata",
+    "NodeDecommissionClass": {
+      "description": "The progress of draining the shards of a class from a node",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+        "class": {
+          "description": "The name of the class.",
           "type": "string"
         },
         "error": {
-          "description": "error message if creation failed",
+          "description": "Error message if a shard of the class could not be moved.",
           "type": "string"
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+        "shardsMoved": {
+          "description": "The number of shards which have been moved away from the node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shardsTotal": {
+          "description": "The number of shards of the class held by the node.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "NodeShardStatus": {
+      "description": "The definition of a node shard status response body",
+      "properties": {
+        "class": {
+          "description": "The name of shard's class.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objectCount": {
+          "description": "The number of objects in shard.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "NodeStats": {
+      "description": "The summary of Weaviate's statistics.",
+      "properties": {
+        "objectCount": {
+          "description": "The total number of objects in DB.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "shardCount": {
+          "description": "The count of Weaviate's shards.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
+        }
+      }
+    },
+    "NodeStatus": {
+      "description": "The definition of a backup node status response body",
+      "properties": {
+        "decommission": {
+          "description": "Progress of decommissioning the node, if it is being or has been decommissioned.",
+          "$ref": "#/definitions/NodeDecommission"
+        },
+        "gitHash": {
+          "description": "The gitHash of Weaviate.",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
+        "name": {
+          "description": "The name of the node.",
           "type": "string"
         },
+        "shards": {
+          "description": "The list of the shards with it's statistics.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardStatus"
+          }
+        },
+        "stats": {
+          "description": "Weaviate overall statistics.",
+          "type": "object",
+          "$ref": "#/definitions/NodeStats"
+        },
         "status": {
-          "description": "phase of backup creation process",
+          "description": "Node's status.",
           "type": "string",
-          "default": "STARTED",
+          "default": "HEALTHY",
           "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE"
           ]
+        },
+        "version": {
+          "description": "The version of Weaviate.",
+          "type": "string"
+        }
+      }
+    },
+    "NodesStatusResponse": {
+      "description": "The status of all of the Weaviate nodes",
+      "type": "object",
+      "properties": {
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeStatus"
+          }
+        }
+      }
+    },
+    "Object": {
+      "type": "object",
+      "properties": {
+        "additional": {
+          "$ref": "#/definitions/AdditionalProperties"
+        },
+        "class": {
+          "description": "Class of the Object, defined in the schema.",
+          "type": "string"
+        },
+        "creationTimeUnix": {
+          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "vector": {
+          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
+          "$ref": "#/definitions/C11yVector"
+        },
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
+        }
+      }
+    },
+    "ObjectsGetResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/Object"
+        },
+        {
+          "properties": {
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
+              }
+            }
+          }
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Object.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "PENDING",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
         }
-      }
+      ]
     },
-    "BackupRestoreRequest": {
-      "description": "Request body for restoring a backup for a set of classes",
+    "ObjectsListResponse": {
+      "description": "List of Objects.",
+      "type": "object",
       "properties": {
-        "config": {
-          "description": "Custom configuration for the backup restoration process",
-          "type": "object"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup restoration process",
+        "deprecations": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/Deprecation"
           }
         },
-        "include": {
-          "description": "List of classes to include in the backup restoration process",
+        "objects": {
+          "description": "The actual list of Objects.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/Object"
           }
+        },
+        "totalResults": {
+          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "BackupRestoreResponse": {
-      "description": "The definition of a backup restore response body",
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "classes": {
-          "description": "The list of classes for which the backup restoration process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
+        "merge": {
+          "$ref": "#/definitions/Object"
         },
-        "status": {
-          "description": "phase of backup restoration process",
+        "op": {
+          "description": "The operation to be performed.",
           "type": "string",
-          "default": "STARTED",
           "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
           ]
-        }
-      }
-    },
-    "BackupRestoreStatusResponse": {
-      "description": "The definition of a backup restore metadata",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
         },
         "path": {
-          "description": "destination path of backup files proper to selected backup backend",
+          "description": "A JSON-Pointer.",
           "type": "string"
         },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED"
-          ]
-        }
-      }
-    },
-    "BatchDelete": {
-      "type": "object",
-      "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
-        },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-          "type": "string",
-          "default": "minimal"
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
         }
       }
     },
-    "BatchDeleteResponse": {
-      "description": "Delete Objects response.",
-      "type": "object",
+    "PatchDocumentObject": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
       "properties": {
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
         },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+        "merge": {
+          "$ref": "#/definitions/Object"
         },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+        "op": {
+          "description": "The operation to be performed.",
           "type": "string",
-          "default": "minimal"
-        },
-        "results": {
-          "type": "object",
-          "properties": {
-            "failed": {
-              "description": "How many objects should have been deleted but could not be deleted.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "limit": {
-              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "matches": {
-              "description": "How many objects were matched by the filter.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "objects": {
-              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
-              "type": "array",
-              "items": {
-                "description": "Results for this specific Object.",
-                "format": "object",
-                "properties": {
-                  "errors": {
-                    "$ref": "#/definitions/ErrorResponse"
-                  },
-                  "id": {
-                    "description": "ID of the Object.",
-                    "type": "string",
-                    "format": "uuid"
-                  },
-                  "status": {
-                    "type": "string",
-                    "default": "SUCCESS",
-                    "enum": [
-                      "SUCCESS",
-                      "DRYRUN",
-                      "FAILED"
-                    ]
-                  }
-                }
-              }
-            },
-            "successful": {
-              "description": "How many objects were successfully deleted in this round.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            }
-          }
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
         }
       }
     },
-    "BatchReference": {
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
       "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+        "id": {
+          "description": "The session ID of the peer.",
           "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+          "format": "uuid"
         },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+        "name": {
+          "description": "Human readable name.",
+          "type": "string"
+        },
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
+          "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
           "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+          "format": "uri"
         }
       }
     },
-    "BatchReferenceResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
+    "PeerUpdateList": {
+      "description": "List of known peers.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/PeerUpdate"
+      }
+    },
+    "PhoneNumber": {
+      "properties": {
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
+          "type": "string"
+        },
+        "input": {
+          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
+          "type": "string"
+        },
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+          "type": "string"
+        },
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
+        },
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
+          "type": "string"
+        },
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
+        }
+      }
+    },
+    "Principal": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
+          "type": "string"
         }
-      ]
+      }
     },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+    "Property": {
+      "type": "object",
       "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
+        "dataType": {
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\". Nested objects use \"object\" or \"object[]\" together with nestedProperties.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
+        "description": {
+          "description": "Description of the property.",
           "type": "string"
         },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+        "indexFilterable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexInverted": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
+        "name": {
+          "description": "Name of the property as URI relative to the schema URL.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field"
+          ]
+        }
+      }
+    },
+    "PropertySchema": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
+      "properties": {
+        "closestLosingDistance": {
+          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
           "type": "number",
-          "format": "float"
+          "format": "float32",
+          "x-nullable": true
+        },
+        "closestOverallDistance": {
+          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
+          "type": "number",
+          "format": "float32"
+        },
+        "closestWinningDistance": {
+          "description": "Closest distance of a neighbor from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingCount": {
+          "description": "size of the losing group, can be 0 if the winning group size equals k",
+          "type": "number",
+          "format": "int64"
+        },
+        "losingDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanLosingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanWinningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "overallCount": {
+          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningCount": {
+          "description": "size of the winning group, a number between 1..k",
+          "type": "number",
+          "format": "int64"
+        },
+        "winningDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32"
         }
       }
     },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "distance": {
-            "type": "number",
-            "format": "float"
-          },
-          "word": {
-            "type": "string"
-          }
+    "ReplicationConfig": {
+      "description": "Configure how replication is executed in a cluster",
+      "type": "object",
+      "properties": {
+        "factor": {
+          "description": "Number of times a class is replicated",
+          "type": "integer"
         }
       }
     },
-    "C11yVector": {
-      "description": "A Vector in the Contextionary",
-      "type": "array",
-      "items": {
-        "type": "number",
-        "format": "float"
+    "Role": {
+      "description": "A role grants a set of permissions to the users and groups it is assigned to",
+      "properties": {
+        "groups": {
+          "description": "OIDC groups the role is assigned to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "The name of the role. Only letters, numbers, underscore and minus characters are allowed.",
+          "type": "string"
+        },
+        "permissions": {
+          "description": "The permissions granted by the role",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RolePermission"
+          }
+        },
+        "users": {
+          "description": "Users the role is assigned to. Users authenticated with an API key are identified by the user the key is assigned to.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
       }
     },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classProps": {
-            "description": "Vectorized properties.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "object",
-              "properties": {
-                "propsVectors": {
-                  "type": "array",
-                  "items": {
-                    "type": "number",
-                    "format": "float"
-                  }
-                },
-                "value": {
-                  "description": "String with valuename.",
-                  "type": "string"
-                }
-              }
-            }
-          },
-          "classVectors": {
-            "description": "Vectorized classname.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "number",
-              "format": "float"
-            }
+    "RolePermission": {
+      "description": "A permission allows a set of actions on all resources matching a pattern",
+      "properties": {
+        "actions": {
+          "description": "The actions which are allowed, e.g. get, list, create, update or delete. Use * to allow all actions.",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "resource": {
+          "description": "Pattern of the resources the actions are allowed on, e.g. objects/Invoice/* for the objects of class Invoice, schema/* for the schema or backups/* for backups. The wildcard * matches any sequence of characters.",
+          "type": "string"
         }
       }
     },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
+      "type": "object",
       "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
+        "aliases": {
+          "description": "Alternative names of classes, mapped to the name of the class they refer to.",
           "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
-              "type": "array",
-              "items": {
-                "format": "string"
-              }
-            }
+          "additionalProperties": {
+            "type": "string"
           }
         },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
+        "classes": {
+          "description": "Semantic classes that are available.",
           "type": "array",
           "items": {
-            "type": "object",
-            "properties": {
-              "info": {
-                "type": "object",
-                "properties": {
-                  "nearestNeighbors": {
-                    "$ref": "#/definitions/C11yNearestNeighbors"
-                  },
-                  "vector": {
-                    "$ref": "#/definitions/C11yVector"
-                  }
-                }
-              },
-              "present": {
-                "type": "boolean"
-              },
-              "word": {
-                "type": "string"
-              }
-            }
+            "$ref": "#/definitions/Class"
           }
+        },
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
+        },
+        "name": {
+          "description": "Name of the schema.",
+          "type": "string"
         }
       }
     },
-    "Class": {
-      "type": "object",
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "ShardMove": {
+      "description": "Move of a physical shard of a class from one node to another",
       "properties": {
         "class": {
-          "description": "Name of the class as URI relative to the schema URL.",
+          "description": "The class the shard belongs to",
           "type": "string"
         },
-        "description": {
-          "description": "Description of the class.",
-          "type": "string"
+        "completionTimeUnix": {
+          "description": "Timestamp of the completion of the move in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
         },
-        "invertedIndexConfig": {
-          "$ref": "#/definitions/InvertedIndexConfig"
+        "error": {
+          "description": "The reason the shard move failed, if any",
+          "type": "string",
+          "readOnly": true
         },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
+        "id": {
+          "description": "The ID of the shard move",
+          "type": "string",
+          "readOnly": true
         },
-        "properties": {
-          "description": "The properties of the class.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Property"
-          }
+        "shard": {
+          "description": "The name of the shard",
+          "type": "string"
         },
-        "replicationConfig": {
-          "$ref": "#/definitions/ReplicationConfig"
+        "sourceNode": {
+          "description": "The node the shard is moved from",
+          "type": "string"
         },
-        "shardingConfig": {
-          "description": "Manage how the index should be sharded and distributed in the cluster",
-          "type": "object"
+        "startTimeUnix": {
+          "description": "Timestamp of the start of the move in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
         },
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
+        "status": {
+          "description": "Phase of the shard move",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "COPYING",
+            "CATCHING_UP",
+            "SWITCHING",
+            "SUCCESS",
+            "FAILED"
+          ],
+          "readOnly": true
         },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
+        "targetNode": {
+          "description": "The node the shard is moved to",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStatus": {
+      "description": "The status of a single shard",
+      "properties": {
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStatusGetResponse": {
+      "description": "Response body of shard status get request",
+      "properties": {
+        "name": {
+          "description": "Name of the shard",
           "type": "string"
         },
-        "vectorizer": {
-          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStatusList": {
+      "description": "The status of all the shards of a Class",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ShardStatusGetResponse"
+      }
+    },
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
+      "properties": {
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri"
+        },
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "type": "string",
+          "format": "uri"
+        },
+        "classification": {
+          "description": "Additional Meta information about classifications if the item was part of one",
+          "$ref": "#/definitions/ReferenceMetaClassification"
+        },
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
+        },
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "StopwordConfig": {
+      "description": "fine-grained control over stopword list usage",
+      "type": "object",
+      "properties": {
+        "additions": {
+          "description": "stopwords to be considered additionally",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "preset": {
+          "description": "pre-existing list of common words by language",
           "type": "string"
+        },
+        "removals": {
+          "description": "stopwords to be removed from consideration",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
+    "VectorWeights": {
+      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
+      "type": "object"
+    },
+    "WhereFilter": {
+      "description": "Filter search results using a where filter",
       "type": "object",
       "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
+        "operands": {
+          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
           "type": "array",
           "items": {
-            "type": "string"
-          },
-          "example": [
-            "description"
-          ]
+            "$ref": "#/definitions/WhereFilter"
+          }
         },
-        "class": {
-          "description": "class (name) which is used in this classification",
+        "operator": {
+          "description": "operator to use",
           "type": "string",
-          "example": "City"
+          "enum": [
+            "And",
+            "Or",
+            "Equal",
+            "Like",
+            "Not",
+            "NotEqual",
+            "GreaterThan",
+            "GreaterThanEqual",
+            "LessThan",
+            "LessThanEqual",
+            "WithinGeoRange",
+            "IsNull"
+          ],
+          "example": "GreaterThanEqual"
         },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
+        "path": {
+          "description": "path to the property currently being filtered",
           "type": "array",
           "items": {
             "type": "string"
           },
           "example": [
-            "inCountry"
+            "inCity",
+            "City",
+            "name"
           ]
         },
-        "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
-        },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+        "valueBoolean": {
+          "description": "value as boolean",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": false
         },
-        "id": {
-          "description": "ID to uniquely identify this classification run",
+        "valueDate": {
+          "description": "value as date (as string)",
           "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+          "x-nullable": true,
+          "example": "TODO"
         },
-        "meta": {
-          "description": "additional meta information about the classification",
+        "valueGeoRange": {
+          "description": "value as geo coordinates and distance",
           "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoRange"
         },
-        "settings": {
-          "description": "classification-type specific settings",
-          "type": "object"
+        "valueInt": {
+          "description": "value as integer",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true,
+          "example": 2000
         },
-        "status": {
-          "description": "status of this classification",
+        "valueNumber": {
+          "description": "value as number/float",
+          "type": "number",
+          "format": "float64",
+          "x-nullable": true,
+          "example": 3.14
+        },
+        "valueString": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
           "type": "string",
-          "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
+          "x-nullable": true,
+          "example": "my search term"
         },
-        "type": {
-          "description": "which algorithm to use for classifications",
-          "type": "string"
+        "valueText": {
+          "description": "value as text",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
         }
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
+    "WhereFilterGeoRange": {
+      "description": "filter within a distance of a georange",
       "type": "object",
       "properties": {
-        "completed": {
-          "description": "time when this classification finished",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
-        },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
-          "type": "integer",
-          "example": 147
-        },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
-          "type": "integer",
-          "example": 7
-        },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
-          "type": "integer",
-          "example": 140
+        "distance": {
+          "type": "object",
+          "properties": {
+            "max": {
+              "type": "number",
+              "format": "float64"
+            }
+          }
         },
-        "started": {
-          "description": "time when this classification was started",
-          "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+        "geoCoordinates": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
         }
       }
+    }
+  },
+  "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "description": "The starting ID of the result window.",
+      "name": "after",
+      "in": "query"
+    },
+    "CommonClassParameterQuery": {
+      "type": "string",
+      "description": "Class parameter specifies the class from which to query objects",
+      "name": "class",
+      "in": "query"
+    },
+    "CommonConsistencyLevelParameterQuery": {
+      "type": "string",
+      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+      "name": "consistency_level",
+      "in": "query"
+    },
+    "CommonIncludeParameterQuery": {
+      "type": "string",
+      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+      "name": "include",
+      "in": "query"
+    },
+    "CommonLimitParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+      "name": "limit",
+      "in": "query"
+    },
+    "CommonNodeNameParameterQuery": {
+      "type": "string",
+      "description": "The target node which should fulfill the request",
+      "name": "node_name",
+      "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "default": 0,
+      "description": "The starting index of the result window. Default value is 0.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonOrderParameterQuery": {
+      "type": "string",
+      "description": "Order parameter to tell how to order (asc or desc) data within given field",
+      "name": "order",
+      "in": "query"
+    },
+    "CommonSortParameterQuery": {
+      "type": "string",
+      "description": "Sort parameter to pass an information about the names of the sort fields",
+      "name": "sort",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "oidc": {
+      "description": "OIDC (OpenConnect ID - based on OAuth2)",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "http://to-be-configured-in-the-application-config"
+    }
+  },
+  "security": [
+    {},
+    {
+      "oidc": []
+    }
+  ],
+  "tags": [
+    {
+      "name": "objects"
+    },
+    {
+      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
+      "name": "batch"
+    },
+    {
+      "name": "graphql"
+    },
+    {
+      "name": "meta"
     },
-    "Deprecation": {
-      "type": "object",
-      "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
-          "type": "string"
-        },
-        "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
-          "type": "string"
-        },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "mitigation": {
-          "description": "User-required object to not be affected by the (planned) removal",
-          "type": "string"
-        },
-        "msg": {
-          "description": "What this deprecation is about",
-          "type": "string"
-        },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
-          "type": "string"
-        },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
-          "type": "string",
-          "x-nullable": true
-        },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
-          "type": "string",
-          "format": "date-time",
-          "x-nullable": true
-        },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string",
-          "format": "date-time"
-        },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string"
-        },
-        "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
-          "type": "string"
-        }
-      }
+    {
+      "name": "P2P"
     },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "message": {
-                "type": "string"
+    {
+      "description": "All functions related to the Contextionary.",
+      "name": "contextionary-API"
+    },
+    {
+      "description": "These operations enable manipulation of the schema in Weaviate schema.",
+      "name": "schema"
+    }
+  ],
+  "externalDocs": {
+    "url": "https://github.com/weaviate/weaviate"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json",
+    "application/yaml"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Cloud-native, modular vector database",
+    "title": "Weaviate",
+    "contact": {
+      "name": "Weaviate",
+      "url": "https://github.com/weaviate",
+      "email": "hello@weaviate.io"
+    },
+    "version": "1.19.1"
+  },
+  "basePath": "/v1",
+  "paths": {
+    "/": {
+      "get": {
+        "description": "Home. Discover the REST API",
+        "operationId": "weaviate.root",
+        "responses": {
+          "200": {
+            "description": "Weaviate is alive and ready to serve content",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Link"
+                  }
+                }
               }
             }
           }
         }
       }
     },
-    "GeoCoordinates": {
-      "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
+    "/.well-known/live": {
+      "get": {
+        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
+        "operationId": "weaviate.wellknown.liveness",
+        "responses": {
+          "200": {
+            "description": "The application is able to respond to HTTP requests"
+          }
         }
       }
     },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
-      "properties": {
-        "locations": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "column": {
-                "type": "integer",
-                "format": "int64"
-              },
-              "line": {
-                "type": "integer",
-                "format": "int64"
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                },
+                "scopes": {
+                  "description": "OAuth Scopes",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                }
               }
             }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "message": {
-          "type": "string"
-        },
-        "path": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        }
-      }
-    },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
-      }
-    },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
-      "type": "object",
-      "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
-          "type": "string"
-        },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
-          "type": "string"
-        },
-        "variables": {
-          "description": "Additional variables for the query.",
-          "type": "object"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
-      "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
-          }
-        },
-        "errors": {
-          "description": "Array with errors.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
           },
-          "x-omitempty": true
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
         }
       }
     },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLResponse"
-      }
-    },
-    "InvertedIndexConfig": {
-      "description": "Configure the inverted index built into Weaviate",
-      "type": "object",
-      "properties": {
-        "bm25": {
-          "$ref": "#/definitions/BM25Config"
-        },
-        "cleanupIntervalSeconds": {
-          "description": "Asynchronous index clean up happens every n seconds",
-          "type": "number",
-          "format": "int"
-        },
-        "indexNullState": {
-          "description": "Index each object with the null state",
-          "type": "boolean"
-        },
-        "indexPropertyLength": {
-          "description": "Index length of properties",
-          "type": "boolean"
+    "/authz/roles": {
+      "get": {
+        "description": "Lists all roles of the cluster",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.roles.list",
+        "responses": {
+          "200": {
+            "description": "Successfully listed the roles.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Role"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "indexTimestamps": {
-          "description": "Index each object by its internal timestamps",
-          "type": "boolean"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "post": {
+        "description": "Creates or replaces a role. The role is replicated to all nodes of the cluster and takes effect immediately.",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.roles.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role successfully created.",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid role.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "stopwords": {
-          "$ref": "#/definitions/StopwordConfig"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Link": {
-      "type": "object",
-      "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
-          "type": "string"
-        },
-        "href": {
-          "description": "target of the link",
-          "type": "string"
+    "/authz/roles/{name}": {
+      "get": {
+        "description": "Returns a single role",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.roles.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the role.",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved the role.",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Role does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "name": {
-          "description": "human readable name of the resource group",
-          "type": "string"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "description": "Deletes a role. Users and groups the role was assigned to lose its permissions immediately.",
+        "tags": [
+          "authz"
+        ],
+        "operationId": "authz.roles.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the role.",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Role successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Role does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
-      "type": "object",
-      "properties": {
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
+    "/backup-schedules": {
+      "get": {
+        "description": "Lists all backup schedules of the cluster",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.schedules.list",
+        "responses": {
+          "200": {
+            "description": "Successfully listed the backup schedules.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BackupSchedule"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "modules": {
-          "description": "Module-specific meta information",
-          "type": "object"
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Creates or replaces a schedule to periodically create backups of a set of classes. Backups created by the schedule are deleted according to its retention policy.",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.schedules.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup schedule successfully created.",
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup schedule.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "version": {
-          "description": "Version of weaviate you are currently running",
-          "type": "string"
-        }
-      }
-    },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
       }
     },
-    "NodeShardStatus": {
-      "description": "The definition of a node shard status response body",
-      "properties": {
-        "class": {
-          "description": "The name of shard's class.",
-          "type": "string",
-          "x-omitempty": false
+    "/backup-schedules/{id}": {
+      "get": {
+        "description": "Returns a backup schedule including the backups it created which have not expired yet",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.schedules.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the backup schedule.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup schedule successfully returned.",
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup schedule does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "name": {
-          "description": "The name of the shard.",
-          "type": "string",
-          "x-omitempty": false
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "delete": {
+        "description": "Deletes a backup schedule. Backups which were already created by the schedule are kept.",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.schedules.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the backup schedule.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Backup schedule successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup schedule does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "objectCount": {
-          "description": "The number of objects in shard.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
-        }
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
       }
     },
-    "NodeStats": {
-      "description": "The summary of Weaviate's statistics.",
-      "properties": {
-        "objectCount": {
-          "description": "The total number of objects in DB.",
-          "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+    "/backups/{backend}": {
+      "post": {
+        "description": "Starts a process of creating a backup for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.create",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup create process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup creation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "shardCount": {
-          "description": "The count of Weaviate's shards.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
-        }
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
       }
     },
-    "NodeStatus": {
-      "description": "The definition of a backup node status response body",
-      "properties": {
-        "gitHash": {
-          "description": "The gitHash of Weaviate.",
-          "type": "string"
-        },
-        "name": {
-          "description": "The name of the node.",
-          "type": "string"
-        },
-        "shards": {
-          "description": "The list of the shards with it's statistics.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeShardStatus"
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of backup creation attempt for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.create.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup creation status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupCreateStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "stats": {
-          "description": "Weaviate overall statistics.",
-          "type": "object",
-          "$ref": "#/definitions/NodeStats"
-        },
-        "status": {
-          "description": "Node's status.",
-          "type": "string",
-          "default": "HEALTHY",
-          "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE"
-          ]
-        },
-        "version": {
-          "description": "The version of Weaviate.",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
       }
     },
-    "NodesStatusResponse": {
-      "description": "The status of all of the Weaviate nodes",
-      "type": "object",
-      "properties": {
-        "nodes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeStatus"
+    "/backups/{backend}/{id}/restore": {
+      "get": {
+        "description": "Returns status of a backup restoration attempt for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.restore.status",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup restoration status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
-      }
-    },
-    "Object": {
-      "type": "object",
-      "properties": {
-        "additional": {
-          "$ref": "#/definitions/AdditionalProperties"
-        },
-        "class": {
-          "description": "Class of the Object, defined in the schema.",
-          "type": "string"
-        },
-        "creationTimeUnix": {
-          "description": "Timestamp of creation of this Object in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "lastUpdateTimeUnix": {
-          "description": "Timestamp of the last Object update in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "properties": {
-          "$ref": "#/definitions/PropertySchema"
-        },
-        "vector": {
-          "description": "This object's position in the Contextionary vector space. Read-only if using a vectorizer other than 'none'. Writable and required if using 'none' as vectorizer.",
-          "$ref": "#/definitions/C11yVector"
-        },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
-        }
-      }
-    },
-    "ObjectsGetResponse": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Object"
         },
-        {
-          "properties": {
-            "deprecations": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Deprecation"
-              }
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Starts a process of restoring a backup for a set of classes",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.restore",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup restoration process successfully started.",
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Object.",
-              "format": "object",
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/batch/objects": {
+      "post": {
+        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
               "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
                 },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "PENDING",
-                    "FAILED"
-                  ]
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
                 }
               }
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
-        }
-      ]
-    },
-    "ObjectsListResponse": {
-      "description": "List of Objects.",
-      "type": "object",
-      "properties": {
-        "deprecations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Deprecation"
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "objects": {
-          "description": "The actual list of Objects.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Object"
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
+      },
+      "delete": {
+        "description": "Delete Objects in bulk that match a certain filter.",
+        "tags": [
+          "batch",
+          "objects"
+        ],
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
-        },
-        "totalResults": {
-          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
-          "type": "integer",
-          "format": "int64"
-        }
-      }
-    },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Object"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
-        },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
-        }
-      }
-    },
-    "PatchDocumentObject": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
-      "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
-        },
-        "merge": {
-          "$ref": "#/definitions/Object"
-        },
-        "op": {
-          "description": "The operation to be performed.",
-          "type": "string",
-          "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
-          ]
-        },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
-        }
-      }
-    },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
-      "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "name": {
-          "description": "Human readable name.",
-          "type": "string"
-        },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
-          "type": "string"
-        },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
-          "type": "string",
-          "format": "uri"
-        }
-      }
-    },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/PeerUpdate"
-      }
-    },
-    "PhoneNumber": {
-      "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
-          "type": "string"
-        },
-        "input": {
-          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
-          "type": "string"
-        },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
-          "type": "string"
-        },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
-          "type": "number",
-          "format": "uint64"
-        },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
-          "type": "string"
-        },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
-          "type": "boolean"
-        }
-      }
-    },
-    "Principal": {
-      "type": "object",
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "$ref": "#/definitions/BatchDeleteResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
-          "type": "string"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.manipulate"
+        ]
       }
     },
-    "Property": {
-      "type": "object",
-      "properties": {
-        "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/batch/references": {
+      "post": {
+        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+        "tags": [
+          "batch",
+          "references"
+        ],
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
+        "parameters": [
+          {
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "description": {
-          "description": "Description of the property.",
-          "type": "string"
-        },
-        "indexFilterable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexInverted": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "indexSearchable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
-        },
-        "name": {
-          "description": "Name of the property as URI relative to the schema URL.",
-          "type": "string"
-        },
-        "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
-          "type": "string",
-          "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field"
-          ]
-        }
-      }
-    },
-    "PropertySchema": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
-      "properties": {
-        "closestLosingDistance": {
-          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "closestOverallDistance": {
-          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
-          "type": "number",
-          "format": "float32"
-        },
-        "closestWinningDistance": {
-          "description": "Closest distance of a neighbor from the winning group",
-          "type": "number",
-          "format": "float32"
-        },
-        "losingCount": {
-          "description": "size of the losing group, can be 0 if the winning group size equals k",
-          "type": "number",
-          "format": "int64"
-        },
-        "losingDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "meanLosingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "meanWinningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
-        },
-        "overallCount": {
-          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
-          "type": "number",
-          "format": "int64"
-        },
-        "winningCount": {
-          "description": "size of the winning group, a number between 1..k",
-          "type": "number",
-          "format": "int64"
-        },
-        "winningDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32"
-        }
-      }
-    },
-    "ReplicationConfig": {
-      "description": "Configure how replication is executed in a cluster",
-      "type": "object",
-      "properties": {
-        "factor": {
-          "description": "Number of times a class is replicated",
-          "type": "integer"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.add"
+        ]
       }
     },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
-      "type": "object",
-      "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Class"
+    "/batch/search": {
+      "post": {
+        "description": "Run several independent searches in a single request. The searches are executed concurrently with a shared deadline and every search gets its own result or error.",
+        "tags": [
+          "batch",
+          "search"
+        ],
+        "summary": "Run several independent searches in a single request.",
+        "operationId": "batch.search",
+        "parameters": [
+          {
+            "description": "The searches to run.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchSearchRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request Successful. Warning: A successful request does not guarantee that every query succeeded. Inspect the response body to see which queries succeeded and which failed.",
+            "schema": {
+              "$ref": "#/definitions/BatchSearchResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "maintainer": {
-          "description": "Email of the maintainer.",
-          "type": "string",
-          "format": "email"
-        },
-        "name": {
-          "description": "Name of the schema.",
-          "type": "string"
-        }
-      }
-    },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
-    },
-    "ShardStatus": {
-      "description": "The status of a single shard",
-      "properties": {
-        "status": {
-          "description": "Status of the shard",
-          "type": "string"
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "ShardStatusGetResponse": {
-      "description": "Response body of shard status get request",
-      "properties": {
-        "name": {
-          "description": "Name of the shard",
-          "type": "string"
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
+        "parameters": [
+          {
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "status": {
-          "description": "Status of the shard",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.classifications.post"
+        ]
       }
     },
-    "ShardStatusList": {
-      "description": "The status of all the shards of a Class",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/ShardStatusGetResponse"
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
+        "tags": [
+          "classifications"
+        ],
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "classification id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the classification, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Classification does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.get"
+        ]
       }
     },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
-      "properties": {
-        "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri"
-        },
-        "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
-          "type": "string",
-          "format": "uri"
+    "/cluster/shard-moves": {
+      "get": {
+        "description": "Lists the shard moves started on this node, including moves started by the automatic rebalancer",
+        "tags": [
+          "cluster"
+        ],
+        "operationId": "cluster.shardMoves.list",
+        "responses": {
+          "200": {
+            "description": "Successfully listed the shard moves.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ShardMove"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "classification": {
-          "description": "Additional Meta information about classifications if the item was part of one",
-          "$ref": "#/definitions/ReferenceMetaClassification"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "post": {
+        "description": "Starts moving a shard of a class from one node to another. The shard is copied to the target node, catches up with writes which happened in the meantime and is then removed from the source node. The move runs in the background, its progress can be followed through its status.",
+        "tags": [
+          "cluster"
+        ],
+        "operationId": "cluster.shardMoves.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardMove"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Shard move successfully started.",
+            "schema": {
+              "$ref": "#/definitions/ShardMove"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid shard move.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
-          "type": "string",
-          "format": "uri"
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/cluster/shard-moves/{id}": {
+      "get": {
+        "description": "Returns the status of a shard move",
+        "tags": [
+          "cluster"
+        ],
+        "operationId": "cluster.shardMoves.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the shard move.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard move successfully returned.",
+            "schema": {
+              "$ref": "#/definitions/ShardMove"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Shard move does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "StopwordConfig": {
-      "description": "fine-grained control over stopword list usage",
-      "type": "object",
-      "properties": {
-        "additions": {
-          "description": "stopwords to be considered additionally",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/clusterings/": {
+      "post": {
+        "description": "Trigger a clustering based on the specified params. Clusterings will run in the background, use GET /clusterings/\u003cid\u003e to retrieve the status of your clustering.",
+        "tags": [
+          "clusterings"
+        ],
+        "summary": "Starts a clustering.",
+        "operationId": "clusterings.post",
+        "parameters": [
+          {
+            "description": "parameters to start a clustering",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Clustering"
+            }
           }
-        },
-        "preset": {
-          "description": "pre-existing list of common words by language",
-          "type": "string"
-        },
-        "removals": {
-          "description": "stopwords to be removed from consideration",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started clustering.",
+            "schema": {
+              "$ref": "#/definitions/Clustering"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.clusterings.post"
+        ]
       }
     },
-    "VectorWeights": {
-      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
-      "type": "object"
-    },
-    "WhereFilter": {
-      "description": "Filter search results using a where filter",
-      "type": "object",
-      "properties": {
-        "operands": {
-          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/WhereFilter"
+    "/clusterings/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created clustering",
+        "tags": [
+          "clusterings"
+        ],
+        "summary": "View previously created clustering",
+        "operationId": "clusterings.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "clustering id",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
-        },
-        "operator": {
-          "description": "operator to use",
-          "type": "string",
-          "enum": [
-            "And",
-            "Or",
-            "Equal",
-            "Like",
-            "Not",
-            "NotEqual",
-            "GreaterThan",
-            "GreaterThanEqual",
-            "LessThan",
-            "LessThanEqual",
-            "WithinGeoRange",
-            "IsNull"
-          ],
-          "example": "GreaterThanEqual"
-        },
-        "path": {
-          "description": "path to the property currently being filtered",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the clustering, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Clustering"
+            }
           },
-          "example": [
-            "inCity",
-            "City",
-            "name"
-          ]
-        },
-        "valueBoolean": {
-          "description": "value as boolean",
-          "type": "boolean",
-          "x-nullable": true,
-          "example": false
-        },
-        "valueDate": {
-          "description": "value as date (as string)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "TODO"
-        },
-        "valueGeoRange": {
-          "description": "value as geo coordinates and distance",
-          "type": "object",
-          "x-nullable": true,
-          "$ref": "#/definitions/WhereFilterGeoRange"
-        },
-        "valueInt": {
-          "description": "value as integer",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true,
-          "example": 2000
-        },
-        "valueNumber": {
-          "description": "value as number/float",
-          "type": "number",
-          "format": "float64",
-          "x-nullable": true,
-          "example": 3.14
-        },
-        "valueString": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Clustering does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "valueText": {
-          "description": "value as text",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
-        }
+        "x-serviceIds": [
+          "weaviate.clusterings.get"
+        ]
       }
     },
-    "WhereFilterGeoRange": {
-      "description": "filter within a distance of a georange",
-      "type": "object",
-      "properties": {
-        "distance": {
-          "type": "object",
-          "properties": {
-            "max": {
-              "type": "number",
-              "format": "float64"
+    "/deduplications/": {
+      "post": {
+        "description": "Trigger a deduplication based on the specified params. Deduplications will run in the background, use GET /deduplications/\u003cid\u003e to retrieve the status of your deduplication.",
+        "tags": [
+          "deduplications"
+        ],
+        "summary": "Starts a deduplication.",
+        "operationId": "deduplications.post",
+        "parameters": [
+          {
+            "description": "parameters to start a deduplication",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Deduplication"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully started deduplication.",
+            "schema": {
+              "$ref": "#/definitions/Deduplication"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "geoCoordinates": {
-          "x-nullable": false,
-          "$ref": "#/definitions/GeoCoordinates"
-        }
+        "x-serviceIds": [
+          "weaviate.deduplications.post"
+        ]
       }
-    }
-  },
-  "parameters": {
-    "CommonAfterParameterQuery": {
-      "type": "string",
-      "description": "The starting ID of the result window.",
-      "name": "after",
-      "in": "query"
-    },
-    "CommonClassParameterQuery": {
-      "type": "string",
-      "description": "Class parameter specifies the class from which to query objects",
-      "name": "class",
-      "in": "query"
-    },
-    "CommonConsistencyLevelParameterQuery": {
-      "type": "string",
-      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-      "name": "consistency_level",
-      "in": "query"
-    },
-    "CommonIncludeParameterQuery": {
-      "type": "string",
-      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-      "name": "include",
-      "in": "query"
-    },
-    "CommonLimitParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-      "name": "limit",
-      "in": "query"
-    },
-    "CommonNodeNameParameterQuery": {
-      "type": "string",
-      "description": "The target node which should fulfill the request",
-      "name": "node_name",
-      "in": "query"
-    },
-    "CommonOffsetParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "default": 0,
-      "description": "The starting index of the result window. Default value is 0.",
-      "name": "offset",
-      "in": "query"
-    },
-    "CommonOrderParameterQuery": {
-      "type": "string",
-      "description": "Order parameter to tell how to order (asc or desc) data within given field",
-      "name": "order",
-      "in": "query"
-    },
-    "CommonSortParameterQuery": {
-      "type": "string",
-      "description": "Sort parameter to pass an information about the names of the sort fields",
-      "name": "sort",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "oidc": {
-      "description": "OIDC (OpenConnect ID - based on OAuth2)",
-      "type": "oauth2",
-      "flow": "implicit",
-      "authorizationUrl": "http://to-be-configured-in-the-application-config"
-    }
-  },
-  "security": [
-    {},
-    {
-      "oidc": []
-    }
-  ],
-  "tags": [
-    {
-      "name": "objects"
-    },
-    {
-      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
-      "name": "batch"
-    },
-    {
-      "name": "graphql"
-    },
-    {
-      "name": "meta"
-    },
-    {
-      "name": "P2P"
-    },
-    {
-      "description": "All functions related to the Contextionary.",
-      "name": "contextionary-API"
-    },
-    {
-      "description": "These operations enable manipulation of the schema in Weaviate schema.",
-      "name": "schema"
-    }
-  ],
-  "externalDocs": {
-    "url": "https://github.com/weaviate/weaviate"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json",
-    "application/yaml"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Cloud-native, modular vector database",
-    "title": "Weaviate",
-    "contact": {
-      "name": "Weaviate",
-      "url": "https://github.com/weaviate",
-      "email": "hello@weaviate.io"
     },
-    "version": "1.19.1"
-  },
-  "basePath": "/v1",
-  "paths": {
-    "/": {
+    "/deduplications/{id}": {
       "get": {
-        "description": "Home. Discover the REST API",
-        "operationId": "weaviate.root",
+        "description": "Get status and metadata of a previously created deduplication",
+        "tags": [
+          "deduplications"
+        ],
+        "summary": "View previously created deduplication",
+        "operationId": "deduplications.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "deduplication id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Weaviate is alive and ready to serve content",
+            "description": "Found the deduplication, returned as body",
             "schema": {
-              "type": "object",
-              "properties": {
-                "links": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Link"
-                  }
-                }
-              }
+              "$ref": "#/definitions/Deduplication"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Deduplication does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.deduplications.get"
+        ]
       }
     },
-    "/.well-known/live": {
+    "/deduplications/{id}/result": {
       "get": {
-        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
-        "operationId": "weaviate.wellknown.liveness",
+        "description": "Get the groups of duplicates found by a previously completed deduplication",
+        "tags": [
+          "deduplications"
+        ],
+        "summary": "View the groups of duplicates of a deduplication",
+        "operationId": "deduplications.result.get",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "deduplication id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "The application is able to respond to HTTP requests"
+            "description": "Found the result of the deduplication, returned as body",
+            "schema": {
+              "$ref": "#/definitions/DeduplicationResult"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Deduplication does not exist or has not completed"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.deduplications.result.get"
+        ]
       }
     },
-    "/.well-known/openid-configuration": {
+    "/export/{className}": {
       "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "description": "Export all objects of a class as a stream in NDJSON or Parquet format. Objects are returned in the order of their ids and can optionally be restricted by a where filter.",
+        "produces": [
+          "application/json",
+          "application/vnd.apache.parquet",
+          "application/x-ndjson"
+        ],
         "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
+          "objects"
+        ],
+        "summary": "Export the objects of a class.",
+        "operationId": "objects.export",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the class",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "ndjson",
+              "parquet"
+            ],
+            "type": "string",
+            "default": "ndjson",
+            "description": "The format of the exported objects, either 'ndjson' (one JSON object per line) or 'parquet'. Default value is 'ndjson'.",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A JSON encoded where filter restricting the exported objects",
+            "name": "where",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "Whether to include the vectors of the objects. Default value is true.",
+            "name": "includeVector",
+            "in": "query"
+          }
         ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
         "responses": {
           "200": {
-            "description": "Successful response, inspect body",
+            "description": "Stream of all matching objects in the requested format.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "type": "object",
-              "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
-                },
-                "scopes": {
-                  "description": "OAuth Scopes",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  },
-                  "x-omitempty": true
-                }
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "404": {
-            "description": "Not found, no oidc provider present"
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -4424,52 +8360,36 @@ func init() {
           }
         },
         "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "operationId": "weaviate.wellknown.readiness",
-        "responses": {
-          "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
-          },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
-          }
-        }
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
       }
     },
-    "/backups/{backend}": {
+    "/graphql": {
       "post": {
-        "description": "Starts a process of creating a backup for a set of classes",
+        "description": "Get an object based on GraphQL",
         "tags": [
-          "backups"
+          "graphql"
         ],
-        "operationId": "backups.create",
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
+              "$ref": "#/definitions/GraphQLQuery"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup create process successfully started.",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -4482,7 +8402,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup creation attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4494,39 +8414,40 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/backups/{backend}/{id}": {
-      "get": {
-        "description": "Returns status of backup creation attempt for a set of classes",
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "backups"
+          "graphql"
         ],
-        "operationId": "backups.create.status",
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "The GraphQL queries.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQueries"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup creation status successfully returned",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
+              "$ref": "#/definitions/GraphQLResponses"
             }
           },
           "401": {
@@ -4538,14 +8459,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4557,39 +8472,85 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/backups/{backend}/{id}/restore": {
-      "get": {
-        "description": "Returns status of a backup restoration attempt for a set of classes",
+    "/import/{className}": {
+      "post": {
+        "description": "Import a stream of objects in NDJSON or Parquet format, as produced by the export endpoint, into a class. All objects are moved to the target class and their properties can be renamed. Objects are added through the batch import, so ids and vectors are preserved.",
+        "consumes": [
+          "application/octet-stream",
+          "application/vnd.apache.parquet",
+          "application/x-ndjson"
+        ],
         "tags": [
-          "backups"
+          "objects"
         ],
-        "operationId": "backups.restore.status",
+        "summary": "Import objects into a class.",
+        "operationId": "objects.import",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
+            "description": "The name of the class the objects are imported into",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          {
+            "enum": [
+              "ndjson",
+              "parquet"
+            ],
             "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "default": "ndjson",
+            "description": "The format of the uploaded objects, either 'ndjson' (one JSON object per line) or 'parquet'. Default value is 'ndjson'.",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Renames properties of the imported objects, each entry has the form 'source=target'",
+            "name": "propertyMapping",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration status successfully returned",
+            "description": "Import finished, see the response body for the number of imported and failed objects.",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreStatusResponse"
+              "$ref": "#/definitions/ImportResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -4602,7 +8563,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4614,45 +8578,63 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.add"
         ]
-      },
-      "post": {
-        "description": "Starts a process of restoring a backup for a set of classes",
+      }
+    },
+    "/meta": {
+      "get": {
+        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "backups"
+          "meta"
         ],
-        "operationId": "backups.restore",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Meta"
+            }
           },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreRequest"
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Returns status of Weaviate DB.",
+        "tags": [
+          "nodes"
         ],
+        "operationId": "nodes.get",
         "responses": {
           "200": {
-            "description": "Backup restoration process successfully started.",
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreResponse"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -4671,7 +8653,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup restoration attempt.",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4684,72 +8666,31 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.nodes.status.get"
         ]
       }
     },
-    "/batch/objects": {
+    "/nodes/{name}/decommission": {
       "post": {
-        "description": "Register new Objects in bulk. Provided meta-data and schema values are validated.",
+        "description": "Starts decommissioning a node. Every shard replica the node holds is moved to another node, or re-replicated from another replica if the node is not reachable anymore. No new shards are assigned to the node while it is being drained. Once it holds no shards anymore, the node leaves the cluster. The progress is reported as part of the node status.",
         "tags": [
-          "batch",
-          "objects"
+          "nodes"
         ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
+        "operationId": "nodes.decommission",
         "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
-            }
-          },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
+            "description": "The name of the node to decommission.",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+          "202": {
+            "description": "Decommissioning successfully started.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/NodeDecommission"
             }
           },
           "401": {
@@ -4761,8 +8702,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Node does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "The node cannot be decommissioned, e.g. because it is the only node of the cluster or it is already being decommissioned.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4774,41 +8721,71 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.nodes.decommission"
         ]
-      },
-      "delete": {
-        "description": "Delete Objects in bulk that match a certain filter.",
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
         "tags": [
-          "batch",
           "objects"
         ],
-        "summary": "Deletes Objects based on a match filter as a batch.",
-        "operationId": "batch.objects.delete",
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BatchDelete"
-            }
+            "type": "string",
+            "description": "The starting ID of the result window.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The starting index of the result window. Default value is 0.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+            "name": "limit",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Sort parameter to pass an information about the names of the sort fields",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Order parameter to tell how to order (asc or desc) data within given field",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Class parameter specifies the class from which to query objects",
+            "name": "class",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
+              "$ref": "#/definitions/ObjectsListResponse"
             }
           },
           "400": {
@@ -4826,6 +8803,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -4842,30 +8822,23 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/batch/references": {
+      },
       "post": {
-        "description": "Register cross-references between any class items (objects or objects) in bulk.",
+        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
         "tags": [
-          "batch",
-          "references"
+          "objects"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+        "summary": "Create Objects between two Objects (object and subject).",
+        "operationId": "objects.create",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
+              "$ref": "#/definitions/Object"
             }
           },
           {
@@ -4877,12 +8850,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "description": "Object created.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
+              "$ref": "#/definitions/Object"
             }
           },
           "400": {
@@ -4920,37 +8890,27 @@ func init() {
         ]
       }
     },
-    "/classifications/": {
+    "/objects/validate": {
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
         "tags": [
-          "classifications"
+          "objects"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/Object"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "200": {
+            "description": "Successfully validated."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4961,6 +8921,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -4968,33 +8934,66 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/classifications/{id}": {
+    "/objects/{className}/{id}": {
       "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+        "description": "Get a single data object",
         "tags": [
-          "classifications"
+          "objects"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
         "parameters": [
           {
             "type": "string",
-            "description": "classification id",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The target node which should fulfill the request",
+            "name": "node_name",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the classification, returned as body",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -5007,7 +9006,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Classification does not exist"
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5016,35 +9015,54 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.get"
-        ]
-      }
-    },
-    "/graphql": {
-      "post": {
-        "description": "Get an object based on GraphQL",
+          "weaviate.local.query"
+        ]
+      },
+      "put": {
+        "description": "Update an individual data object based on its class and uuid.",
         "tags": [
-          "graphql"
+          "objects"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Successfully received.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -5056,6 +9074,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -5072,37 +9093,46 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+      },
+      "delete": {
+        "description": "Delete a single data object.",
         "tags": [
-          "graphql"
+          "objects"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful query (with select).",
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -5114,11 +9144,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5127,30 +9154,45 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/meta": {
-      "get": {
-        "description": "Gives meta information about the server and can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+      },
+      "head": {
+        "description": "Checks if a data object exists without retrieving it.",
         "tags": [
-          "meta"
+          "objects"
+        ],
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
         "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Meta"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5161,6 +9203,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Object doesn't exist."
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -5168,27 +9213,57 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/nodes": {
-      "get": {
-        "description": "Returns status of Weaviate DB.",
+      },
+      "patch": {
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
-          "nodes"
+          "objects"
         ],
-        "operationId": "nodes.get",
-        "responses": {
-          "200": {
-            "description": "Nodes status successfully returned",
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.class.patch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/Object"
             }
           },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
+          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -5199,13 +9274,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5217,72 +9289,62 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.nodes.status.get"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/objects": {
-      "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Update all references of a property of a data object.",
         "tags": [
           "objects"
-        ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The starting ID of the result window.",
-            "name": "after",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "default": 0,
-            "description": "The starting index of the result window. Default value is 0.",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
-            "name": "limit",
-            "in": "query"
-          },
+        ],
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
+        "parameters": [
           {
             "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Sort parameter to pass an information about the names of the sort fields",
-            "name": "sort",
-            "in": "query"
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Order parameter to tell how to order (asc or desc) data within given field",
-            "name": "order",
-            "in": "query"
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
           },
           {
             "type": "string",
-            "description": "Class parameter specifies the class from which to query objects",
-            "name": "class",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
-            }
+            "description": "Successfully replaced all the references."
           },
           "400": {
             "description": "Malformed request.",
@@ -5300,10 +9362,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Source object doesn't exist."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5318,23 +9380,45 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
       },
       "post": {
-        "description": "Registers a new Object. Provided meta-data and schema values are validated.",
+        "description": "Add a single reference to a class-property.",
         "tags": [
           "objects"
         ],
-        "summary": "Create Objects between two Objects (object and subject).",
-        "operationId": "objects.create",
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
           },
           {
@@ -5346,10 +9430,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Object created.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "description": "Successfully added the reference."
           },
           "400": {
             "description": "Malformed request.",
@@ -5366,8 +9447,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5382,31 +9466,63 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/validate": {
-      "post": {
-        "description": "Validate an Object's schema and meta-data. It has to be based on a schema, which is related to the given Object to be accepted by this validation.",
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
         "tags": [
           "objects"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully validated."
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -5417,8 +9533,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -5433,25 +9555,20 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/objects/{className}/{id}": {
+    "/objects/{id}": {
       "get": {
-        "description": "Get a single data object",
+        "description": "Lists Objects.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -5465,18 +9582,6 @@ func init() {
             "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
             "name": "include",
             "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The target node which should fulfill the request",
-            "name": "node_name",
-            "in": "query"
           }
         ],
         "responses": {
@@ -5518,23 +9623,18 @@ func init() {
         ]
       },
       "put": {
-        "description": "Update an individual data object based on its class and uuid.",
+        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The uuid of the data object to update.",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
@@ -5593,19 +9693,14 @@ func init() {
         ]
       },
       "delete": {
-        "description": "Delete a single data object.",
+        "description": "Deletes an Object from the system.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -5625,12 +9720,6 @@ func init() {
           "204": {
             "description": "Successfully deleted."
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -5657,33 +9746,21 @@ func init() {
         ]
       },
       "head": {
-        "description": "Checks if a data object exists without retrieving it.",
+        "description": "Checks if an Object exists in the system.",
         "tags": [
           "objects"
         ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The uuid of the data object",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
         ],
         "responses": {
@@ -5716,24 +9793,18 @@ func init() {
         ]
       },
       "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
         "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
+        "operationId": "objects.patch",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The uuid of the data object to update.",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
@@ -5792,22 +9863,16 @@ func init() {
         ]
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
+    "/objects/{id}/references/{propertyName}": {
       "put": {
-        "description": "Update all references of a property of a data object.",
+        "description": "Replace all references to a class-property.",
         "tags": [
           "objects"
         ],
         "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "operationId": "objects.references.update",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -5830,24 +9895,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/MultipleRef"
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Successfully replaced all the references."
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -5857,9 +9910,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Source object doesn't exist."
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
@@ -5885,15 +9935,9 @@ func init() {
           "objects"
         ],
         "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "operationId": "objects.references.create",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -5916,24 +9960,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/SingleRef"
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "Successfully added the reference."
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -5943,9 +9975,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Source object doesn't exist."
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
@@ -5966,20 +9995,14 @@ func init() {
         ]
       },
       "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property of a data object has",
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
           "objects"
         ],
         "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
+        "operationId": "objects.references.delete",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -6002,24 +10025,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/SingleRef"
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
         ],
         "responses": {
           "204": {
             "description": "Successfully deleted."
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -6035,12 +10046,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6055,43 +10060,106 @@ func init() {
         ]
       }
     },
-    "/objects/{id}": {
+    "/schema": {
       "get": {
-        "description": "Lists Objects.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
+        "responses": {
+          "200": {
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
+        "parameters": [
           {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
+            "name": "objectClass",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Class"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Added the new Object class to the schema.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Class"
             }
           },
-          "400": {
-            "description": "Malformed request.",
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Object class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.add.meta"
+        ]
+      }
+    },
+    "/schema/aliases": {
+      "get": {
+        "description": "Lists all aliases and the classes they point to.",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.aliases.list",
+        "responses": {
+          "200": {
+            "description": "Successfully listed the aliases.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Alias"
+              }
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6102,9 +10170,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6112,49 +10177,31 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate.meta"
         ]
       },
-      "put": {
-        "description": "Updates an Object's data. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "post": {
+        "description": "Creates an alias for a class. Objects of the class can be read and written using the alias wherever a class name is expected.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
-        "deprecated": true,
+        "operationId": "schema.aliases.create",
         "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Alias"
             }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Alias successfully created.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/Alias"
             }
           },
           "401": {
@@ -6166,11 +10213,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid alias, e.g. the alias already exists or the class does not exist.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6182,39 +10226,41 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "delete": {
-        "description": "Deletes an Object from the system.",
+      }
+    },
+    "/schema/aliases/{aliasName}": {
+      "put": {
+        "description": "Points an existing alias to another class. All reads and writes using the alias are applied to the new class from then on.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
+        "operationId": "schema.aliases.update",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "description": "The name of the alias.",
+            "name": "aliasName",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Alias successfully updated.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6226,7 +10272,16 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Alias does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias, e.g. the class does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6235,33 +10290,28 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       },
-      "head": {
-        "description": "Checks if an Object exists in the system.",
+      "delete": {
+        "description": "Deletes an alias. The class it points to is not affected.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
+        "operationId": "schema.aliases.delete",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
+            "description": "The name of the alias.",
+            "name": "aliasName",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "204": {
-            "description": "Object exists."
+            "description": "Alias successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6273,7 +10323,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Object doesn't exist."
+            "description": "Not Found - Alias does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6282,51 +10335,28 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "patch": {
-        "description": "Updates an Object. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/schema/migrations": {
+      "get": {
+        "description": "Lists the migrations started on this node",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
-            "name": "body",
-            "in": "body",
+        "operationId": "schema.migrations.list",
+        "responses": {
+          "200": {
+            "description": "Successfully listed the migrations.",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ClassMigration"
+              }
             }
           },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -6336,15 +10366,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6352,50 +10373,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references to a class-property.",
+      },
+      "post": {
+        "description": "Starts migrating a class to a new definition. A shadow class with the new definition is created and all objects are copied to it in the background, while writes to the class are also applied to the shadow class. Once all objects have been copied, the shadow class takes the place of the class under its name.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
-        "deprecated": true,
+        "operationId": "schema.migrations.create",
         "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/ClassMigration"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully replaced all the references."
+          "202": {
+            "description": "Migration successfully started.",
+            "schema": {
+              "$ref": "#/definitions/ClassMigration"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6407,7 +10410,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Invalid migration.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6419,48 +10422,33 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
-      },
-      "post": {
-        "description": "Add a single reference to a class-property.",
+      }
+    },
+    "/schema/migrations/{id}": {
+      "get": {
+        "description": "Returns the status of a migration",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
-        "deprecated": true,
+        "operationId": "schema.migrations.get",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The ID of the migration.",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Migration successfully returned.",
+            "schema": {
+              "$ref": "#/definitions/ClassMigration"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6471,8 +10459,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+          "404": {
+            "description": "Not Found - Migration does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6484,48 +10472,31 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       },
       "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "description": "Aborts a migration. Writes are no longer applied to the shadow class and the shadow class is deleted. A migration cannot be aborted once the shadow class is taking the place of the class.",
         "tags": [
-          "objects"
+          "schema"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
-        "deprecated": true,
+        "operationId": "schema.migrations.abort",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The ID of the migration.",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Migration successfully aborted.",
+            "schema": {
+              "$ref": "#/definitions/ClassMigration"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -6537,7 +10508,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
+            "description": "Not Found - Migration does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The migration cannot be aborted anymore.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6549,25 +10526,32 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
-    "/schema": {
-      "get": {
+    "/schema/migrations/{id}/pause": {
+      "post": {
+        "description": "Pauses copying objects to the shadow class. Writes to the class are still applied to the shadow class.",
         "tags": [
           "schema"
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
+        "operationId": "schema.migrations.pause",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of the migration.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "Migration successfully paused.",
             "schema": {
-              "$ref": "#/definitions/Schema"
+              "$ref": "#/definitions/ClassMigration"
             }
           },
           "401": {
@@ -6579,6 +10563,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Migration does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The migration is not copying objects.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6587,30 +10583,31 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      },
+      }
+    },
+    "/schema/migrations/{id}/resume": {
       "post": {
+        "description": "Resumes copying objects to the shadow class",
         "tags": [
           "schema"
         ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
+        "operationId": "schema.migrations.resume",
         "parameters": [
           {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "type": "string",
+            "description": "The ID of the migration.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the new Object class to the schema.",
+            "description": "Migration successfully resumed.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ClassMigration"
             }
           },
           "401": {
@@ -6622,8 +10619,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Migration does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Object class",
+            "description": "The migration is not paused.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6636,7 +10639,7 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.manipulate.meta"
         ]
       }
     },
@@ -6981,6 +10984,20 @@ func init() {
         "type": "object"
       }
     },
+    "Alias": {
+      "description": "An alternative name of a class.",
+      "type": "object",
+      "properties": {
+        "alias": {
+          "description": "The name of the alias. It follows the same rules as class names.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The name of the class the alias points to.",
+          "type": "string"
+        }
+      }
+    },
     "BM25Config": {
       "description": "tuning parameters for the BM25 algorithm",
       "type": "object",
@@ -7000,6 +11017,10 @@ func init() {
     "BackupCreateRequest": {
       "description": "Request body for creating a backup of a set of classes",
       "properties": {
+        "baseId": {
+          "description": "The ID of an earlier backup on the same backend to base this backup on. Only files which are not already part of the base backup are uploaded, restoring the backup fetches the remaining files from the base backup and its own bases.",
+          "type": "string"
+        },
         "config": {
           "description": "Custom configuration for the backup creation process",
           "type": "object"
@@ -7031,6 +11052,10 @@ func init() {
           "description": "Backup backend name e.g. filesystem, gcs, s3.",
           "type": "string"
         },
+        "baseId": {
+          "description": "The ID of the backup this backup is based on, if it is incremental",
+          "type": "string"
+        },
         "classes": {
           "description": "The list of classes for which the backup creation process was started",
           "type": "array",
@@ -7193,6 +11218,105 @@ func init() {
         }
       }
     },
+    "BackupSchedule": {
+      "description": "A schedule to periodically create backups of a set of classes",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "backups": {
+          "description": "Backups created by the schedule which have not been deleted by the retention policy yet, oldest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BackupScheduleBackup"
+          },
+          "readOnly": true
+        },
+        "creationTimeUnix": {
+          "description": "Timestamp of the creation of the schedule in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "cron": {
+          "description": "Cron expression in UTC with the five fields minute, hour, day of month, month and day of week, e.g. ` + "`" + `0 3 * * *` + "`" + `. Descriptors such as ` + "`" + `@daily` + "`" + ` or ` + "`" + `@every 6h` + "`" + ` are supported as well.",
+          "type": "string"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backups",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the schedule. Backups created by the schedule use it as prefix of their IDs. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of classes to include in the backups",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "lastError": {
+          "description": "Error of the last run of the schedule, if any",
+          "type": "string",
+          "readOnly": true
+        },
+        "lastRunTimeUnix": {
+          "description": "Timestamp of the last time a backup was started by the schedule in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "retention": {
+          "$ref": "#/definitions/BackupScheduleRetention"
+        }
+      }
+    },
+    "BackupScheduleBackup": {
+      "description": "A backup created by a schedule",
+      "properties": {
+        "id": {
+          "description": "The ID of the backup",
+          "type": "string"
+        },
+        "startTimeUnix": {
+          "description": "Timestamp of the start of the backup in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "Phase of the backup creation process",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "BackupScheduleRetention": {
+      "description": "Determines which backups created by a schedule are kept. Backups retained by any of the rules are kept, all others are deleted from the backend. If no rule is set, all backups are kept.",
+      "properties": {
+        "keepDailyDays": {
+          "description": "Number of days, including today, for which the most recent successful backup of each day is kept",
+          "type": "integer",
+          "format": "int64"
+        },
+        "keepLast": {
+          "description": "Number of most recent successful backups to keep",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "BatchDelete": {
       "type": "object",
       "properties": {
@@ -7447,6 +11571,294 @@ func init() {
         }
       }
     },
+    "BatchSearchQuery": {
+      "description": "A single search of a batch. At most one of nearVector, nearObject, bm25 and hybrid can be set.",
+      "type": "object",
+      "required": [
+        "class"
+      ],
+      "properties": {
+        "additional": {
+          "description": "Additional properties to return for every object, such as \"id\", \"vector\", \"distance\", \"certainty\" or \"score\".",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "bm25": {
+          "description": "Search by keyword ranking with bm25.",
+          "type": "object",
+          "properties": {
+            "properties": {
+              "description": "The properties to search in. Defaults to all text properties.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "query": {
+              "description": "The query to rank the objects with.",
+              "type": "string"
+            }
+          }
+        },
+        "class": {
+          "description": "Class (name) to search in.",
+          "type": "string",
+          "example": "City"
+        },
+        "hybrid": {
+          "description": "Search by combining bm25 and vector search.",
+          "type": "object",
+          "properties": {
+            "alpha": {
+              "description": "The weight of the vector search between 0 and 1. Defaults to 0.75.",
+              "type": "number",
+              "format": "double",
+              "x-nullable": true
+            },
+            "properties": {
+              "description": "The properties to search in with bm25. Defaults to all text properties.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "query": {
+              "description": "The query to rank the objects with.",
+              "type": "string"
+            },
+            "vector": {
+              "description": "The vector to search with. Defaults to the vector of the query.",
+              "type": "array",
+              "items": {
+                "type": "number",
+                "format": "float"
+              }
+            }
+          }
+        },
+        "limit": {
+          "description": "The maximum number of objects to return.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "nearObject": {
+          "description": "Search for objects near the given object.",
+          "type": "object",
+          "properties": {
+            "certainty": {
+              "description": "The minimal certainty of the results.",
+              "type": "number",
+              "format": "double",
+              "x-nullable": true
+            },
+            "distance": {
+              "description": "The maximal distance of the results.",
+              "type": "number",
+              "format": "double",
+              "x-nullable": true
+            },
+            "id": {
+              "description": "The ID of the object to search near.",
+              "type": "string",
+              "format": "uuid"
+            }
+          }
+        },
+        "nearVector": {
+          "description": "Search for objects near the given vector.",
+          "type": "object",
+          "properties": {
+            "certainty": {
+              "description": "The minimal certainty of the results.",
+              "type": "number",
+              "format": "double",
+              "x-nullable": true
+            },
+            "distance": {
+              "description": "The maximal distance of the results.",
+              "type": "number",
+              "format": "double",
+              "x-nullable": true
+            },
+            "vector": {
+              "description": "The vector to search near.",
+              "type": "array",
+              "items": {
+                "type": "number",
+                "format": "float"
+              }
+            }
+          }
+        },
+        "offset": {
+          "description": "The number of objects to skip.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "description": "Properties to return for every object. If empty, no properties are returned.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "where": {
+          "description": "Filter to limit the objects to search in.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "BatchSearchQueryBm25": {
+      "description": "Search by keyword ranking with bm25.",
+      "type": "object",
+      "properties": {
+        "properties": {
+          "description": "The properties to search in. Defaults to all text properties.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "query": {
+          "description": "The query to rank the objects with.",
+          "type": "string"
+        }
+      }
+    },
+    "BatchSearchQueryHybrid": {
+      "description": "Search by combining bm25 and vector search.",
+      "type": "object",
+      "properties": {
+        "alpha": {
+          "description": "The weight of the vector search between 0 and 1. Defaults to 0.75.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "properties": {
+          "description": "The properties to search in with bm25. Defaults to all text properties.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "query": {
+          "description": "The query to rank the objects with.",
+          "type": "string"
+        },
+        "vector": {
+          "description": "The vector to search with. Defaults to the vector of the query.",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "BatchSearchQueryNearObject": {
+      "description": "Search for objects near the given object.",
+      "type": "object",
+      "properties": {
+        "certainty": {
+          "description": "The minimal certainty of the results.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "distance": {
+          "description": "The maximal distance of the results.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "id": {
+          "description": "The ID of the object to search near.",
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "BatchSearchQueryNearVector": {
+      "description": "Search for objects near the given vector.",
+      "type": "object",
+      "properties": {
+        "certainty": {
+          "description": "The minimal certainty of the results.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "distance": {
+          "description": "The maximal distance of the results.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "vector": {
+          "description": "The vector to search near.",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "BatchSearchRequest": {
+      "description": "Several independent searches which are executed concurrently.",
+      "type": "object",
+      "required": [
+        "queries"
+      ],
+      "properties": {
+        "queries": {
+          "description": "The searches to run. Every search gets its own result or error.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchSearchQuery"
+          }
+        },
+        "timeout": {
+          "description": "Deadline in seconds shared by all searches of the batch. Searches which have not completed by then fail.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "BatchSearchResponse": {
+      "description": "The results of a batch search.",
+      "type": "object",
+      "properties": {
+        "results": {
+          "description": "One result per search, in the order of the searches in the request.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BatchSearchResult"
+          }
+        }
+      }
+    },
+    "BatchSearchResult": {
+      "description": "The result of a single search of a batch.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "Set instead of objects if the search failed.",
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "objects": {
+          "description": "The objects found by the search, in the same shape as in a GraphQL Get query.",
+          "type": "array",
+          "items": {
+            "type": "object"
+          }
+        }
+      }
+    },
     "C11yExtension": {
       "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
       "properties": {
@@ -7673,6 +12085,73 @@ func init() {
         }
       }
     },
+    "ClassMigration": {
+      "description": "Migration of a populated class to a new class definition. The objects are copied to a shadow class with the new definition, which then takes the place of the class.",
+      "properties": {
+        "class": {
+          "description": "The class to migrate. Once the migration has finished, this name refers to the migrated class.",
+          "type": "string"
+        },
+        "completionTimeUnix": {
+          "description": "Timestamp of the completion of the migration in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "definition": {
+          "description": "The new definition of the class. Its class name is ignored. Properties with a different data type are converted where possible, properties which are not part of the new definition are dropped.",
+          "$ref": "#/definitions/Class"
+        },
+        "error": {
+          "description": "The reason the migration failed, if any",
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "description": "The ID of the migration",
+          "type": "string",
+          "readOnly": true
+        },
+        "objectsCopied": {
+          "description": "The number of objects copied to the shadow class so far",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "propertyRenames": {
+          "description": "Properties which are renamed, mapped from their current name to their name in the new definition.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "shadowClass": {
+          "description": "The class the objects are copied to",
+          "type": "string",
+          "readOnly": true
+        },
+        "startTimeUnix": {
+          "description": "Timestamp of the start of the migration in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "status": {
+          "description": "Phase of the migration",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "COPYING",
+            "PAUSED",
+            "SWAPPING",
+            "SUCCESS",
+            "FAILED",
+            "ABORTED"
+          ],
+          "readOnly": true
+        }
+      }
+    },
     "Classification": {
       "description": "Manage classifications, trigger them and view status of past classifications.",
       "type": "object",
@@ -7706,45 +12185,297 @@ func init() {
           "description": "error message if status == failed",
           "type": "string",
           "default": "",
-          "example": "classify xzy: something went wrong"
-        },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+          "example": "classify xzy: something went wrong"
+        },
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "id": {
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "settings": {
+          "description": "classification-type specific settings",
+          "type": "object"
+        },
+        "status": {
+          "description": "status of this classification",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "description": "which algorithm to use for classifications",
+          "type": "string"
+        }
+      }
+    },
+    "ClassificationFilters": {
+      "type": "object",
+      "properties": {
+        "sourceWhere": {
+          "description": "limit the objects to be classified",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "targetWhere": {
+          "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "trainingSetWhere": {
+          "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
+        },
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
+        },
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
+        },
+        "started": {
+          "description": "time when this classification was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "Clustering": {
+      "description": "Cluster the vectors of a class with k-means, and view the status and result of past clusterings.",
+      "type": "object",
+      "properties": {
+        "batchSize": {
+          "description": "number of vectors sampled per iteration if method is miniBatchKmeans, defaults to 1024",
+          "type": "integer"
+        },
+        "class": {
+          "description": "class (name) whose vectors are clustered",
+          "type": "string",
+          "example": "City"
+        },
+        "clusters": {
+          "description": "the clusters found, set once status == completed",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ClusteringCluster"
+          }
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "cluster xzy: something went wrong"
+        },
+        "id": {
+          "description": "ID to uniquely identify this clustering run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        },
+        "k": {
+          "description": "number of clusters",
+          "type": "integer",
+          "example": 8
+        },
+        "maxIterations": {
+          "description": "maximum number of iterations, defaults to 50",
+          "type": "integer"
+        },
+        "meta": {
+          "description": "additional meta information about the clustering",
+          "type": "object",
+          "$ref": "#/definitions/ClusteringMeta"
+        },
+        "method": {
+          "description": "which algorithm to use, defaults to kmeans",
+          "type": "string",
+          "enum": [
+            "kmeans",
+            "miniBatchKmeans"
+          ]
+        },
+        "representatives": {
+          "description": "number of objects closest to the centroid to return per cluster, defaults to 1",
+          "type": "integer"
+        },
+        "status": {
+          "description": "status of this clustering",
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "where": {
+          "description": "limit the clustering to the objects matching this filter",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        },
+        "writeProperty": {
+          "description": "int property to write the cluster ID of every object to",
+          "type": "string",
+          "example": "cluster"
+        }
+      }
+    },
+    "ClusteringCluster": {
+      "description": "A single cluster of a clustering",
+      "type": "object",
+      "properties": {
+        "centroid": {
+          "description": "the center of the cluster",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        },
+        "id": {
+          "description": "ID of the cluster, the value written to the writeProperty",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "representatives": {
+          "description": "IDs of the objects closest to the centroid, closest first",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "size": {
+          "description": "number of objects in the cluster",
+          "type": "integer",
+          "x-omitempty": false
+        }
+      }
+    },
+    "ClusteringMeta": {
+      "description": "Additional information to a specific clustering",
+      "type": "object",
+      "properties": {
+        "completed": {
+          "description": "time when this clustering finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "count": {
+          "description": "number of objects which were clustered",
+          "type": "integer",
+          "example": 147
+        },
+        "started": {
+          "description": "time when this clustering was started",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
+    "Deduplication": {
+      "description": "Find objects of a class whose vectors are within a distance of each other, and view the status of past deduplications.",
+      "type": "object",
+      "required": [
+        "distance"
+      ],
+      "properties": {
+        "action": {
+          "description": "what to do with the duplicates of every group: keep them, delete all but the oldest object, or reference the oldest object from all others, defaults to none",
+          "type": "string",
+          "enum": [
+            "none",
+            "delete",
+            "reference"
+          ]
+        },
+        "class": {
+          "description": "class (name) whose objects are deduplicated",
+          "type": "string",
+          "example": "Article"
+        },
+        "distance": {
+          "description": "maximum distance between the vectors of two objects to consider them duplicates",
+          "type": "number",
+          "example": 0.01
+        },
+        "error": {
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "deduplicate xzy: something went wrong"
         },
         "id": {
-          "description": "ID to uniquely identify this classification run",
+          "description": "ID to uniquely identify this deduplication run",
           "type": "string",
           "format": "uuid",
           "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
         },
         "meta": {
-          "description": "additional meta information about the classification",
+          "description": "additional meta information about the deduplication",
           "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
+          "$ref": "#/definitions/DeduplicationMeta"
         },
-        "settings": {
-          "description": "classification-type specific settings",
-          "type": "object"
+        "referenceProperty": {
+          "description": "reference property which is set to the oldest object of the group on all duplicates if action is reference",
+          "type": "string",
+          "example": "duplicateOf"
         },
         "status": {
-          "description": "status of this classification",
+          "description": "status of this deduplication",
           "type": "string",
           "enum": [
             "running",
@@ -7752,66 +12483,69 @@ func init() {
             "failed"
           ],
           "example": "running"
-        },
-        "type": {
-          "description": "which algorithm to use for classifications",
-          "type": "string"
         }
       }
     },
-    "ClassificationFilters": {
+    "DeduplicationGroup": {
+      "description": "A group of objects whose vectors are within the distance of the oldest object of the group",
       "type": "object",
       "properties": {
-        "sourceWhere": {
-          "description": "limit the objects to be classified",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
-        },
-        "targetWhere": {
-          "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
+        "duplicates": {
+          "description": "IDs of the other objects of the group, oldest first",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
         },
-        "trainingSetWhere": {
-          "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-          "type": "object",
-          "$ref": "#/definitions/WhereFilter"
+        "keep": {
+          "description": "ID of the oldest object of the group, which is kept if action is delete",
+          "type": "string",
+          "format": "uuid"
         }
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
+    "DeduplicationMeta": {
+      "description": "Additional information to a specific deduplication",
       "type": "object",
       "properties": {
         "completed": {
-          "description": "time when this classification finished",
+          "description": "time when this deduplication finished",
           "type": "string",
           "format": "date-time",
           "example": "2017-07-21T17:32:28Z"
         },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
+        "duplicates": {
+          "description": "number of objects which are duplicates of an older object",
           "type": "integer",
-          "example": 147
+          "example": 12
         },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
+        "groups": {
+          "description": "number of groups of duplicates which were found",
           "type": "integer",
           "example": 7
         },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
-          "type": "integer",
-          "example": 140
-        },
         "started": {
-          "description": "time when this classification was started",
+          "description": "time when this deduplication was started",
           "type": "string",
           "format": "date-time",
           "example": "2017-07-21T17:32:28Z"
         }
       }
     },
+    "DeduplicationResult": {
+      "description": "The groups of duplicates found by a deduplication",
+      "type": "object",
+      "properties": {
+        "groups": {
+          "description": "the groups of duplicates",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DeduplicationGroup"
+          }
+        }
+      }
+    },
     "Deprecation": {
       "type": "object",
       "properties": {
@@ -7989,6 +12723,35 @@ func init() {
         "$ref": "#/definitions/GraphQLResponse"
       }
     },
+    "ImportResponse": {
+      "description": "Result of an import of objects into a class.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "The class the objects were imported into.",
+          "type": "string"
+        },
+        "errors": {
+          "description": "Errors of the objects that failed to be imported. Only the first 100 errors are reported.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failed": {
+          "description": "How many objects failed to be imported.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "imported": {
+          "description": "How many objects were imported successfully.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "InvertedIndexConfig": {
       "description": "Configure the inverted index built into Weaviate",
       "type": "object",
@@ -8069,6 +12832,116 @@ func init() {
         "$ref": "#/definitions/SingleRef"
       }
     },
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "description": "A primitive data type, or \"object\" or \"object[]\" to nest further. References are not supported within nested objects.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the property.",
+          "type": "string"
+        },
+        "indexFilterable": {
+          "description": "Optional. Should this nested property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use it in where filters or sorting.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this nested property be indexed for bm25 and hybrid search. Defaults to true. Applicable only to nested properties of data type text and text[].",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the nested property. It is addressed by its dotted path (for example address.city) in filters and sorting.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field"
+          ]
+        }
+      }
+    },
+    "NodeDecommission": {
+      "description": "The progress of decommissioning a node",
+      "properties": {
+        "classes": {
+          "description": "Progress per class.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeDecommissionClass"
+          }
+        },
+        "completionTimeUnix": {
+          "description": "Time when decommissioning completed or failed (unix timestamp in milliseconds).",
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "Error message if decommissioning failed.",
+          "type": "string"
+        },
+        "node": {
+          "description": "The name of the node.",
+          "type": "string"
+        },
+        "startTimeUnix": {
+          "description": "Time when decommissioning started (unix timestamp in milliseconds).",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "description": "Status of the decommissioning. A DRAINING node does not receive new shards, a DECOMMISSIONED node has left the cluster.",
+          "type": "string",
+          "enum": [
+            "DRAINING",
+            "DECOMMISSIONED",
+            "FAILED"
+          ]
+        }
+      }
+    },
+    "NodeDecommissionClass": {
+      "description": "The progress of draining the shards of a class from a node",
+      "properties": {
+        "class": {
+          "description": "The name of the class.",
+          "type": "string"
+        },
+        "error": {
+          "description": "Error message if a shard of the class could not be moved.",
+          "type": "string"
+        },
+        "shardsMoved": {
+          "description": "The number of shards which have been moved away from the node.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "shardsTotal": {
+          "description": "The number of shards of the class held by the node.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -8110,6 +12983,10 @@ func init() {
     "NodeStatus": {
       "description": "The definition of a backup node status response body",
       "properties": {
+        "decommission": {
+          "description": "Progress of decommissioning the node, if it is being or has been decommissioned.",
+          "$ref": "#/definitions/NodeDecommission"
+        },
         "gitHash": {
           "description": "The gitHash of Weaviate.",
           "type": "string"
@@ -8432,7 +13309,7 @@ func init() {
       "type": "object",
       "properties": {
         "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\". Nested objects use \"object\" or \"object[]\" together with nestedProperties.",
           "type": "array",
           "items": {
             "type": "string"
@@ -8465,6 +13342,14 @@ func init() {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
         "tokenization": {
           "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
           "type": "string",
@@ -8549,10 +13434,63 @@ func init() {
         }
       }
     },
+    "Role": {
+      "description": "A role grants a set of permissions to the users and groups it is assigned to",
+      "properties": {
+        "groups": {
+          "description": "OIDC groups the role is assigned to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "The name of the role. Only letters, numbers, underscore and minus characters are allowed.",
+          "type": "string"
+        },
+        "permissions": {
+          "description": "The permissions granted by the role",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RolePermission"
+          }
+        },
+        "users": {
+          "description": "Users the role is assigned to. Users authenticated with an API key are identified by the user the key is assigned to.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "RolePermission": {
+      "description": "A permission allows a set of actions on all resources matching a pattern",
+      "properties": {
+        "actions": {
+          "description": "The actions which are allowed, e.g. get, list, create, update or delete. Use * to allow all actions.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "resource": {
+          "description": "Pattern of the resources the actions are allowed on, e.g. objects/Invoice/* for the objects of class Invoice, schema/* for the schema or backups/* for backups. The wildcard * matches any sequence of characters.",
+          "type": "string"
+        }
+      }
+    },
     "Schema": {
       "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
       "type": "object",
       "properties": {
+        "aliases": {
+          "description": "Alternative names of classes, mapped to the name of the class they refer to.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "classes": {
           "description": "Semantic classes that are available.",
           "type": "array",
@@ -8575,6 +13513,62 @@ func init() {
       "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
       "type": "object"
     },
+    "ShardMove": {
+      "description": "Move of a physical shard of a class from one node to another",
+      "properties": {
+        "class": {
+          "description": "The class the shard belongs to",
+          "type": "string"
+        },
+        "completionTimeUnix": {
+          "description": "Timestamp of the completion of the move in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "error": {
+          "description": "The reason the shard move failed, if any",
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "description": "The ID of the shard move",
+          "type": "string",
+          "readOnly": true
+        },
+        "shard": {
+          "description": "The name of the shard",
+          "type": "string"
+        },
+        "sourceNode": {
+          "description": "The node the shard is moved from",
+          "type": "string"
+        },
+        "startTimeUnix": {
+          "description": "Timestamp of the start of the move in milliseconds since epoch UTC",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "status": {
+          "description": "Phase of the shard move",
+          "type": "string",
+          "enum": [
+            "STARTED",
+            "COPYING",
+            "CATCHING_UP",
+            "SWITCHING",
+            "SUCCESS",
+            "FAILED"
+          ],
+          "readOnly": true
+        },
+        "targetNode": {
+          "description": "The node the shard is moved to",
+          "type": "string"
+        }
+      }
+    },
     "ShardStatus": {
       "description": "The status of a single shard",
       "properties": {
@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+)
+
+type authzHandlers struct {
+	manager *rbac.Manager
+}
+
+func (h *authzHandlers) createRole(params authz.AuthzRolesCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	role, err := h.manager.CreateRole(params.HTTPRequest.Context(),
+		principal, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzRolesCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case rbac.ErrInvalidUserInput:
+			return authz.NewAuthzRolesCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzRolesCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return authz.NewAuthzRolesCreateOK().WithPayload(role)
+}
+
+func (h *authzHandlers) listRoles(params authz.AuthzRolesListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	roles, err := h.manager.ListRoles(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzRolesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzRolesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return authz.NewAuthzRolesListOK().WithPayload(roles)
+}
+
+func (h *authzHandlers) getRole(params authz.AuthzRolesGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	role, err := h.manager.GetRole(params.HTTPRequest.Context(),
+		principal, params.Name)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzRolesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case rbac.ErrNotFound:
+			return authz.NewAuthzRolesGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzRolesGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return authz.NewAuthzRolesGetOK().WithPayload(role)
+}
+
+func (h *authzHandlers) deleteRole(params authz.AuthzRolesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.manager.DeleteRole(params.HTTPRequest.Context(),
+		principal, params.Name)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return authz.NewAuthzRolesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case rbac.ErrNotFound:
+			return authz.NewAuthzRolesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzRolesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return authz.NewAuthzRolesDeleteNoContent()
+}
+
+func setupAuthzHandlers(api *operations.WeaviateAPI, manager *rbac.Manager) {
+	h := &authzHandlers{manager}
+	api.AuthzAuthzRolesCreateHandler = authz.
+		AuthzRolesCreateHandlerFunc(h.createRole)
+	api.AuthzAuthzRolesListHandler = authz.
+		AuthzRolesListHandlerFunc(h.listRoles)
+	api.AuthzAuthzRolesGetHandler = authz.
+		AuthzRolesGetHandlerFunc(h.getRole)
+	api.AuthzAuthzRolesDeleteHandler = authz.
+		AuthzRolesDeleteHandlerFunc(h.deleteRole)
+}
@@ -22,7 +22,8 @@ import (
 )
 
 type backupHandlers struct {
-	manager *ubak.Scheduler
+	manager   *ubak.Scheduler
+	schedules *ubak.ScheduleManager
 }
 
 func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
@@ -33,6 +34,7 @@ func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 		Backend: params.Backend,
 		Include: params.Body.Include,
 		Exclude: params.Body.Exclude,
+		BaseID:  params.Body.BaseID,
 	}
 	meta, err := s.manager.Backup(params.HTTPRequest.Context(), principal, &req)
 	if err != nil {
@@ -146,10 +148,94 @@ func (s *backupHandlers) restoreBackupStatus(params backups.BackupsRestoreStatus
 	return backups.NewBackupsRestoreStatusOK().WithPayload(&payload)
 }
 
+func (s *backupHandlers) createSchedule(params backups.BackupsSchedulesCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	schedule, err := s.schedules.CreateSchedule(params.HTTPRequest.Context(),
+		principal, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsSchedulesCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return backups.NewBackupsSchedulesCreateOK().WithPayload(schedule)
+}
+
+func (s *backupHandlers) listSchedules(params backups.BackupsSchedulesListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	schedules, err := s.schedules.ListSchedules(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return backups.NewBackupsSchedulesListOK().WithPayload(schedules)
+}
+
+func (s *backupHandlers) getSchedule(params backups.BackupsSchedulesGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	schedule, err := s.schedules.GetSchedule(params.HTTPRequest.Context(),
+		principal, params.ID)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsSchedulesGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return backups.NewBackupsSchedulesGetOK().WithPayload(schedule)
+}
+
+func (s *backupHandlers) deleteSchedule(params backups.BackupsSchedulesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.schedules.DeleteSchedule(params.HTTPRequest.Context(),
+		principal, params.ID)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsSchedulesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return backups.NewBackupsSchedulesDeleteNoContent()
+}
+
 func setupBackupHandlers(api *operations.WeaviateAPI,
-	scheduler *ubak.Scheduler,
+	scheduler *ubak.Scheduler, schedules *ubak.ScheduleManager,
 ) {
-	h := &backupHandlers{scheduler}
+	h := &backupHandlers{scheduler, schedules}
 	api.BackupsBackupsCreateHandler = backups.
 		BackupsCreateHandlerFunc(h.createBackup)
 	api.BackupsBackupsCreateStatusHandler = backups.
@@ -158,4 +244,12 @@ func setupBackupHandlers(api *operations.WeaviateAPI,
 		BackupsRestoreHandlerFunc(h.restoreBackup)
 	api.BackupsBackupsRestoreStatusHandler = backups.
 		BackupsRestoreStatusHandlerFunc(h.restoreBackupStatus)
+	api.BackupsBackupsSchedulesCreateHandler = backups.
+		BackupsSchedulesCreateHandlerFunc(h.createSchedule)
+	api.BackupsBackupsSchedulesListHandler = backups.
+		BackupsSchedulesListHandlerFunc(h.listSchedules)
+	api.BackupsBackupsSchedulesGetHandler = backups.
+		BackupsSchedulesGetHandlerFunc(h.getSchedule)
+	api.BackupsBackupsSchedulesDeleteHandler = backups.
+		BackupsSchedulesDeleteHandlerFunc(h.deleteSchedule)
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/objects"
 )
@@ -50,6 +51,13 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 		}
 	}
 
+	for _, obj := range objs {
+		if obj.Err == nil && obj.Object != nil {
+			audit.AnnotateClasses(params.HTTPRequest.Context(), obj.Object.Class)
+			audit.AnnotateObjectIDs(params.HTTPRequest.Context(), obj.UUID.String())
+		}
+	}
+
 	return batch.NewBatchObjectsCreateOK().
 		WithPayload(h.objectsResponse(objs))
 }
@@ -154,6 +162,15 @@ func (h *batchObjectHandlers) deleteObjects(params batch.BatchObjectsDeleteParam
 		}
 	}
 
+	audit.AnnotateClasses(params.HTTPRequest.Context(), res.Match.Class)
+	if !res.DryRun {
+		for _, obj := range res.Result.Objects {
+			if obj.Err == nil {
+				audit.AnnotateObjectIDs(params.HTTPRequest.Context(), obj.UUID.String())
+			}
+		}
+	}
+
 	return batch.NewBatchObjectsDeleteOK().
 		WithPayload(h.objectsDeleteResponse(res))
 }
@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+type batchSearchHandlers struct {
+	traverser    *traverser.Traverser
+	defaultLimit int
+}
+
+func (h *batchSearchHandlers) search(params batch.BatchSearchParams,
+	principal *models.Principal,
+) middleware.Responder {
+	if err := traverser.ValidateBatchGetSize(len(params.Body.Queries)); err != nil {
+		return batch.NewBatchSearchUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	ctx := params.HTTPRequest.Context()
+	if params.Body.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(params.Body.Timeout)*time.Second)
+		defer cancel()
+	}
+
+	results := make([]*models.BatchSearchResult, len(params.Body.Queries))
+
+	// queries with invalid params fail on their own, the others are still
+	// executed
+	var queries []dto.GetParams
+	var positions []int
+	for i, query := range params.Body.Queries {
+		getParams, err := h.getParams(query)
+		if err != nil {
+			results[i] = &models.BatchSearchResult{Error: errPayloadFromSingleErr(err)}
+			continue
+		}
+		queries = append(queries, getParams)
+		positions = append(positions, i)
+	}
+
+	if len(queries) > 0 {
+		res, err := h.traverser.BatchGetClass(ctx, principal, queries)
+		if err != nil {
+			return batch.NewBatchSearchUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		for i, r := range res {
+			if r.Err != nil {
+				results[positions[i]] = &models.BatchSearchResult{Error: errPayloadFromSingleErr(r.Err)}
+				continue
+			}
+			objects := r.Results
+			if objects == nil {
+				objects = []interface{}{}
+			}
+			results[positions[i]] = &models.BatchSearchResult{Objects: objects}
+		}
+	}
+
+	return batch.NewBatchSearchOK().
+		WithPayload(&models.BatchSearchResponse{Results: results})
+}
+
+func (h *batchSearchHandlers) getParams(query *models.BatchSearchQuery) (dto.GetParams, error) {
+	if query == nil {
+		return dto.GetParams{}, fmt.Errorf("query must not be null")
+	}
+	if query.Class == nil || *query.Class == "" {
+		return dto.GetParams{}, fmt.Errorf("class must be set")
+	}
+	out := dto.GetParams{ClassName: *query.Class}
+
+	for _, prop := range query.Properties {
+		out.Properties = append(out.Properties, search.SelectProperty{
+			Name:        prop,
+			IsPrimitive: true,
+		})
+	}
+	if len(out.Properties) == 0 {
+		out.AdditionalProperties.NoProps = true
+	}
+
+	for _, prop := range query.Additional {
+		switch prop {
+		case "id":
+			out.AdditionalProperties.ID = true
+		case "vector":
+			out.AdditionalProperties.Vector = true
+		case "distance":
+			out.AdditionalProperties.Distance = true
+		case "certainty":
+			out.AdditionalProperties.Certainty = true
+		case "score":
+			out.AdditionalProperties.Score = true
+		default:
+			return out, fmt.Errorf("unknown additional property %q", prop)
+		}
+	}
+
+	if query.Where != nil {
+		filter, err := filterext.Parse(query.Where, out.ClassName)
+		if err != nil {
+			return out, fmt.Errorf("where: %w", err)
+		}
+		out.Filters = filter
+	}
+
+	if nv := query.NearVector; nv != nil {
+		if nv.Certainty != nil && nv.Distance != nil {
+			return out, fmt.Errorf("nearVector: cannot provide distance and certainty")
+		}
+		out.NearVector = &searchparams.NearVector{Vector: nv.Vector}
+		if nv.Certainty != nil {
+			out.NearVector.Certainty = *nv.Certainty
+		}
+		if nv.Distance != nil {
+			out.NearVector.Distance = *nv.Distance
+			out.NearVector.WithDistance = true
+		}
+	}
+
+	if no := query.NearObject; no != nil {
+		if no.Certainty != nil && no.Distance != nil {
+			return out, fmt.Errorf("nearObject: cannot provide distance and certainty")
+		}
+		out.NearObject = &searchparams.NearObject{ID: no.ID.String()}
+		if no.Certainty != nil {
+			out.NearObject.Certainty = *no.Certainty
+		}
+		if no.Distance != nil {
+			out.NearObject.Distance = *no.Distance
+			out.NearObject.WithDistance = true
+		}
+	}
+
+	if bm25 := query.Bm25; bm25 != nil {
+		out.KeywordRanking = &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Query:      bm25.Query,
+			Properties: bm25.Properties,
+		}
+	}
+
+	if hs := query.Hybrid; hs != nil {
+		out.HybridSearch = &searchparams.HybridSearch{
+			Type:       "hybrid",
+			Query:      hs.Query,
+			Properties: hs.Properties,
+			Vector:     hs.Vector,
+			Alpha:      config.DefaultAlpha,
+		}
+		if hs.Alpha != nil {
+			if *hs.Alpha < 0 || *hs.Alpha > 1 {
+				return out, fmt.Errorf("hybrid: alpha should be between 0.0 and 1.0")
+			}
+			out.HybridSearch.Alpha = *hs.Alpha
+		}
+	}
+
+	out.Pagination = &filters.Pagination{
+		Offset: int(query.Offset),
+		Limit:  int(query.Limit),
+	}
+	if out.Pagination.Limit <= 0 {
+		out.Pagination.Limit = h.defaultLimit
+	}
+	if out.HybridSearch != nil {
+		out.HybridSearch.Limit = out.Pagination.Limit
+	}
+
+	return out, nil
+}
+
+func setupBatchSearchHandlers(api *operations.WeaviateAPI, traverser *traverser.Traverser,
+	defaultLimit int,
+) {
+	h := &batchSearchHandlers{traverser: traverser, defaultLimit: defaultLimit}
+
+	api.BatchBatchSearchHandler = batch.BatchSearchHandlerFunc(h.search)
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+func TestBatchSearchGetParams(t *testing.T) {
+	h := &batchSearchHandlers{defaultLimit: 25}
+
+	t.Run("nearVector with properties", func(t *testing.T) {
+		params, err := h.getParams(&models.BatchSearchQuery{
+			Class:      swag.String("City"),
+			Properties: []string{"name"},
+			Additional: []string{"id", "distance"},
+			Limit:      3,
+			NearVector: &models.BatchSearchQueryNearVector{
+				Vector:   []float32{1, 2},
+				Distance: swag.Float64(0.2),
+			},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, dto.GetParams{
+			ClassName:            "City",
+			Properties:           search.SelectProperties{{Name: "name", IsPrimitive: true}},
+			AdditionalProperties: additional.Properties{ID: true, Distance: true},
+			Pagination:           &filters.Pagination{Limit: 3},
+			NearVector: &searchparams.NearVector{
+				Vector:       []float32{1, 2},
+				Distance:     0.2,
+				WithDistance: true,
+			},
+		}, params)
+	})
+
+	t.Run("hybrid without limit", func(t *testing.T) {
+		params, err := h.getParams(&models.BatchSearchQuery{
+			Class:  swag.String("City"),
+			Hybrid: &models.BatchSearchQueryHybrid{Query: "berlin"},
+		})
+		require.Nil(t, err)
+
+		assert.True(t, params.AdditionalProperties.NoProps)
+		assert.Equal(t, 25, params.Pagination.Limit)
+		assert.Equal(t, 25, params.HybridSearch.Limit)
+		assert.Equal(t, 0.75, params.HybridSearch.Alpha)
+	})
+
+	t.Run("invalid queries", func(t *testing.T) {
+		_, err := h.getParams(&models.BatchSearchQuery{
+			Class:      swag.String("City"),
+			Additional: []string{"classification"},
+		})
+		assert.EqualError(t, err, `unknown additional property "classification"`)
+
+		_, err = h.getParams(&models.BatchSearchQuery{
+			Class: swag.String("City"),
+			NearObject: &models.BatchSearchQueryNearObject{
+				Certainty: swag.Float64(0.8),
+				Distance:  swag.Float64(0.2),
+			},
+		})
+		assert.EqualError(t, err, "nearObject: cannot provide distance and certainty")
+
+		_, err = h.getParams(nil)
+		assert.EqualError(t, err, "query must not be null")
+
+		_, err = h.getParams(&models.BatchSearchQuery{})
+		assert.EqualError(t, err, "class must be set")
+	})
+}
+
+func TestBatchSearchTooManyQueries(t *testing.T) {
+	h := &batchSearchHandlers{defaultLimit: 25}
+
+	queries := make([]*models.BatchSearchQuery, traverser.MaxBatchGetQueries+1)
+	for i := range queries {
+		queries[i] = &models.BatchSearchQuery{Class: swag.String("City")}
+	}
+	res := h.search(batch.BatchSearchParams{
+		Body: &models.BatchSearchRequest{Queries: queries},
+	}, nil)
+
+	require.IsType(t, &batch.BatchSearchUnprocessableEntity{}, res)
+	payload := res.(*batch.BatchSearchUnprocessableEntity).Payload
+	assert.Equal(t, "batch contains 101 queries, at most 100 are allowed",
+		payload.Error[0].Message)
+}
@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/clusterings"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/clustering"
+)
+
+func setupClusteringHandlers(api *operations.WeaviateAPI,
+	clusterer *clustering.Clusterer,
+) {
+	api.ClusteringsClusteringsGetHandler = clusterings.ClusteringsGetHandlerFunc(
+		func(params clusterings.ClusteringsGetParams, principal *models.Principal) middleware.Responder {
+			res, err := clusterer.Get(params.HTTPRequest.Context(), principal, strfmt.UUID(params.ID))
+			if err != nil {
+				return clusterings.NewClusteringsGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+			}
+
+			if res == nil {
+				return clusterings.NewClusteringsGetNotFound()
+			}
+
+			return clusterings.NewClusteringsGetOK().WithPayload(res)
+		},
+	)
+
+	api.ClusteringsClusteringsPostHandler = clusterings.ClusteringsPostHandlerFunc(
+		func(params clusterings.ClusteringsPostParams, principal *models.Principal) middleware.Responder {
+			res, err := clusterer.Schedule(params.HTTPRequest.Context(), principal, *params.Params)
+			if err != nil {
+				return clusterings.NewClusteringsPostBadRequest().WithPayload(errPayloadFromSingleErr(err))
+			}
+
+			return clusterings.NewClusteringsPostCreated().WithPayload(res)
+		},
+	)
+}
@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/deduplications"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/deduplication"
+)
+
+func setupDeduplicationHandlers(api *operations.WeaviateAPI,
+	deduplicator *deduplication.Deduplicator,
+) {
+	api.DeduplicationsDeduplicationsGetHandler = deduplications.DeduplicationsGetHandlerFunc(
+		func(params deduplications.DeduplicationsGetParams, principal *models.Principal) middleware.Responder {
+			res, err := deduplicator.Get(params.HTTPRequest.Context(), principal, strfmt.UUID(params.ID))
+			if err != nil {
+				return deduplications.NewDeduplicationsGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+			}
+
+			if res == nil {
+				return deduplications.NewDeduplicationsGetNotFound()
+			}
+
+			return deduplications.NewDeduplicationsGetOK().WithPayload(res)
+		},
+	)
+
+	api.DeduplicationsDeduplicationsPostHandler = deduplications.DeduplicationsPostHandlerFunc(
+		func(params deduplications.DeduplicationsPostParams, principal *models.Principal) middleware.Responder {
+			res, err := deduplicator.Schedule(params.HTTPRequest.Context(), principal, *params.Params)
+			if err != nil {
+				return deduplications.NewDeduplicationsPostBadRequest().WithPayload(errPayloadFromSingleErr(err))
+			}
+
+			return deduplications.NewDeduplicationsPostCreated().WithPayload(res)
+		},
+	)
+
+	api.DeduplicationsDeduplicationsResultGetHandler = deduplications.DeduplicationsResultGetHandlerFunc(
+		func(params deduplications.DeduplicationsResultGetParams, principal *models.Principal) middleware.Responder {
+			res, err := deduplicator.GetResult(params.HTTPRequest.Context(), principal, strfmt.UUID(params.ID))
+			if err != nil {
+				return deduplications.NewDeduplicationsResultGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+			}
+
+			if res == nil {
+				return deduplications.NewDeduplicationsResultGetNotFound()
+			}
+
+			return deduplications.NewDeduplicationsResultGetOK().WithPayload(res)
+		},
+	)
+}
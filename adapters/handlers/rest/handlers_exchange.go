@@ -0,0 +1,220 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/entities/exchange"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+type exchangeHandlers struct {
+	manager      *uco.Manager
+	batchManager *uco.BatchManager
+	logger       logrus.FieldLogger
+}
+
+func (h *exchangeHandlers) exportObjects(params objects.ObjectsExportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	format, err := exchange.ParseFormat(stringOrEmpty(params.Format))
+	if err != nil {
+		return objects.NewObjectsExportBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	var where *models.WhereFilter
+	if params.Where != nil && *params.Where != "" {
+		where = &models.WhereFilter{}
+		if err := json.Unmarshal([]byte(*params.Where), where); err != nil {
+			return objects.NewObjectsExportBadRequest().
+				WithPayload(errPayloadFromSingleErr(
+					fmt.Errorf("invalid where filter: %w", err)))
+		}
+	}
+
+	exportParams := uco.ExportParams{
+		Class:         params.ClassName,
+		Where:         where,
+		IncludeVector: params.IncludeVector == nil || *params.IncludeVector,
+	}
+
+	// The status code can only be chosen as long as nothing was written yet.
+	// Therefore the response is only started with the first exported object,
+	// errors occurring later on can only be logged.
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		var enc exchange.Encoder
+		start := func() (err error) {
+			rw.Header().Set(runtime.HeaderContentType, format.ContentType())
+			rw.WriteHeader(http.StatusOK)
+			enc, err = exchange.NewEncoder(format, rw)
+			return err
+		}
+
+		rerr := h.manager.Export(params.HTTPRequest.Context(), principal, exportParams,
+			func(obj *models.Object) error {
+				if enc == nil {
+					if err := start(); err != nil {
+						return err
+					}
+				}
+				return enc.Encode(obj)
+			})
+		if rerr != nil {
+			if enc == nil {
+				rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+				exportErrorResponder(rerr).WriteResponse(rw, runtime.JSONProducer())
+				return
+			}
+			h.logger.WithField("action", "export_objects").
+				WithField("class", params.ClassName).
+				WithError(rerr).Error("export aborted after response was started")
+			return
+		}
+
+		if enc == nil {
+			// nothing matched, still respond with a valid empty file
+			if err := start(); err != nil {
+				h.logger.WithField("action", "export_objects").
+					WithError(err).Error("start export")
+				return
+			}
+		}
+		if err := enc.Close(); err != nil {
+			h.logger.WithField("action", "export_objects").
+				WithField("class", params.ClassName).
+				WithError(err).Error("finish export")
+		}
+	})
+}
+
+func exportErrorResponder(err *uco.Error) middleware.Responder {
+	switch err.Code {
+	case uco.StatusForbidden:
+		return objects.NewObjectsExportForbidden().
+			WithPayload(errPayloadFromSingleErr(err))
+	case uco.StatusNotFound:
+		return objects.NewObjectsExportNotFound()
+	case uco.StatusBadRequest:
+		return objects.NewObjectsExportUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	default:
+		return objects.NewObjectsExportInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+}
+
+func (h *exchangeHandlers) importObjects(params objects.ObjectsImportParams,
+	principal *models.Principal,
+) middleware.Responder {
+	defer params.Body.Close()
+
+	format, err := exchange.ParseFormat(stringOrEmpty(params.Format))
+	if err != nil {
+		return objects.NewObjectsImportBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	mapping, err := parsePropertyMapping(params.PropertyMapping)
+	if err != nil {
+		return objects.NewObjectsImportBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		return objects.NewObjectsImportBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	dec, err := exchange.NewDecoder(format, params.Body)
+	if err != nil {
+		return objects.NewObjectsImportUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+	defer dec.Close()
+
+	res, err := h.batchManager.Import(params.HTTPRequest.Context(), principal,
+		uco.ImportParams{
+			Class:           params.ClassName,
+			PropertyMapping: mapping,
+			Replication:     repl,
+		}, dec.Decode)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return objects.NewObjectsImportForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrInvalidUserInput:
+			return objects.NewObjectsImportUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return objects.NewObjectsImportInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return objects.NewObjectsImportOK().
+		WithPayload(&models.ImportResponse{
+			Class:    params.ClassName,
+			Imported: res.Imported,
+			Failed:   res.Failed,
+			Errors:   res.Errors,
+		})
+}
+
+// parsePropertyMapping parses entries of the form "source=target"
+func parsePropertyMapping(entries []string) (map[string]string, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	mapping := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		source, target, ok := strings.Cut(entry, "=")
+		source, target = strings.TrimSpace(source), strings.TrimSpace(target)
+		if !ok || source == "" || target == "" {
+			return nil, fmt.Errorf("invalid property mapping %q, "+
+				"expected the form 'source=target'", entry)
+		}
+		mapping[source] = target
+	}
+	return mapping, nil
+}
+
+func stringOrEmpty(in *string) string {
+	if in == nil {
+		return ""
+	}
+	return *in
+}
+
+func setupExchangeHandlers(api *operations.WeaviateAPI, manager *uco.Manager,
+	batchManager *uco.BatchManager, logger logrus.FieldLogger,
+) {
+	h := &exchangeHandlers{manager, batchManager, logger}
+	api.ObjectsObjectsExportHandler = objects.
+		ObjectsExportHandlerFunc(h.exportObjects)
+	api.ObjectsObjectsImportHandler = objects.
+		ObjectsImportHandlerFunc(h.importObjects)
+}
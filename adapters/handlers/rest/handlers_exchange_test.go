@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePropertyMapping(t *testing.T) {
+	t.Run("no entries", func(t *testing.T) {
+		mapping, err := parsePropertyMapping(nil)
+		require.Nil(t, err)
+		assert.Nil(t, mapping)
+	})
+
+	t.Run("valid entries", func(t *testing.T) {
+		mapping, err := parsePropertyMapping([]string{"name=title", " body = content "})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{"name": "title", "body": "content"}, mapping)
+	})
+
+	t.Run("invalid entries", func(t *testing.T) {
+		for _, entry := range []string{"name", "=title", "name=", ""} {
+			_, err := parsePropertyMapping([]string{entry})
+			assert.NotNil(t, err, entry)
+		}
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"strconv"
 	"sync"
 
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/schema"
 
@@ -93,6 +94,9 @@ func setupGraphQLHandlers(api *operations.WeaviateAPI, gqlProvider graphQLProvid
 
 		result := graphQL.Resolve(ctx, query,
 			operationName, variables)
+		if len(result.Errors) > 0 {
+			audit.AnnotateError(ctx, result.Errors[0].Message)
+		}
 
 		// Marshal the JSON
 		resultJSON, jsonErr := json.Marshal(result)
@@ -193,6 +197,9 @@ func handleUnbatchedGraphQLRequest(ctx context.Context, wg *sync.WaitGroup, grap
 		}
 
 		result := graphQL.Resolve(ctx, query, operationName, variables)
+		if len(result.Errors) > 0 {
+			audit.AnnotateError(ctx, result.Errors[0].Message)
+		}
 
 		// Marshal the JSON
 		resultJSON, jsonErr := json.Marshal(result)
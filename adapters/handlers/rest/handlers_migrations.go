@@ -0,0 +1,175 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/migrations"
+)
+
+type migrationHandlers struct {
+	manager *migrations.Manager
+}
+
+func (h *migrationHandlers) createMigration(params schema.SchemaMigrationsCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	migration, err := h.manager.Create(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaMigrationsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, migrations.ErrInvalidMigration):
+			return schema.NewSchemaMigrationsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaMigrationsCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaMigrationsCreateAccepted().WithPayload(migration)
+}
+
+func (h *migrationHandlers) listMigrations(params schema.SchemaMigrationsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	list, err := h.manager.List(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaMigrationsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaMigrationsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaMigrationsListOK().WithPayload(list)
+}
+
+func (h *migrationHandlers) getMigration(params schema.SchemaMigrationsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	migration, err := h.manager.Get(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaMigrationsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, migrations.ErrMigrationNotFound):
+			return schema.NewSchemaMigrationsGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaMigrationsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaMigrationsGetOK().WithPayload(migration)
+}
+
+func (h *migrationHandlers) pauseMigration(params schema.SchemaMigrationsPauseParams,
+	principal *models.Principal,
+) middleware.Responder {
+	migration, err := h.manager.Pause(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaMigrationsPauseForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, migrations.ErrMigrationNotFound):
+			return schema.NewSchemaMigrationsPauseNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, migrations.ErrInvalidMigration):
+			return schema.NewSchemaMigrationsPauseUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaMigrationsPauseInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaMigrationsPauseOK().WithPayload(migration)
+}
+
+func (h *migrationHandlers) resumeMigration(params schema.SchemaMigrationsResumeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	migration, err := h.manager.Resume(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaMigrationsResumeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, migrations.ErrMigrationNotFound):
+			return schema.NewSchemaMigrationsResumeNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, migrations.ErrInvalidMigration):
+			return schema.NewSchemaMigrationsResumeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaMigrationsResumeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaMigrationsResumeOK().WithPayload(migration)
+}
+
+func (h *migrationHandlers) abortMigration(params schema.SchemaMigrationsAbortParams,
+	principal *models.Principal,
+) middleware.Responder {
+	migration, err := h.manager.Abort(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return schema.NewSchemaMigrationsAbortForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, migrations.ErrMigrationNotFound):
+			return schema.NewSchemaMigrationsAbortNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, migrations.ErrInvalidMigration):
+			return schema.NewSchemaMigrationsAbortUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaMigrationsAbortInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaMigrationsAbortOK().WithPayload(migration)
+}
+
+func setupMigrationHandlers(api *operations.WeaviateAPI, manager *migrations.Manager) {
+	h := &migrationHandlers{manager}
+	api.SchemaSchemaMigrationsCreateHandler = schema.
+		SchemaMigrationsCreateHandlerFunc(h.createMigration)
+	api.SchemaSchemaMigrationsListHandler = schema.
+		SchemaMigrationsListHandlerFunc(h.listMigrations)
+	api.SchemaSchemaMigrationsGetHandler = schema.
+		SchemaMigrationsGetHandlerFunc(h.getMigration)
+	api.SchemaSchemaMigrationsPauseHandler = schema.
+		SchemaMigrationsPauseHandlerFunc(h.pauseMigration)
+	api.SchemaSchemaMigrationsResumeHandler = schema.
+		SchemaMigrationsResumeHandlerFunc(h.resumeMigration)
+	api.SchemaSchemaMigrationsAbortHandler = schema.
+		SchemaMigrationsAbortHandlerFunc(h.abortMigration)
+}
@@ -25,7 +25,8 @@ import (
 )
 
 type nodesHandlers struct {
-	manager *nodesUC.Manager
+	manager        *nodesUC.Manager
+	decommissioner *nodesUC.Decommissioner
 }
 
 func (s *nodesHandlers) getNodesStatus(params nodes.NodesGetParams, principal *models.Principal) middleware.Responder {
@@ -54,13 +55,37 @@ func (s *nodesHandlers) getNodesStatus(params nodes.NodesGetParams, principal *m
 	return nodes.NewNodesGetOK().WithPayload(status)
 }
 
+func (s *nodesHandlers) decommissionNode(params nodes.NodesDecommissionParams, principal *models.Principal) middleware.Responder {
+	status, err := s.decommissioner.Decommission(params.HTTPRequest.Context(), principal, params.Name)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return nodes.NewNodesDecommissionForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return nodes.NewNodesDecommissionUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrNotFound:
+			return nodes.NewNodesDecommissionNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesDecommissionInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return nodes.NewNodesDecommissionAccepted().WithPayload(status)
+}
+
 func setupNodesHandlers(api *operations.WeaviateAPI,
 	schemaManger *schemaUC.Manager, repo *db.DB, appState *state.State,
 ) {
 	nodesManager := nodesUC.NewManager(appState.Logger, appState.Authorizer,
-		repo, schemaManger)
+		repo, schemaManger, appState.Decommissioner)
 
-	h := &nodesHandlers{nodesManager}
+	h := &nodesHandlers{nodesManager, appState.Decommissioner}
 	api.NodesNodesGetHandler = nodes.
 		NodesGetHandlerFunc(h.getNodesStatus)
+	api.NodesNodesDecommissionHandler = nodes.
+		NodesDecommissionHandlerFunc(h.decommissionNode)
 }
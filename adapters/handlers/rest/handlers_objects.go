@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
 	uco "github.com/weaviate/weaviate/usecases/objects"
@@ -90,6 +91,9 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 		}
 	}
 
+	audit.AnnotateClasses(params.HTTPRequest.Context(), object.Class)
+	audit.AnnotateObjectIDs(params.HTTPRequest.Context(), object.ID.String())
+
 	propertiesMap, ok := object.Properties.(map[string]interface{})
 	if ok {
 		object.Properties = h.extendPropertiesWithAPILinks(propertiesMap)
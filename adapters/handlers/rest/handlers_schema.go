@@ -12,6 +12,8 @@
 package rest
 
 import (
+	"sort"
+
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
@@ -125,7 +127,7 @@ func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesA
 }
 
 func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
-	dbSchema, err := s.manager.GetSchema(principal)
+	dbSchema, err := s.manager.GetConsistentSchema(params.HTTPRequest.Context(), principal)
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -182,6 +184,99 @@ func (s *schemaHandlers) updateShardStatus(params schema.SchemaObjectsShardsUpda
 	return schema.NewSchemaObjectsShardsUpdateOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) getAliases(params schema.SchemaAliasesListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	aliases, err := s.manager.GetAliases(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaAliasesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaAliasesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := make([]*models.Alias, 0, len(aliases))
+	for alias, class := range aliases {
+		payload = append(payload, &models.Alias{Alias: alias, Class: class})
+	}
+	sort.Slice(payload, func(i, j int) bool {
+		return payload[i].Alias < payload[j].Alias
+	})
+
+	return schema.NewSchemaAliasesListOK().WithPayload(payload)
+}
+
+func (s *schemaHandlers) addAlias(params schema.SchemaAliasesCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.AddAlias(params.HTTPRequest.Context(), principal,
+		params.Body.Alias, params.Body.Class)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaAliasesCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaAliasesCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaAliasesCreateOK().WithPayload(params.Body)
+}
+
+func (s *schemaHandlers) updateAlias(params schema.SchemaAliasesUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.UpdateAlias(params.HTTPRequest.Context(), principal,
+		params.AliasName, params.Body.Class)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaAliasesUpdateNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaAliasesUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaAliasesUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaAliasesUpdateOK().WithPayload(&models.Alias{
+		Alias: params.AliasName,
+		Class: params.Body.Class,
+	})
+}
+
+func (s *schemaHandlers) deleteAlias(params schema.SchemaAliasesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.DeleteAlias(params.HTTPRequest.Context(), principal, params.AliasName)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaAliasesDeleteNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaAliasesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaAliasesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaAliasesDeleteNoContent()
+}
+
 func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager) {
 	h := &schemaHandlers{manager}
 
@@ -204,4 +299,13 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager)
 		SchemaObjectsShardsGetHandlerFunc(h.getShardsStatus)
 	api.SchemaSchemaObjectsShardsUpdateHandler = schema.
 		SchemaObjectsShardsUpdateHandlerFunc(h.updateShardStatus)
+
+	api.SchemaSchemaAliasesListHandler = schema.
+		SchemaAliasesListHandlerFunc(h.getAliases)
+	api.SchemaSchemaAliasesCreateHandler = schema.
+		SchemaAliasesCreateHandlerFunc(h.addAlias)
+	api.SchemaSchemaAliasesUpdateHandler = schema.
+		SchemaAliasesUpdateHandlerFunc(h.updateAlias)
+	api.SchemaSchemaAliasesDeleteHandler = schema.
+		SchemaAliasesDeleteHandlerFunc(h.deleteAlias)
 }
@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/scaler"
+)
+
+type shardMoveHandlers struct {
+	mover *scaler.Mover
+}
+
+func (h *shardMoveHandlers) createMove(params cluster.ClusterShardMovesCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	move, err := h.mover.Move(params.HTTPRequest.Context(), principal, params.Body)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return cluster.NewClusterShardMovesCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, scaler.ErrInvalidMove):
+			return cluster.NewClusterShardMovesCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterShardMovesCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return cluster.NewClusterShardMovesCreateAccepted().WithPayload(move)
+}
+
+func (h *shardMoveHandlers) listMoves(params cluster.ClusterShardMovesListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	moves, err := h.mover.List(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return cluster.NewClusterShardMovesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterShardMovesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return cluster.NewClusterShardMovesListOK().WithPayload(moves)
+}
+
+func (h *shardMoveHandlers) getMove(params cluster.ClusterShardMovesGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	move, err := h.mover.Get(params.HTTPRequest.Context(), principal, params.ID)
+	if err != nil {
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return cluster.NewClusterShardMovesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, scaler.ErrMoveNotFound):
+			return cluster.NewClusterShardMovesGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterShardMovesGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return cluster.NewClusterShardMovesGetOK().WithPayload(move)
+}
+
+func setupShardMoveHandlers(api *operations.WeaviateAPI, mover *scaler.Mover) {
+	h := &shardMoveHandlers{mover}
+	api.ClusterClusterShardMovesCreateHandler = cluster.
+		ClusterShardMovesCreateHandlerFunc(h.createMove)
+	api.ClusterClusterShardMovesListHandler = cluster.
+		ClusterShardMovesListHandlerFunc(h.listMoves)
+	api.ClusterClusterShardMovesGetHandler = cluster.
+		ClusterShardMovesGetHandlerFunc(h.getMove)
+}
@@ -18,11 +18,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-openapi/runtime/middleware"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/swagger_middleware"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
@@ -34,14 +37,92 @@ import (
 // to some resources which are not exposed
 func makeSetupMiddlewares(appState *state.State) func(http.Handler) http.Handler {
 	return func(handler http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var next http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if r.URL.String() == "/v1/.well-known/openid-configuration" || r.URL.String() == "/v1" {
 				handler.ServeHTTP(w, r)
 				return
 			}
 			appState.AnonymousAccess.Middleware(handler).ServeHTTP(w, r)
 		})
+		if appState.Auditor != nil {
+			next = makeAddAudit(appState.Auditor)(next)
+		}
+		return next
+	}
+}
+
+// makeAddAudit records every request to the audit log. It runs after routing,
+// so the matched operation is known. The principal is only known once the
+// operation has authenticated the request, which updates the request in
+// place, so it is read after the request has been handled.
+func makeAddAudit(auditor *audit.Auditor) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := middleware.MatchedRouteFrom(r)
+			if route == nil || route.Operation == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &audit.Record{
+				Time:      time.Now(),
+				Protocol:  audit.ProtocolREST,
+				Operation: route.Operation.ID,
+				Verb:      auditVerb(r.Method, route.Operation.ID),
+				Resource:  strings.TrimPrefix(r.URL.Path, "/v1/"),
+			}
+			if strings.HasPrefix(route.Operation.ID, "graphql.") {
+				rec.Protocol = audit.ProtocolGraphQL
+			}
+			rec.AddClasses(route.Params.Get("className"))
+			rec.AddObjectIDs(route.Params.Get("id"))
+
+			r = r.WithContext(audit.NewContext(r.Context(), rec))
+			sw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r)
+
+			principal, _ := middleware.SecurityPrincipalFrom(r).(*models.Principal)
+			rec.SetPrincipal(principal)
+			rec.Status = sw.status
+			rec.Result = audit.ResultFromHTTPStatus(sw.status)
+			auditor.Log(rec)
+		})
+	}
+}
+
+// auditVerb derives the verb from the HTTP method, using the same verbs as
+// the authorizer. GraphQL and batch searches only support queries,
+// validation never changes any data.
+func auditVerb(method, operationID string) string {
+	switch {
+	case strings.HasPrefix(operationID, "graphql."), operationID == "batch.search":
+		return "get"
+	case strings.HasSuffix(operationID, ".validate"):
+		return "validate"
 	}
+
+	switch method {
+	case http.MethodGet:
+		return "get"
+	case http.MethodHead:
+		return "head"
+	case http.MethodPut, http.MethodPatch:
+		return "update"
+	case http.MethodDelete:
+		return "delete"
+	default:
+		return "create"
+	}
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
 }
 
 func addHandleRoot(next http.Handler) http.Handler {
@@ -145,7 +226,7 @@ func addPreflight(next http.Handler) http.Handler {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
 			w.Header().Set("Access-Control-Allow-Methods", "*")
 			w.Header().Set("Access-Control-Allow-Headers",
-				"Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Cohere-Api-Key, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Palm-Api-Key")
+				"Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Cohere-Api-Key, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Palm-Api-Key, X-Local-Api-Key")
 			return
 		}
 
@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditVerb(t *testing.T) {
+	tests := []struct {
+		method      string
+		operationID string
+		expected    string
+	}{
+		{http.MethodGet, "objects.class.get", "get"},
+		{http.MethodHead, "objects.class.head", "head"},
+		{http.MethodPost, "objects.create", "create"},
+		{http.MethodPost, "objects.validate", "validate"},
+		{http.MethodPut, "objects.class.put", "update"},
+		{http.MethodPatch, "objects.class.patch", "update"},
+		{http.MethodDelete, "objects.class.delete", "delete"},
+		{http.MethodPost, "graphql.post", "get"},
+		{http.MethodPost, "graphql.batch", "get"},
+		{http.MethodPost, "batch.search", "get"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, auditVerb(tt.method, tt.operationID), tt.operationID)
+	}
+}
@@ -18,6 +18,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -31,9 +32,13 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/backups"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/classifications"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/clusterings"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/deduplications"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/meta"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
@@ -61,14 +66,33 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
+		ApplicationVndApacheParquetConsumer: runtime.ConsumerFunc(func(r io.Reader, target interface{}) error {
+			return errors.NotImplemented("applicationVndApacheParquet consumer has not yet been implemented")
+		}),
+		BinConsumer:  runtime.ByteStreamConsumer(),
 		JSONConsumer: runtime.JSONConsumer(),
 		YamlConsumer: yamlpc.YAMLConsumer(),
 
+		ApplicationVndApacheParquetProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("applicationVndApacheParquet producer has not yet been implemented")
+		}),
 		JSONProducer: runtime.JSONProducer(),
 
 		WellKnownGetWellKnownOpenidConfigurationHandler: well_known.GetWellKnownOpenidConfigurationHandlerFunc(func(params well_known.GetWellKnownOpenidConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation well_known.GetWellKnownOpenidConfiguration has not yet been implemented")
 		}),
+		AuthzAuthzRolesCreateHandler: authz.AuthzRolesCreateHandlerFunc(func(params authz.AuthzRolesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesCreate has not yet been implemented")
+		}),
+		AuthzAuthzRolesDeleteHandler: authz.AuthzRolesDeleteHandlerFunc(func(params authz.AuthzRolesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesDelete has not yet been implemented")
+		}),
+		AuthzAuthzRolesGetHandler: authz.AuthzRolesGetHandlerFunc(func(params authz.AuthzRolesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesGet has not yet been implemented")
+		}),
+		AuthzAuthzRolesListHandler: authz.AuthzRolesListHandlerFunc(func(params authz.AuthzRolesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesList has not yet been implemented")
+		}),
 		BackupsBackupsCreateHandler: backups.BackupsCreateHandlerFunc(func(params backups.BackupsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsCreate has not yet been implemented")
 		}),
@@ -81,6 +105,18 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BackupsBackupsRestoreStatusHandler: backups.BackupsRestoreStatusHandlerFunc(func(params backups.BackupsRestoreStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsRestoreStatus has not yet been implemented")
 		}),
+		BackupsBackupsSchedulesCreateHandler: backups.BackupsSchedulesCreateHandlerFunc(func(params backups.BackupsSchedulesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesCreate has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesDeleteHandler: backups.BackupsSchedulesDeleteHandlerFunc(func(params backups.BackupsSchedulesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesDelete has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesGetHandler: backups.BackupsSchedulesGetHandlerFunc(func(params backups.BackupsSchedulesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesGet has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesListHandler: backups.BackupsSchedulesListHandlerFunc(func(params backups.BackupsSchedulesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesList has not yet been implemented")
+		}),
 		BatchBatchObjectsCreateHandler: batch.BatchObjectsCreateHandlerFunc(func(params batch.BatchObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsCreate has not yet been implemented")
 		}),
@@ -90,12 +126,39 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BatchBatchReferencesCreateHandler: batch.BatchReferencesCreateHandlerFunc(func(params batch.BatchReferencesCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchReferencesCreate has not yet been implemented")
 		}),
+		BatchBatchSearchHandler: batch.BatchSearchHandlerFunc(func(params batch.BatchSearchParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchSearch has not yet been implemented")
+		}),
 		ClassificationsClassificationsGetHandler: classifications.ClassificationsGetHandlerFunc(func(params classifications.ClassificationsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation classifications.ClassificationsGet has not yet been implemented")
 		}),
 		ClassificationsClassificationsPostHandler: classifications.ClassificationsPostHandlerFunc(func(params classifications.ClassificationsPostParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation classifications.ClassificationsPost has not yet been implemented")
 		}),
+		ClusterClusterShardMovesCreateHandler: cluster.ClusterShardMovesCreateHandlerFunc(func(params cluster.ClusterShardMovesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterShardMovesCreate has not yet been implemented")
+		}),
+		ClusterClusterShardMovesGetHandler: cluster.ClusterShardMovesGetHandlerFunc(func(params cluster.ClusterShardMovesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterShardMovesGet has not yet been implemented")
+		}),
+		ClusterClusterShardMovesListHandler: cluster.ClusterShardMovesListHandlerFunc(func(params cluster.ClusterShardMovesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterShardMovesList has not yet been implemented")
+		}),
+		ClusteringsClusteringsGetHandler: clusterings.ClusteringsGetHandlerFunc(func(params clusterings.ClusteringsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation clusterings.ClusteringsGet has not yet been implemented")
+		}),
+		ClusteringsClusteringsPostHandler: clusterings.ClusteringsPostHandlerFunc(func(params clusterings.ClusteringsPostParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation clusterings.ClusteringsPost has not yet been implemented")
+		}),
+		DeduplicationsDeduplicationsGetHandler: deduplications.DeduplicationsGetHandlerFunc(func(params deduplications.DeduplicationsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation deduplications.DeduplicationsGet has not yet been implemented")
+		}),
+		DeduplicationsDeduplicationsPostHandler: deduplications.DeduplicationsPostHandlerFunc(func(params deduplications.DeduplicationsPostParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation deduplications.DeduplicationsPost has not yet been implemented")
+		}),
+		DeduplicationsDeduplicationsResultGetHandler: deduplications.DeduplicationsResultGetHandlerFunc(func(params deduplications.DeduplicationsResultGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation deduplications.DeduplicationsResultGet has not yet been implemented")
+		}),
 		GraphqlGraphqlBatchHandler: graphql.GraphqlBatchHandlerFunc(func(params graphql.GraphqlBatchParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlBatch has not yet been implemented")
 		}),
@@ -105,6 +168,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		MetaMetaGetHandler: meta.MetaGetHandlerFunc(func(params meta.MetaGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation meta.MetaGet has not yet been implemented")
 		}),
+		NodesNodesDecommissionHandler: nodes.NodesDecommissionHandlerFunc(func(params nodes.NodesDecommissionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesDecommission has not yet been implemented")
+		}),
 		NodesNodesGetHandler: nodes.NodesGetHandlerFunc(func(params nodes.NodesGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation nodes.NodesGet has not yet been implemented")
 		}),
@@ -138,12 +204,18 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsDeleteHandler: objects.ObjectsDeleteHandlerFunc(func(params objects.ObjectsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsDelete has not yet been implemented")
 		}),
+		ObjectsObjectsExportHandler: objects.ObjectsExportHandlerFunc(func(params objects.ObjectsExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsExport has not yet been implemented")
+		}),
 		ObjectsObjectsGetHandler: objects.ObjectsGetHandlerFunc(func(params objects.ObjectsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsGet has not yet been implemented")
 		}),
 		ObjectsObjectsHeadHandler: objects.ObjectsHeadHandlerFunc(func(params objects.ObjectsHeadParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsHead has not yet been implemented")
 		}),
+		ObjectsObjectsImportHandler: objects.ObjectsImportHandlerFunc(func(params objects.ObjectsImportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation objects.ObjectsImport has not yet been implemented")
+		}),
 		ObjectsObjectsListHandler: objects.ObjectsListHandlerFunc(func(params objects.ObjectsListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsList has not yet been implemented")
 		}),
@@ -165,9 +237,39 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsValidateHandler: objects.ObjectsValidateHandlerFunc(func(params objects.ObjectsValidateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsValidate has not yet been implemented")
 		}),
+		SchemaSchemaAliasesCreateHandler: schema.SchemaAliasesCreateHandlerFunc(func(params schema.SchemaAliasesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaAliasesCreate has not yet been implemented")
+		}),
+		SchemaSchemaAliasesDeleteHandler: schema.SchemaAliasesDeleteHandlerFunc(func(params schema.SchemaAliasesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaAliasesDelete has not yet been implemented")
+		}),
+		SchemaSchemaAliasesListHandler: schema.SchemaAliasesListHandlerFunc(func(params schema.SchemaAliasesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaAliasesList has not yet been implemented")
+		}),
+		SchemaSchemaAliasesUpdateHandler: schema.SchemaAliasesUpdateHandlerFunc(func(params schema.SchemaAliasesUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaAliasesUpdate has not yet been implemented")
+		}),
 		SchemaSchemaDumpHandler: schema.SchemaDumpHandlerFunc(func(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaDump has not yet been implemented")
 		}),
+		SchemaSchemaMigrationsAbortHandler: schema.SchemaMigrationsAbortHandlerFunc(func(params schema.SchemaMigrationsAbortParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaMigrationsAbort has not yet been implemented")
+		}),
+		SchemaSchemaMigrationsCreateHandler: schema.SchemaMigrationsCreateHandlerFunc(func(params schema.SchemaMigrationsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaMigrationsCreate has not yet been implemented")
+		}),
+		SchemaSchemaMigrationsGetHandler: schema.SchemaMigrationsGetHandlerFunc(func(params schema.SchemaMigrationsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaMigrationsGet has not yet been implemented")
+		}),
+		SchemaSchemaMigrationsListHandler: schema.SchemaMigrationsListHandlerFunc(func(params schema.SchemaMigrationsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaMigrationsList has not yet been implemented")
+		}),
+		SchemaSchemaMigrationsPauseHandler: schema.SchemaMigrationsPauseHandlerFunc(func(params schema.SchemaMigrationsPauseParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaMigrationsPause has not yet been implemented")
+		}),
+		SchemaSchemaMigrationsResumeHandler: schema.SchemaMigrationsResumeHandlerFunc(func(params schema.SchemaMigrationsResumeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaMigrationsResume has not yet been implemented")
+		}),
 		SchemaSchemaObjectsCreateHandler: schema.SchemaObjectsCreateHandlerFunc(func(params schema.SchemaObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsCreate has not yet been implemented")
 		}),
@@ -232,15 +334,26 @@ type WeaviateAPI struct {
 	// It has a default implementation in the security package, however you can replace it for your particular usage.
 	BearerAuthenticator func(string, security.ScopedTokenAuthentication) runtime.Authenticator
 
+	// ApplicationVndApacheParquetConsumer registers a consumer for the following mime types:
+	//   - application/vnd.apache.parquet
+	ApplicationVndApacheParquetConsumer runtime.Consumer
+	// BinConsumer registers a consumer for the following mime types:
+	//   - application/octet-stream
+	BinConsumer runtime.Consumer
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
+	//   - application/x-ndjson
 	JSONConsumer runtime.Consumer
 	// YamlConsumer registers a consumer for the following mime types:
 	//   - application/yaml
 	YamlConsumer runtime.Consumer
 
+	// ApplicationVndApacheParquetProducer registers a producer for the following mime types:
+	//   - application/vnd.apache.parquet
+	ApplicationVndApacheParquetProducer runtime.Producer
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
+	//   - application/x-ndjson
 	JSONProducer runtime.Producer
 
 	// OidcAuth registers a function that takes an access token and a collection of required scopes and returns a principal
@@ -252,6 +365,14 @@ type WeaviateAPI struct {
 
 	// WellKnownGetWellKnownOpenidConfigurationHandler sets the operation handler for the get well known openid configuration operation
 	WellKnownGetWellKnownOpenidConfigurationHandler well_known.GetWellKnownOpenidConfigurationHandler
+	// AuthzAuthzRolesCreateHandler sets the operation handler for the authz roles create operation
+	AuthzAuthzRolesCreateHandler authz.AuthzRolesCreateHandler
+	// AuthzAuthzRolesDeleteHandler sets the operation handler for the authz roles delete operation
+	AuthzAuthzRolesDeleteHandler authz.AuthzRolesDeleteHandler
+	// AuthzAuthzRolesGetHandler sets the operation handler for the authz roles get operation
+	AuthzAuthzRolesGetHandler authz.AuthzRolesGetHandler
+	// AuthzAuthzRolesListHandler sets the operation handler for the authz roles list operation
+	AuthzAuthzRolesListHandler authz.AuthzRolesListHandler
 	// BackupsBackupsCreateHandler sets the operation handler for the backups create operation
 	BackupsBackupsCreateHandler backups.BackupsCreateHandler
 	// BackupsBackupsCreateStatusHandler sets the operation handler for the backups create status operation
@@ -260,22 +381,50 @@ type WeaviateAPI struct {
 	BackupsBackupsRestoreHandler backups.BackupsRestoreHandler
 	// BackupsBackupsRestoreStatusHandler sets the operation handler for the backups restore status operation
 	BackupsBackupsRestoreStatusHandler backups.BackupsRestoreStatusHandler
+	// BackupsBackupsSchedulesCreateHandler sets the operation handler for the backups schedules create operation
+	BackupsBackupsSchedulesCreateHandler backups.BackupsSchedulesCreateHandler
+	// BackupsBackupsSchedulesDeleteHandler sets the operation handler for the backups schedules delete operation
+	BackupsBackupsSchedulesDeleteHandler backups.BackupsSchedulesDeleteHandler
+	// BackupsBackupsSchedulesGetHandler sets the operation handler for the backups schedules get operation
+	BackupsBackupsSchedulesGetHandler backups.BackupsSchedulesGetHandler
+	// BackupsBackupsSchedulesListHandler sets the operation handler for the backups schedules list operation
+	BackupsBackupsSchedulesListHandler backups.BackupsSchedulesListHandler
 	// BatchBatchObjectsCreateHandler sets the operation handler for the batch objects create operation
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
 	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
 	BatchBatchObjectsDeleteHandler batch.BatchObjectsDeleteHandler
 	// BatchBatchReferencesCreateHandler sets the operation handler for the batch references create operation
 	BatchBatchReferencesCreateHandler batch.BatchReferencesCreateHandler
+	// BatchBatchSearchHandler sets the operation handler for the batch search operation
+	BatchBatchSearchHandler batch.BatchSearchHandler
 	// ClassificationsClassificationsGetHandler sets the operation handler for the classifications get operation
 	ClassificationsClassificationsGetHandler classifications.ClassificationsGetHandler
 	// ClassificationsClassificationsPostHandler sets the operation handler for the classifications post operation
 	ClassificationsClassificationsPostHandler classifications.ClassificationsPostHandler
+	// ClusterClusterShardMovesCreateHandler sets the operation handler for the cluster shard moves create operation
+	ClusterClusterShardMovesCreateHandler cluster.ClusterShardMovesCreateHandler
+	// ClusterClusterShardMovesGetHandler sets the operation handler for the cluster shard moves get operation
+	ClusterClusterShardMovesGetHandler cluster.ClusterShardMovesGetHandler
+	// ClusterClusterShardMovesListHandler sets the operation handler for the cluster shard moves list operation
+	ClusterClusterShardMovesListHandler cluster.ClusterShardMovesListHandler
+	// ClusteringsClusteringsGetHandler sets the operation handler for the clusterings get operation
+	ClusteringsClusteringsGetHandler clusterings.ClusteringsGetHandler
+	// ClusteringsClusteringsPostHandler sets the operation handler for the clusterings post operation
+	ClusteringsClusteringsPostHandler clusterings.ClusteringsPostHandler
+	// DeduplicationsDeduplicationsGetHandler sets the operation handler for the deduplications get operation
+	DeduplicationsDeduplicationsGetHandler deduplications.DeduplicationsGetHandler
+	// DeduplicationsDeduplicationsPostHandler sets the operation handler for the deduplications post operation
+	DeduplicationsDeduplicationsPostHandler deduplications.DeduplicationsPostHandler
+	// DeduplicationsDeduplicationsResultGetHandler sets the operation handler for the deduplications result get operation
+	DeduplicationsDeduplicationsResultGetHandler deduplications.DeduplicationsResultGetHandler
 	// GraphqlGraphqlBatchHandler sets the operation handler for the graphql batch operation
 	GraphqlGraphqlBatchHandler graphql.GraphqlBatchHandler
 	// GraphqlGraphqlPostHandler sets the operation handler for the graphql post operation
 	GraphqlGraphqlPostHandler graphql.GraphqlPostHandler
 	// MetaMetaGetHandler sets the operation handler for the meta get operation
 	MetaMetaGetHandler meta.MetaGetHandler
+	// NodesNodesDecommissionHandler sets the operation handler for the nodes decommission operation
+	NodesNodesDecommissionHandler nodes.NodesDecommissionHandler
 	// NodesNodesGetHandler sets the operation handler for the nodes get operation
 	NodesNodesGetHandler nodes.NodesGetHandler
 	// ObjectsObjectsClassDeleteHandler sets the operation handler for the objects class delete operation
@@ -298,10 +447,14 @@ type WeaviateAPI struct {
 	ObjectsObjectsCreateHandler objects.ObjectsCreateHandler
 	// ObjectsObjectsDeleteHandler sets the operation handler for the objects delete operation
 	ObjectsObjectsDeleteHandler objects.ObjectsDeleteHandler
+	// ObjectsObjectsExportHandler sets the operation handler for the objects export operation
+	ObjectsObjectsExportHandler objects.ObjectsExportHandler
 	// ObjectsObjectsGetHandler sets the operation handler for the objects get operation
 	ObjectsObjectsGetHandler objects.ObjectsGetHandler
 	// ObjectsObjectsHeadHandler sets the operation handler for the objects head operation
 	ObjectsObjectsHeadHandler objects.ObjectsHeadHandler
+	// ObjectsObjectsImportHandler sets the operation handler for the objects import operation
+	ObjectsObjectsImportHandler objects.ObjectsImportHandler
 	// ObjectsObjectsListHandler sets the operation handler for the objects list operation
 	ObjectsObjectsListHandler objects.ObjectsListHandler
 	// ObjectsObjectsPatchHandler sets the operation handler for the objects patch operation
@@ -316,8 +469,28 @@ type WeaviateAPI struct {
 	ObjectsObjectsUpdateHandler objects.ObjectsUpdateHandler
 	// ObjectsObjectsValidateHandler sets the operation handler for the objects validate operation
 	ObjectsObjectsValidateHandler objects.ObjectsValidateHandler
+	// SchemaSchemaAliasesCreateHandler sets the operation handler for the schema aliases create operation
+	SchemaSchemaAliasesCreateHandler schema.SchemaAliasesCreateHandler
+	// SchemaSchemaAliasesDeleteHandler sets the operation handler for the schema aliases delete operation
+	SchemaSchemaAliasesDeleteHandler schema.SchemaAliasesDeleteHandler
+	// SchemaSchemaAliasesListHandler sets the operation handler for the schema aliases list operation
+	SchemaSchemaAliasesListHandler schema.SchemaAliasesListHandler
+	// SchemaSchemaAliasesUpdateHandler sets the operation handler for the schema aliases update operation
+	SchemaSchemaAliasesUpdateHandler schema.SchemaAliasesUpdateHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
 	SchemaSchemaDumpHandler schema.SchemaDumpHandler
+	// SchemaSchemaMigrationsAbortHandler sets the operation handler for the schema migrations abort operation
+	SchemaSchemaMigrationsAbortHandler schema.SchemaMigrationsAbortHandler
+	// SchemaSchemaMigrationsCreateHandler sets the operation handler for the schema migrations create operation
+	SchemaSchemaMigrationsCreateHandler schema.SchemaMigrationsCreateHandler
+	// SchemaSchemaMigrationsGetHandler sets the operation handler for the schema migrations get operation
+	SchemaSchemaMigrationsGetHandler schema.SchemaMigrationsGetHandler
+	// SchemaSchemaMigrationsListHandler sets the operation handler for the schema migrations list operation
+	SchemaSchemaMigrationsListHandler schema.SchemaMigrationsListHandler
+	// SchemaSchemaMigrationsPauseHandler sets the operation handler for the schema migrations pause operation
+	SchemaSchemaMigrationsPauseHandler schema.SchemaMigrationsPauseHandler
+	// SchemaSchemaMigrationsResumeHandler sets the operation handler for the schema migrations resume operation
+	SchemaSchemaMigrationsResumeHandler schema.SchemaMigrationsResumeHandler
 	// SchemaSchemaObjectsCreateHandler sets the operation handler for the schema objects create operation
 	SchemaSchemaObjectsCreateHandler schema.SchemaObjectsCreateHandler
 	// SchemaSchemaObjectsDeleteHandler sets the operation handler for the schema objects delete operation
@@ -407,6 +580,12 @@ func (o *WeaviateAPI) RegisterFormat(name string, format strfmt.Format, validato
 func (o *WeaviateAPI) Validate() error {
 	var unregistered []string
 
+	if o.ApplicationVndApacheParquetConsumer == nil {
+		unregistered = append(unregistered, "ApplicationVndApacheParquetConsumer")
+	}
+	if o.BinConsumer == nil {
+		unregistered = append(unregistered, "BinConsumer")
+	}
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
@@ -414,6 +593,9 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "YamlConsumer")
 	}
 
+	if o.ApplicationVndApacheParquetProducer == nil {
+		unregistered = append(unregistered, "ApplicationVndApacheParquetProducer")
+	}
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
@@ -425,6 +607,18 @@ func (o *WeaviateAPI) Validate() error {
 	if o.WellKnownGetWellKnownOpenidConfigurationHandler == nil {
 		unregistered = append(unregistered, "well_known.GetWellKnownOpenidConfigurationHandler")
 	}
+	if o.AuthzAuthzRolesCreateHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesCreateHandler")
+	}
+	if o.AuthzAuthzRolesDeleteHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesDeleteHandler")
+	}
+	if o.AuthzAuthzRolesGetHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesGetHandler")
+	}
+	if o.AuthzAuthzRolesListHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesListHandler")
+	}
 	if o.BackupsBackupsCreateHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsCreateHandler")
 	}
@@ -437,6 +631,18 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BackupsBackupsRestoreStatusHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsRestoreStatusHandler")
 	}
+	if o.BackupsBackupsSchedulesCreateHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesCreateHandler")
+	}
+	if o.BackupsBackupsSchedulesDeleteHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesDeleteHandler")
+	}
+	if o.BackupsBackupsSchedulesGetHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesGetHandler")
+	}
+	if o.BackupsBackupsSchedulesListHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesListHandler")
+	}
 	if o.BatchBatchObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsCreateHandler")
 	}
@@ -446,12 +652,39 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BatchBatchReferencesCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchReferencesCreateHandler")
 	}
+	if o.BatchBatchSearchHandler == nil {
+		unregistered = append(unregistered, "batch.BatchSearchHandler")
+	}
 	if o.ClassificationsClassificationsGetHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsGetHandler")
 	}
 	if o.ClassificationsClassificationsPostHandler == nil {
 		unregistered = append(unregistered, "classifications.ClassificationsPostHandler")
 	}
+	if o.ClusterClusterShardMovesCreateHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterShardMovesCreateHandler")
+	}
+	if o.ClusterClusterShardMovesGetHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterShardMovesGetHandler")
+	}
+	if o.ClusterClusterShardMovesListHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterShardMovesListHandler")
+	}
+	if o.ClusteringsClusteringsGetHandler == nil {
+		unregistered = append(unregistered, "clusterings.ClusteringsGetHandler")
+	}
+	if o.ClusteringsClusteringsPostHandler == nil {
+		unregistered = append(unregistered, "clusterings.ClusteringsPostHandler")
+	}
+	if o.DeduplicationsDeduplicationsGetHandler == nil {
+		unregistered = append(unregistered, "deduplications.DeduplicationsGetHandler")
+	}
+	if o.DeduplicationsDeduplicationsPostHandler == nil {
+		unregistered = append(unregistered, "deduplications.DeduplicationsPostHandler")
+	}
+	if o.DeduplicationsDeduplicationsResultGetHandler == nil {
+		unregistered = append(unregistered, "deduplications.DeduplicationsResultGetHandler")
+	}
 	if o.GraphqlGraphqlBatchHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlBatchHandler")
 	}
@@ -461,6 +694,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.MetaMetaGetHandler == nil {
 		unregistered = append(unregistered, "meta.MetaGetHandler")
 	}
+	if o.NodesNodesDecommissionHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesDecommissionHandler")
+	}
 	if o.NodesNodesGetHandler == nil {
 		unregistered = append(unregistered, "nodes.NodesGetHandler")
 	}
@@ -494,12 +730,18 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsDeleteHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsDeleteHandler")
 	}
+	if o.ObjectsObjectsExportHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsExportHandler")
+	}
 	if o.ObjectsObjectsGetHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsGetHandler")
 	}
 	if o.ObjectsObjectsHeadHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsHeadHandler")
 	}
+	if o.ObjectsObjectsImportHandler == nil {
+		unregistered = append(unregistered, "objects.ObjectsImportHandler")
+	}
 	if o.ObjectsObjectsListHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsListHandler")
 	}
@@ -521,9 +763,39 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsValidateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsValidateHandler")
 	}
+	if o.SchemaSchemaAliasesCreateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaAliasesCreateHandler")
+	}
+	if o.SchemaSchemaAliasesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaAliasesDeleteHandler")
+	}
+	if o.SchemaSchemaAliasesListHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaAliasesListHandler")
+	}
+	if o.SchemaSchemaAliasesUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaAliasesUpdateHandler")
+	}
 	if o.SchemaSchemaDumpHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaDumpHandler")
 	}
+	if o.SchemaSchemaMigrationsAbortHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaMigrationsAbortHandler")
+	}
+	if o.SchemaSchemaMigrationsCreateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaMigrationsCreateHandler")
+	}
+	if o.SchemaSchemaMigrationsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaMigrationsGetHandler")
+	}
+	if o.SchemaSchemaMigrationsListHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaMigrationsListHandler")
+	}
+	if o.SchemaSchemaMigrationsPauseHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaMigrationsPauseHandler")
+	}
+	if o.SchemaSchemaMigrationsResumeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaMigrationsResumeHandler")
+	}
 	if o.SchemaSchemaObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsCreateHandler")
 	}
@@ -593,8 +865,14 @@ func (o *WeaviateAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consu
 	result := make(map[string]runtime.Consumer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "application/vnd.apache.parquet":
+			result["application/vnd.apache.parquet"] = o.ApplicationVndApacheParquetConsumer
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinConsumer
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "application/x-ndjson":
+			result["application/x-ndjson"] = o.JSONConsumer
 		case "application/yaml":
 			result["application/yaml"] = o.YamlConsumer
 		}
@@ -612,8 +890,12 @@ func (o *WeaviateAPI) ProducersFor(mediaTypes []string) map[string]runtime.Produ
 	result := make(map[string]runtime.Producer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "application/vnd.apache.parquet":
+			result["application/vnd.apache.parquet"] = o.ApplicationVndApacheParquetProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "application/x-ndjson":
+			result["application/x-ndjson"] = o.JSONProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -661,6 +943,22 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/authz/roles"] = authz.NewAuthzRolesCreate(o.context, o.AuthzAuthzRolesCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/authz/roles/{name}"] = authz.NewAuthzRolesDelete(o.context, o.AuthzAuthzRolesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/roles/{name}"] = authz.NewAuthzRolesGet(o.context, o.AuthzAuthzRolesGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/roles"] = authz.NewAuthzRolesList(o.context, o.AuthzAuthzRolesListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/backups/{backend}"] = backups.NewBackupsCreate(o.context, o.BackupsBackupsCreateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -677,6 +975,22 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/backup-schedules"] = backups.NewBackupsSchedulesCreate(o.context, o.BackupsBackupsSchedulesCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/backup-schedules/{id}"] = backups.NewBackupsSchedulesDelete(o.context, o.BackupsBackupsSchedulesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backup-schedules/{id}"] = backups.NewBackupsSchedulesGet(o.context, o.BackupsBackupsSchedulesGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backup-schedules"] = backups.NewBackupsSchedulesList(o.context, o.BackupsBackupsSchedulesListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/batch/objects"] = batch.NewBatchObjectsCreate(o.context, o.BatchBatchObjectsCreateHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -686,6 +1000,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/batch/references"] = batch.NewBatchReferencesCreate(o.context, o.BatchBatchReferencesCreateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/batch/search"] = batch.NewBatchSearch(o.context, o.BatchBatchSearchHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -697,6 +1015,38 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/cluster/shard-moves"] = cluster.NewClusterShardMovesCreate(o.context, o.ClusterClusterShardMovesCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster/shard-moves/{id}"] = cluster.NewClusterShardMovesGet(o.context, o.ClusterClusterShardMovesGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster/shard-moves"] = cluster.NewClusterShardMovesList(o.context, o.ClusterClusterShardMovesListHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusterings/{id}"] = clusterings.NewClusteringsGet(o.context, o.ClusteringsClusteringsGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/clusterings"] = clusterings.NewClusteringsPost(o.context, o.ClusteringsClusteringsPostHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/deduplications/{id}"] = deduplications.NewDeduplicationsGet(o.context, o.DeduplicationsDeduplicationsGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/deduplications"] = deduplications.NewDeduplicationsPost(o.context, o.DeduplicationsDeduplicationsPostHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/deduplications/{id}/result"] = deduplications.NewDeduplicationsResultGet(o.context, o.DeduplicationsDeduplicationsResultGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/graphql/batch"] = graphql.NewGraphqlBatch(o.context, o.GraphqlGraphqlBatchHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -706,6 +1056,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/meta"] = meta.NewMetaGet(o.context, o.MetaMetaGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/nodes/{name}/decommission"] = nodes.NewNodesDecommission(o.context, o.NodesNodesDecommissionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -753,11 +1107,19 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/export/{className}"] = objects.NewObjectsExport(o.context, o.ObjectsObjectsExportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/objects/{id}"] = objects.NewObjectsGet(o.context, o.ObjectsObjectsGetHandler)
 	if o.handlers["HEAD"] == nil {
 		o.handlers["HEAD"] = make(map[string]http.Handler)
 	}
 	o.handlers["HEAD"]["/objects/{id}"] = objects.NewObjectsHead(o.context, o.ObjectsObjectsHeadHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/import/{className}"] = objects.NewObjectsImport(o.context, o.ObjectsObjectsImportHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -786,10 +1148,50 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/objects/validate"] = objects.NewObjectsValidate(o.context, o.ObjectsObjectsValidateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/aliases"] = schema.NewSchemaAliasesCreate(o.context, o.SchemaSchemaAliasesCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/aliases/{aliasName}"] = schema.NewSchemaAliasesDelete(o.context, o.SchemaSchemaAliasesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/aliases"] = schema.NewSchemaAliasesList(o.context, o.SchemaSchemaAliasesListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/aliases/{aliasName}"] = schema.NewSchemaAliasesUpdate(o.context, o.SchemaSchemaAliasesUpdateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/schema"] = schema.NewSchemaDump(o.context, o.SchemaSchemaDumpHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/migrations/{id}"] = schema.NewSchemaMigrationsAbort(o.context, o.SchemaSchemaMigrationsAbortHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/migrations"] = schema.NewSchemaMigrationsCreate(o.context, o.SchemaSchemaMigrationsCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/migrations/{id}"] = schema.NewSchemaMigrationsGet(o.context, o.SchemaSchemaMigrationsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/migrations"] = schema.NewSchemaMigrationsList(o.context, o.SchemaSchemaMigrationsListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/migrations/{id}/pause"] = schema.NewSchemaMigrationsPause(o.context, o.SchemaSchemaMigrationsPauseHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/migrations/{id}/resume"] = schema.NewSchemaMigrationsResume(o.context, o.SchemaSchemaMigrationsResumeHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -14,8 +14,13 @@ package state
 import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql"
+	"github.com/weaviate/weaviate/adapters/repos/backupschedules"
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
+	"github.com/weaviate/weaviate/adapters/repos/clusterings"
 	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/adapters/repos/deduplications"
+	"github.com/weaviate/weaviate/adapters/repos/roles"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
@@ -24,8 +29,11 @@ import (
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/locks"
+	"github.com/weaviate/weaviate/usecases/migrations"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/nodes"
+	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
@@ -49,12 +57,22 @@ type State struct {
 	SchemaManager         *schema.Manager
 	Scaler                *scaler.Scaler
 	Cluster               *cluster.State
+	SchemaRaft            *cluster.Raft
 	RemoteIndexIncoming   *sharding.RemoteIndexIncoming
 	RemoteNodeIncoming    *sharding.RemoteNodeIncoming
 	RemoteReplicaIncoming *replica.RemoteReplicaIncoming
 	Traverser             *traverser.Traverser
+	ObjectsManager        *objects.Manager
+	BatchObjectsManager   *objects.BatchManager
+	Auditor               *audit.Auditor
 
 	ClassificationRepo *classifications.DistributedRepo
+	ClusteringRepo     *clusterings.DistributedRepo
+	DeduplicationRepo  *deduplications.DistributedRepo
+	BackupScheduleRepo *backupschedules.DistributedRepo
+	RoleRepo           *roles.DistributedRepo
+	Decommissioner     *nodes.Decommissioner
+	Migrations         *migrations.Manager
 	Metrics            *monitoring.PrometheusMetrics
 	BackupManager      *backup.Manager
 	DB                 *db.DB
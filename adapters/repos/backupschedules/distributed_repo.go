@@ -0,0 +1,254 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backupschedules
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/backup"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const DefaultTxTTL = 60 * time.Second
+
+// DistributedRepo replicates every change of a backup schedule to all nodes
+// of the cluster using a cluster-wide transaction
+type DistributedRepo struct {
+	sync.RWMutex
+	txRemote     *cluster.TxManager
+	localRepo    localRepo
+	memberLister cluster.MemberLister
+	logger       logrus.FieldLogger
+}
+
+type localRepo interface {
+	Put(ctx context.Context, schedule models.BackupSchedule) error
+	Get(ctx context.Context, id string) (*models.BackupSchedule, error)
+	List(ctx context.Context) ([]*models.BackupSchedule, error)
+	Delete(ctx context.Context, id string) error
+}
+
+func NewDistributedRepo(remoteClient cluster.Client,
+	memberLister cluster.MemberLister, localRepo localRepo,
+	logger logrus.FieldLogger,
+) *DistributedRepo {
+	broadcaster := cluster.NewTxBroadcaster(memberLister, remoteClient)
+	txRemote := cluster.NewTxManager(broadcaster, logger)
+	repo := &DistributedRepo{
+		txRemote:     txRemote,
+		localRepo:    localRepo,
+		memberLister: memberLister,
+		logger:       logger,
+	}
+
+	repo.txRemote.SetCommitFn(repo.incomingCommit)
+	repo.txRemote.SetResponseFn(repo.incomingResponse)
+	broadcaster.SetConsensusFunction(readConsensus)
+
+	return repo
+}
+
+func (r *DistributedRepo) Get(ctx context.Context,
+	id string,
+) (*models.BackupSchedule, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.localRepo.Get(ctx, id)
+}
+
+func (r *DistributedRepo) List(ctx context.Context) ([]*models.BackupSchedule, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.localRepo.List(ctx)
+}
+
+func (r *DistributedRepo) Put(ctx context.Context,
+	schedule models.BackupSchedule,
+) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.broadcast(ctx, backup.TransactionPutSchedule,
+		backup.TransactionPutSchedulePayload{Schedule: schedule}); err != nil {
+		return err
+	}
+
+	return r.localRepo.Put(ctx, schedule)
+}
+
+func (r *DistributedRepo) Delete(ctx context.Context, id string) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.broadcast(ctx, backup.TransactionDeleteSchedule,
+		backup.TransactionDeleteSchedulePayload{ID: id}); err != nil {
+		return err
+	}
+
+	return r.localRepo.Delete(ctx, id)
+}
+
+func (r *DistributedRepo) broadcast(ctx context.Context,
+	txType cluster.TransactionType, payload interface{},
+) error {
+	tx, err := r.txRemote.BeginTransaction(ctx, txType, payload, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	err = r.txRemote.CommitWriteTransaction(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return nil
+}
+
+func (r *DistributedRepo) incomingCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	switch tx.Type {
+	case backup.TransactionPutSchedule:
+		return r.localRepo.Put(ctx, tx.Payload.(backup.TransactionPutSchedulePayload).
+			Schedule)
+	case backup.TransactionDeleteSchedule:
+		return r.localRepo.Delete(ctx, tx.Payload.(backup.TransactionDeleteSchedulePayload).
+			ID)
+	case backup.TransactionReadSchedules:
+		// read-only, nothing to commit
+		return nil
+	default:
+		return errors.Errorf("unrecognized tx type: %s", tx.Type)
+	}
+}
+
+func (r *DistributedRepo) incomingResponse(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	switch tx.Type {
+	case backup.TransactionReadSchedules:
+		schedules, err := r.localRepo.List(ctx)
+		if err != nil {
+			return errors.Wrap(err, "list local schedules")
+		}
+		tx.Payload = backup.TransactionReadSchedulesPayload{Schedules: schedules}
+		return nil
+	default:
+		// silently ignore. Not all types support responses
+		return nil
+	}
+}
+
+// StartupClusterSync copies the schedules of the other nodes to the local
+// node if it does not know about any schedules yet, which is the case for a
+// node that joins an existing cluster. Changes made after the node joined are
+// received through the regular put and delete transactions.
+func (r *DistributedRepo) StartupClusterSync(ctx context.Context) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if len(r.memberLister.AllNames()) <= 1 {
+		return nil
+	}
+
+	local, err := r.localRepo.List(ctx)
+	if err != nil {
+		return errors.Wrap(err, "list local schedules")
+	}
+	if len(local) > 0 {
+		return nil
+	}
+
+	tx, err := r.txRemote.BeginTransaction(ctx, backup.TransactionReadSchedules,
+		backup.TransactionReadSchedulesPayload{}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "read schedules: open transaction")
+	}
+
+	// this tx is read-only, so we don't have to worry about aborting it, the
+	// close should be the same on both happy and unhappy path
+	defer r.txRemote.CloseReadTransaction(ctx, tx)
+
+	pl, ok := tx.Payload.(backup.TransactionReadSchedulesPayload)
+	if !ok {
+		return errors.Errorf("unrecognized tx response payload: %T", tx.Payload)
+	}
+
+	for _, schedule := range pl.Schedules {
+		if err := r.localRepo.Put(ctx, *schedule); err != nil {
+			return errors.Wrapf(err, "put schedule %q", schedule.ID)
+		}
+	}
+
+	r.logger.WithField("action", "backup_schedules_startup_sync").
+		WithField("count", len(pl.Schedules)).
+		Debug("copied backup schedules from cluster")
+
+	return nil
+}
+
+// readConsensus merges the schedules returned by all nodes. A put or delete
+// which failed on some of the nodes can leave them with different versions
+// of the same schedule, in which case the one which ran most recently wins.
+func readConsensus(ctx context.Context,
+	in []*cluster.Transaction,
+) (*cluster.Transaction, error) {
+	if len(in) == 0 || in[0].Type != backup.TransactionReadSchedules {
+		return nil, nil
+	}
+
+	byID := map[string]*models.BackupSchedule{}
+	var ids []string
+	for _, tx := range in {
+		raw, ok := tx.Payload.(json.RawMessage)
+		if !ok {
+			return nil, errors.Errorf("unrecognized tx response payload: %T", tx.Payload)
+		}
+		typed, err := backup.UnmarshalScheduleTransaction(tx.Type, raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshal tx")
+		}
+
+		for _, schedule := range typed.(backup.TransactionReadSchedulesPayload).Schedules {
+			prev, ok := byID[schedule.ID]
+			if !ok {
+				ids = append(ids, schedule.ID)
+			}
+			if !ok || schedule.LastRunTimeUnix > prev.LastRunTimeUnix {
+				byID[schedule.ID] = schedule
+			}
+		}
+	}
+
+	merged := make([]*models.BackupSchedule, len(ids))
+	for i, id := range ids {
+		merged[i] = byID[id]
+	}
+
+	consensus := *in[0]
+	consensus.Payload = backup.TransactionReadSchedulesPayload{Schedules: merged}
+	return &consensus, nil
+}
+
+func (r *DistributedRepo) TxManager() *cluster.TxManager {
+	return r.txRemote
+}
+
+var _ = backup.ScheduleRepo(&DistributedRepo{})
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backupschedules
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/backup"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+func TestDistributedRepo_StartupClusterSync(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	t.Run("joining node copies the schedules of the cluster", func(t *testing.T) {
+		client := &fakeTxClient{responses: map[string][]*models.BackupSchedule{
+			"node2:8301": {
+				{ID: "a", Cron: "0 * * * *", LastRunTimeUnix: 10},
+				{ID: "b", Cron: "0 0 * * *", LastRunTimeUnix: 20},
+			},
+			"node3:8301": {
+				{ID: "a", Cron: "0 * * * *", LastRunTimeUnix: 30},
+			},
+		}}
+		local := newFakeLocalRepo()
+		repo := NewDistributedRepo(client, &fakeMembers{
+			names: []string{"node1", "node2", "node3"},
+			hosts: []string{"node2:8301", "node3:8301"},
+		}, local, logger)
+
+		require.Nil(t, repo.StartupClusterSync(ctx))
+
+		res, err := repo.List(ctx)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+		assert.Equal(t, "a", res[0].ID)
+		assert.Equal(t, int64(30), res[0].LastRunTimeUnix)
+		assert.Equal(t, "b", res[1].ID)
+		assert.Equal(t, 2, client.commits, "read tx is closed on all nodes")
+	})
+
+	t.Run("node with schedules keeps its own state", func(t *testing.T) {
+		client := &fakeTxClient{responses: map[string][]*models.BackupSchedule{
+			"node2:8301": {{ID: "a"}, {ID: "b"}},
+		}}
+		local := newFakeLocalRepo()
+		require.Nil(t, local.Put(ctx, models.BackupSchedule{ID: "a"}))
+		repo := NewDistributedRepo(client, &fakeMembers{
+			names: []string{"node1", "node2"},
+			hosts: []string{"node2:8301"},
+		}, local, logger)
+
+		require.Nil(t, repo.StartupClusterSync(ctx))
+
+		res, err := repo.List(ctx)
+		require.Nil(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, 0, client.opens)
+	})
+
+	t.Run("single node cluster", func(t *testing.T) {
+		client := &fakeTxClient{}
+		repo := NewDistributedRepo(client, &fakeMembers{
+			names: []string{"node1"},
+		}, newFakeLocalRepo(), logger)
+
+		require.Nil(t, repo.StartupClusterSync(ctx))
+		assert.Equal(t, 0, client.opens)
+	})
+}
+
+type fakeMembers struct {
+	names []string
+	hosts []string
+}
+
+func (f *fakeMembers) AllNames() []string {
+	return f.names
+}
+
+func (f *fakeMembers) Hostnames() []string {
+	return f.hosts
+}
+
+type fakeTxClient struct {
+	sync.Mutex
+	responses map[string][]*models.BackupSchedule
+	opens     int
+	commits   int
+}
+
+func (f *fakeTxClient) OpenTransaction(ctx context.Context, host string,
+	tx *cluster.Transaction,
+) error {
+	f.Lock()
+	f.opens++
+	f.Unlock()
+	if tx.Type != backup.TransactionReadSchedules {
+		return nil
+	}
+
+	raw, err := json.Marshal(backup.TransactionReadSchedulesPayload{
+		Schedules: f.responses[host],
+	})
+	if err != nil {
+		return err
+	}
+	tx.Payload = json.RawMessage(raw)
+	return nil
+}
+
+func (f *fakeTxClient) AbortTransaction(ctx context.Context, host string,
+	tx *cluster.Transaction,
+) error {
+	return nil
+}
+
+func (f *fakeTxClient) CommitTransaction(ctx context.Context, host string,
+	tx *cluster.Transaction,
+) error {
+	f.Lock()
+	f.commits++
+	f.Unlock()
+	return nil
+}
+
+type fakeLocalRepo struct {
+	schedules map[string]models.BackupSchedule
+}
+
+func newFakeLocalRepo() *fakeLocalRepo {
+	return &fakeLocalRepo{schedules: map[string]models.BackupSchedule{}}
+}
+
+func (f *fakeLocalRepo) Put(ctx context.Context, schedule models.BackupSchedule) error {
+	f.schedules[schedule.ID] = schedule
+	return nil
+}
+
+func (f *fakeLocalRepo) Get(ctx context.Context, id string) (*models.BackupSchedule, error) {
+	s, ok := f.schedules[id]
+	if !ok {
+		return nil, nil
+	}
+	return &s, nil
+}
+
+func (f *fakeLocalRepo) List(ctx context.Context) ([]*models.BackupSchedule, error) {
+	out := make([]*models.BackupSchedule, 0, len(f.schedules))
+	for _, s := range f.schedules {
+		s := s
+		out = append(out, &s)
+	}
+	return out, nil
+}
+
+func (f *fakeLocalRepo) Delete(ctx context.Context, id string) error {
+	delete(f.schedules, id)
+	return nil
+}
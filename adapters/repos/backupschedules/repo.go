@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backupschedules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	bolt "go.etcd.io/bbolt"
+)
+
+var schedulesBucket = []byte("backup_schedules")
+
+// Repo stores backup schedules of the local node
+type Repo struct {
+	logger  logrus.FieldLogger
+	baseDir string
+	db      *bolt.DB
+}
+
+func NewRepo(baseDir string, logger logrus.FieldLogger) (*Repo, error) {
+	r := &Repo{
+		baseDir: baseDir,
+		logger:  logger,
+	}
+
+	err := r.init()
+	return r, err
+}
+
+func (r *Repo) DBPath() string {
+	return fmt.Sprintf("%s/backup_schedules.db", r.baseDir)
+}
+
+func (r *Repo) init() error {
+	if err := os.MkdirAll(r.baseDir, 0o777); err != nil {
+		return errors.Wrapf(err, "create root path directory at %s", r.baseDir)
+	}
+
+	boltdb, err := bolt.Open(r.DBPath(), 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open bolt at %s", r.DBPath())
+	}
+
+	err = boltdb.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(schedulesBucket); err != nil {
+			return errors.Wrapf(err, "create backup schedules bucket '%s'",
+				string(schedulesBucket))
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "create bolt buckets")
+	}
+
+	r.db = boltdb
+
+	return nil
+}
+
+func (r *Repo) Put(ctx context.Context, schedule models.BackupSchedule) error {
+	scheduleJSON, err := json.Marshal(schedule)
+	if err != nil {
+		return errors.Wrap(err, "marshal backup schedule to JSON")
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(schedulesBucket)
+		return b.Put([]byte(schedule.ID), scheduleJSON)
+	})
+}
+
+func (r *Repo) Get(ctx context.Context, id string) (*models.BackupSchedule, error) {
+	var scheduleJSON []byte
+	r.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(schedulesBucket)
+		if v := b.Get([]byte(id)); v != nil {
+			scheduleJSON = make([]byte, len(v))
+			copy(scheduleJSON, v)
+		}
+		return nil
+	})
+
+	if len(scheduleJSON) == 0 {
+		return nil, nil
+	}
+
+	var s models.BackupSchedule
+	if err := json.Unmarshal(scheduleJSON, &s); err != nil {
+		return nil, errors.Wrapf(err, "parse backup schedule from JSON")
+	}
+
+	return &s, nil
+}
+
+func (r *Repo) List(ctx context.Context) ([]*models.BackupSchedule, error) {
+	var schedules []*models.BackupSchedule
+	err := r.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(schedulesBucket)
+		return b.ForEach(func(k, v []byte) error {
+			var s models.BackupSchedule
+			if err := json.Unmarshal(v, &s); err != nil {
+				return errors.Wrapf(err, "parse backup schedule %q from JSON", k)
+			}
+			schedules = append(schedules, &s)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+func (r *Repo) Delete(ctx context.Context, id string) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(schedulesBucket)
+		return b.Delete([]byte(id))
+	})
+}
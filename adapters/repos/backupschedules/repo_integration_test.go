@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package backupschedules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_BackupSchedulesRepo(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+
+	r, err := NewRepo(dirName, logger)
+	require.Nil(t, err)
+
+	t.Run("asking for a non-existing schedule", func(t *testing.T) {
+		res, err := r.Get(context.Background(), "wrong-id")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("storing schedules", func(t *testing.T) {
+		require.Nil(t, r.Put(context.Background(), exampleOne()))
+		require.Nil(t, r.Put(context.Background(), exampleTwo()))
+	})
+
+	t.Run("retrieving stored schedules", func(t *testing.T) {
+		expectedOne := exampleOne()
+		expectedTwo := exampleTwo()
+
+		res, err := r.Get(context.Background(), expectedOne.ID)
+		require.Nil(t, err)
+		assert.Equal(t, &expectedOne, res)
+
+		all, err := r.List(context.Background())
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []*models.BackupSchedule{&expectedOne, &expectedTwo}, all)
+	})
+
+	t.Run("deleting a schedule", func(t *testing.T) {
+		require.Nil(t, r.Delete(context.Background(), exampleOne().ID))
+
+		res, err := r.Get(context.Background(), exampleOne().ID)
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
+		all, err := r.List(context.Background())
+		require.Nil(t, err)
+		assert.Len(t, all, 1)
+	})
+}
+
+func exampleOne() models.BackupSchedule {
+	return models.BackupSchedule{
+		ID:        "nightly",
+		Backend:   "filesystem",
+		Cron:      "0 2 * * *",
+		Retention: &models.BackupScheduleRetention{KeepLast: 7},
+	}
+}
+
+func exampleTwo() models.BackupSchedule {
+	return models.BackupSchedule{
+		ID:      "hourly",
+		Backend: "s3",
+		Cron:    "@hourly",
+		Include: []string{"Article"},
+		Backups: []*models.BackupScheduleBackup{
+			{ID: "hourly-20230501120000", Status: "SUCCESS", StartTimeUnix: 1682942400000},
+		},
+	}
+}
@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/clustering"
+	bolt "go.etcd.io/bbolt"
+)
+
+var clusteringsBucket = []byte("clusterings")
+
+type Repo struct {
+	logger  logrus.FieldLogger
+	baseDir string
+	db      *bolt.DB
+}
+
+func NewRepo(baseDir string, logger logrus.FieldLogger) (*Repo, error) {
+	r := &Repo{
+		baseDir: baseDir,
+		logger:  logger,
+	}
+
+	err := r.init()
+	return r, err
+}
+
+func (r *Repo) DBPath() string {
+	return fmt.Sprintf("%s/clusterings.db", r.baseDir)
+}
+
+func (r *Repo) keyFromID(id strfmt.UUID) []byte {
+	return []byte(id)
+}
+
+func (r *Repo) init() error {
+	if err := os.MkdirAll(r.baseDir, 0o777); err != nil {
+		return errors.Wrapf(err, "create root path directory at %s", r.baseDir)
+	}
+
+	boltdb, err := bolt.Open(r.DBPath(), 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open bolt at %s", r.DBPath())
+	}
+
+	err = boltdb.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(clusteringsBucket); err != nil {
+			return errors.Wrapf(err, "create clusterings bucket '%s'",
+				string(helpers.ObjectsBucket))
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "create bolt buckets")
+	}
+
+	r.db = boltdb
+
+	return nil
+}
+
+func (r *Repo) Put(ctx context.Context, clustering models.Clustering) error {
+	clusteringJSON, err := json.Marshal(clustering)
+	if err != nil {
+		return errors.Wrap(err, "marshal clustering to JSON")
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(clusteringsBucket)
+		return b.Put(r.keyFromID(clustering.ID), clusteringJSON)
+	})
+}
+
+func (r *Repo) Get(ctx context.Context, id strfmt.UUID) (*models.Clustering, error) {
+	var clusteringJSON []byte
+	r.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(clusteringsBucket)
+		clusteringJSON = b.Get(r.keyFromID(id))
+		return nil
+	})
+
+	if len(clusteringJSON) == 0 {
+		return nil, nil
+	}
+
+	var c models.Clustering
+	err := json.Unmarshal(clusteringJSON, &c)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse clustering from JSON")
+	}
+
+	return &c, nil
+}
+
+var _ = clustering.Repo(&Repo{})
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package clusterings
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_ClusteringsRepo(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+
+	r, err := NewRepo(dirName, logger)
+	require.Nil(t, err)
+	_ = r
+
+	t.Run("asking for a non-existing clustering", func(t *testing.T) {
+		res, err := r.Get(context.Background(), "wrong-id")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("storing clusterings", func(t *testing.T) {
+		err := r.Put(context.Background(), exampleOne())
+		require.Nil(t, err)
+
+		err = r.Put(context.Background(), exampleTwo())
+		require.Nil(t, err)
+	})
+
+	t.Run("retrieving stored clusterings", func(t *testing.T) {
+		expectedOne := exampleOne()
+		expectedTwo := exampleTwo()
+
+		res, err := r.Get(context.Background(), expectedOne.ID)
+		require.Nil(t, err)
+		assert.Equal(t, &expectedOne, res)
+
+		res, err = r.Get(context.Background(), expectedTwo.ID)
+		require.Nil(t, err)
+		assert.Equal(t, &expectedTwo, res)
+	})
+}
+
+func exampleOne() models.Clustering {
+	return models.Clustering{
+		ID:    "01ed111a-919c-4dd5-ab9e-7b247b11e18c",
+		Class: "ExampleClassOne",
+		K:     2,
+	}
+}
+
+func exampleTwo() models.Clustering {
+	return models.Clustering{
+		ID:    "4fbaebf3-41a9-414b-ac1d-433d74d4ef2c",
+		Class: "ExampleClassTwo",
+		K:     4,
+	}
+}
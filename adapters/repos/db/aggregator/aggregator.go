@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 )
@@ -30,6 +31,10 @@ type vectorIndex interface {
 	SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64,
 		allowList helpers.AllowList) ([]uint64, []float32, error)
 	SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error)
+	SearchByBestScoreDistance(positives, negatives [][]float32, targetDistance float32,
+		maxLimit int64, allowList helpers.AllowList) ([]uint64, []float32, error)
+	SearchByBestScore(positives, negatives [][]float32, k int,
+		allowList helpers.AllowList) ([]uint64, []float32, error)
 }
 
 type Aggregator struct {
@@ -79,6 +84,12 @@ func (a *Aggregator) Do(ctx context.Context) (*aggregation.Result, error) {
 	return newUnfilteredAggregator(a).Do(ctx)
 }
 
+// class returns the aggregated class, nil if it is not in the schema
+func (a *Aggregator) class() *models.Class {
+	s := a.getSchema.GetSchemaSkipAuth()
+	return s.GetClass(a.params.ClassName)
+}
+
 func (a *Aggregator) aggTypeOfProperty(
 	name schema.PropertyName,
 ) (aggregation.PropertyType, schema.DataType, error) {
@@ -99,7 +110,8 @@ func (a *Aggregator) aggTypeOfProperty(
 		return aggregation.PropertyTypeNumerical, dt, nil
 	case schema.DataTypeBoolean, schema.DataTypeBooleanArray:
 		return aggregation.PropertyTypeBoolean, dt, nil
-	case schema.DataTypeText, schema.DataTypeTextArray:
+	case schema.DataTypeText, schema.DataTypeTextArray, schema.DataTypeUUID,
+		schema.DataTypeUUIDArray:
 		return aggregation.PropertyTypeText, dt, nil
 	case schema.DataTypeDate, schema.DataTypeDateArray:
 		return aggregation.PropertyTypeDate, dt, nil
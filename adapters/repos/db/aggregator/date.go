@@ -29,6 +29,7 @@ func addDateAggregations(prop *aggregation.Property,
 		prop.DateAggregations = map[string]interface{}{}
 	}
 	agg.buildPairsFromCounts()
+	agg.sketches.addTo(prop)
 
 	// if there are no elements to aggregate over because a filter does not match anything, calculating median etc. makes
 	// no sense. Non-existent entries evaluate to nil with an interface{} map
@@ -78,6 +79,8 @@ type dateAggregator struct {
 	mode         timestamp
 	pairs        []timestampCountPair // for row-based median calculation
 	valueCounter map[timestamp]uint64 // for individual median calculation
+	keepValues   bool                 // whether to fill the valueCounter
+	sketches     sketches
 }
 
 func newDateAggregator() *dateAggregator {
@@ -85,6 +88,22 @@ func newDateAggregator() *dateAggregator {
 		min:          timestamp{epochNano: math.MaxInt64},
 		valueCounter: map[timestamp]uint64{},
 		pairs:        make([]timestampCountPair, 0),
+		keepValues:   true,
+	}
+}
+
+// setAggregators prepares the sketches and stops holding on to every value,
+// unless one of the aggregators requires them
+func (a *dateAggregator) setAggregators(aggs []aggregation.Aggregator,
+	percentiles []float64,
+) {
+	a.sketches = newSketches(aggs, percentiles)
+	a.keepValues = false
+	for _, agg := range aggs {
+		switch agg {
+		case aggregation.ModeAggregator, aggregation.MedianAggregator:
+			a.keepValues = true
+		}
 	}
 }
 
@@ -145,6 +164,11 @@ func (a *dateAggregator) addRow(ts timestamp, count uint64) error {
 	if ts.epochNano > a.max.epochNano {
 		a.max = ts
 	}
+	a.sketches.addFloat64(float64(ts.epochNano), count)
+
+	if !a.keepValues {
+		return nil
+	}
 
 	currentCount := a.valueCounter[ts]
 	currentCount += count
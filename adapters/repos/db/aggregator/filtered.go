@@ -255,11 +255,11 @@ func (fa *filteredAggregator) addPropValue(prop propAgg, value interface{}) erro
 			return nil
 		}
 		switch prop.dataType {
-		case schema.DataTypeText:
+		case schema.DataTypeText, schema.DataTypeUUID:
 			if err := analyzeString(value); err != nil {
 				return err
 			}
-		case schema.DataTypeTextArray:
+		case schema.DataTypeTextArray, schema.DataTypeUUIDArray:
 			valueStruct, ok := value.([]interface{})
 			if !ok {
 				return fmt.Errorf("expected property type []text or []string, received %T", valueStruct)
@@ -349,6 +349,10 @@ func (fa *filteredAggregator) prepareResult(ctx context.Context, foundIDs []uint
 		return nil, errors.Wrap(err, "aggregate properties")
 	}
 
+	if err := fa.addHistograms(ctx, props, fa.scanIDs(foundIDs)); err != nil {
+		return nil, errors.Wrap(err, "aggregate histograms")
+	}
+
 	out.Groups[0].Properties = props
 	return &out, nil
 }
@@ -360,6 +364,9 @@ type propAgg struct {
 	// the user is interested in those specific aggregations
 	specifiedAggregators []aggregation.Aggregator
 
+	// percentiles to approximate if requested
+	percentiles []float64
+
 	// underlying data type of prop
 	dataType schema.DataType
 
@@ -381,12 +388,15 @@ func (pa *propAgg) initAggregator() {
 	case aggregation.PropertyTypeText:
 		limit := extractLimitFromTopOccs(pa.specifiedAggregators)
 		pa.textAgg = newTextAggregator(limit)
+		pa.textAgg.sketches = newSketches(pa.specifiedAggregators, pa.percentiles)
 	case aggregation.PropertyTypeBoolean:
 		pa.boolAgg = newBoolAggregator()
 	case aggregation.PropertyTypeNumerical:
 		pa.numericalAgg = newNumericalAggregator()
+		pa.numericalAgg.setAggregators(pa.specifiedAggregators, pa.percentiles)
 	case aggregation.PropertyTypeDate:
 		pa.dateAgg = newDateAggregator()
+		pa.dateAgg.setAggregators(pa.specifiedAggregators, pa.percentiles)
 	case aggregation.PropertyTypeReference:
 		pa.refAgg = newRefAggregator()
 	default:
@@ -408,6 +418,7 @@ func (pa propAggs) results() (map[string]aggregation.Property, error) {
 			out[prop.name.String()] = aggProp
 		case aggregation.PropertyTypeText:
 			aggProp.TextAggregation = prop.textAgg.Res()
+			prop.textAgg.sketches.addTo(&aggProp)
 			out[prop.name.String()] = aggProp
 		case aggregation.PropertyTypeNumerical:
 			addNumericalAggregations(&aggProp, prop.specifiedAggregators,
@@ -436,6 +447,7 @@ func (fa *filteredAggregator) prepareAggregatorsForProps() (propAggs, error) {
 		pa := propAgg{
 			name:                 prop.Name,
 			specifiedAggregators: prop.Aggregators,
+			percentiles:          prop.Percentiles,
 		}
 
 		at, dt, err := fa.aggTypeOfProperty(prop.Name)
@@ -78,6 +78,10 @@ func (ga *groupedAggregator) aggregateGroup(ctx context.Context,
 		return out, errors.Wrap(err, "aggregate properties")
 	}
 
+	if err := fa.addHistograms(ctx, props, fa.scanIDs(ids)); err != nil {
+		return out, errors.Wrap(err, "aggregate histograms")
+	}
+
 	out.Properties = props
 	return out, nil
 }
@@ -0,0 +1,289 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/docid"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// maxHistogramBuckets protects against intervals which are far too small for
+// the value range of a property
+const maxHistogramBuckets = 10000
+
+// objectScan calls scanFn for every object in the scope of an aggregation
+type objectScan func(scanFn docid.ObjectScanFn, properties []string) error
+
+func (a *Aggregator) scanIDs(ids []uint64) objectScan {
+	return func(scanFn docid.ObjectScanFn, properties []string) error {
+		return docid.ScanObjectsLSM(a.store, ids, scanFn, properties)
+	}
+}
+
+func (a *Aggregator) scanAll() objectScan {
+	return func(scanFn docid.ObjectScanFn, _ []string) error {
+		return ScanAllLSM(a.store, a.class(), scanFn)
+	}
+}
+
+// addHistograms adds the buckets of every requested histogram to props
+func (a *Aggregator) addHistograms(ctx context.Context,
+	props map[string]aggregation.Property, scan objectScan,
+) error {
+	for _, prop := range a.params.Properties {
+		if prop.Histogram == nil {
+			continue
+		}
+
+		aggType, dt, err := a.aggTypeOfProperty(prop.Name)
+		if err != nil {
+			return err
+		}
+
+		h, err := newHistogrammer(prop.Name, dt, *prop.Histogram)
+		if err != nil {
+			return errors.Wrapf(err, "histogram %s", prop.Name)
+		}
+
+		if err := scan(h.add, []string{prop.Name.String()}); err != nil {
+			return errors.Wrapf(err, "histogram %s", prop.Name)
+		}
+
+		buckets, err := h.aggregate(ctx, a)
+		if err != nil {
+			return errors.Wrapf(err, "histogram %s", prop.Name)
+		}
+
+		res := props[prop.Name.String()]
+		res.Type = aggType
+		res.Buckets = buckets
+		props[prop.Name.String()] = res
+	}
+
+	return nil
+}
+
+// histogrammer assigns objects to the buckets of a single histogram. Similar
+// to the grouper it keeps the docIDs of each bucket, so the sub-aggregations
+// can be performed for each bucket in a second step.
+type histogrammer struct {
+	prop      schema.PropertyName
+	dataType  schema.DataType
+	histogram aggregation.Histogram
+	location  *time.Location
+	values    map[interface{}]map[uint64]struct{}
+}
+
+func newHistogrammer(prop schema.PropertyName, dataType schema.DataType,
+	histogram aggregation.Histogram,
+) (*histogrammer, error) {
+	h := &histogrammer{
+		prop:      prop,
+		dataType:  dataType,
+		histogram: histogram,
+		values:    map[interface{}]map[uint64]struct{}{},
+	}
+
+	switch dataType {
+	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeIntArray,
+		schema.DataTypeNumberArray:
+		if histogram.DateInterval != "" {
+			return nil, fmt.Errorf("date interval on %s property", dataType)
+		}
+		if !(histogram.Interval > 0) {
+			return nil, fmt.Errorf("interval must be positive, got %v", histogram.Interval)
+		}
+	case schema.DataTypeDate, schema.DataTypeDateArray:
+		if _, err := aggregation.ParseDateInterval(string(histogram.DateInterval)); err != nil {
+			return nil, err
+		}
+		loc, err := time.LoadLocation(histogram.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("timezone: %w", err)
+		}
+		h.location = loc
+	default:
+		return nil, fmt.Errorf("dataType %s can't be bucketed", dataType)
+	}
+
+	return h, nil
+}
+
+func (h *histogrammer) add(props *models.PropertySchema, docID uint64) (bool, error) {
+	if props == nil {
+		return true, nil
+	}
+
+	propMap, ok := (*props).(map[string]interface{})
+	if !ok {
+		return true, nil
+	}
+
+	switch typed := propMap[h.prop.String()].(type) {
+	case nil:
+		return true, nil
+	case []interface{}:
+		for _, value := range typed {
+			if err := h.addValue(value, docID); err != nil {
+				return false, err
+			}
+		}
+	case []float64:
+		for _, value := range typed {
+			if err := h.addValue(value, docID); err != nil {
+				return false, err
+			}
+		}
+	case []string:
+		for _, value := range typed {
+			if err := h.addValue(value, docID); err != nil {
+				return false, err
+			}
+		}
+	default:
+		if err := h.addValue(typed, docID); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (h *histogrammer) addValue(value interface{}, docID uint64) error {
+	key, err := h.key(value)
+	if err != nil {
+		return err
+	}
+
+	ids, ok := h.values[key]
+	if !ok {
+		if len(h.values) >= maxHistogramBuckets {
+			return fmt.Errorf("more than %d buckets, choose a larger interval",
+				maxHistogramBuckets)
+		}
+		ids = map[uint64]struct{}{}
+		h.values[key] = ids
+	}
+	ids[docID] = struct{}{}
+
+	return nil
+}
+
+// key returns the lower bound of the bucket value falls into
+func (h *histogrammer) key(value interface{}) (interface{}, error) {
+	if h.location == nil {
+		asFloat, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("expected property type float64, received %T", value)
+		}
+		return math.Floor(asFloat/h.histogram.Interval) * h.histogram.Interval, nil
+	}
+
+	asString, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected property type date, received %T", value)
+	}
+	t, err := time.Parse(time.RFC3339Nano, asString)
+	if err != nil {
+		return nil, fmt.Errorf("parse date %q: %w", asString, err)
+	}
+
+	return dateBucketStart(t, h.histogram.DateInterval, h.location).
+		Format(time.RFC3339), nil
+}
+
+// aggregate turns the collected values into buckets and performs the
+// sub-aggregations for each of them
+func (h *histogrammer) aggregate(ctx context.Context,
+	a *Aggregator,
+) ([]aggregation.Group, error) {
+	sub := *a
+	sub.params.Properties = h.histogram.Properties
+
+	buckets := make([]aggregation.Group, 0, len(h.values))
+	for key, idMap := range h.values {
+		ids := make([]uint64, 0, len(idMap))
+		for id := range idMap {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+
+		bucket := aggregation.Group{
+			GroupedBy: &aggregation.GroupedBy{
+				Value: key,
+				Path:  []string{h.prop.String()},
+			},
+			Count: len(ids),
+		}
+
+		if len(sub.params.Properties) > 0 {
+			props, err := newFilteredAggregator(&sub).properties(ctx, ids)
+			if err != nil {
+				return nil, errors.Wrapf(err, "bucket %v", key)
+			}
+			if err := sub.addHistograms(ctx, props, sub.scanIDs(ids)); err != nil {
+				return nil, errors.Wrapf(err, "bucket %v", key)
+			}
+			bucket.Properties = props
+		}
+
+		buckets = append(buckets, bucket)
+	}
+
+	sortBuckets(buckets)
+	return buckets, nil
+}
+
+// dateBucketStart returns the start of the day, week (starting on Monday) or
+// month t falls into in the given location
+func dateBucketStart(t time.Time, interval aggregation.DateInterval,
+	loc *time.Location,
+) time.Time {
+	t = t.In(loc)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+
+	switch interval {
+	case aggregation.DateIntervalWeek:
+		sinceMonday := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -sinceMonday)
+	case aggregation.DateIntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	default:
+		return day
+	}
+}
+
+// sortBuckets orders buckets by their lower bound. Date bounds are formatted
+// in the same timezone, so they can be compared as strings.
+func sortBuckets(buckets []aggregation.Group) {
+	sort.Slice(buckets, func(a, b int) bool {
+		switch left := buckets[a].GroupedBy.Value.(type) {
+		case float64:
+			right, _ := buckets[b].GroupedBy.Value.(float64)
+			return left < right
+		case string:
+			right, _ := buckets[b].GroupedBy.Value.(string)
+			return left < right
+		default:
+			return false
+		}
+	})
+}
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestHistogrammerNumerical(t *testing.T) {
+	h, err := newHistogrammer("price", schema.DataTypeNumberArray,
+		aggregation.Histogram{Interval: 10})
+	require.Nil(t, err)
+
+	objects := []interface{}{
+		7.0,
+		[]interface{}{12.5, 19.0},
+		-0.5,
+		nil,
+		10.0,
+	}
+	for i, value := range objects {
+		var props models.PropertySchema = map[string]interface{}{"price": value}
+		_, err := h.add(&props, uint64(i))
+		require.Nil(t, err)
+	}
+
+	buckets, err := h.aggregate(context.Background(), &Aggregator{})
+	require.Nil(t, err)
+
+	keys := make([]interface{}, len(buckets))
+	counts := make([]int, len(buckets))
+	for i, b := range buckets {
+		keys[i] = b.GroupedBy.Value
+		counts[i] = b.Count
+	}
+	assert.Equal(t, []interface{}{-10.0, 0.0, 10.0}, keys)
+	// the object with two values in the same bucket is counted once
+	assert.Equal(t, []int{1, 1, 2}, counts)
+}
+
+func TestHistogrammerDates(t *testing.T) {
+	tests := []struct {
+		interval aggregation.DateInterval
+		timezone string
+		date     string
+		expected string
+	}{
+		{aggregation.DateIntervalDay, "", "2023-03-15T17:30:12Z", "2023-03-15T00:00:00Z"},
+		{aggregation.DateIntervalDay, "Europe/Berlin", "2023-03-15T23:30:00Z", "2023-03-16T00:00:00+01:00"},
+		{aggregation.DateIntervalWeek, "", "2023-03-15T17:30:12Z", "2023-03-13T00:00:00Z"},
+		{aggregation.DateIntervalWeek, "", "2023-03-19T23:59:59Z", "2023-03-13T00:00:00Z"},
+		{aggregation.DateIntervalMonth, "", "2023-03-31T17:30:12.123Z", "2023-03-01T00:00:00Z"},
+		{aggregation.DateIntervalMonth, "America/New_York", "2023-04-01T02:00:00Z", "2023-03-01T00:00:00-05:00"},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.interval)+" "+test.date, func(t *testing.T) {
+			h, err := newHistogrammer("published", schema.DataTypeDate,
+				aggregation.Histogram{DateInterval: test.interval, Timezone: test.timezone})
+			require.Nil(t, err)
+
+			key, err := h.key(test.date)
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, key)
+		})
+	}
+}
+
+func TestHistogrammerValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		dataType  schema.DataType
+		histogram aggregation.Histogram
+	}{
+		{"zero interval", schema.DataTypeInt, aggregation.Histogram{}},
+		{"negative interval", schema.DataTypeNumber, aggregation.Histogram{Interval: -1}},
+		{"date interval on number", schema.DataTypeNumber, aggregation.Histogram{Interval: 1, DateInterval: "day"}},
+		{"missing date interval", schema.DataTypeDate, aggregation.Histogram{}},
+		{"unknown date interval", schema.DataTypeDate, aggregation.Histogram{DateInterval: "year"}},
+		{"unknown timezone", schema.DataTypeDate, aggregation.Histogram{DateInterval: "day", Timezone: "Mars/Olympus"}},
+		{"text", schema.DataTypeText, aggregation.Histogram{Interval: 1}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := newHistogrammer("prop", test.dataType, test.histogram)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestShardCombinerMergeBuckets(t *testing.T) {
+	bucket := func(key float64, count int, sum float64) aggregation.Group {
+		return aggregation.Group{
+			GroupedBy: &aggregation.GroupedBy{Value: key, Path: []string{"price"}},
+			Count:     count,
+			Properties: map[string]aggregation.Property{
+				"stock": {
+					Type:                  aggregation.PropertyTypeNumerical,
+					NumericalAggregations: map[string]interface{}{"sum": sum},
+				},
+			},
+		}
+	}
+	shard := func(buckets ...aggregation.Group) *aggregation.Result {
+		return &aggregation.Result{Groups: []aggregation.Group{{
+			Count: len(buckets),
+			Properties: map[string]aggregation.Property{
+				"price": {
+					Type:    aggregation.PropertyTypeNumerical,
+					Buckets: buckets,
+				},
+			},
+		}}}
+	}
+
+	res := NewShardCombiner().Do([]*aggregation.Result{
+		shard(bucket(10, 2, 5), bucket(0, 1, 1)),
+		shard(bucket(20, 1, 3), bucket(10, 3, 7)),
+	})
+
+	require.Len(t, res.Groups, 1)
+	buckets := res.Groups[0].Properties["price"].Buckets
+	require.Len(t, buckets, 3)
+	assert.Equal(t, 0.0, buckets[0].GroupedBy.Value)
+	assert.Equal(t, 10.0, buckets[1].GroupedBy.Value)
+	assert.Equal(t, 5, buckets[1].Count)
+	assert.Equal(t, 12.0, buckets[1].Properties["stock"].NumericalAggregations["sum"])
+	assert.Equal(t, 20.0, buckets[2].GroupedBy.Value)
+}
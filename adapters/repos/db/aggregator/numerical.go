@@ -27,6 +27,7 @@ func addNumericalAggregations(prop *aggregation.Property,
 		prop.NumericalAggregations = map[string]interface{}{}
 	}
 	agg.buildPairsFromCounts()
+	agg.sketches.addTo(prop)
 
 	// if there are no elements to aggregate over because a filter does not match anything, calculating mean etc. makes
 	// no sense. Non-existent entries evaluate to nil with an interface{} map
@@ -79,6 +80,22 @@ func newNumericalAggregator() *numericalAggregator {
 		max:          -math.MaxFloat64,
 		valueCounter: map[float64]uint64{},
 		pairs:        make([]floatCountPair, 0),
+		keepValues:   true,
+	}
+}
+
+// setAggregators prepares the sketches and stops holding on to every value,
+// unless one of the aggregators requires them
+func (a *numericalAggregator) setAggregators(aggs []aggregation.Aggregator,
+	percentiles []float64,
+) {
+	a.sketches = newSketches(aggs, percentiles)
+	a.keepValues = false
+	for _, agg := range aggs {
+		switch agg {
+		case aggregation.ModeAggregator, aggregation.MedianAggregator, aggregation.MeanAggregator:
+			a.keepValues = true
+		}
 	}
 }
 
@@ -91,6 +108,8 @@ type numericalAggregator struct {
 	mode         float64
 	pairs        []floatCountPair   // for row-based median calculation
 	valueCounter map[float64]uint64 // for individual median calculation
+	keepValues   bool               // whether to fill the valueCounter
+	sketches     sketches
 }
 
 type floatCountPair struct {
@@ -155,6 +174,11 @@ func (a *numericalAggregator) AddNumberRow(number float64, count uint64) error {
 	if number > a.max {
 		a.max = number
 	}
+	a.sketches.addFloat64(number, count)
+
+	if !a.keepValues {
+		return nil
+	}
 
 	currentCount := a.valueCounter[number]
 	currentCount += count
@@ -12,10 +12,12 @@
 package aggregator
 
 import (
+	"math"
 	"sort"
 	"time"
 
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/sketch"
 )
 
 type ShardCombiner struct{}
@@ -125,11 +127,77 @@ func (sc *ShardCombiner) mergeIntoCombinedGroupAtPos(combinedGroups []aggregatio
 		default:
 			panic("unknown prop type: " + prop.Type)
 		}
+		sc.mergeSketches(&combinedProp, &prop)
+		combinedProp.Buckets = sc.mergeBuckets(combinedProp.Buckets, prop.Buckets)
 		combinedGroups[pos].Properties[propName] = combinedProp
 
 	}
 }
 
+func (sc *ShardCombiner) mergeBuckets(first, second []aggregation.Group) []aggregation.Group {
+	for _, bucket := range second {
+		pos := getPosOfGroup(first, bucket.GroupedBy.Value)
+		if pos < 0 {
+			first = append(first, bucket)
+		} else {
+			sc.mergeIntoCombinedGroupAtPos(first, pos, bucket)
+		}
+	}
+
+	return first
+}
+
+// mergeSketches merges the sketches of source into combined. The sketches of
+// combined are copied first, so the results of a shard are never altered.
+func (sc *ShardCombiner) mergeSketches(combined, source *aggregation.Property) {
+	if p := source.PercentilesAggregation; p != nil && p.Sketch != nil {
+		if combined.PercentilesAggregation == nil {
+			combined.PercentilesAggregation = &aggregation.Percentiles{
+				Percentiles: p.Percentiles,
+				Sketch:      sketch.NewTDigest(p.Sketch.Compression),
+			}
+		}
+		combined.PercentilesAggregation.Sketch.Merge(p.Sketch)
+	}
+
+	if c := source.CardinalityAggregation; c != nil && c.Sketch != nil {
+		if combined.CardinalityAggregation == nil {
+			combined.CardinalityAggregation = &aggregation.Cardinality{
+				Sketch: sketch.NewHyperLogLog(c.Sketch.Precision),
+			}
+		}
+		// all shards use the same precision, so merging can't fail
+		combined.CardinalityAggregation.Sketch.Merge(c.Sketch)
+	}
+}
+
+// finalizeSketches turns the sketches into the values requested by the user
+func (sc *ShardCombiner) finalizeSketches(prop *aggregation.Property) {
+	if p := prop.PercentilesAggregation; p != nil && p.Sketch != nil {
+		p.Values = make([]aggregation.Percentile, len(p.Percentiles))
+		for i, percentile := range p.Percentiles {
+			p.Values[i].Percentile = percentile
+
+			value := p.Sketch.Quantile(percentile / 100)
+			if math.IsNaN(value) {
+				continue
+			}
+			if prop.Type == aggregation.PropertyTypeDate {
+				p.Values[i].Value = time.Unix(0, int64(value)).UTC().
+					Format(time.RFC3339Nano)
+			} else {
+				p.Values[i].Value = value
+			}
+		}
+		p.Sketch = nil
+	}
+
+	if c := prop.CardinalityAggregation; c != nil && c.Sketch != nil {
+		c.Value = int(c.Sketch.Estimate())
+		c.Sketch = nil
+	}
+}
+
 func (sc *ShardCombiner) mergeDateProp(first, second map[string]interface{}) {
 	if len(second) == 0 {
 		return
@@ -326,6 +394,11 @@ func (sc *ShardCombiner) finalizeGroup(group *aggregation.Group) {
 		default:
 			panic("Unknown prop type: " + prop.Type)
 		}
+		sc.finalizeSketches(&prop)
+		for i := range prop.Buckets {
+			sc.finalizeGroup(&prop.Buckets[i])
+		}
+		sortBuckets(prop.Buckets)
 		group.Properties[propName] = prop
 	}
 }
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/sketch"
+)
+
+// sketches approximate the aggregations which would otherwise require to
+// hold every value of a property. Unlike the exact aggregators they survive
+// being sent to another node, so they can be merged across all shards.
+type sketches struct {
+	percentiles []float64
+	digest      *sketch.TDigest
+	distinct    *sketch.HyperLogLog
+}
+
+func newSketches(aggs []aggregation.Aggregator, percentiles []float64) sketches {
+	s := sketches{percentiles: percentiles}
+	for _, agg := range aggs {
+		switch agg {
+		case aggregation.PercentilesAggregator:
+			s.digest = sketch.NewTDigest(sketch.DefaultCompression)
+		case aggregation.CardinalityAggregator:
+			s.distinct = sketch.NewHyperLogLog(sketch.DefaultPrecision)
+		}
+	}
+	return s
+}
+
+func (s *sketches) addFloat64(value float64, count uint64) {
+	if s.digest != nil {
+		s.digest.Add(value, float64(count))
+	}
+	if s.distinct != nil {
+		s.distinct.AddFloat64(value)
+	}
+}
+
+func (s *sketches) addString(value string) {
+	if s.distinct != nil {
+		s.distinct.AddString(value)
+	}
+}
+
+// addTo hands the sketches over to prop. Values added afterwards, e.g. when
+// the exact aggregators of several shards are merged, are not counted twice.
+func (s *sketches) addTo(prop *aggregation.Property) {
+	if s.digest != nil {
+		prop.PercentilesAggregation = &aggregation.Percentiles{
+			Percentiles: s.percentiles,
+			Sketch:      s.digest,
+		}
+	}
+	if s.distinct != nil {
+		prop.CardinalityAggregation = &aggregation.Cardinality{Sketch: s.distinct}
+	}
+	s.digest, s.distinct = nil, nil
+}
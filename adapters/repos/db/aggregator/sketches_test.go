@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+)
+
+func TestNumericalAggregatorSketches(t *testing.T) {
+	aggs := []aggregation.Aggregator{
+		aggregation.CountAggregator,
+		aggregation.PercentilesAggregator,
+		aggregation.CardinalityAggregator,
+	}
+	agg := newNumericalAggregator()
+	agg.setAggregators(aggs, []float64{50})
+
+	for i := 0; i < 100; i++ {
+		require.Nil(t, agg.AddFloat64(float64(i%10)))
+	}
+	// values are only kept for the exact aggregators which need them
+	assert.Empty(t, agg.valueCounter)
+
+	prop := aggregation.Property{Type: aggregation.PropertyTypeNumerical}
+	addNumericalAggregations(&prop, aggs, agg)
+	require.NotNil(t, prop.PercentilesAggregation)
+	require.NotNil(t, prop.CardinalityAggregation)
+	assert.Equal(t, 100.0, prop.PercentilesAggregation.Sketch.Count)
+}
+
+func TestShardCombinerMergeSketches(t *testing.T) {
+	aggs := []aggregation.Aggregator{
+		aggregation.PercentilesAggregator,
+		aggregation.CardinalityAggregator,
+	}
+
+	shard := func(from, to int) *aggregation.Result {
+		agg := newNumericalAggregator()
+		agg.setAggregators(aggs, []float64{0, 50, 100})
+		for i := from; i < to; i++ {
+			require.Nil(t, agg.AddFloat64(float64(i)))
+		}
+		prop := aggregation.Property{Type: aggregation.PropertyTypeNumerical}
+		addNumericalAggregations(&prop, aggs, agg)
+
+		res := &aggregation.Result{Groups: []aggregation.Group{{
+			Count:      to - from,
+			Properties: map[string]aggregation.Property{"price": prop},
+		}}}
+
+		// remote shards are sent as JSON
+		bytes, err := json.Marshal(res)
+		require.Nil(t, err)
+		var unmarshalled aggregation.Result
+		require.Nil(t, json.Unmarshal(bytes, &unmarshalled))
+		return &unmarshalled
+	}
+
+	res := NewShardCombiner().Do([]*aggregation.Result{
+		shard(0, 1000),
+		shard(500, 2000),
+	})
+
+	require.Len(t, res.Groups, 1)
+	prop := res.Groups[0].Properties["price"]
+	require.NotNil(t, prop.PercentilesAggregation)
+	assert.Nil(t, prop.PercentilesAggregation.Sketch)
+
+	values := prop.PercentilesAggregation.Values
+	require.Len(t, values, 3)
+	assert.Equal(t, 0.0, values[0].Value)
+	assert.InDelta(t, 875.0, values[1].Value, 20)
+	assert.Equal(t, 1999.0, values[2].Value)
+
+	require.NotNil(t, prop.CardinalityAggregation)
+	assert.Nil(t, prop.CardinalityAggregation.Sketch)
+	assert.InDelta(t, 2000, prop.CardinalityAggregation.Value, 2000*0.02)
+}
+
+func TestShardCombinerDatePercentiles(t *testing.T) {
+	aggs := []aggregation.Aggregator{aggregation.PercentilesAggregator}
+	agg := newDateAggregator()
+	agg.setAggregators(aggs, []float64{50})
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		date := start.AddDate(0, 0, i).Format(time.RFC3339)
+		require.Nil(t, agg.AddTimestamp(date))
+	}
+
+	prop := aggregation.Property{Type: aggregation.PropertyTypeDate}
+	addDateAggregations(&prop, aggs, agg)
+
+	res := NewShardCombiner().Do([]*aggregation.Result{{
+		Groups: []aggregation.Group{{
+			Count:      3,
+			Properties: map[string]aggregation.Property{"published": prop},
+		}},
+	}})
+
+	values := res.Groups[0].Properties["published"].PercentilesAggregation.Values
+	require.Len(t, values, 1)
+	assert.Equal(t, "2023-01-02T00:00:00Z", fmt.Sprint(values[0].Value))
+}
@@ -44,6 +44,8 @@ type textAggregator struct {
 	// always keep sorted, so we can cut off the last elem, when it grows larger
 	// than max
 	topPairs []aggregation.TextOccurrence
+
+	sketches sketches
 }
 
 func (a *Aggregator) parseAndAddTextRow(agg *textAggregator,
@@ -68,6 +70,7 @@ func (a *Aggregator) parseAndAddTextRow(agg *textAggregator,
 
 func (a *textAggregator) AddText(value string) error {
 	a.count++
+	a.sketches.addString(value)
 
 	itemCount := a.itemCounter[value]
 	itemCount++
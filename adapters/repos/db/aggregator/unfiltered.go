@@ -55,6 +55,10 @@ func (ua *unfilteredAggregator) Do(ctx context.Context) (*aggregation.Result, er
 		return nil, errors.Wrap(err, "aggregate properties")
 	}
 
+	if err := ua.addHistograms(ctx, props, ua.scanAll()); err != nil {
+		return nil, errors.Wrap(err, "aggregate histograms")
+	}
+
 	out.Groups[0].Properties = props
 
 	return &out, nil
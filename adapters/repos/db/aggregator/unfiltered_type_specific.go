@@ -154,6 +154,7 @@ func (ua unfilteredAggregator) floatProperty(ctx context.Context,
 	}
 
 	agg := newNumericalAggregator()
+	agg.setAggregators(prop.Aggregators, prop.Percentiles)
 
 	// flat never has a frequency, so it's either a Set or RoaringSet
 	if b.Strategy() == lsmkv.StrategyRoaringSet {
@@ -195,6 +196,7 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 	}
 
 	agg := newNumericalAggregator()
+	agg.setAggregators(prop.Aggregators, prop.Percentiles)
 
 	// int never has a frequency, so it's either a Set or RoaringSet
 	if b.Strategy() == lsmkv.StrategyRoaringSet {
@@ -237,6 +239,7 @@ func (ua unfilteredAggregator) dateProperty(ctx context.Context,
 	}
 
 	agg := newDateAggregator()
+	agg.setAggregators(prop.Aggregators, prop.Percentiles)
 
 	// dates don't have frequency, so it's either a Set or RoaringSet
 	if b.Strategy() == lsmkv.StrategyRoaringSet {
@@ -310,6 +313,7 @@ func (ua unfilteredAggregator) dateArrayProperty(ctx context.Context,
 	}
 
 	agg := newDateAggregator()
+	agg.setAggregators(prop.Aggregators, prop.Percentiles)
 
 	c := b.Cursor()
 	defer c.Close()
@@ -452,6 +456,7 @@ func (ua unfilteredAggregator) textProperty(ctx context.Context,
 	}
 
 	agg := newTextAggregator(limit)
+	agg.sketches = newSketches(prop.Aggregators, prop.Percentiles)
 
 	// we're looking at the whole object, so this is neither a Set, nor a Map, but
 	// a Replace strategy
@@ -465,6 +470,7 @@ func (ua unfilteredAggregator) textProperty(ctx context.Context,
 	}
 
 	out.TextAggregation = agg.Res()
+	agg.sketches.addTo(&out)
 
 	return &out, nil
 }
@@ -483,6 +489,7 @@ func (ua unfilteredAggregator) numberArrayProperty(ctx context.Context,
 	}
 
 	agg := newNumericalAggregator()
+	agg.setAggregators(prop.Aggregators, prop.Percentiles)
 
 	c := b.Cursor()
 	defer c.Close()
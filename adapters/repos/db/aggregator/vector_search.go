@@ -30,7 +30,15 @@ func (a *Aggregator) vectorSearch(allow helpers.AllowList, vec []float32) ([]uin
 }
 
 func (a *Aggregator) searchByVector(searchVector []float32, limit *int, ids helpers.AllowList) ([]uint64, []float32, error) {
-	idsFound, dists, err := a.vectorIndex.SearchByVector(searchVector, *limit, ids)
+	var idsFound []uint64
+	var dists []float32
+	var err error
+	if recommend := a.params.Recommend; recommend.IsBestScore() {
+		idsFound, dists, err = a.vectorIndex.SearchByBestScore(recommend.PositiveVectors,
+			recommend.NegativeVectors, *limit, ids)
+	} else {
+		idsFound, dists, err = a.vectorIndex.SearchByVector(searchVector, *limit, ids)
+	}
 	if err != nil {
 		return idsFound, nil, err
 	}
@@ -58,7 +66,15 @@ func (a *Aggregator) searchByVectorDistance(searchVector []float32, ids helpers.
 	}
 
 	targetDist := float32(1-a.params.Certainty) * 2
-	idsFound, dists, err := a.vectorIndex.SearchByVectorDistance(searchVector, targetDist, -1, ids)
+	var idsFound []uint64
+	var dists []float32
+	var err error
+	if recommend := a.params.Recommend; recommend.IsBestScore() {
+		idsFound, dists, err = a.vectorIndex.SearchByBestScoreDistance(recommend.PositiveVectors,
+			recommend.NegativeVectors, targetDist, -1, ids)
+	} else {
+		idsFound, dists, err = a.vectorIndex.SearchByVectorDistance(searchVector, targetDist, -1, ids)
+	}
 	if err != nil {
 		return nil, nil, fmt.Errorf("aggregate search by vector: %w", err)
 	}
@@ -75,7 +91,7 @@ func (a *Aggregator) objectVectorSearch(searchVector []float32,
 	}
 
 	bucket := a.store.Bucket(helpers.ObjectsBucketLSM)
-	objs, err := storobj.ObjectsByDocID(bucket, ids, additional.Properties{})
+	objs, err := storobj.ObjectsByDocID(bucket, ids, additional.Properties{}, a.class())
 	if err != nil {
 		return nil, nil, fmt.Errorf("get objects by doc id: %w", err)
 	}
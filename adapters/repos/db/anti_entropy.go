@@ -0,0 +1,282 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// AntiEntropy compares the local replica of every replicated shard with its
+// other replicas every interval until ctx is cancelled. Objects which are
+// missing or outdated on another replica are streamed to it. Since every node
+// runs anti-entropy, differences are repaired in both directions.
+//
+// maxBytesPerSecond limits the rate at which objects are streamed, a value of
+// zero disables the limit.
+func (db *DB) AntiEntropy(ctx context.Context, interval time.Duration,
+	maxBytesPerSecond int,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			limiter := newBandwidthLimiter(maxBytesPerSecond)
+			db.indexLock.RLock()
+			indices := make([]*Index, 0, len(db.indices))
+			for _, index := range db.indices {
+				indices = append(indices, index)
+			}
+			db.indexLock.RUnlock()
+
+			for _, index := range indices {
+				index.antiEntropy(ctx, limiter)
+			}
+		}
+	}
+}
+
+// HashTreeLevel returns the digests of the given nodes of one level of the
+// hash tree of a local shard
+func (db *DB) HashTreeLevel(ctx context.Context,
+	class, shardName string, level int, nodes []int,
+) ([]uint64, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return nil, fmt.Errorf("class %q not found locally", class)
+	}
+	shard, ok := index.Shards[shardName]
+	if !ok {
+		return nil, fmt.Errorf("shard %q does not exist locally", shardName)
+	}
+	tree, err := shard.hashTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tree.Level(level, nodes)
+}
+
+// antiEntropy repairs the other replicas of all local shards
+func (i *Index) antiEntropy(ctx context.Context, limiter *bandwidthLimiter) {
+	if !i.replicationEnabled() {
+		return
+	}
+	ss := i.getSchema.ShardingState(i.Config.ClassName.String())
+	if ss == nil {
+		return
+	}
+	local := i.getSchema.NodeName()
+
+	i.backupStateLock.RLock()
+	shards := make(map[string]*Shard, len(i.Shards))
+	for name, shard := range i.Shards {
+		shards[name] = shard
+	}
+	i.backupStateLock.RUnlock()
+
+	for name, shard := range shards {
+		phys, ok := ss.Physical[name]
+		if !ok || len(phys.BelongsToNodes) < 2 {
+			continue
+		}
+		tree, err := shard.hashTree(ctx)
+		if err != nil {
+			i.logger.WithField("action", "anti_entropy").WithField("shard", name).Error(err)
+			continue
+		}
+		for _, node := range phys.BelongsToNodes {
+			if node == local {
+				continue
+			}
+			if err := i.repairReplica(ctx, shard, tree, node, limiter); err != nil {
+				i.logger.WithField("action", "anti_entropy").WithField("shard", name).
+					WithField("node", node).Error(err)
+			}
+		}
+	}
+}
+
+// repairReplica streams the local objects of all leaves in which the hash
+// trees of the local and the remote replica differ to the remote replica.
+// Only objects which are missing or outdated on the remote replica are sent.
+func (i *Index) repairReplica(ctx context.Context, shard *Shard,
+	tree *replica.HashTree, node string, limiter *bandwidthLimiter,
+) error {
+	leaves, err := i.replicator.DiffHashTree(ctx, shard.name, node, tree)
+	if err != nil || len(leaves) == 0 {
+		return err
+	}
+
+	conflicts := 0
+	for _, leaf := range leaves {
+		xs, sizes, err := shard.leafObjects(ctx, tree, leaf)
+		if err != nil {
+			return err
+		}
+		for start := 0; start < len(xs); start += replicaBatchSize {
+			end := start + replicaBatchSize
+			if end > len(xs) {
+				end = len(xs)
+			}
+			size := 0
+			for _, n := range sizes[start:end] {
+				size += n
+			}
+			if err := limiter.wait(ctx, size); err != nil {
+				return err
+			}
+			// objects deleted on the remote replica are conflicts, as for read
+			// repairs the deletion is not propagated
+			deleted, err := i.replicator.SyncReplica(ctx, shard.name, node, xs[start:end])
+			if err != nil {
+				return err
+			}
+			conflicts += len(deleted)
+		}
+	}
+
+	i.logger.WithField("action", "anti_entropy").WithField("shard", shard.name).
+		WithField("node", node).WithField("leaves", len(leaves)).
+		WithField("conflicts", conflicts).Debug("repaired replica")
+	return nil
+}
+
+// hashTree returns the hash tree of the shard. It is built from the objects
+// bucket on first use and kept up to date by all writes afterwards, see
+// updateHashTree.
+func (s *Shard) hashTree(ctx context.Context) (*replica.HashTree, error) {
+	s.hashTreeLock.RLock()
+	tree := s.hashTreeCache
+	s.hashTreeLock.RUnlock()
+	if tree != nil {
+		return tree, nil
+	}
+
+	// writes are blocked while the tree is built
+	s.hashTreeLock.Lock()
+	defer s.hashTreeLock.Unlock()
+	if s.hashTreeCache != nil {
+		return s.hashTreeCache, nil
+	}
+
+	tree = replica.NewHashTree(replica.HashTreeHeight)
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		updateTime, err := storobj.UpdateTimeFromBinary(v)
+		if err != nil {
+			return nil, fmt.Errorf("shard %q: hash tree: %w", s.name, err)
+		}
+		tree.Add(k, updateTime)
+	}
+
+	s.hashTreeCache = tree
+	return tree, nil
+}
+
+// updateHashTree replaces the version previous of an object by the version
+// next in the hash tree of the shard, if it has been built. previous is nil
+// for new objects and next for deleted ones. It must be called right after
+// the write while holding hashTreeLock for reading, otherwise a concurrent
+// build could add the object twice.
+func (s *Shard) updateHashTree(id, previous, next []byte) error {
+	tree := s.hashTreeCache
+	if tree == nil {
+		return nil
+	}
+	if previous != nil {
+		updateTime, err := storobj.UpdateTimeFromBinary(previous)
+		if err != nil {
+			return fmt.Errorf("shard %q: hash tree: %w", s.name, err)
+		}
+		tree.Remove(id, updateTime)
+	}
+	if next != nil {
+		updateTime, err := storobj.UpdateTimeFromBinary(next)
+		if err != nil {
+			return fmt.Errorf("shard %q: hash tree: %w", s.name, err)
+		}
+		tree.Add(id, updateTime)
+	}
+	return nil
+}
+
+// leafObjects returns all objects belonging to a leaf of the hash tree along
+// with their binary sizes
+func (s *Shard) leafObjects(ctx context.Context, tree *replica.HashTree,
+	leaf int,
+) ([]*storobj.Object, []int, error) {
+	var (
+		xs    []*storobj.Object
+		sizes []int
+	)
+	class := s.index.getClass()
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+	for k, v := cursor.Seek(tree.LeafPrefix(leaf)); k != nil && tree.Leaf(k) == leaf; k, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		obj, err := storobj.FromBinary(v, class)
+		if err != nil {
+			return nil, nil, fmt.Errorf("shard %q: unmarshal object: %w", s.name, err)
+		}
+		xs = append(xs, obj)
+		sizes = append(sizes, len(v))
+	}
+	return xs, sizes, nil
+}
+
+// bandwidthLimiter limits the average rate at which bytes are streamed
+type bandwidthLimiter struct {
+	bytesPerSecond int
+	start          time.Time
+	sent           int64
+}
+
+func newBandwidthLimiter(bytesPerSecond int) *bandwidthLimiter {
+	return &bandwidthLimiter{bytesPerSecond: bytesPerSecond, start: time.Now()}
+}
+
+// wait blocks until n more bytes can be sent without exceeding the limit
+func (l *bandwidthLimiter) wait(ctx context.Context, n int) error {
+	if l.bytesPerSecond <= 0 {
+		return nil
+	}
+	due := l.start.Add(time.Duration(l.sent) * time.Second / time.Duration(l.bytesPerSecond))
+	l.sent += int64(n)
+	delay := time.Until(due)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
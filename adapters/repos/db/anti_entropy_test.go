@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestShard_HashTree(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, _ := testShard(t, ctx, className)
+
+	objs := createRandomObjects(className, 50)
+	for i, obj := range objs {
+		obj.Object.LastUpdateTimeUnix = int64(i + 1)
+		require.Nil(t, shd.putObject(ctx, obj))
+	}
+
+	tree, err := shd.hashTree(ctx)
+	require.Nil(t, err)
+	assert.NotZero(t, tree.Root())
+
+	// rebuild returns a tree built from scratch out of the objects bucket
+	rebuild := func(t *testing.T) uint64 {
+		shd.hashTreeLock.Lock()
+		shd.hashTreeCache = nil
+		shd.hashTreeLock.Unlock()
+		rebuilt, err := shd.hashTree(ctx)
+		require.Nil(t, err)
+		shd.hashTreeLock.Lock()
+		shd.hashTreeCache = tree
+		shd.hashTreeLock.Unlock()
+		return rebuilt.Root()
+	}
+
+	t.Run("reused", func(t *testing.T) {
+		cached, err := shd.hashTree(ctx)
+		require.Nil(t, err)
+		assert.Same(t, tree, cached)
+	})
+
+	t.Run("leaf objects", func(t *testing.T) {
+		id, err := uuid.MustParse(objs[0].ID().String()).MarshalBinary()
+		require.Nil(t, err)
+		leaf := tree.Leaf(id)
+
+		xs, sizes, err := shd.leafObjects(ctx, tree, leaf)
+		require.Nil(t, err)
+		require.Len(t, sizes, len(xs))
+		found := false
+		for _, x := range xs {
+			b, err := uuid.MustParse(x.ID().String()).MarshalBinary()
+			require.Nil(t, err)
+			assert.Equal(t, leaf, tree.Leaf(b))
+			found = found || x.ID() == objs[0].ID()
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("updated on writes", func(t *testing.T) {
+		root := tree.Root()
+
+		obj := objs[0]
+		obj.Object.LastUpdateTimeUnix = 1000
+		require.Nil(t, shd.putObject(ctx, storobj.FromObject(&obj.Object, obj.Vector)))
+		assert.NotEqual(t, root, tree.Root())
+		assert.Equal(t, rebuild(t), tree.Root())
+
+		created := createRandomObjects(className, 1)[0]
+		require.Nil(t, shd.putObject(ctx, created))
+		assert.Equal(t, rebuild(t), tree.Root())
+
+		require.Nil(t, shd.deleteObject(ctx, created.ID()))
+		require.Nil(t, shd.deleteObject(ctx, objs[1].ID()))
+		assert.Equal(t, rebuild(t), tree.Root())
+	})
+}
+
+func TestBandwidthLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unlimited", func(t *testing.T) {
+		l := newBandwidthLimiter(0)
+		start := time.Now()
+		for i := 0; i < 10; i++ {
+			require.Nil(t, l.wait(ctx, 1<<30))
+		}
+		assert.Less(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		l := newBandwidthLimiter(1000)
+		start := time.Now()
+		require.Nil(t, l.wait(ctx, 100))
+		require.Nil(t, l.wait(ctx, 100))
+		assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		l := newBandwidthLimiter(1)
+		require.Nil(t, l.wait(ctx, 100))
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, l.wait(cctx, 100), context.Canceled)
+	})
+}
@@ -164,6 +164,13 @@ func (db *DB) ListClasses(ctx context.Context) []string {
 	return classNames
 }
 
+// ResolveAlias returns the class an alias points to, or name itself if it
+// is not an alias.
+func (db *DB) ResolveAlias(name string) string {
+	sch := db.schemaGetter.GetSchemaSkipAuth()
+	return sch.ResolveAlias(name)
+}
+
 // descriptor record everything needed to restore a class
 func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.ClassDescriptor) (err error) {
 	if err := i.initBackup(backupID); err != nil {
@@ -99,8 +99,10 @@ func TestBackup_DBLevel(t *testing.T) {
 					assert.Equal(t, expectedShardName, shd.Name)
 					assert.Equal(t, expectedNodeName, shd.Node)
 					assert.NotEmpty(t, shd.Files)
+					assert.Len(t, shd.FileStats, len(shd.Files))
 					for _, f := range shd.Files {
 						assert.NotEmpty(t, f)
+						assert.Len(t, shd.FileStats[f].Hash, 64)
 					}
 					assert.Equal(t, expectedCounterPath, shd.DocIDCounterPath)
 					assert.Equal(t, expectedCounter, shd.DocIDCounter)
@@ -291,6 +291,12 @@ func (f *fakeBackupBackend) Initialize(ctx context.Context, backupID string) err
 	return nil
 }
 
+func (f *fakeBackupBackend) Delete(ctx context.Context, backupID string) error {
+	f.Lock()
+	defer f.Unlock()
+	return nil
+}
+
 func (f *fakeBackupBackend) successGlobalMeta() backup.DistributedBackupDescriptor {
 	return backup.DistributedBackupDescriptor{
 		StartedAt: f.startedAt,
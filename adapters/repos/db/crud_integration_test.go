@@ -1438,6 +1438,24 @@ func TestCRUD_Query(t *testing.T) {
 				query:            toParams(className, 0, 7, &filters.Cursor{After: "", Limit: 7}, nil, nil),
 				expectedThingIDs: []strfmt.UUID{thingID1, thingID2, thingID3, thingID4, thingID5, thingID6, thingID7},
 			},
+			{
+				name: "filtered results with step limit: 1",
+				query: toParams(className, 0, 1, &filters.Cursor{After: "", Limit: 1},
+					&filters.LocalFilter{
+						Root: &filters.Clause{
+							Operator: filters.OperatorEqual,
+							On: &filters.Path{
+								Class:    schema.ClassName(className),
+								Property: "stringProp",
+							},
+							Value: &filters.Value{
+								Value: "zebra",
+								Type:  schema.DataTypeText,
+							},
+						},
+					}, nil),
+				expectedThingIDs: []strfmt.UUID{thingID2, thingID6},
+			},
 			{
 				name:               "error on empty class",
 				query:              toParams("", 0, 7, &filters.Cursor{After: "", Limit: 7}, nil, nil),
@@ -1482,7 +1500,7 @@ func TestCRUD_Query(t *testing.T) {
 					}
 				} else {
 					cursorSearch := func(t *testing.T, className string, cursor *filters.Cursor) []strfmt.UUID {
-						res, err := repo.Query(context.Background(), toParams(className, 0, cursor.Limit, cursor, nil, nil))
+						res, err := repo.Query(context.Background(), toParams(className, 0, cursor.Limit, cursor, tt.query.Filters, nil))
 						require.Nil(t, err)
 						var ids []strfmt.UUID
 						for i := range res {
@@ -0,0 +1,401 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// duplicatesPageSize is the number of vectors of a shard which are searched
+// in the other shards at once
+const duplicatesPageSize = 1000
+
+// FindDuplicates returns the groups of objects of a class whose vectors are
+// within maxDistance of each other.
+//
+// Every shard walks its vector index to find the pairs of duplicates within
+// the shard, and searches its vectors in the other shards page by page to find
+// the pairs across shards. Shards held by other nodes are walked on their
+// node. Only the pairs are returned, so memory is bounded by the number of
+// duplicates rather than the size of the class.
+//
+// The objects of all pairs are then visited in order of creation time, oldest
+// first. Every object which is not part of a group yet starts a new one, which
+// it shares with all other ungrouped objects within maxDistance of its vector.
+// Thus every object of a group is a duplicate of the first one, which is the
+// oldest.
+func (db *DB) FindDuplicates(ctx context.Context, className string,
+	maxDistance float32,
+) ([][]strfmt.UUID, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, fmt.Errorf("class %s not found", className)
+	}
+
+	pairs, err := idx.findDuplicates(ctx, maxDistance)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupDuplicates(pairs), nil
+}
+
+func (i *Index) findDuplicates(ctx context.Context,
+	maxDistance float32,
+) ([]search.DuplicatePair, error) {
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+
+	var pairs []search.DuplicatePair
+	for _, shardName := range shardState.AllPhysicalShards() {
+		var err error
+		var res []search.DuplicatePair
+		if !shardState.IsShardLocal(shardName) {
+			res, err = i.remote.FindDuplicates(ctx, shardName, maxDistance)
+		} else {
+			res, err = i.shardDuplicates(ctx, i.Shards[shardName], maxDistance)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "shard %s", shardName)
+		}
+
+		pairs = append(pairs, res...)
+	}
+
+	return pairs, nil
+}
+
+func (i *Index) IncomingFindDuplicates(ctx context.Context, shardName string,
+	maxDistance float32,
+) ([]search.DuplicatePair, error) {
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	return i.shardDuplicates(ctx, shard, maxDistance)
+}
+
+func (i *Index) IncomingSearchDuplicates(ctx context.Context, shardName string,
+	vectors [][]float32, maxDistance float32,
+) ([][]search.Duplicate, error) {
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	return shard.searchDuplicates(ctx, vectors, maxDistance)
+}
+
+// shardDuplicates returns all pairs of duplicates with at least one object
+// in shard. The vectors of the shard are only searched in the shards with a
+// greater name, so that every pair across shards is found once.
+func (i *Index) shardDuplicates(ctx context.Context, shard *Shard,
+	maxDistance float32,
+) ([]search.DuplicatePair, error) {
+	pairs, err := shard.findDuplicates(ctx, maxDistance)
+	if err != nil {
+		return nil, err
+	}
+
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+	var others []string
+	for _, name := range shardState.AllPhysicalShards() {
+		if name > shard.name {
+			others = append(others, name)
+		}
+	}
+	if len(others) == 0 {
+		return pairs, nil
+	}
+
+	err = shard.iterateVectors(ctx, duplicatesPageSize,
+		func(objs []search.Duplicate, vectors [][]float32) error {
+			for _, other := range others {
+				var err error
+				var found [][]search.Duplicate
+				if !shardState.IsShardLocal(other) {
+					found, err = i.remote.SearchDuplicates(ctx, other, vectors, maxDistance)
+				} else {
+					found, err = i.Shards[other].searchDuplicates(ctx, vectors, maxDistance)
+				}
+				if err != nil {
+					return errors.Wrapf(err, "search shard %s", other)
+				}
+				if len(found) != len(objs) {
+					return errors.Errorf("search shard %s: got %d results for %d vectors",
+						other, len(found), len(objs))
+				}
+
+				for j, duplicates := range found {
+					for _, duplicate := range duplicates {
+						pairs = append(pairs, search.DuplicatePair{A: objs[j], B: duplicate})
+					}
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return pairs, nil
+}
+
+// findDuplicates walks the vector index of the shard and returns all pairs
+// of objects of the shard within maxDistance of each other
+func (s *Shard) findDuplicates(ctx context.Context,
+	maxDistance float32,
+) ([]search.DuplicatePair, error) {
+	var (
+		pairs []search.DuplicatePair
+		found = map[[2]uint64]struct{}{}
+		// objects are usually part of several pairs
+		resolved = map[uint64]*search.Duplicate{}
+	)
+	resolve := func(docID uint64) (*search.Duplicate, error) {
+		if dup, ok := resolved[docID]; ok {
+			return dup, nil
+		}
+		dup, err := s.duplicateByDocID(ctx, docID)
+		if err != nil {
+			return nil, err
+		}
+		resolved[docID] = dup
+		return dup, nil
+	}
+
+	err := s.vectorIndex.SearchDuplicates(ctx, maxDistance,
+		func(id uint64, duplicates []uint64) error {
+			for _, other := range duplicates {
+				// pairs are usually found from both sides
+				key := [2]uint64{id, other}
+				if other < id {
+					key = [2]uint64{other, id}
+				}
+				if _, ok := found[key]; ok {
+					continue
+				}
+				found[key] = struct{}{}
+
+				a, err := resolve(id)
+				if err != nil {
+					return err
+				}
+				b, err := resolve(other)
+				if err != nil {
+					return err
+				}
+				if a == nil || b == nil {
+					// deleted since the search, it is no longer a duplicate
+					continue
+				}
+				pairs = append(pairs, search.DuplicatePair{A: *a, B: *b})
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return pairs, nil
+}
+
+// searchDuplicates returns the objects of the shard within maxDistance of
+// every vector
+func (s *Shard) searchDuplicates(ctx context.Context, vectors [][]float32,
+	maxDistance float32,
+) ([][]search.Duplicate, error) {
+	out := make([][]search.Duplicate, len(vectors))
+	for j, vector := range vectors {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		ids, _, err := s.vectorIndex.SearchByVectorDistance(vector, maxDistance, -1, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "vector search")
+		}
+		for _, id := range ids {
+			dup, err := s.duplicateByDocID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if dup != nil {
+				out[j] = append(out[j], *dup)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// duplicateByDocID returns nil if the object has been deleted in the meantime
+func (s *Shard) duplicateByDocID(ctx context.Context,
+	docID uint64,
+) (*search.Duplicate, error) {
+	obj, err := s.objectByIndexID(ctx, docID, false)
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "get object of docID %d", docID)
+	}
+
+	return &search.Duplicate{ID: obj.ID(), CreationTimeUnix: obj.CreationTimeUnix()}, nil
+}
+
+// iterateVectors passes the objects of the shard which have a vector to fn in
+// pages of pageSize. The objects bucket is not locked while fn runs, since fn
+// may search other nodes.
+func (s *Shard) iterateVectors(ctx context.Context, pageSize int,
+	fn func(objs []search.Duplicate, vectors [][]float32) error,
+) error {
+	var after []byte
+	for {
+		objs, vectors, last, err := s.vectorsPage(ctx, after, pageSize)
+		if err != nil {
+			return err
+		}
+		if len(objs) > 0 {
+			if err := fn(objs, vectors); err != nil {
+				return err
+			}
+		}
+		if last == nil {
+			return nil
+		}
+		after = last
+	}
+}
+
+// vectorsPage returns up to pageSize objects with a vector following the key
+// after, along with the key of the last object read. The key is nil once all
+// objects have been read.
+func (s *Shard) vectorsPage(ctx context.Context, after []byte, pageSize int,
+) ([]search.Duplicate, [][]float32, []byte, error) {
+	var (
+		objs    []search.Duplicate
+		vectors [][]float32
+	)
+
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	k, v := cursor.First()
+	if after != nil {
+		k, v = cursor.Seek(after)
+		if k != nil && bytes.Equal(k, after) {
+			k, v = cursor.Next()
+		}
+	}
+	for ; k != nil; k, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, nil, err
+		}
+
+		obj, err := storobj.FromBinaryOptional(v, additional.Properties{Vector: true}, nil)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "unmarshal object")
+		}
+		if len(obj.Vector) > 0 {
+			objs = append(objs, search.Duplicate{
+				ID:               obj.ID(),
+				CreationTimeUnix: obj.CreationTimeUnix(),
+			})
+			vectors = append(vectors, obj.Vector)
+		}
+		if len(objs) == pageSize {
+			// keys are only valid until the cursor moves
+			return objs, vectors, append([]byte(nil), k...), nil
+		}
+	}
+
+	return objs, vectors, nil, nil
+}
+
+// groupDuplicates groups the objects of pairs around the oldest ones, see
+// FindDuplicates
+func groupDuplicates(pairs []search.DuplicatePair) [][]strfmt.UUID {
+	var (
+		objs      = map[strfmt.UUID]search.Duplicate{}
+		neighbors = map[strfmt.UUID][]strfmt.UUID{}
+	)
+	for _, pair := range pairs {
+		if pair.A.ID == pair.B.ID {
+			continue
+		}
+		objs[pair.A.ID], objs[pair.B.ID] = pair.A, pair.B
+		neighbors[pair.A.ID] = append(neighbors[pair.A.ID], pair.B.ID)
+		neighbors[pair.B.ID] = append(neighbors[pair.B.ID], pair.A.ID)
+	}
+
+	sorted := make([]search.Duplicate, 0, len(objs))
+	for _, obj := range objs {
+		sorted = append(sorted, obj)
+	}
+	sortByCreation(sorted)
+
+	var (
+		groups  [][]strfmt.UUID
+		grouped = make(map[strfmt.UUID]struct{}, len(objs))
+	)
+	for _, obj := range sorted {
+		if _, ok := grouped[obj.ID]; ok {
+			continue
+		}
+
+		var duplicates []search.Duplicate
+		for _, id := range neighbors[obj.ID] {
+			if _, ok := grouped[id]; ok {
+				continue
+			}
+			grouped[id] = struct{}{}
+			duplicates = append(duplicates, objs[id])
+		}
+		if len(duplicates) == 0 {
+			continue
+		}
+		sortByCreation(duplicates)
+
+		ids := make([]strfmt.UUID, 0, len(duplicates)+1)
+		ids = append(ids, obj.ID)
+		for _, duplicate := range duplicates {
+			ids = append(ids, duplicate.ID)
+		}
+		grouped[obj.ID] = struct{}{}
+		groups = append(groups, ids)
+	}
+
+	return groups
+}
+
+// sortByCreation orders objs by creation time, oldest first
+func sortByCreation(objs []search.Duplicate) {
+	sort.Slice(objs, func(i, j int) bool {
+		if objs[i].CreationTimeUnix != objs[j].CreationTimeUnix {
+			return objs[i].CreationTimeUnix < objs[j].CreationTimeUnix
+		}
+		return objs[i].ID < objs[j].ID
+	})
+}
@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	hnswConfig := enthnsw.NewDefaultUserConfig()
+	hnswConfig.Distance = enthnsw.DistanceL2Squared
+	class := &models.Class{
+		Class:               "ScrapedDocument",
+		VectorIndexConfig:   hnswConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:         "url",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWhitespace,
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t,
+		migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	objects := []struct {
+		id      strfmt.UUID
+		created int64
+		vector  []float32
+	}{
+		{"00000000-0000-0000-0000-000000000001", 3, []float32{0, 0}},
+		{"00000000-0000-0000-0000-000000000002", 1, []float32{0.1, 0}},
+		{"00000000-0000-0000-0000-000000000003", 2, []float32{-0.1, 0}},
+		{"00000000-0000-0000-0000-000000000004", 1, []float32{10, 10}},
+		{"00000000-0000-0000-0000-000000000005", 1, []float32{20, 20}},
+		{"00000000-0000-0000-0000-000000000006", 2, []float32{20, 20.1}},
+		// a chain of near duplicates, the first and the last are too far apart
+		{"00000000-0000-0000-0000-000000000007", 1, []float32{30, 0}},
+		{"00000000-0000-0000-0000-000000000008", 2, []float32{30.2, 0}},
+		{"00000000-0000-0000-0000-000000000009", 3, []float32{30.4, 0}},
+	}
+	for _, obj := range objects {
+		err := repo.PutObject(context.Background(), &models.Object{
+			ID:               obj.id,
+			Class:            class.Class,
+			CreationTimeUnix: obj.created,
+		}, obj.vector, nil)
+		require.Nil(t, err)
+	}
+
+	t.Run("groups are ordered by creation time", func(t *testing.T) {
+		groups, err := repo.FindDuplicates(context.Background(), class.Class, 0.05)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, [][]strfmt.UUID{
+			{
+				"00000000-0000-0000-0000-000000000002",
+				"00000000-0000-0000-0000-000000000003",
+				"00000000-0000-0000-0000-000000000001",
+			},
+			{
+				"00000000-0000-0000-0000-000000000005",
+				"00000000-0000-0000-0000-000000000006",
+			},
+			{
+				"00000000-0000-0000-0000-000000000007",
+				"00000000-0000-0000-0000-000000000008",
+			},
+		}, groups)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := repo.FindDuplicates(context.Background(), "Unknown", 0.05)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestFindDuplicatesAcrossShards(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	hnswConfig := enthnsw.NewDefaultUserConfig()
+	hnswConfig.Distance = enthnsw.DistanceL2Squared
+	class := &models.Class{
+		Class:               "ScrapedDocument",
+		VectorIndexConfig:   hnswConfig,
+		InvertedIndexConfig: invertedConfig(),
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: multiShardState()}
+	repo, err := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t,
+		migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	// objects are assigned to shards by their ids, so most of the original
+	// and duplicate pairs are spread over two shards
+	var expected [][]strfmt.UUID
+	for i := 0; i < 30; i++ {
+		original := strfmt.UUID(uuid.NewString())
+		duplicate := strfmt.UUID(uuid.NewString())
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID: original, Class: class.Class, CreationTimeUnix: 1,
+		}, []float32{float32(i) * 10, 0}, nil))
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID: duplicate, Class: class.Class, CreationTimeUnix: 2,
+		}, []float32{float32(i)*10 + 0.1, 0}, nil))
+		expected = append(expected, []strfmt.UUID{original, duplicate})
+	}
+
+	groups, err := repo.FindDuplicates(context.Background(), class.Class, 0.05)
+	require.Nil(t, err)
+	assert.ElementsMatch(t, expected, groups)
+}
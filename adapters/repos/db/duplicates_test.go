@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestGroupDuplicates(t *testing.T) {
+	dup := func(id string, created int64) search.Duplicate {
+		return search.Duplicate{ID: strfmt.UUID(id), CreationTimeUnix: created}
+	}
+	a, b, c, d, e := dup("a", 3), dup("b", 1), dup("c", 2), dup("d", 5), dup("e", 4)
+
+	groups := groupDuplicates([]search.DuplicatePair{
+		{A: a, B: b},
+		{A: b, B: c},
+		{A: a, B: c},
+		{A: b, B: a}, // found from both sides
+		// a chain, d is only a duplicate of e
+		{A: c, B: e},
+		{A: e, B: d},
+	})
+
+	assert.Equal(t, [][]strfmt.UUID{{"b", "c", "a"}, {"e", "d"}}, groups)
+}
@@ -0,0 +1,391 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// defaultFacetLimit is the number of terms returned per property if the user
+// did not set a limit
+const defaultFacetLimit = 10
+
+// Facets counts the indexed terms of the requested properties. If ids is nil,
+// all objects matching the filters are counted, otherwise only the objects
+// with the given ids, such as the results of a vector or keyword search.
+func (db *DB) Facets(ctx context.Context, params dto.GetParams,
+	ids []strfmt.UUID,
+) ([]search.Facet, error) {
+	if params.Facets == nil {
+		return nil, nil
+	}
+
+	idx := db.GetIndex(schema.ClassName(params.ClassName))
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
+	}
+
+	return idx.facets(ctx, params.Filters, ids, *params.Facets)
+}
+
+// facets counts the terms in two rounds if more than one shard is involved:
+// The shards only return their most frequent terms, so a term misses the
+// counts of every shard where it is less frequent. The second round counts
+// the most frequent terms of the first one exactly in every shard.
+func (i *Index) facets(ctx context.Context, filters *filters.LocalFilter,
+	ids []strfmt.UUID, params searchparams.Facets,
+) ([]search.Facet, error) {
+	var idsByShard map[string][]strfmt.UUID
+	if ids != nil {
+		idsByShard = map[string][]strfmt.UUID{}
+		for _, id := range ids {
+			shardName, err := i.shardFromUUID(id)
+			if err != nil {
+				return nil, err
+			}
+			idsByShard[shardName] = append(idsByShard[shardName], id)
+		}
+	}
+
+	results, err := i.shardFacets(ctx, filters, idsByShard, params)
+	if err != nil {
+		return nil, err
+	}
+	merged := mergeFacets(params, results)
+	if len(results) <= 1 {
+		return merged, nil
+	}
+
+	exact := params
+	exact.Terms = make([][]string, len(merged))
+	for pos, facet := range merged {
+		exact.Terms[pos] = make([]string, len(facet.Values))
+		for j, value := range facet.Values {
+			exact.Terms[pos][j] = value.Value
+		}
+	}
+	results, err = i.shardFacets(ctx, filters, idsByShard, exact)
+	if err != nil {
+		return nil, err
+	}
+	return mergeFacets(exact, results), nil
+}
+
+// shardFacets counts the terms in every shard. If idsByShard is set, shards
+// without any ids are skipped.
+func (i *Index) shardFacets(ctx context.Context, filters *filters.LocalFilter,
+	idsByShard map[string][]strfmt.UUID, params searchparams.Facets,
+) ([][]search.Facet, error) {
+	shardState := i.getSchema.ShardingState(i.Config.ClassName.String())
+
+	var results [][]search.Facet
+	for _, shardName := range shardState.AllPhysicalShards() {
+		var shardIDs []strfmt.UUID
+		if idsByShard != nil {
+			shardIDs = idsByShard[shardName]
+			if len(shardIDs) == 0 {
+				continue
+			}
+		}
+
+		var err error
+		var res []search.Facet
+		if !shardState.IsShardLocal(shardName) {
+			res, err = i.remote.Facets(ctx, shardName, filters, shardIDs, params)
+		} else {
+			shard := i.Shards[shardName]
+			res, err = shard.facets(ctx, filters, shardIDs, params)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "shard %s", shardName)
+		}
+
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+func (i *Index) IncomingFacets(ctx context.Context, shardName string,
+	filters *filters.LocalFilter, ids []strfmt.UUID, params searchparams.Facets,
+) ([]search.Facet, error) {
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return nil, errors.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	res, err := shard.facets(ctx, filters, ids, params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return res, nil
+}
+
+// facetLimit is the number of terms returned per property
+func facetLimit(params searchparams.Facets) int {
+	if params.Limit <= 0 {
+		return defaultFacetLimit
+	}
+	return params.Limit
+}
+
+// shardFacetLimit is the number of terms a shard returns per property. Shards
+// return more terms than requested, so that terms which are frequent across
+// all shards but not among the most frequent ones of every shard still make
+// it into the merged result.
+func shardFacetLimit(params searchparams.Facets) int {
+	limit := facetLimit(params)
+	return limit*3/2 + 10
+}
+
+// sortFacetValues sorts the most frequent terms first, ties are broken by the
+// term itself to keep the order stable
+func sortFacetValues(values []search.FacetValue) {
+	sort.Slice(values, func(a, b int) bool {
+		if values[a].Count != values[b].Count {
+			return values[a].Count > values[b].Count
+		}
+		return values[a].Value < values[b].Value
+	})
+}
+
+// mergeFacets sums up the counts of all shards. Only the most frequent terms
+// are kept.
+func mergeFacets(params searchparams.Facets, results [][]search.Facet) []search.Facet {
+	limit := facetLimit(params)
+
+	out := make([]search.Facet, len(params.Properties))
+	for pos, prop := range params.Properties {
+		counts := map[string]int{}
+		for _, res := range results {
+			if pos >= len(res) {
+				continue
+			}
+			for _, value := range res[pos].Values {
+				counts[value.Value] += value.Count
+			}
+		}
+
+		values := make([]search.FacetValue, 0, len(counts))
+		for value, count := range counts {
+			values = append(values, search.FacetValue{Value: value, Count: count})
+		}
+		sortFacetValues(values)
+		if len(values) > limit {
+			values = values[:limit]
+		}
+
+		out[pos] = search.Facet{Property: prop, Values: values}
+	}
+
+	return out
+}
+
+// facets counts the terms of every requested property within the shard. The
+// counts are the cardinalities of the bitmaps of the filterable index, so no
+// object has to be read. Only the most frequent terms up to the shard limit
+// are returned, see shardFacetLimit, unless the terms are set explicitly.
+func (s *Shard) facets(ctx context.Context, filters *filters.LocalFilter,
+	ids []strfmt.UUID, params searchparams.Facets,
+) ([]search.Facet, error) {
+	var allowList helpers.AllowList
+	var err error
+	switch {
+	case ids != nil:
+		allowList, err = s.allowListFromUUIDs(ids)
+	case filters != nil:
+		allowList, err = s.buildAllowList(ctx, filters, additional.Properties{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]search.Facet, len(params.Properties))
+	for i, prop := range params.Properties {
+		values, err := s.facetValues(ctx, prop, allowList)
+		if err != nil {
+			return nil, errors.Wrapf(err, "facet %s", prop)
+		}
+		if params.Terms != nil {
+			values = filterFacetValues(values, params.Terms[i])
+		} else {
+			sortFacetValues(values)
+			if limit := shardFacetLimit(params); len(values) > limit {
+				values = values[:limit]
+			}
+		}
+		out[i] = search.Facet{Property: prop, Values: values}
+	}
+
+	return out, nil
+}
+
+// filterFacetValues keeps the values of the given terms
+func filterFacetValues(values []search.FacetValue, terms []string) []search.FacetValue {
+	wanted := make(map[string]struct{}, len(terms))
+	for _, term := range terms {
+		wanted[term] = struct{}{}
+	}
+
+	out := make([]search.FacetValue, 0, len(terms))
+	for _, value := range values {
+		if _, ok := wanted[value.Value]; ok {
+			out = append(out, value)
+		}
+	}
+	return out
+}
+
+func (s *Shard) allowListFromUUIDs(ids []strfmt.UUID) (helpers.AllowList, error) {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return nil, errors.Errorf("objects bucket not found")
+	}
+
+	allowList := helpers.NewAllowList()
+	for _, id := range ids {
+		idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		obj, err := bucket.Get(idBytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get object %s", id)
+		}
+		if obj == nil {
+			// deleted in the meantime
+			continue
+		}
+
+		docID, err := storobj.DocIDFromBinary(obj)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get doc id of object %s", id)
+		}
+		allowList.Insert(docID)
+	}
+
+	return allowList, nil
+}
+
+func (s *Shard) facetValues(ctx context.Context, propName string,
+	allowList helpers.AllowList,
+) ([]search.FacetValue, error) {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	prop, err := sch.GetProperty(s.index.Config.ClassName, schema.PropertyName(propName))
+	if err != nil {
+		return nil, err
+	}
+
+	dataType, ok := schema.AsPrimitive(prop.DataType)
+	if !ok {
+		return nil, errors.Errorf("data type %v can't be faceted", prop.DataType)
+	}
+
+	isText := dataType == schema.DataTypeText || dataType == schema.DataTypeTextArray ||
+		dataType == schema.DataTypeString || dataType == schema.DataTypeStringArray
+	if !inverted.HasFilterableIndex(prop) || (isText && s.isFallbackToSearchable()) {
+		return nil, errors.Errorf("property has no filterable index")
+	}
+
+	bucket := s.store.Bucket(helpers.BucketFromPropNameLSM(propName))
+	if bucket == nil {
+		return nil, errors.Errorf("bucket for property not found")
+	}
+
+	c := bucket.CursorRoaringSet()
+	defer c.Close()
+
+	var out []search.FacetValue
+	for key, bm := c.First(); key != nil; key, bm = c.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var count int
+		if allowList == nil {
+			count = bm.GetCardinality()
+		} else {
+			count = allowList.AndCardinality(bm)
+		}
+		if count == 0 {
+			continue
+		}
+
+		value, err := facetValueFromKey(dataType, key)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, search.FacetValue{Value: value, Count: count})
+	}
+
+	return out, nil
+}
+
+// facetValueFromKey reverses the encoding of the inverted.Analyzer
+func facetValueFromKey(dataType schema.DataType, key []byte) (string, error) {
+	switch dataType {
+	case schema.DataTypeText, schema.DataTypeTextArray, schema.DataTypeString,
+		schema.DataTypeStringArray:
+		return string(key), nil
+	case schema.DataTypeInt, schema.DataTypeIntArray:
+		value, err := inverted.ParseLexicographicallySortableInt64(key)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(value, 10), nil
+	case schema.DataTypeNumber, schema.DataTypeNumberArray:
+		value, err := inverted.ParseLexicographicallySortableFloat64(key)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatFloat(value, 'f', -1, 64), nil
+	case schema.DataTypeBoolean:
+		if len(key) != 1 {
+			return "", errors.Errorf("invalid boolean of length %d", len(key))
+		}
+		return strconv.FormatBool(key[0] != 0), nil
+	case schema.DataTypeDate, schema.DataTypeDateArray:
+		value, err := inverted.ParseLexicographicallySortableInt64(key)
+		if err != nil {
+			return "", err
+		}
+		return time.Unix(0, value).UTC().Format(time.RFC3339Nano), nil
+	case schema.DataTypeUUID, schema.DataTypeUUIDArray:
+		value, err := uuid.FromBytes(key)
+		if err != nil {
+			return "", err
+		}
+		return value.String(), nil
+	default:
+		// boolean arrays are indexed as a whole and can't be split into terms
+		return "", errors.Errorf("data type %s can't be faceted", dataType)
+	}
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestFacetsAcrossShards(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "Product",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:         "brand",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationField,
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{shardState: multiShardState()}
+	repo, err := New(logger, Config{
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushIdleAfter:   60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t,
+		migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	put := func(shardName, brand string) {
+		// objects are assigned to shards by their ids
+		for {
+			id := strfmt.UUID(uuid.NewString())
+			name, err := idx.shardFromUUID(id)
+			require.Nil(t, err)
+			if name != shardName {
+				continue
+			}
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				ID:         id,
+				Class:      class.Class,
+				Properties: map[string]interface{}{"brand": brand},
+			}, []float32{1, 2}, nil))
+			return
+		}
+	}
+
+	// with a limit of 1 every shard returns its 11 most frequent brands, so
+	// "common" is cut off in the first shard but the most frequent one overall
+	shards := schemaGetter.shardState.AllPhysicalShards()
+	require.Greater(t, len(shards), 1)
+	for i := 0; i < 3; i++ {
+		put(shards[0], "local")
+	}
+	for i := 0; i < 12; i++ {
+		put(shards[0], fmt.Sprintf("filler-%02d", i))
+		put(shards[0], fmt.Sprintf("filler-%02d", i))
+	}
+	put(shards[0], "common")
+	for _, shardName := range shards[1:] {
+		for i := 0; i < 3; i++ {
+			put(shardName, "common")
+		}
+	}
+
+	res, err := repo.Facets(context.Background(), dto.GetParams{
+		ClassName: class.Class,
+		Facets:    &searchparams.Facets{Properties: []string{"brand"}, Limit: 1},
+	}, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []search.Facet{{
+		Property: "brand",
+		Values:   []search.FacetValue{{Value: "common", Count: 1 + 3*(len(shards)-1)}},
+	}}, res)
+}
@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_MergeFacets(t *testing.T) {
+	params := searchparams.Facets{Properties: []string{"brand", "color"}, Limit: 2}
+	shardResults := [][]search.Facet{
+		{
+			{Property: "brand", Values: []search.FacetValue{{Value: "acme", Count: 3}, {Value: "zeta", Count: 1}}},
+			{Property: "color", Values: []search.FacetValue{{Value: "red", Count: 2}}},
+		},
+		{
+			{Property: "brand", Values: []search.FacetValue{{Value: "zeta", Count: 4}, {Value: "beta", Count: 3}}},
+			{Property: "color", Values: []search.FacetValue{{Value: "blue", Count: 2}, {Value: "green", Count: 1}}},
+		},
+		// a shard without any matches in scope contributes nothing
+		nil,
+	}
+
+	expected := []search.Facet{
+		{Property: "brand", Values: []search.FacetValue{{Value: "zeta", Count: 5}, {Value: "acme", Count: 3}}},
+		{Property: "color", Values: []search.FacetValue{{Value: "blue", Count: 2}, {Value: "red", Count: 2}}},
+	}
+	assert.Equal(t, expected, mergeFacets(params, shardResults))
+}
+
+func Test_ShardFacetLimit(t *testing.T) {
+	assert.Equal(t, 25, shardFacetLimit(searchparams.Facets{}))
+	assert.Equal(t, 160, shardFacetLimit(searchparams.Facets{Limit: 100}))
+}
+
+func Test_FilterFacetValues(t *testing.T) {
+	values := []search.FacetValue{{Value: "acme", Count: 3}, {Value: "beta", Count: 2}, {Value: "zeta", Count: 1}}
+	assert.Equal(t, []search.FacetValue{{Value: "acme", Count: 3}, {Value: "zeta", Count: 1}},
+		filterFacetValues(values, []string{"zeta", "acme", "unknown"}))
+	assert.Empty(t, filterFacetValues(values, nil))
+}
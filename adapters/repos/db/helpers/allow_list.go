@@ -26,6 +26,7 @@ type AllowList interface {
 	Size() uint64
 	Iterator() AllowListIterator
 	LimitedIterator(limit int) AllowListIterator
+	AndCardinality(bm *sroar.Bitmap) int
 }
 
 type AllowListIterator interface {
@@ -86,6 +87,11 @@ func (al *bitmapAllowList) LimitedIterator(limit int) AllowListIterator {
 	return newBitmapAllowListIterator(al.bm, limit)
 }
 
+// AndCardinality returns how many ids of the allow list are contained in bm
+func (al *bitmapAllowList) AndCardinality(bm *sroar.Bitmap) int {
+	return sroar.And(al.bm, bm).GetCardinality()
+}
+
 type bitmapAllowListIterator struct {
 	len     int
 	counter int
@@ -76,12 +76,14 @@ func tokenizeLowercase(in string) []string {
 // tokenizeWord splits on any non-alphanumerical and lowercases the words
 // (former DataTypeText/Word)
 func tokenizeWord(in string) []string {
-	terms := strings.FieldsFunc(in, func(r rune) bool {
-		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
-	})
+	terms := strings.FieldsFunc(in, isWordSeparator)
 	return lowercase(terms)
 }
 
+func isWordSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
+
 // tokenizeWordWithWildcards splits on any non-alphanumerical except wildcard-symbols and
 // lowercases the words
 func tokenizeWordWithWildcards(in string) []string {
@@ -91,6 +93,60 @@ func tokenizeWordWithWildcards(in string) []string {
 	return lowercase(terms)
 }
 
+// TokenSpan is a token as returned by Tokenize together with its position
+// in the tokenized input, given as byte offsets
+type TokenSpan struct {
+	Token string
+	Start int
+	End   int
+}
+
+// TokenizeWithSpans tokenizes the same way as Tokenize, but additionally
+// returns where each token is located within the input. This allows to
+// point back into the original text, e.g. to highlight matched terms.
+func TokenizeWithSpans(tokenization string, in string) []TokenSpan {
+	switch tokenization {
+	case models.PropertyTokenizationWord:
+		return spans(in, isWordSeparator, strings.ToLower)
+	case models.PropertyTokenizationLowercase:
+		return spans(in, unicode.IsSpace, strings.ToLower)
+	case models.PropertyTokenizationWhitespace:
+		return spans(in, unicode.IsSpace, func(s string) string { return s })
+	case models.PropertyTokenizationField:
+		start := len(in) - len(strings.TrimLeftFunc(in, unicode.IsSpace))
+		end := len(strings.TrimRightFunc(in, unicode.IsSpace))
+		if end < start {
+			end = start
+		}
+		return []TokenSpan{{Token: in[start:end], Start: start, End: end}}
+	default:
+		return []TokenSpan{}
+	}
+}
+
+func spans(in string, isSeparator func(rune) bool,
+	normalize func(string) string,
+) []TokenSpan {
+	out := []TokenSpan{}
+	start := -1
+	for i, r := range in {
+		if isSeparator(r) {
+			if start >= 0 {
+				out = append(out, TokenSpan{Token: normalize(in[start:i]), Start: start, End: i})
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		out = append(out, TokenSpan{Token: normalize(in[start:]), Start: start, End: len(in)})
+	}
+	return out
+}
+
 func lowercase(terms []string) []string {
 	for i := range terms {
 		terms[i] = strings.ToLower(terms[i])
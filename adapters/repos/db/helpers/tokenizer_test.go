@@ -77,6 +77,19 @@ func TestTokenize(t *testing.T) {
 			assert.ElementsMatch(t, tc.expected, terms)
 		}
 	})
+
+	t.Run("tokenize with spans", func(t *testing.T) {
+		for _, tokenization := range Tokenizations {
+			spans := TokenizeWithSpans(tokenization, input)
+			terms := Tokenize(tokenization, input)
+
+			assert.Len(t, spans, len(terms))
+			for i, span := range spans {
+				assert.Equal(t, terms[i], span.Token)
+				assert.Equal(t, terms[i], Tokenize(tokenization, input[span.Start:span.End])[0])
+			}
+		}
+	})
 }
 
 func TestTokenizeAndCountDuplicates(t *testing.T) {
@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+const hintsBucket = "hints"
+
+// hintStore is a bounded replica.HintStore persisted in an LSM bucket.
+//
+// Keys are made of the node name followed by a zero byte and a big-endian
+// sequence number, so the hints of a node are iterated in insertion order.
+type hintStore struct {
+	sync.Mutex
+	store    *lsmkv.Store
+	bucket   *lsmkv.Bucket
+	maxHints int
+	size     int
+	seq      uint64
+	pending  map[string]int       // number of pending hints by node
+	metric   *prometheus.GaugeVec // nil if metrics are disabled
+}
+
+func newHintStore(ctx context.Context, rootPath string, maxHints int,
+	logger logrus.FieldLogger, promMetrics *monitoring.PrometheusMetrics,
+) (*hintStore, error) {
+	store, err := lsmkv.New(path.Join(rootPath, hintsBucket), rootPath, logger, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "init hint store")
+	}
+	if err := store.CreateOrLoadBucket(ctx, hintsBucket); err != nil {
+		return nil, errors.Wrap(err, "create or load hints bucket")
+	}
+	s := &hintStore{
+		store:    store,
+		bucket:   store.Bucket(hintsBucket),
+		maxHints: maxHints,
+		pending:  map[string]int{},
+	}
+	if promMetrics != nil {
+		s.metric = promMetrics.ReplicationPendingHints
+	}
+
+	c := s.bucket.Cursor()
+	defer c.Close()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		node, seq, err := parseHintKey(k)
+		if err != nil {
+			return nil, err
+		}
+		s.pending[node]++
+		s.size++
+		if seq > s.seq {
+			s.seq = seq
+		}
+	}
+	for node, n := range s.pending {
+		s.setMetric(node, n)
+	}
+
+	return s, nil
+}
+
+func (s *hintStore) AddHint(h *replica.Hint) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.size >= s.maxHints {
+		return replica.ErrHintStoreFull
+	}
+	value, err := json.Marshal(h)
+	if err != nil {
+		return errors.Wrap(err, "marshal hint")
+	}
+	s.seq++
+	key := hintKey(h.Node, s.seq)
+	if err := s.bucket.Put(key, value); err != nil {
+		return errors.Wrap(err, "put hint")
+	}
+	h.Key = key
+	s.size++
+	s.pending[h.Node]++
+	s.setMetric(h.Node, s.pending[h.Node])
+	return nil
+}
+
+func (s *hintStore) Hints(node string, limit int) ([]*replica.Hint, error) {
+	prefix := hintKey(node, 0)[:len(node)+1]
+	c := s.bucket.Cursor()
+	defer c.Close()
+
+	hints := make([]*replica.Hint, 0, limit)
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix) &&
+		len(hints) < limit; k, v = c.Next() {
+		h := &replica.Hint{}
+		if err := json.Unmarshal(v, h); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal hint %x", k)
+		}
+		h.Key = append([]byte{}, k...)
+		hints = append(hints, h)
+	}
+	return hints, nil
+}
+
+func (s *hintStore) DeleteHint(h *replica.Hint) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if err := s.bucket.Delete(h.Key); err != nil {
+		return errors.Wrap(err, "delete hint")
+	}
+	s.size--
+	s.pending[h.Node]--
+	n := s.pending[h.Node]
+	if n <= 0 {
+		delete(s.pending, h.Node)
+	}
+	s.setMetric(h.Node, n)
+	return nil
+}
+
+func (s *hintStore) PendingNodes() []string {
+	s.Lock()
+	defer s.Unlock()
+
+	nodes := make([]string, 0, len(s.pending))
+	for node := range s.pending {
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+func (s *hintStore) Shutdown(ctx context.Context) error {
+	return s.store.Shutdown(ctx)
+}
+
+func (s *hintStore) setMetric(node string, n int) {
+	if s.metric != nil {
+		s.metric.With(prometheus.Labels{"node_name": node}).Set(float64(n))
+	}
+}
+
+func hintKey(node string, seq uint64) []byte {
+	key := make([]byte, len(node)+9)
+	copy(key, node)
+	binary.BigEndian.PutUint64(key[len(node)+1:], seq)
+	return key
+}
+
+func parseHintKey(key []byte) (string, uint64, error) {
+	if len(key) < 9 || key[len(key)-9] != 0 {
+		return "", 0, fmt.Errorf("invalid hint key %x", key)
+	}
+	return string(key[:len(key)-9]), binary.BigEndian.Uint64(key[len(key)-8:]), nil
+}
+
+// hintStore returns the hint store of this node or nil if hinted handoff is
+// disabled. A nil interface is returned in the latter case so that
+// replicators can tell that no hints should be recorded.
+func (db *DB) hintStore() replica.HintStore {
+	if db.hints == nil {
+		return nil
+	}
+	return db.hints
+}
+
+// HintedHandoff replays hints, writes which replicas missed while they were
+// unreachable, every interval until ctx is cancelled. It does nothing if
+// hinted handoff is disabled.
+func (db *DB) HintedHandoff(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var handoff *replica.Handoff
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !db.StartupComplete() || db.hints == nil {
+				continue
+			}
+			if handoff == nil {
+				handoff = replica.NewHandoff(db.schemaGetter.NodeName(), db.hints,
+					db.replicaClient, db.schemaGetter, db.nodeResolver, db.logger)
+			}
+			handoff.Replay(ctx)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestHintStore(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	store, err := newHintStore(ctx, dirName, 3, logger, nil)
+	require.Nil(t, err)
+
+	t.Run("add hints", func(t *testing.T) {
+		for _, node := range []string{"node1", "node2", "node1"} {
+			h := &replica.Hint{Node: node, Class: "C", Shard: "S", Payload: []byte(node)}
+			require.Nil(t, store.AddHint(h))
+			assert.NotEmpty(t, h.Key)
+		}
+		assert.ElementsMatch(t, []string{"node1", "node2"}, store.PendingNodes())
+	})
+
+	t.Run("store is bounded", func(t *testing.T) {
+		err := store.AddHint(&replica.Hint{Node: "node2"})
+		assert.ErrorIs(t, err, replica.ErrHintStoreFull)
+	})
+
+	t.Run("hints of a node", func(t *testing.T) {
+		hints, err := store.Hints("node1", 10)
+		require.Nil(t, err)
+		require.Len(t, hints, 2)
+		for _, h := range hints {
+			assert.Equal(t, "node1", h.Node)
+			assert.Equal(t, "C", h.Class)
+		}
+		assert.Less(t, string(hints[0].Key), string(hints[1].Key))
+
+		hints, err = store.Hints("node1", 1)
+		require.Nil(t, err)
+		assert.Len(t, hints, 1)
+	})
+
+	t.Run("delete hints", func(t *testing.T) {
+		hints, err := store.Hints("node2", 10)
+		require.Nil(t, err)
+		require.Len(t, hints, 1)
+		require.Nil(t, store.DeleteHint(hints[0]))
+
+		hints, err = store.Hints("node2", 10)
+		require.Nil(t, err)
+		assert.Empty(t, hints)
+		assert.Equal(t, []string{"node1"}, store.PendingNodes())
+		assert.Nil(t, store.AddHint(&replica.Hint{Node: "node3"}))
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		require.Nil(t, store.Shutdown(ctx))
+		store, err = newHintStore(ctx, dirName, 3, logger, nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []string{"node1", "node3"}, store.PendingNodes())
+		assert.ErrorIs(t, store.AddHint(&replica.Hint{Node: "node3"}), replica.ErrHintStoreFull)
+
+		hints, err := store.Hints("node1", 10)
+		require.Nil(t, err)
+		require.Len(t, hints, 2)
+		require.Nil(t, store.DeleteHint(hints[0]))
+		assert.Nil(t, store.AddHint(&replica.Hint{Node: "node3"}))
+		require.Nil(t, store.Shutdown(ctx))
+	})
+}
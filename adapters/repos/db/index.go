@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -82,6 +83,10 @@ type Index struct {
 
 	metrics         *Metrics
 	centralJobQueue chan job
+
+	// writeVersionCounter is bumped by every change of the data of the
+	// index, see bumpWriteVersion
+	writeVersionCounter atomic.Uint64
 }
 
 func (i *Index) ID() string {
@@ -99,7 +104,7 @@ func NewIndex(ctx context.Context, config IndexConfig,
 	vectorIndexUserConfig schema.VectorIndexConfig, sg schemaUC.SchemaGetter,
 	cs inverted.ClassSearcher, logger logrus.FieldLogger,
 	nodeResolver nodeResolver, remoteClient sharding.RemoteIndexClient,
-	replicaClient replica.Client,
+	replicaClient replica.Client, hints replica.HintStore,
 	promMetrics *monitoring.PrometheusMetrics, class *models.Class, jobQueueCh chan job,
 ) (*Index, error) {
 	sd, err := stopwords.NewDetectorFromConfig(invertedIndexConfig.Stopwords)
@@ -108,7 +113,7 @@ func NewIndex(ctx context.Context, config IndexConfig,
 	}
 
 	repl := replica.NewReplicator(config.ClassName.String(),
-		sg, nodeResolver, replicaClient, logger)
+		sg, nodeResolver, replicaClient, hints, logger)
 
 	index := &Index{
 		Config:                config,
@@ -148,14 +153,36 @@ func NewIndex(ctx context.Context, config IndexConfig,
 	return index, nil
 }
 
+// getClass returns the class of the index, nil if it is not in the schema
+func (i *Index) getClass() *models.Class {
+	sch := i.getSchema.GetSchemaSkipAuth()
+	return sch.GetClass(i.Config.ClassName)
+}
+
+// enrichSchemaTypes parses the property values of objects received from
+// other nodes according to their data types, see storobj.FromBinary
+func (i *Index) enrichSchemaTypes(objs []*storobj.Object) error {
+	class := i.getClass()
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		if err := obj.EnrichSchemaTypes(class); err != nil {
+			return fmt.Errorf("object %s: %w", obj.ID(), err)
+		}
+	}
+	return nil
+}
+
 // Iterate over all objects in the index, applying the callback function to each one.  Adding or removing objects during iteration is not supported.
 func (i *Index) IterateObjects(ctx context.Context, cb func(index *Index, shard *Shard, object *storobj.Object) error) error {
+	class := i.getClass()
 	for _, shard := range i.Shards {
 		wrapper := func(object *storobj.Object) error {
 			return cb(i, shard, object)
 		}
 		bucket := shard.store.Bucket(helpers.ObjectsBucketLSM)
-		if err := bucket.IterateObjects(ctx, wrapper); err != nil {
+		if err := bucket.IterateObjects(ctx, class, wrapper); err != nil {
 			return err
 		}
 	}
@@ -623,8 +650,11 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 			err = fmt.Errorf("get object from remote index: %w", err)
 		}
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	return obj, err
+	return obj, i.enrichSchemaTypes([]*storobj.Object{obj})
 }
 
 func (i *Index) IncomingGetObject(ctx context.Context, shardName string,
@@ -703,6 +733,9 @@ func (i *Index) multiObjectByID(ctx context.Context,
 			if err != nil {
 				return nil, errors.Wrapf(err, "remote shard %s", shardName)
 			}
+			if err := i.enrichSchemaTypes(objects); err != nil {
+				return nil, errors.Wrapf(err, "remote shard %s", shardName)
+			}
 		}
 
 		for i, obj := range objects {
@@ -799,6 +832,15 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		propHash := cl.Properties
 		// Get keys of hash
 		for _, v := range propHash {
+			if _, ok := schema.AsNested(v.DataType); ok {
+				// nested text is searched through its dotted leaf paths
+				for _, leaf := range schema.FlattenNestedProperties(v) {
+					if inverted.HasSearchableIndex(leaf) {
+						keywordRanking.Properties = append(keywordRanking.Properties, leaf.Name)
+					}
+				}
+				continue
+			}
 			if inverted.PropertyHasSearchableIndex(i.getSchema.GetSchemaSkipAuth().Objects,
 				i.Config.ClassName.String(), v.Name) {
 
@@ -888,6 +930,10 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 			i.logger.WithField("action", "object_search").
 				Errorf("failed to check consistency of search results: %v", err)
 		}
+		// outdated results are replaced by the objects of other replicas
+		if err := i.enrichSchemaTypes(outObjects); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	return outObjects, outScores, nil
@@ -922,11 +968,15 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 			} else {
 				objs, scores, err = i.remote.SearchShard(
 					ctx, shardName, nil, limit, filters, keywordRanking,
-					sort, cursor, nil, addlProps, i.replicationEnabled())
+					sort, cursor, nil, nil, addlProps, i.replicationEnabled())
 				if err != nil {
 					return fmt.Errorf(
 						"remote shard object serach %s: %w", shardName, err)
 				}
+				if err := i.enrichSchemaTypes(objs); err != nil {
+					return fmt.Errorf(
+						"remote shard object serach %s: %w", shardName, err)
+				}
 			}
 
 			shardResultLock.Lock()
@@ -970,12 +1020,12 @@ func (i *Index) mergeGroups(objects []*storobj.Object, dists []float32,
 
 func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVector []float32,
 	dist float32, limit int, filters *filters.LocalFilter,
-	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
-	shardName string,
+	sort []filters.Sort, groupBy *searchparams.GroupBy, recommend *searchparams.Recommend,
+	additional additional.Properties, shardName string,
 ) ([]*storobj.Object, []float32, error) {
 	shard := i.Shards[shardName]
 	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+		ctx, searchVector, dist, limit, filters, sort, groupBy, recommend, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -985,7 +1035,7 @@ func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVe
 
 func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 	dist float32, limit int, filters *filters.LocalFilter,
-	sort []filters.Sort, groupBy *searchparams.GroupBy,
+	sort []filters.Sort, groupBy *searchparams.GroupBy, recommend *searchparams.Recommend,
 	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	shardingState := i.getSchema.ShardingState(i.Config.ClassName.String())
@@ -993,7 +1043,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 	if len(shardNames) == 1 && shardingState.IsShardLocal(shardNames[0]) {
 		return i.singleLocalShardObjectVectorSearch(ctx, searchVector, dist, limit, filters,
-			sort, groupBy, additional, shardNames[0])
+			sort, groupBy, recommend, additional, shardNames[0])
 	}
 
 	// a limit of -1 is used to signal a search by distance. if that is
@@ -1022,17 +1072,20 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 			if local {
 				shard := i.Shards[shardName]
 				res, resDists, err = shard.objectVectorSearch(
-					ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+					ctx, searchVector, dist, limit, filters, sort, groupBy, recommend, additional)
 				if err != nil {
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
 			} else {
 				res, resDists, err = i.remote.SearchShard(ctx,
 					shardName, searchVector, limit, filters,
-					nil, sort, nil, groupBy, additional, i.replicationEnabled())
+					nil, sort, nil, groupBy, recommend, additional, i.replicationEnabled())
 				if err != nil {
 					return errors.Wrapf(err, "remote shard %s", shardName)
 				}
+				if err := i.enrichSchemaTypes(res); err != nil {
+					return errors.Wrapf(err, "remote shard %s", shardName)
+				}
 			}
 
 			m.Lock()
@@ -1073,7 +1126,7 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	searchVector []float32, distance float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
-	additional additional.Properties,
+	recommend *searchparams.Recommend, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	shard, ok := i.Shards[shardName]
 	if !ok {
@@ -1090,7 +1143,7 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	}
 
 	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, distance, limit, filters, sort, groupBy, additional)
+		ctx, searchVector, distance, limit, filters, sort, groupBy, recommend, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1330,7 +1383,7 @@ func (i *Index) updateShardStatus(ctx context.Context, shardName, targetStatus s
 		if !ok {
 			err = errors.Errorf("shard %s does not exist", shardName)
 		} else {
-			err = shard.updateStatus(targetStatus)
+			err = shard.updateStatus(ctx, targetStatus)
 		}
 	}
 	if err != nil {
@@ -1345,7 +1398,7 @@ func (i *Index) IncomingUpdateShardStatus(ctx context.Context, shardName, target
 	if !ok {
 		return errors.Errorf("shard %s does not exist", shardName)
 	}
-	return shard.updateStatus(targetStatus)
+	return shard.updateStatus(ctx, targetStatus)
 }
 
 func (i *Index) notifyReady() {
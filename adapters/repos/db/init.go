@@ -32,6 +32,15 @@ func (db *DB) init(ctx context.Context) error {
 		return errors.Wrapf(err, "create root path directory at %s", db.config.RootPath)
 	}
 
+	if cfg := db.config.HintedHandoff; cfg.Enabled {
+		hints, err := newHintStore(ctx, db.config.RootPath, cfg.MaxHints,
+			db.logger, db.promMetrics)
+		if err != nil {
+			return err
+		}
+		db.hints = hints
+	}
+
 	objects := db.schemaGetter.GetSchemaSkipAuth().Objects
 	if objects != nil {
 		for _, class := range objects.Classes {
@@ -70,7 +79,7 @@ func (db *DB) init(ctx context.Context) error {
 				inverted.ConfigFromModel(invertedConfig),
 				class.VectorIndexConfig.(schema.VectorIndexConfig),
 				db.schemaGetter, db, db.logger, db.nodeResolver, db.remoteIndex,
-				db.replicaClient, db.promMetrics, class, db.jobQueueCh)
+				db.replicaClient, db.hintStore(), db.promMetrics, class, db.jobQueueCh)
 			if err != nil {
 				return errors.Wrap(err, "create index")
 			}
@@ -0,0 +1,229 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// Highlighter wraps the query terms of a keyword search within the text
+// properties of its results. The query and the texts are tokenized exactly
+// like the BM25Searcher does, stopwords included, so that a token is
+// highlighted if and only if it contributed to the score.
+type Highlighter struct {
+	properties   []*models.Property
+	queryTerms   map[string]map[string]struct{}
+	fragmentSize int
+	preTag       string
+	postTag      string
+}
+
+// NewHighlighter prepares the highlighting of the given query. The
+// properties to highlight are taken from the params, or if not set, from the
+// properties the keyword search ran on, or if not set either, all searchable
+// properties of the class.
+func NewHighlighter(class *models.Class, query string, searchProperties []string,
+	params additional.HighlightParams,
+) (*Highlighter, error) {
+	h := &Highlighter{
+		queryTerms:   map[string]map[string]struct{}{},
+		fragmentSize: params.FragmentSize,
+		preTag:       params.PreTag,
+		postTag:      params.PostTag,
+	}
+	if h.fragmentSize <= 0 {
+		h.fragmentSize = additional.DefaultHighlightFragmentSize
+	}
+	if h.preTag == "" && h.postTag == "" {
+		h.preTag = additional.DefaultHighlightPreTag
+		h.postTag = additional.DefaultHighlightPostTag
+	}
+
+	propNames := params.Properties
+	if len(propNames) == 0 {
+		propNames = searchProperties
+	}
+	if len(propNames) == 0 {
+		propNames = searchablePropertyNames(class)
+	}
+
+	for _, propName := range propNames {
+		// strip the boost of a search property, e.g. title^2
+		propName = strings.Split(propName, "^")[0]
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil {
+			return nil, err
+		}
+		if !HasSearchableIndex(prop) {
+			return nil, fmt.Errorf("property '%s' cannot be highlighted, "+
+				"it is not a text/text[] property indexed for bm25 search", propName)
+		}
+		h.properties = append(h.properties, prop)
+	}
+
+	var detector *stopwords.Detector
+	if class.InvertedIndexConfig != nil && class.InvertedIndexConfig.Stopwords != nil {
+		var err error
+		detector, err = stopwords.NewDetectorFromConfig(*(class.InvertedIndexConfig.Stopwords))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, prop := range h.properties {
+		if _, ok := h.queryTerms[prop.Tokenization]; ok {
+			continue
+		}
+		terms := map[string]struct{}{}
+		for _, term := range helpers.Tokenize(prop.Tokenization, query) {
+			// stopwords are only removed for word tokenization, see
+			// BM25Searcher.wand
+			if prop.Tokenization == models.PropertyTokenizationWord &&
+				detector != nil && detector.IsStopword(term) {
+				continue
+			}
+			terms[term] = struct{}{}
+		}
+		h.queryTerms[prop.Tokenization] = terms
+	}
+
+	return h, nil
+}
+
+func searchablePropertyNames(class *models.Class) []string {
+	var names []string
+	for _, prop := range class.Properties {
+		if _, ok := schema.AsNested(prop.DataType); ok {
+			for _, leaf := range schema.FlattenNestedProperties(prop) {
+				if HasSearchableIndex(leaf) {
+					names = append(names, leaf.Name)
+				}
+			}
+			continue
+		}
+		if HasSearchableIndex(prop) {
+			names = append(names, prop.Name)
+		}
+	}
+	return names
+}
+
+// Highlight returns the fragments of every highlighted property in which at
+// least one query term matched. Properties without any match are omitted.
+func (h *Highlighter) Highlight(props map[string]interface{}) []additional.Highlight {
+	var out []additional.Highlight
+	for _, prop := range h.properties {
+		var fragments []string
+		for _, text := range textValues(props, prop.Name) {
+			fragments = append(fragments, h.fragments(text, prop.Tokenization)...)
+		}
+		if len(fragments) == 0 {
+			continue
+		}
+		out = append(out, additional.Highlight{
+			Property:  prop.Name,
+			Fragments: fragments,
+		})
+	}
+	return out
+}
+
+func textValues(props map[string]interface{}, propName string) []string {
+	path := strings.Split(propName, ".")
+	var out []string
+	for _, value := range schema.NestedValues(props[path[0]], path[1:]) {
+		switch typed := value.(type) {
+		case string:
+			out = append(out, typed)
+		case []string:
+			out = append(out, typed...)
+		}
+	}
+	return out
+}
+
+// fragments cuts the text into snippets of at most fragmentSize characters
+// around the matched tokens. A snippet always starts and ends at a token
+// boundary and holds as many matches as fit into it. A single token longer
+// than fragmentSize becomes a snippet of its own.
+func (h *Highlighter) fragments(text, tokenization string) []string {
+	queryTerms := h.queryTerms[tokenization]
+	spans := helpers.TokenizeWithSpans(tokenization, text)
+	matched := make([]bool, len(spans))
+	anyMatch := false
+	for i := range spans {
+		if _, ok := queryTerms[spans[i].Token]; ok {
+			matched[i] = true
+			anyMatch = true
+		}
+	}
+	if !anyMatch {
+		return nil
+	}
+
+	length := func(lo, hi int) int {
+		return utf8.RuneCountInString(text[spans[lo].Start:spans[hi].End])
+	}
+
+	var out []string
+	prevHi := -1
+	for m := 0; m < len(spans); m++ {
+		if !matched[m] {
+			continue
+		}
+
+		// leading context takes up to half of the fragment, the remainder is
+		// filled with trailing context, and if the text ends before that,
+		// with more leading context
+		lo, hi := m, m
+		for lo-1 > prevHi && length(lo-1, m) <= h.fragmentSize/2 {
+			lo--
+		}
+		for hi+1 < len(spans) && length(lo, hi+1) <= h.fragmentSize {
+			hi++
+		}
+		for lo-1 > prevHi && length(lo-1, hi) <= h.fragmentSize {
+			lo--
+		}
+
+		out = append(out, h.wrap(text, spans[lo:hi+1], matched[lo:hi+1]))
+		prevHi = hi
+		m = hi
+	}
+
+	return out
+}
+
+func (h *Highlighter) wrap(text string, spans []helpers.TokenSpan, matched []bool) string {
+	var b strings.Builder
+	pos := spans[0].Start
+	for i, span := range spans {
+		if !matched[i] {
+			continue
+		}
+		b.WriteString(text[pos:span.Start])
+		b.WriteString(h.preTag)
+		b.WriteString(text[span.Start:span.End])
+		b.WriteString(h.postTag)
+		pos = span.End
+	}
+	b.WriteString(text[pos:spans[len(spans)-1].End])
+	return b.String()
+}
@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestHighlighter(t *testing.T) {
+	vFalse := false
+	class := &models.Class{
+		Class: "Article",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Stopwords: &models.StopwordConfig{Preset: "en"},
+		},
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{
+				Name:            "internal",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexSearchable: &vFalse,
+			},
+			{
+				Name:     "views",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+			{
+				Name:     "author",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{
+						Name:         "bio",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationLowercase,
+					},
+				},
+			},
+		},
+	}
+	props := map[string]interface{}{
+		"title":    "The Quick brown fox, and the lazy dog!",
+		"tags":     []string{"quick fox", "dog"},
+		"internal": "quick",
+		"author": map[string]interface{}{
+			"bio": "Writes about Quick foxes",
+		},
+	}
+
+	t.Run("defaults to all searchable properties", func(t *testing.T) {
+		h, err := NewHighlighter(class, "the quick fox", nil, additional.HighlightParams{})
+		require.Nil(t, err)
+
+		assert.Equal(t, []additional.Highlight{
+			{
+				Property:  "title",
+				Fragments: []string{"The <em>Quick</em> brown <em>fox</em>, and the lazy dog"},
+			},
+			{
+				Property:  "author.bio",
+				Fragments: []string{"Writes about <em>Quick</em> foxes"},
+			},
+		}, h.Highlight(props))
+	})
+
+	t.Run("search properties and custom tags", func(t *testing.T) {
+		h, err := NewHighlighter(class, "quick fox", []string{"title^2", "tags"},
+			additional.HighlightParams{PreTag: "[", PostTag: "]"})
+		require.Nil(t, err)
+
+		assert.Equal(t, []additional.Highlight{
+			{
+				Property:  "title",
+				Fragments: []string{"The [Quick] brown [fox], and the lazy dog"},
+			},
+			{
+				Property:  "tags",
+				Fragments: []string{"[quick fox]"},
+			},
+		}, h.Highlight(props))
+	})
+
+	t.Run("fragments", func(t *testing.T) {
+		h, err := NewHighlighter(class, "quick dog", nil,
+			additional.HighlightParams{Properties: []string{"title"}, FragmentSize: 15})
+		require.Nil(t, err)
+
+		assert.Equal(t, []additional.Highlight{
+			{
+				Property: "title",
+				Fragments: []string{
+					"<em>Quick</em> brown fox",
+					"the lazy <em>dog</em>",
+				},
+			},
+		}, h.Highlight(props))
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		h, err := NewHighlighter(class, "cat", nil, additional.HighlightParams{})
+		require.Nil(t, err)
+
+		assert.Empty(t, h.Highlight(props))
+	})
+
+	t.Run("property not searchable", func(t *testing.T) {
+		for _, prop := range []string{"internal", "views", "author", "unknown"} {
+			_, err := NewHighlighter(class, "quick", nil,
+				additional.HighlightParams{Properties: []string{prop}})
+			assert.NotNil(t, err, prop)
+		}
+	})
+}
@@ -14,6 +14,7 @@ package inverted
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -68,6 +69,13 @@ func (a *Analyzer) analyzeProps(propsMap map[string]*models.Property,
 			return nil, fmt.Errorf("prop %q has no datatype", prop.Name)
 		}
 
+		if _, ok := schema.AsNested(prop.DataType); ok {
+			if err := a.extendPropertiesWithNested(&out, prop, input, key); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		if !HasInvertedIndex(prop) {
 			continue
 		}
@@ -203,6 +211,61 @@ func (a *Analyzer) extendPropertiesWithPrimitive(properties *[]Property,
 	return nil
 }
 
+// extendPropertiesWithNested mutates the passed in properties, by extending
+// it with one property per indexed leaf of the nested object. Leaves are
+// named by their dotted path, e.g. "address.city", and leaves nested inside
+// an object[] are analyzed as arrays holding the values of all elements.
+func (a *Analyzer) extendPropertiesWithNested(properties *[]Property,
+	prop *models.Property, input map[string]any, propName string,
+) error {
+	value, ok := input[propName]
+	if !ok {
+		// skip any nested prop that's not set
+		return nil
+	}
+
+	for _, leaf := range schema.FlattenNestedProperties(prop) {
+		if !HasInvertedIndex(leaf) {
+			continue
+		}
+
+		path := strings.Split(leaf.Name, ".")[1:]
+		values := schema.NestedValues(value, path)
+		for i := range values {
+			if asJsonNumber, ok := values[i].(json.Number); ok {
+				asFloat, err := asJsonNumber.Float64()
+				if err != nil {
+					return fmt.Errorf("analyze nested prop %s: %w", leaf.Name, err)
+				}
+				values[i] = asFloat
+			}
+		}
+
+		if len(values) == 0 {
+			// leaves without any value are treated like unset props
+			continue
+		}
+
+		var property *Property
+		var err error
+		if schema.IsArrayDataType(leaf.DataType) {
+			property, err = a.analyzeArrayProp(leaf, values)
+		} else {
+			property, err = a.analyzePrimitiveProp(leaf, values[0])
+		}
+		if err != nil {
+			return fmt.Errorf("analyze nested prop %s: %w", leaf.Name, err)
+		}
+		if property == nil {
+			continue
+		}
+
+		*properties = append(*properties, *property)
+	}
+
+	return nil
+}
+
 func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Property, error) {
 	var items []Countable
 	hasFilterableIndex := HasFilterableIndex(prop)
@@ -92,7 +92,7 @@ func (s *Searcher) Objects(ctx context.Context, limit int,
 		it = allowList.LimitedIterator(limit)
 	}
 
-	return s.objectsByDocID(it, additional)
+	return s.objectsByDocID(it, additional, s.schema.GetClass(className))
 }
 
 func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, docIDs helpers.AllowList,
@@ -106,7 +106,7 @@ func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, doc
 }
 
 func (s *Searcher) objectsByDocID(it docIDsIterator,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) ([]*storobj.Object, error) {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	if bucket == nil {
@@ -132,7 +132,7 @@ func (s *Searcher) objectsByDocID(it docIDsIterator,
 		if additional.ReferenceQuery {
 			unmarshalled, err = storobj.FromBinaryUUIDOnly(res)
 		} else {
-			unmarshalled, err = storobj.FromBinaryOptional(res, additional)
+			unmarshalled, err = storobj.FromBinaryOptional(res, additional, class)
 		}
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarshal data object at position %d", i)
@@ -247,7 +247,7 @@ func (r *ShardInvertedReindexer) reindexProperties(ctx context.Context, reindexa
 		Debug("starting populating indexes")
 
 	i := 0
-	if err := objectsBucket.IterateObjects(ctx, func(object *storobj.Object) error {
+	if err := objectsBucket.IterateObjects(ctx, r.class, func(object *storobj.Object) error {
 		// check context expired every 100k objects
 		if i%100_000 == 0 && i != 0 {
 			if err := r.checkContextExpired(ctx, "iterating through objects stopped due to context canceled"); err != nil {
@@ -61,7 +61,7 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 		inverted.ConfigFromModel(class.InvertedIndexConfig),
 		class.VectorIndexConfig.(schema.VectorIndexConfig),
 		m.db.schemaGetter, m.db, m.logger, m.db.nodeResolver, m.db.remoteIndex,
-		m.db.replicaClient, m.db.promMetrics, class, m.db.jobQueueCh)
+		m.db.replicaClient, m.db.hintStore(), m.db.promMetrics, class, m.db.jobQueueCh)
 	if err != nil {
 		return errors.Wrap(err, "create index")
 	}
@@ -150,6 +150,22 @@ func (m *Migrator) UpdateShardStatus(ctx context.Context, className, shardName,
 	return idx.updateShardStatus(ctx, shardName, targetStatus)
 }
 
+// DropShards removes the given shards from the index. Like dropping an index,
+// it waits until the index is no longer in use.
+func (m *Migrator) DropShards(ctx context.Context, className string, shards []string) error {
+	m.db.indexLock.Lock()
+	defer m.db.indexLock.Unlock()
+
+	idx, ok := m.db.indices[indexID(schema.ClassName(className))]
+	if !ok {
+		return errors.Errorf("cannot drop shards of a non-existing index for %s", className)
+	}
+	idx.dropIndex.Lock()
+	defer idx.dropIndex.Unlock()
+
+	return idx.dropShards(shards)
+}
+
 func NewMigrator(db *DB, logger logrus.FieldLogger) *Migrator {
 	return &Migrator{db: db, logger: logger}
 }
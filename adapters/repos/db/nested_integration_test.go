@@ -0,0 +1,233 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestNestedProperties(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	vTrue := true
+	vFalse := false
+	// nested properties with the defaults of the schema manager
+	text := func(name string) *models.NestedProperty {
+		return &models.NestedProperty{
+			Name:            name,
+			DataType:        schema.DataTypeText.PropString(),
+			Tokenization:    models.PropertyTokenizationWord,
+			IndexFilterable: &vTrue,
+			IndexSearchable: &vTrue,
+		}
+	}
+	number := func(name string) *models.NestedProperty {
+		return &models.NestedProperty{
+			Name:            name,
+			DataType:        schema.DataTypeNumber.PropString(),
+			IndexFilterable: &vTrue,
+			IndexSearchable: &vFalse,
+		}
+	}
+	// the nested properties are chosen so that the objects look like a phone
+	// number, geo coordinates and references
+	class := &models.Class{
+		Class:               "Company",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					text("city"),
+					text("input"),
+				},
+			},
+			{
+				Name:     "headquarters",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					number("latitude"),
+					number("longitude"),
+				},
+			},
+			{
+				Name:     "offices",
+				DataType: schema.DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					text("city"),
+					text("beacon"),
+				},
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	acme := &models.Object{
+		Class: "Company",
+		ID:    strfmt.UUID("a1b6d3e6-5b1c-4c5e-9d6c-7a6a0f4c1d01"),
+		Properties: map[string]interface{}{
+			"address": map[string]interface{}{
+				"city":  "Berlin",
+				"input": "Hauptstrasse 1",
+			},
+			"headquarters": map[string]interface{}{
+				"latitude":  52.52,
+				"longitude": 13.40,
+			},
+			"offices": []interface{}{
+				map[string]interface{}{"city": "Rome", "beacon": "north"},
+				map[string]interface{}{"city": "Madrid"},
+			},
+		},
+	}
+	globex := &models.Object{
+		Class: "Company",
+		ID:    strfmt.UUID("a1b6d3e6-5b1c-4c5e-9d6c-7a6a0f4c1d02"),
+		Properties: map[string]interface{}{
+			"address": map[string]interface{}{
+				"city":  "Paris",
+				"input": "Rue de Rivoli 2",
+			},
+			"headquarters": map[string]interface{}{
+				"latitude":  48.86,
+				"longitude": 2.35,
+			},
+			"offices": []interface{}{
+				map[string]interface{}{"city": "Berlin", "beacon": "south"},
+			},
+		},
+	}
+	for _, obj := range []*models.Object{acme, globex} {
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil))
+	}
+
+	idx := repo.GetIndex("Company")
+	require.NotNil(t, idx)
+
+	ids := func(objs []*storobj.Object) []strfmt.UUID {
+		out := make([]strfmt.UUID, len(objs))
+		for i, obj := range objs {
+			out[i] = obj.ID()
+		}
+		return out
+	}
+	textFilter := func(path, value string) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    "Company",
+				Property: schema.PropertyName(path),
+			},
+			Value: &filters.Value{Value: value, Type: schema.DataTypeText},
+		}}
+	}
+
+	t.Run("nested objects are returned as they were stored", func(t *testing.T) {
+		obj, err := idx.objectByID(context.Background(), acme.ID, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		require.NotNil(t, obj)
+
+		props := obj.Properties().(map[string]interface{})
+		assert.Equal(t, acme.Properties.(map[string]interface{})["address"], props["address"])
+		assert.Equal(t, acme.Properties.(map[string]interface{})["headquarters"], props["headquarters"])
+		assert.Equal(t, acme.Properties.(map[string]interface{})["offices"], props["offices"])
+	})
+
+	t.Run("filter on a property of an object", func(t *testing.T) {
+		res, _, err := idx.objectSearch(context.Background(), 10,
+			textFilter("address.city", "Berlin"), nil, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{acme.ID}, ids(res))
+	})
+
+	t.Run("filter on a property of an object array", func(t *testing.T) {
+		res, _, err := idx.objectSearch(context.Background(), 10,
+			textFilter("offices.city", "Berlin"), nil, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{globex.ID}, ids(res))
+	})
+
+	t.Run("filter on a number property of an object", func(t *testing.T) {
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorGreaterThan,
+			On: &filters.Path{
+				Class:    "Company",
+				Property: "headquarters.latitude",
+			},
+			Value: &filters.Value{Value: 50.0, Type: schema.DataTypeNumber},
+		}}
+		res, _, err := idx.objectSearch(context.Background(), 10,
+			filter, nil, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{acme.ID}, ids(res))
+	})
+
+	t.Run("bm25 on a property of an object", func(t *testing.T) {
+		kwr := &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Properties: []string{"address.city"},
+			Query:      "paris",
+		}
+		res, _, err := idx.objectSearch(context.Background(), 10,
+			nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{globex.ID}, ids(res))
+	})
+
+	t.Run("bm25 on all properties includes nested ones", func(t *testing.T) {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Query: "madrid"}
+		res, _, err := idx.objectSearch(context.Background(), 10,
+			nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{acme.ID}, ids(res))
+
+		kwr = &searchparams.KeywordRanking{Type: "bm25", Query: "berlin"}
+		res, _, err = idx.objectSearch(context.Background(), 10,
+			nil, kwr, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{acme.ID, globex.ID}, ids(res))
+	})
+}
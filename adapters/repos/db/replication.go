@@ -20,6 +20,7 @@ import (
 	"path/filepath"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/multi"
@@ -275,6 +276,153 @@ func (i *Index) IncomingReinitShard(ctx context.Context,
 	return shard.reinit(ctx)
 }
 
+// dropShards drops local shards which no longer belong to this node,
+// e.g. after the replication factor of the class has been decreased.
+// The caller must hold the db indexLock and the dropIndex lock, since
+// i.Shards is not guarded otherwise.
+func (i *Index) dropShards(names []string) error {
+	i.backupStateLock.RLock()
+	defer i.backupStateLock.RUnlock()
+
+	for _, name := range names {
+		shard, ok := i.Shards[name]
+		if !ok {
+			continue
+		}
+		if err := shard.drop(); err != nil {
+			return fmt.Errorf("drop shard %q: %w", name, err)
+		}
+		delete(i.Shards, name)
+	}
+
+	return nil
+}
+
+// replicaBatchSize is the number of objects which are compared with other
+// replicas in a single request
+const replicaBatchSize = 100
+
+// VerifyShardReplicas makes sure that every object of a local shard is also
+// present on each of the given nodes in an equal or more recent version
+func (db *DB) VerifyShardReplicas(ctx context.Context,
+	class, shardName string, nodes []string,
+) error {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return fmt.Errorf("class %q not found locally", class)
+	}
+	return index.verifyShardReplicas(ctx, shardName, nodes)
+}
+
+func (i *Index) verifyShardReplicas(ctx context.Context,
+	shardName string, nodes []string,
+) error {
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return fmt.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	err := shard.iterateObjectBatches(ctx, replicaBatchSize, func(xs []*storobj.Object) error {
+		digests := make([]replica.RepairResponse, len(xs))
+		for j, x := range xs {
+			digests[j] = replica.RepairResponse{
+				ID:         x.ID().String(),
+				UpdateTime: x.LastUpdateTimeUnix(),
+			}
+		}
+		return i.replicator.CheckUpToDate(ctx, shardName, nodes, digests)
+	})
+	if err != nil {
+		return fmt.Errorf("shard %q: %w", shardName, err)
+	}
+	return nil
+}
+
+// SyncShardReplica brings the replica of a local shard on the given node up
+// to date. Local objects which have been deleted on that node are deleted
+// locally as well.
+func (db *DB) SyncShardReplica(ctx context.Context,
+	class, shardName, node string,
+) error {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return fmt.Errorf("class %q not found locally", class)
+	}
+	return index.syncShardReplica(ctx, shardName, node)
+}
+
+func (i *Index) syncShardReplica(ctx context.Context,
+	shardName, node string,
+) error {
+	shard, ok := i.Shards[shardName]
+	if !ok {
+		return fmt.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	var deleted []strfmt.UUID
+	err := shard.iterateObjectBatches(ctx, replicaBatchSize, func(xs []*storobj.Object) error {
+		ids, err := i.replicator.SyncReplica(ctx, shardName, node, xs)
+		deleted = append(deleted, ids...)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("shard %q: %w", shardName, err)
+	}
+
+	// objects cannot be removed while iterating
+	for _, id := range deleted {
+		if err := shard.deleteObject(ctx, id); err != nil {
+			return fmt.Errorf("shard %q: delete object %s: %w", shardName, id, err)
+		}
+	}
+	return nil
+}
+
+// LocalShardStatus returns the status of a local shard
+func (db *DB) LocalShardStatus(ctx context.Context,
+	class, shardName string,
+) (string, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return "", fmt.Errorf("class %q not found locally", class)
+	}
+	return index.IncomingGetShardStatus(ctx, shardName)
+}
+
+// UpdateLocalShardStatus changes the status of a local shard. Making it
+// read-only returns once the writes in flight have completed.
+func (db *DB) UpdateLocalShardStatus(ctx context.Context,
+	class, shardName, status string,
+) error {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return fmt.Errorf("class %q not found locally", class)
+	}
+	return index.IncomingUpdateShardStatus(ctx, shardName, status)
+}
+
+// iterateObjectBatches iterates over all objects of the shard and passes
+// them in batches of the given size to fn
+func (s *Shard) iterateObjectBatches(ctx context.Context, size int,
+	fn func(xs []*storobj.Object) error,
+) error {
+	batch := make([]*storobj.Object, 0, size)
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	err := bucket.IterateObjects(ctx, s.index.getClass(), func(obj *storobj.Object) error {
+		batch = append(batch, obj)
+		if len(batch) < size {
+			return nil
+		}
+		err := fn(batch)
+		batch = batch[:0]
+		return err
+	})
+	if err != nil || len(batch) == 0 {
+		return err
+	}
+	return fn(batch)
+}
+
 func (s *Shard) filePutter(ctx context.Context,
 	filePath string,
 ) (io.WriteCloser, error) {
@@ -295,6 +443,7 @@ func (s *Shard) filePutter(ctx context.Context,
 }
 
 func (s *Shard) reinit(ctx context.Context) error {
+	defer s.bumpWriteVersion()
 	if err := s.shutdown(ctx); err != nil {
 		return fmt.Errorf("shutdown shard: %w", err)
 	}
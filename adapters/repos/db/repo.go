@@ -40,6 +40,7 @@ type DB struct {
 	nodeResolver      nodeResolver
 	remoteNode        *sharding.RemoteNode
 	promMetrics       *monitoring.PrometheusMetrics
+	hints             *hintStore // nil if hinted handoff is disabled
 	shutdown          chan struct{}
 	startupComplete   atomic.Bool
 	resourceScanState *resourceScanState
@@ -133,6 +134,7 @@ type Config struct {
 	MemtablesMinActiveSeconds int
 	MemtablesMaxActiveSeconds int
 	TrackVectorDimensions     bool
+	HintedHandoff             config.HintedHandoff
 	ServerVersion             string
 	GitHash                   string
 }
@@ -213,6 +215,12 @@ func (db *DB) Shutdown(ctx context.Context) error {
 		}
 	}
 
+	if db.hints != nil {
+		if err := db.hints.Shutdown(ctx); err != nil {
+			return errors.Wrap(err, "shutdown hint store")
+		}
+	}
+
 	db.shutDownWg.Wait() // wait until job queue shutdown is completed
 
 	return nil
@@ -12,6 +12,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"runtime"
 	"runtime/debug"
@@ -208,7 +209,7 @@ func (d *DB) setShardsReadOnly() {
 	d.indexLock.Lock()
 	for _, index := range d.indices {
 		for _, shard := range index.Shards {
-			err := shard.updateStatus(storagestate.StatusReadOnly.String())
+			err := shard.updateStatus(context.Background(), storagestate.StatusReadOnly.String())
 			if err != nil {
 				d.logger.WithField("action", "set_shard_read_only").
 					WithField("path", d.config.RootPath).
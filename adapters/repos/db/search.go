@@ -111,7 +111,8 @@ func (db *DB) VectorClassSearch(ctx context.Context,
 
 	targetDist := extractDistanceFromParams(params)
 	res, dists, err := idx.objectVectorSearch(ctx, params.SearchVector, targetDist,
-		totalLimit, params.Filters, params.Sort, params.GroupBy, params.AdditionalProperties)
+		totalLimit, params.Filters, params.Sort, params.GroupBy, params.Recommend,
+		params.AdditionalProperties)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
 	}
@@ -153,7 +154,7 @@ func (db *DB) ClassObjectVectorSearch(ctx context.Context, class string, vector
 
 	// TODO: groupBy think of this
 	objs, dist, err := index.objectVectorSearch(
-		ctx, vector, 0, totalLimit, filters, nil, nil, addl)
+		ctx, vector, 0, totalLimit, filters, nil, nil, nil, addl)
 	if err != nil {
 		return nil, nil, fmt.Errorf("search index %s: %w", index.ID(), err)
 	}
@@ -209,7 +210,7 @@ func (db *DB) VectorSearch(ctx context.Context, vector []float32, offset, limit
 			defer wg.Done()
 
 			objs, dist, err := index.objectVectorSearch(
-				ctx, vector, 0, totalLimit, filters, nil, nil, additional.Properties{})
+				ctx, vector, 0, totalLimit, filters, nil, nil, nil, additional.Properties{})
 			if err != nil {
 				mutex.Lock()
 				searchErrors = append(searchErrors, errors.Wrapf(err, "search index %s", index.ID()))
@@ -262,7 +263,9 @@ func (db *DB) Query(ctx context.Context, q *objects.QueryInput) (search.Results,
 		return nil, &objects.Error{Msg: "class not found " + q.Class, Code: objects.StatusNotFound}
 	}
 	if q.Cursor != nil {
-		if err := filters.ValidateCursor(schema.ClassName(q.Class), q.Cursor, q.Offset, q.Filters, q.Sort); err != nil {
+		// unlike Get queries, a cursor may be combined with filters here, the
+		// export of a class pages through the filtered objects with it
+		if err := filters.ValidateCursor(schema.ClassName(q.Class), q.Cursor, q.Offset, nil, q.Sort); err != nil {
 			return nil, &objects.Error{Msg: "cursor api: invalid 'after' parameter", Code: objects.StatusBadRequest, Err: err}
 		}
 	}
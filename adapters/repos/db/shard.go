@@ -36,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/entities/storagestate"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/replica"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -59,6 +60,8 @@ type Shard struct {
 
 	status              storagestate.Status
 	statusLock          sync.Mutex
+	writesInFlight      int           // guarded by statusLock
+	writesDrained       chan struct{} // closed once writesInFlight drops to 0
 	propertyIndicesLock sync.RWMutex
 	stopMetrics         chan struct{}
 
@@ -67,6 +70,8 @@ type Shard struct {
 	docIdLock []sync.Mutex
 	// replication
 	replicationMap pendingReplicaTasks
+	hashTreeLock   sync.RWMutex
+	hashTreeCache  *replica.HashTree // used for anti-entropy, nil until built
 
 	// Indicates whether searchable buckets should be used
 	// when filterable buckets are missing for text/text[] properties
@@ -98,6 +103,7 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 	}
 
 	s.docIdLock = make([]sync.Mutex, IdLockPoolSize)
+	s.bumpWriteVersion()
 
 	defer s.metrics.ShardStartup(before)
 
@@ -249,6 +255,7 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 }
 
 func (s *Shard) drop() error {
+	defer s.bumpWriteVersion()
 	s.replicationMap.clear()
 
 	if s.index.Config.TrackVectorDimensions {
@@ -381,6 +388,15 @@ func (s *Shard) dynamicMemtableSizing() lsmkv.BucketOption {
 }
 
 func (s *Shard) createPropertyIndex(ctx context.Context, prop *models.Property, eg *errgroup.Group) {
+	if _, ok := schema.AsNested(prop.DataType); ok {
+		// nested properties are indexed per leaf, using the dotted path of
+		// the leaf as the property name
+		for _, leaf := range schema.FlattenNestedProperties(prop) {
+			s.createPropertyIndex(ctx, leaf, eg)
+		}
+		return
+	}
+
 	if !inverted.HasInvertedIndex(prop) {
 		return
 	}
@@ -496,9 +512,9 @@ func (s *Shard) updateVectorIndexConfig(ctx context.Context,
 		return storagestate.ErrStatusReadOnly
 	}
 
-	s.updateStatus(storagestate.StatusReadOnly.String())
+	s.updateStatus(ctx, storagestate.StatusReadOnly.String())
 	return s.vectorIndex.UpdateUserConfig(updated, func() {
-		s.updateStatus(storagestate.StatusReady.String())
+		s.updateStatus(context.Background(), storagestate.StatusReady.String())
 	})
 }
 
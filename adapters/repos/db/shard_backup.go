@@ -13,7 +13,10 @@ package db
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"os"
 	"path"
 
@@ -61,9 +64,33 @@ func (s *Shard) listBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 		return err
 	}
 	ret.Files = append(ret.Files, files2...)
+	if ret.FileStats, err = statFiles(s.index.Config.RootPath, ret.Files); err != nil {
+		return err
+	}
 	return nil
 }
 
+// statFiles computes the size and sha256 sum of each file. Incremental
+// backups use them to determine which files are already part of their base
+// backup.
+func statFiles(rootPath string, files []string) (map[string]backup.FileStat, error) {
+	stats := make(map[string]backup.FileStat, len(files))
+	for _, fpath := range files {
+		f, err := os.Open(path.Join(rootPath, fpath))
+		if err != nil {
+			return nil, fmt.Errorf("hash file %s: %w", fpath, err)
+		}
+		h := sha256.New()
+		n, err := io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("hash file %s: %w", fpath, err)
+		}
+		stats[fpath] = backup.FileStat{Size: n, Hash: hex.EncodeToString(h.Sum(nil))}
+	}
+	return stats, nil
+}
+
 func (s *Shard) resumeMaintenanceCycles(ctx context.Context) error {
 	var g errgroup.Group
 
@@ -50,7 +50,7 @@ func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 		return nil, nil
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := storobj.FromBinary(bytes, s.index.getClass())
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal object")
 	}
@@ -73,6 +73,7 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 		ids[i] = idBytes
 	}
 
+	class := s.index.getClass()
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	for i, id := range ids {
 		bytes, err := bucket.Get(id)
@@ -84,7 +85,7 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 			continue
 		}
 
-		obj, err := storobj.FromBinary(bytes)
+		obj, err := storobj.FromBinary(bytes, class)
 		if err != nil {
 			return nil, errors.Wrap(err, "unmarshal kind object")
 		}
@@ -134,7 +135,7 @@ func (s *Shard) objectByIndexID(ctx context.Context,
 			"uuid found for docID, but object is nil")
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := storobj.FromBinary(bytes, s.index.getClass())
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal kind object")
 	}
@@ -212,6 +213,15 @@ func (s *Shard) objectSearch(ctx context.Context, limit int,
 			cursor, additional, s.index.Config.ClassName)
 		return objs, nil, err
 	}
+	if cursor != nil {
+		allowList, err := s.buildAllowList(ctx, filters, additional)
+		if err != nil {
+			return nil, nil, err
+		}
+		objs, err := s.cursorObjectList(ctx, cursor, allowList, additional,
+			s.index.Config.ClassName)
+		return objs, nil, err
+	}
 	objs, err := inverted.NewSearcher(s.index.logger, s.store,
 		s.index.getSchema.GetSchemaSkipAuth(),
 		s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
@@ -222,7 +232,8 @@ func (s *Shard) objectSearch(ctx context.Context, limit int,
 
 func (s *Shard) objectVectorSearch(ctx context.Context,
 	searchVector []float32, targetDist float32, limit int, filters *filters.LocalFilter,
-	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
+	sort []filters.Sort, groupBy *searchparams.GroupBy, recommend *searchparams.Recommend,
+	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	var (
 		ids       []uint64
@@ -242,13 +253,26 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 	}
 
 	beforeVector := time.Now()
-	if limit < 0 {
+	switch {
+	case limit < 0 && recommend.IsBestScore():
+		ids, dists, err = s.vectorIndex.SearchByBestScoreDistance(recommend.PositiveVectors,
+			recommend.NegativeVectors, targetDist, s.index.Config.QueryMaximumResults, allowList)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "best score search by distance")
+		}
+	case recommend.IsBestScore():
+		ids, dists, err = s.vectorIndex.SearchByBestScore(recommend.PositiveVectors,
+			recommend.NegativeVectors, limit, allowList)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "best score search")
+		}
+	case limit < 0:
 		ids, dists, err = s.vectorIndex.SearchByVectorDistance(
 			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search by distance")
 		}
-	} else {
+	default:
 		ids, dists, err = s.vectorIndex.SearchByVector(searchVector, limit, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search")
@@ -281,7 +305,7 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 	beforeObjects := time.Now()
 
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-	objs, err := storobj.ObjectsByDocID(bucket, ids, additional)
+	objs, err := storobj.ObjectsByDocID(bucket, ids, additional, s.index.getClass())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -303,17 +327,19 @@ func (s *Shard) objectList(ctx context.Context, limit int,
 			return nil, err
 		}
 		bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-		return storobj.ObjectsByDocID(bucket, docIDs, additional)
+		return storobj.ObjectsByDocID(bucket, docIDs, additional, s.index.getClass())
 	}
 
 	if cursor == nil {
 		cursor = &filters.Cursor{After: "", Limit: limit}
 	}
-	return s.cursorObjectList(ctx, cursor, additional, className)
+	return s.cursorObjectList(ctx, cursor, nil, additional, className)
 }
 
+// cursorObjectList returns up to c.Limit objects in id order, starting after
+// c.After. If allowList is set, only objects contained in it are returned.
 func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
-	additional additional.Properties,
+	allowList helpers.AllowList, additional additional.Properties,
 	className schema.ClassName,
 ) ([]*storobj.Object, error) {
 	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
@@ -336,9 +362,20 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 
 	i := 0
 	out := make([]*storobj.Object, c.Limit)
+	class := s.index.getClass()
 
 	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
-		obj, err := storobj.FromBinary(val)
+		if allowList != nil {
+			docID, err := storobj.DocIDFromBinary(val)
+			if err != nil {
+				return nil, errors.Wrapf(err, "read doc id of item %d", i)
+			}
+			if !allowList.Contains(docID) {
+				continue
+			}
+		}
+
+		obj, err := storobj.FromBinary(val, class)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
 		}
@@ -440,7 +477,7 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "get existing doc id from object binary")
 	}
 
-	err = bucket.Delete(idBytes)
+	err = s.deleteObjectDataLSM(bucket, idBytes)
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
 	}
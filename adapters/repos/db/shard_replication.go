@@ -64,6 +64,9 @@ func (s *Shard) commit(ctx context.Context, requestID string, backupReadLock *sy
 	defer s.replicationMap.delete(requestID)
 	backupReadLock.RLock()
 	defer backupReadLock.RUnlock()
+	// the write was accepted when it was prepared
+	s.trackWrite()
+	defer s.endWrite()
 
 	return f(ctx)
 }
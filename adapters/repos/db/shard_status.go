@@ -12,6 +12,7 @@
 package db
 
 import (
+	"context"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -36,21 +37,73 @@ func (s *Shard) isReadOnly() bool {
 	return s.getStatus() == storagestate.StatusReadOnly
 }
 
-func (s *Shard) updateStatus(in string) error {
+// updateStatus changes the status of the shard. If the shard becomes
+// read-only, it returns once all writes in flight have completed. If ctx
+// expires before, the shard stays read-only and the context's error is
+// returned.
+func (s *Shard) updateStatus(ctx context.Context, in string) error {
 	s.statusLock.Lock()
-	defer s.statusLock.Unlock()
 
 	targetStatus, err := storagestate.ValidateStatus(strings.ToUpper(in))
 	if err != nil {
+		s.statusLock.Unlock()
 		return errors.Wrap(err, in)
 	}
 
 	s.status = targetStatus
 	s.updateStoreStatus(targetStatus)
 
+	if targetStatus != storagestate.StatusReadOnly || s.writesInFlight == 0 {
+		s.statusLock.Unlock()
+		return nil
+	}
+	if s.writesDrained == nil {
+		s.writesDrained = make(chan struct{})
+	}
+	drained := s.writesDrained
+	s.statusLock.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "wait for writes in flight")
+	}
+}
+
+// beginWrite registers a write to the shard, endWrite must be called once it
+// has completed. It fails if the shard is read-only.
+func (s *Shard) beginWrite() error {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
+	if s.status == storagestate.StatusReadOnly {
+		return storagestate.ErrStatusReadOnly
+	}
+	s.writesInFlight++
 	return nil
 }
 
+// trackWrite registers a write to the shard regardless of its status. It is
+// used for writes which were accepted before, e.g. replication commits.
+func (s *Shard) trackWrite() {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
+	s.writesInFlight++
+}
+
+func (s *Shard) endWrite() {
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
+	s.writesInFlight--
+	if s.writesInFlight == 0 && s.writesDrained != nil {
+		close(s.writesDrained)
+		s.writesDrained = nil
+	}
+}
+
 func (s *Shard) updateStoreStatus(targetStatus storagestate.Status) {
 	s.store.UpdateBucketsStatus(targetStatus)
 }
@@ -61,7 +61,7 @@ func TestShard_UpdateStatus(t *testing.T) {
 	})
 
 	t.Run("mark shard readonly and fail to insert", func(t *testing.T) {
-		err := shd.updateStatus(storagestate.StatusReadOnly.String())
+		err := shd.updateStatus(context.Background(), storagestate.StatusReadOnly.String())
 		require.Nil(t, err)
 
 		err = shd.putObject(ctx, testObject(className))
@@ -69,13 +69,26 @@ func TestShard_UpdateStatus(t *testing.T) {
 	})
 
 	t.Run("mark shard ready and insert successfully", func(t *testing.T) {
-		err := shd.updateStatus(storagestate.StatusReady.String())
+		err := shd.updateStatus(context.Background(), storagestate.StatusReady.String())
 		require.Nil(t, err)
 
 		err = shd.putObject(ctx, testObject(className))
 		require.Nil(t, err)
 	})
 
+	t.Run("stop waiting for writes in flight once the context expires", func(t *testing.T) {
+		require.Nil(t, shd.beginWrite())
+
+		waitCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		err := shd.updateStatus(waitCtx, storagestate.StatusReadOnly.String())
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.True(t, shd.isReadOnly())
+
+		shd.endWrite()
+		require.Nil(t, shd.updateStatus(ctx, storagestate.StatusReady.String()))
+	})
+
 	require.Nil(t, idx.drop())
 	require.Nil(t, os.RemoveAll(idx.Config.RootPath))
 }
@@ -127,7 +140,7 @@ func TestShard_ReadOnly_HaltCompaction(t *testing.T) {
 	})
 
 	t.Run("halt compaction with readonly status", func(t *testing.T) {
-		err := shd.updateStatus(storagestate.StatusReadOnly.String())
+		err := shd.updateStatus(context.Background(), storagestate.StatusReadOnly.String())
 		require.Nil(t, err)
 
 		// give the status time to propagate
@@ -156,7 +169,7 @@ func TestShard_ReadOnly_HaltCompaction(t *testing.T) {
 	})
 
 	t.Run("update shard status to ready", func(t *testing.T) {
-		err := shd.updateStatus(storagestate.StatusReady.String())
+		err := shd.updateStatus(context.Background(), storagestate.StatusReady.String())
 		require.Nil(t, err)
 
 		time.Sleep(time.Second)
@@ -190,3 +203,28 @@ func TestShard_ParallelBatches(t *testing.T) {
 	require.Equal(t, totalObjects, int(shd.counter.Get()))
 	require.Nil(t, idx.drop())
 }
+
+func TestShard_WriteVersion(t *testing.T) {
+	ctx := testCtx()
+	shd, idx := testShard(t, ctx, "TestClass")
+	defer idx.drop()
+
+	initial := idx.writeVersion()
+	require.NotZero(t, initial)
+
+	obj := testObject("TestClass")
+	require.Nil(t, shd.putObject(ctx, obj))
+	afterPut := idx.writeVersion()
+	assert.Greater(t, afterPut, initial)
+
+	_, err := shd.objectByID(ctx, obj.ID(), nil, additional.Properties{})
+	require.Nil(t, err)
+	assert.Equal(t, afterPut, idx.writeVersion(), "reads must not change the version")
+
+	require.Nil(t, shd.deleteObject(ctx, obj.ID()))
+	afterDelete := idx.writeVersion()
+	assert.Greater(t, afterDelete, afterPut)
+
+	require.Nil(t, idx.dropShards([]string{shd.name}))
+	assert.Greater(t, idx.writeVersion(), afterDelete, "dropping a shard changes the version")
+}
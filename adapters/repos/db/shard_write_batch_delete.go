@@ -20,7 +20,6 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/usecases/objects"
 )
 
@@ -28,11 +27,15 @@ import (
 func (s *Shard) deleteObjectBatch(ctx context.Context,
 	docIDs []uint64, dryRun bool,
 ) objects.BatchSimpleObjects {
-	if s.isReadOnly() {
+	if err := s.beginWrite(); err != nil {
 		return objects.BatchSimpleObjects{
-			objects.BatchSimpleObject{Err: storagestate.ErrStatusReadOnly},
+			objects.BatchSimpleObject{Err: err},
 		}
 	}
+	defer s.endWrite()
+	if !dryRun {
+		defer s.bumpWriteVersion()
+	}
 	return newDeleteObjectsBatcher(s).Delete(ctx, docIDs, dryRun)
 }
 
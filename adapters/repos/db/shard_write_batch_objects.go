@@ -19,7 +19,6 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
@@ -27,9 +26,12 @@ import (
 func (s *Shard) putObjectBatch(ctx context.Context,
 	objects []*storobj.Object,
 ) []error {
-	if s.isReadOnly() {
-		return []error{storagestate.ErrStatusReadOnly}
+	defer s.bumpWriteVersion()
+
+	if err := s.beginWrite(); err != nil {
+		return []error{err}
 	}
+	defer s.endWrite()
 
 	return s.putBatch(ctx, objects)
 }
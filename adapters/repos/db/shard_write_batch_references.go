@@ -31,9 +31,11 @@ import (
 func (s *Shard) addReferencesBatch(ctx context.Context,
 	refs objects.BatchReferences,
 ) []error {
-	if s.isReadOnly() {
+	if err := s.beginWrite(); err != nil {
 		return []error{errors.Errorf("shard is read-only")}
 	}
+	defer s.endWrite()
+	defer s.bumpWriteVersion()
 
 	return newReferencesBatcher(s).References(ctx, refs)
 }
@@ -20,15 +20,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 //nolint:all
 func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
-	if s.isReadOnly() {
-		return storagestate.ErrStatusReadOnly
+	if err := s.beginWrite(); err != nil {
+		return err
 	}
+	defer s.endWrite()
 
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
@@ -46,6 +46,7 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		// nothing to do
 		return nil
 	}
+	defer s.bumpWriteVersion()
 
 	// we need the doc ID so we can clean up inverted indices currently
 	// pointing to this object
@@ -54,7 +55,7 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "get existing doc id from object binary")
 	}
 
-	err = bucket.Delete(idBytes)
+	err = s.deleteObjectDataLSM(bucket, idBytes)
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
 	}
@@ -111,7 +112,9 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	if obj == nil || bucket == nil {
 		return nil
 	}
-	err := bucket.Delete(idBytes)
+	defer s.bumpWriteVersion()
+
+	err := s.deleteObjectDataLSM(bucket, idBytes)
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
@@ -140,8 +143,30 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	return nil
 }
 
+// deleteObjectDataLSM deletes the object from the objects bucket and the hash
+// tree of the shard
+func (s *Shard) deleteObjectDataLSM(bucket *lsmkv.Bucket, id []byte) error {
+	// see comment in shard_write_put.go::putObjectLSM
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(id)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	// the object might have been updated since the caller read it
+	previous, err := bucket.Get(id)
+	if err != nil || previous == nil {
+		return err
+	}
+
+	s.hashTreeLock.RLock()
+	defer s.hashTreeLock.RUnlock()
+	if err := bucket.Delete(id); err != nil {
+		return err
+	}
+	return s.updateHashTree(id, previous, nil)
+}
+
 func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) error {
-	previousObject, err := storobj.FromBinary(previous)
+	previousObject, err := storobj.FromBinary(previous, s.index.getClass())
 	if err != nil {
 		return errors.Wrap(err, "unmarshal previous object")
 	}
@@ -13,9 +13,11 @@ package db
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -34,6 +36,28 @@ func isPropertyForLength(dt schema.DataType) bool {
 	}
 }
 
+// nestedNilProps returns a nil prop for every indexed leaf of the nested
+// property that has no value in the given object
+func nestedNilProps(prop *models.Property, value interface{}) []nilProp {
+	var out []nilProp
+	for _, leaf := range schema.FlattenNestedProperties(prop) {
+		if !inverted.HasInvertedIndex(leaf) {
+			continue
+		}
+
+		path := strings.Split(leaf.Name, ".")[1:]
+		if len(schema.NestedValues(value, path)) > 0 {
+			continue
+		}
+
+		out = append(out, nilProp{
+			Name:                leaf.Name,
+			AddToPropertyLength: isPropertyForLength(schema.DataType(leaf.DataType[0])),
+		})
+	}
+	return out
+}
+
 func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []nilProp, error) {
 	schemaModel := s.index.getSchema.GetSchemaSkipAuth().Objects
 	c, err := schema.GetClassByName(schemaModel, object.Class().String())
@@ -64,6 +88,11 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 				continue
 			}
 
+			if _, ok := schema.AsNested(prop.DataType); ok {
+				nilProps = append(nilProps, nestedNilProps(prop, schemaMap[prop.Name])...)
+				continue
+			}
+
 			// Add props as nil props if
 			// 1. They are not in the schema map ( == nil)
 			// 2. Their inverted index is enabled
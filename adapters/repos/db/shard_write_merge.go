@@ -18,15 +18,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/entities/models"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 )
 
 func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) error {
-	if s.isReadOnly() {
-		return storagestate.ErrStatusReadOnly
+	if err := s.beginWrite(); err != nil {
+		return err
 	}
+	defer s.endWrite()
 
 	if merge.Vector != nil {
 		// validation needs to happen before any changes are done. Otherwise, insertion is aborted somewhere in-between.
@@ -45,6 +45,8 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 }
 
 func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocument) error {
+	defer s.bumpWriteVersion()
+
 	next, status, err := s.mergeObjectInStorage(doc, idBytes)
 	if err != nil {
 		return err
@@ -102,7 +104,7 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		return nil, status, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
 	}
 
-	if err := s.upsertObjectDataLSM(bucket, idBytes, nextBytes, status.docID); err != nil {
+	if err := s.upsertObjectDataLSM(bucket, idBytes, nextBytes, previous, status.docID); err != nil {
 		lock.Unlock()
 		return nil, status, errors.Wrap(err, "upsert object data")
 	}
@@ -170,7 +172,7 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 		return out, errors.Wrapf(err, "marshal object %s to binary", nextObj.ID())
 	}
 
-	if err := s.upsertObjectDataLSM(bucket, idBytes, nextBytes, status.docID); err != nil {
+	if err := s.upsertObjectDataLSM(bucket, idBytes, nextBytes, previous, status.docID); err != nil {
 		return out, errors.Wrap(err, "upsert object data")
 	}
 
@@ -197,7 +199,7 @@ func (s *Shard) mergeObjectData(previous []byte,
 		previousObj.SetClass(merge.Class)
 		previousObj.SetID(merge.ID)
 	} else {
-		p, err := storobj.FromBinary(previous)
+		p, err := storobj.FromBinary(previous, s.index.getClass())
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "unmarshal previous")
 		}
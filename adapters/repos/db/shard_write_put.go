@@ -24,14 +24,14 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/filters"
-	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
-	if s.isReadOnly() {
-		return storagestate.ErrStatusReadOnly
+	if err := s.beginWrite(); err != nil {
+		return err
 	}
+	defer s.endWrite()
 	uuid, err := uuid.MustParse(object.ID().String()).MarshalBinary()
 	if err != nil {
 		return err
@@ -40,6 +40,8 @@ func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
 }
 
 func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object) error {
+	defer s.bumpWriteVersion()
+
 	if object.Vector != nil {
 		// validation needs to happen before any changes are done. Otherwise, insertion is aborted somewhere in-between.
 		err := s.vectorIndex.ValidateBeforeInsert(object.Vector)
@@ -126,6 +128,12 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 	before := time.Now()
 	defer s.metrics.PutObject(before)
 
+	// objects received from other nodes still contain the raw values of
+	// their properties, see storobj.FromBinary
+	if err := object.EnrichSchemaTypes(s.index.getClass()); err != nil {
+		return objectInsertStatus{}, errors.Wrap(err, "parse properties")
+	}
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 
 	// First the object bucket is checked if already an object with the same uuid is present, to determine if it is new
@@ -153,7 +161,7 @@ func (s *Shard) putObjectLSM(object *storobj.Object, idBytes []byte,
 	}
 
 	before = time.Now()
-	if err := s.upsertObjectDataLSM(bucket, idBytes, data, status.docID); err != nil {
+	if err := s.upsertObjectDataLSM(bucket, idBytes, data, previous_object_bytes, status.docID); err != nil {
 		lock.Unlock()
 		return status, errors.Wrap(err, "upsert object data")
 	}
@@ -241,14 +249,21 @@ func (s *Shard) determineMutableInsertStatus(previous []byte,
 	return out, nil
 }
 
+// upsertObjectDataLSM must be called while holding the doc id lock of the
+// object, previous is its currently stored version
 func (s *Shard) upsertObjectDataLSM(bucket *lsmkv.Bucket, id []byte, data []byte,
-	docID uint64,
+	previous []byte, docID uint64,
 ) error {
 	keyBuf := bytes.NewBuffer(nil)
 	binary.Write(keyBuf, binary.LittleEndian, &docID)
 	docIDBytes := keyBuf.Bytes()
 
-	return bucket.Put(id, data, lsmkv.WithSecondaryKey(0, docIDBytes))
+	s.hashTreeLock.RLock()
+	defer s.hashTreeLock.RUnlock()
+	if err := bucket.Put(id, data, lsmkv.WithSecondaryKey(0, docIDBytes)); err != nil {
+		return err
+	}
+	return s.updateHashTree(id, previous, data)
 }
 
 func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
@@ -260,7 +275,7 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	}
 
 	if status.docIDChanged {
-		oldObject, err := storobj.FromBinary(previous)
+		oldObject, err := storobj.FromBinary(previous, s.index.getClass())
 		if err == nil {
 
 			oldProps, _, err := s.analyzeObject(oldObject)
@@ -352,7 +367,7 @@ func (s *Shard) updateInvertedIndexCleanupOldLSM(status objectInsertStatus,
 	// NOTE: Since Doc IDs are immutable, there is no need to use a
 	// DeltaAnalyzer. docIDChanged==true, therefore the old docID is
 	// "worthless" and can be cleaned up in the inverted index fully.
-	previousObject, err := storobj.FromBinary(previous)
+	previousObject, err := storobj.FromBinary(previous, s.index.getClass())
 	if err != nil {
 		return errors.Wrap(err, "unmarshal previous object")
 	}
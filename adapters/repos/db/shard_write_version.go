@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// writeSequence is shared by all indexes of the process. Drawing every
+// index's write version from the same monotonic sequence guarantees that a
+// recreated index (e.g. after a class was deleted and added again) can never
+// report a version that was already handed out before.
+var writeSequence atomic.Uint64
+
+// bumpWriteVersion must be called once a write to the shard has completed.
+// Readers that cache results based on the write version, will consider
+// anything cached before the bump as stale.
+func (s *Shard) bumpWriteVersion() {
+	s.index.bumpWriteVersion()
+}
+
+// bumpWriteVersion must be called whenever the data of the index changes,
+// i.e. a shard was written to, created or dropped
+func (i *Index) bumpWriteVersion() {
+	i.writeVersionCounter.Store(writeSequence.Add(1))
+}
+
+// writeVersion changes whenever the data of the index changed. It carries no
+// meaning other than being different from any previous value. Writes which
+// only affect shards on remote nodes are not reflected.
+func (i *Index) writeVersion() uint64 {
+	return i.writeVersionCounter.Load()
+}
+
+// allShardsLocal is true if this node holds a replica of every shard
+func (i *Index) allShardsLocal() bool {
+	ss := i.getSchema.ShardingState(i.Config.ClassName.String())
+	if ss == nil {
+		return false
+	}
+	for name := range ss.Physical {
+		if !ss.IsShardLocal(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// ClassVersion changes whenever an object of the class is written. The
+// second return value is false if the version can not be determined, i.e.
+// if the class does not exist or some of its shards are only held by other
+// nodes, whose writes would not be reflected.
+func (db *DB) ClassVersion(className string) (uint64, bool) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil || !idx.allShardsLocal() {
+		return 0, false
+	}
+	return idx.writeVersion(), true
+}
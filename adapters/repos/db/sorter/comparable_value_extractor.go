@@ -13,7 +13,9 @@ package sorter
 
 import (
 	"encoding/json"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/weaviate/weaviate/entities/filters"
@@ -37,44 +39,48 @@ func (e *comparableValueExtractor) extractFromBytes(objData []byte, propName str
 		return nil
 	}
 	if success {
-		switch e.dataTypesHelper.getType(propName) {
-		case schema.DataTypeBlob:
-			return &value[0]
-		case schema.DataTypeText:
-			return &value[0]
-		case schema.DataTypeTextArray:
-			return &value
-		case schema.DataTypeDate:
-			d := e.mustExtractDates(value[:1])[0]
-			return &d
-		case schema.DataTypeDateArray:
-			da := e.mustExtractDates(value)
-			return &da
-		case schema.DataTypeNumber, schema.DataTypeInt:
-			n := e.mustExtractNumbers(value[:1])[0]
-			return &n
-		case schema.DataTypeNumberArray, schema.DataTypeIntArray:
-			na := e.mustExtractNumbers(value)
-			return &na
-		case schema.DataTypeBoolean:
-			b := e.mustExtractBools(value[:1])[0]
-			return &b
-		case schema.DataTypeBooleanArray:
-			ba := e.mustExtractBools(value)
-			return &ba
-		case schema.DataTypePhoneNumber:
-			fa := e.toFloatArrayFromPhoneNumber(e.mustExtractPhoneNumber(value))
-			return &fa
-		case schema.DataTypeGeoCoordinates:
-			fa := e.toFloatArrayFromGeoCoordinates(e.mustExtractGeoCoordinates(value))
-			return &fa
-		default:
-			return nil
-		}
+		return e.extractFromStrings(value, propName)
 	}
 	return nil
 }
 
+func (e *comparableValueExtractor) extractFromStrings(value []string, propName string) interface{} {
+	switch e.dataTypesHelper.getType(propName) {
+	case schema.DataTypeBlob:
+		return &value[0]
+	case schema.DataTypeText:
+		return &value[0]
+	case schema.DataTypeTextArray:
+		return &value
+	case schema.DataTypeDate:
+		d := e.mustExtractDates(value[:1])[0]
+		return &d
+	case schema.DataTypeDateArray:
+		da := e.mustExtractDates(value)
+		return &da
+	case schema.DataTypeNumber, schema.DataTypeInt:
+		n := e.mustExtractNumbers(value[:1])[0]
+		return &n
+	case schema.DataTypeNumberArray, schema.DataTypeIntArray:
+		na := e.mustExtractNumbers(value)
+		return &na
+	case schema.DataTypeBoolean:
+		b := e.mustExtractBools(value[:1])[0]
+		return &b
+	case schema.DataTypeBooleanArray:
+		ba := e.mustExtractBools(value)
+		return &ba
+	case schema.DataTypePhoneNumber:
+		fa := e.toFloatArrayFromPhoneNumber(e.mustExtractPhoneNumber(value))
+		return &fa
+	case schema.DataTypeGeoCoordinates:
+		fa := e.toFloatArrayFromGeoCoordinates(e.mustExtractGeoCoordinates(value))
+		return &fa
+	default:
+		return nil
+	}
+}
+
 func (e *comparableValueExtractor) extractFromObject(object *storobj.Object, propName string) interface{} {
 	if propName == filters.InternalPropID || propName == filters.InternalPropBackwardsCompatID {
 		id := object.ID().String()
@@ -93,6 +99,20 @@ func (e *comparableValueExtractor) extractFromObject(object *storobj.Object, pro
 	if !ok {
 		return nil
 	}
+	if schema.IsNestedPropertyPath(propName) {
+		path := strings.Split(propName, ".")
+		values := schema.NestedValues(propertiesMap[path[0]], path[1:])
+		if len(values) == 0 {
+			return nil
+		}
+		// nested values are kept in their json representation, so they are
+		// compared the same way as values read from disk
+		asStrings := make([]string, len(values))
+		for i := range values {
+			asStrings[i] = fmt.Sprint(values[i])
+		}
+		return e.extractFromStrings(asStrings, propName)
+	}
 	value, ok := propertiesMap[propName]
 	if !ok {
 		return nil
@@ -42,10 +42,8 @@ func (h *dataTypesHelper) find(propName string) []string {
 	if propName == filters.InternalPropCreationTimeUnix || propName == filters.InternalPropLastUpdateTimeUnix {
 		return []string{string(schema.DataTypeInt)}
 	}
-	for _, property := range h.class.Properties {
-		if property.Name == propName {
-			return property.DataType
-		}
+	if property, err := schema.GetPropertyByName(h.class, propName); err == nil {
+		return property.DataType
 	}
 	return nil
 }
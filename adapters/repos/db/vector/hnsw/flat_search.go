@@ -18,6 +18,16 @@ import (
 
 func (h *hnsw) flatSearch(queryVector []float32, limit int,
 	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return h.flatSearchByDistance(func(nodeID uint64) (float32, bool, error) {
+		return h.distBetweenNodeAndVec(nodeID, queryVector)
+	}, limit, allowList)
+}
+
+// flatSearchByDistance scores every node on the allow list with the given
+// distance func and returns the closest ones
+func (h *hnsw) flatSearchByDistance(distance func(nodeID uint64) (float32, bool, error),
+	limit int, allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
 	results := priorityqueue.NewMax(limit)
 
@@ -39,7 +49,7 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 			continue
 		}
 		h.RUnlock()
-		dist, ok, err := h.distBetweenNodeAndVec(candidate, queryVector)
+		dist, ok, err := distance(candidate)
 		if err != nil {
 			return nil, nil, err
 		}
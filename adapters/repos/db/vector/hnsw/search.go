@@ -89,6 +89,16 @@ func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowLi
 // passed in to truly obtain all results from the vector index.
 func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64,
 	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return h.searchByDistance(func(k int) ([]uint64, []float32, error) {
+		return h.SearchByVector(vector, k, allowList)
+	}, targetDistance, maxLimit)
+}
+
+// searchByDistance calls the knn search recursively with growing k until
+// its results contain all nodes within the target distance
+func (h *hnsw) searchByDistance(search func(k int) ([]uint64, []float32, error),
+	targetDistance float32, maxLimit int64,
 ) ([]uint64, []float32, error) {
 	var (
 		searchParams = newSearchByDistParams(maxLimit)
@@ -100,7 +110,7 @@ func (h *hnsw) SearchByVectorDistance(vector []float32, targetDistance float32,
 	recursiveSearch := func() (bool, error) {
 		shouldContinue := false
 
-		ids, dist, err := h.SearchByVector(vector, searchParams.totalLimit, allowList)
+		ids, dist, err := search(searchParams.totalLimit)
 		if err != nil {
 			return false, errors.Wrap(err, "vector search")
 		}
@@ -161,12 +171,6 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
 	allowList helpers.AllowList) (*priorityqueue.Queue, error,
 ) {
-	h.pools.visitedListsLock.Lock()
-	visited := h.pools.visitedLists.Borrow()
-	h.pools.visitedListsLock.Unlock()
-
-	candidates := h.pools.pqCandidates.GetMin(ef)
-	results := h.pools.pqResults.GetMax(ef)
 	var floatDistancer distancer.Distancer
 	var byteDistancer *ssdhelpers.PQDistancer
 	if h.compressed.Load() {
@@ -175,6 +179,24 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 		floatDistancer = h.distancerProvider.New(queryVector)
 	}
 
+	return h.searchLayerByDistancer(floatDistancer, byteDistancer, entrypoints,
+		ef, level, allowList)
+}
+
+// searchLayerByDistancer searches the layer for the nodes closest to the
+// query the distancer was created for. Only one of the distancers is used,
+// byteDistancer if the index is compressed, floatDistancer otherwise.
+func (h *hnsw) searchLayerByDistancer(floatDistancer distancer.Distancer,
+	byteDistancer *ssdhelpers.PQDistancer, entrypoints *priorityqueue.Queue,
+	ef int, level int, allowList helpers.AllowList) (*priorityqueue.Queue, error,
+) {
+	h.pools.visitedListsLock.Lock()
+	visited := h.pools.visitedLists.Borrow()
+	h.pools.visitedListsLock.Unlock()
+
+	candidates := h.pools.pqCandidates.GetMin(ef)
+	results := h.pools.pqResults.GetMax(ef)
+
 	h.insertViableEntrypointsAsCandidatesAndResults(entrypoints, candidates,
 		results, level, visited, allowList)
 	var worstResultDistance float32
@@ -475,13 +497,49 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 			"it has been flagged for cleanup and should be fixed in the next cleanup cycle")
 	}
 
+	var floatDistancer distancer.Distancer
+	var byteDistancer *ssdhelpers.PQDistancer
+	if h.compressed.Load() {
+		byteDistancer = h.pq.NewDistancer(searchVec)
+	} else {
+		floatDistancer = h.distancerProvider.New(searchVec)
+	}
+
+	return h.knnSearchFromEntrypoint(floatDistancer, byteDistancer,
+		entryPointID, entryPointDistance, k, ef, allowList)
+}
+
+// knnSearchFromEntrypoint descends from the entrypoint to the lowest layer
+// and returns the k nodes closest to the query of the distancer
+func (h *hnsw) knnSearchFromEntrypoint(floatDistancer distancer.Distancer,
+	byteDistancer *ssdhelpers.PQDistancer, entryPointID uint64,
+	entryPointDistance float32, k int, ef int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	entryPointID, entryPointDistance, err := h.descendToLowestLayer(floatDistancer,
+		byteDistancer, entryPointID, entryPointDistance)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	eps := priorityqueue.NewMin(10)
+	eps.Insert(entryPointID, entryPointDistance)
+	return h.knnSearchLowestLayer(floatDistancer, byteDistancer, eps, k, ef, allowList)
+}
+
+// descendToLowestLayer greedily searches the layers above the lowest one for
+// the node closest to the query of the distancer, which serves as the
+// entrypoint into the lowest layer
+func (h *hnsw) descendToLowestLayer(floatDistancer distancer.Distancer,
+	byteDistancer *ssdhelpers.PQDistancer, entryPointID uint64,
+	entryPointDistance float32,
+) (uint64, float32, error) {
 	// stop at layer 1, not 0!
 	for level := h.currentMaximumLayer; level >= 1; level-- {
 		eps := priorityqueue.NewMin(10)
 		eps.Insert(entryPointID, entryPointDistance)
-		res, err := h.searchLayerByVector(searchVec, eps, 1, level, nil)
+		res, err := h.searchLayerByDistancer(floatDistancer, byteDistancer, eps, 1, level, nil)
 		if err != nil {
-			return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", level)
+			return 0, 0, errors.Wrapf(err, "knn search: search layer at level %d", level)
 		}
 
 		// There might be situations where we did not find a better entrypoint at
@@ -499,7 +557,7 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 				// deleted, but not cleaned up properly. Make sure to add a tombstone to
 				// this node, so it can be cleaned up in the next cycle.
 				if err := h.addTombstone(cand.ID); err != nil {
-					return nil, nil, err
+					return 0, 0, err
 				}
 
 				// skip the nil node, as it does not make a valid entrypoint
@@ -520,9 +578,16 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 		h.pools.pqResults.pool.Put(res)
 	}
 
-	eps := priorityqueue.NewMin(10)
-	eps.Insert(entryPointID, entryPointDistance)
-	res, err := h.searchLayerByVector(searchVec, eps, ef, 0, allowList)
+	return entryPointID, entryPointDistance, nil
+}
+
+// knnSearchLowestLayer returns the k nodes of the lowest layer closest to the
+// query of the distancer, starting the search at the given entrypoints
+func (h *hnsw) knnSearchLowestLayer(floatDistancer distancer.Distancer,
+	byteDistancer *ssdhelpers.PQDistancer, eps *priorityqueue.Queue,
+	k int, ef int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	res, err := h.searchLayerByDistancer(floatDistancer, byteDistancer, eps, ef, 0, allowList)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
 	}
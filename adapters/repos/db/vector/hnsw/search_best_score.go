@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+)
+
+// bestScoreDistancer scores a vector by its distance to the closest positive
+// example minus its distance to the closest negative example. The lower the
+// score, the more similar the vector is to any of the positives and the less
+// similar to all of the negatives.
+type bestScoreDistancer struct {
+	positives []distancer.Distancer
+	negatives []distancer.Distancer
+}
+
+func (d *bestScoreDistancer) Distance(vec []float32) (float32, bool, error) {
+	positive, err := closestDistance(d.positives, vec)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(d.negatives) == 0 {
+		return positive, true, nil
+	}
+
+	negative, err := closestDistance(d.negatives, vec)
+	if err != nil {
+		return 0, false, err
+	}
+	return positive - negative, true, nil
+}
+
+func closestDistance(distancers []distancer.Distancer, vec []float32) (float32, error) {
+	closest := float32(math.MaxFloat32)
+	for _, d := range distancers {
+		dist, _, err := d.Distance(vec)
+		if err != nil {
+			return 0, err
+		}
+		if dist < closest {
+			closest = dist
+		}
+	}
+	return closest, nil
+}
+
+func (h *hnsw) newBestScoreDistancer(positives, negatives [][]float32) *bestScoreDistancer {
+	newDistancers := func(vectors [][]float32) []distancer.Distancer {
+		out := make([]distancer.Distancer, len(vectors))
+		for i, vec := range vectors {
+			if h.distancerProvider.Type() == "cosine-dot" {
+				// cosine-dot requires normalized vectors, see SearchByVector
+				vec = distancer.Normalize(vec)
+			}
+			out[i] = h.distancerProvider.New(vec)
+		}
+		return out
+	}
+
+	return &bestScoreDistancer{
+		positives: newDistancers(positives),
+		negatives: newDistancers(negatives),
+	}
+}
+
+// SearchByBestScore returns the k nodes with the best score, i.e. the
+// smallest distance to the closest positive example minus the distance to
+// the closest negative example. The score is evaluated while traversing the
+// graph: every positive example provides an entrypoint into the lowest layer,
+// from which the search continues by score.
+func (h *hnsw) SearchByBestScore(positives, negatives [][]float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	if len(positives) == 0 {
+		return nil, nil, errors.Errorf("best score search requires at least one positive example")
+	}
+	if h.compressed.Load() {
+		return nil, nil, errors.Errorf("best score search is not supported on a compressed index")
+	}
+
+	scorer := h.newBestScoreDistancer(positives, negatives)
+	score := func(nodeID uint64) (float32, bool, error) {
+		return h.distanceToFloatNode(scorer, nodeID)
+	}
+
+	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
+	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
+		return h.flatSearchByDistance(score, k, allowList)
+	}
+
+	if h.isEmpty() {
+		return nil, nil, nil
+	}
+
+	entryPointID := h.entryPointID
+	eps := priorityqueue.NewMin(len(scorer.positives))
+	seen := map[uint64]struct{}{}
+	for _, positive := range scorer.positives {
+		dist, ok, err := h.distanceToFloatNode(positive, entryPointID)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "best score search: distance between entrypoint and example")
+		}
+		if !ok {
+			return nil, nil, fmt.Errorf("entrypoint was deleted in the object store, " +
+				"it has been flagged for cleanup and should be fixed in the next cleanup cycle")
+		}
+
+		id, _, err := h.descendToLowestLayer(positive, nil, entryPointID, dist)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		idScore, ok, err := score(id)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "best score search: score entrypoint")
+		}
+		if ok {
+			eps.Insert(id, idScore)
+		}
+	}
+
+	return h.knnSearchLowestLayer(scorer, nil, eps, k, h.searchTimeEF(k), allowList)
+}
+
+// SearchByBestScoreDistance wraps SearchByBestScore like
+// SearchByVectorDistance wraps SearchByVector
+func (h *hnsw) SearchByBestScoreDistance(positives, negatives [][]float32,
+	targetDistance float32, maxLimit int64, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return h.searchByDistance(func(k int) ([]uint64, []float32, error) {
+		return h.SearchByBestScore(positives, negatives, k, allowList)
+	}, targetDistance, maxLimit)
+}
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSearchByBestScore(t *testing.T) {
+	vectors := [][]float32{
+		{0, 0},
+		{1, 0},
+		{10, 0},
+		{11, 0},
+		{0, 10},
+		{50, 50},
+	}
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "best-score",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        128,
+		VectorCacheMaxObjects: 100000,
+		FlatSearchCutoff:      100,
+	})
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	positives := [][]float32{{0, 0}, {10, 0}}
+	negatives := [][]float32{{0, 10}}
+
+	t.Run("positives and negatives", func(t *testing.T) {
+		ids, dists, err := index.SearchByBestScore(positives, negatives, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{3, 2}, ids)
+		assert.Equal(t, []float32{-220, -200}, dists)
+	})
+
+	t.Run("only positives", func(t *testing.T) {
+		ids, _, err := index.SearchByBestScore(positives[:1], nil, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1}, ids)
+	})
+
+	t.Run("with allow list", func(t *testing.T) {
+		ids, dists, err := index.SearchByBestScore(positives, negatives, 10,
+			helpers.NewAllowList(1, 4, 5))
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 5, 4}, ids)
+		assert.Equal(t, []float32{-100, 0, 100}, dists)
+	})
+
+	t.Run("by distance", func(t *testing.T) {
+		ids, _, err := index.SearchByBestScoreDistance(positives, negatives, -150, 100, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{3, 2}, ids)
+	})
+
+	t.Run("without positives", func(t *testing.T) {
+		_, _, err := index.SearchByBestScore(nil, negatives, 2, nil)
+		assert.NotNil(t, err)
+	})
+}
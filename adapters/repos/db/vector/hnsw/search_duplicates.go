@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// SearchDuplicates walks all nodes of the graph and calls fn for every node
+// that has at least one other node within maxDistance, passing the ids of all
+// such nodes. The neighbors on the lowest layer serve as a cheap pre-check:
+// the neighbor selection heuristic always keeps the closest candidate, so a
+// node without any neighbor within maxDistance is skipped without running a
+// search.
+func (h *hnsw) SearchDuplicates(ctx context.Context, maxDistance float32,
+	fn func(id uint64, duplicates []uint64) error,
+) error {
+	if h.compressed.Load() {
+		return errors.Errorf("duplicate search is not supported on a compressed index")
+	}
+
+	h.RLock()
+	maxNodes := len(h.nodes)
+	h.RUnlock()
+
+	for i := 0; i < maxNodes; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		id := uint64(i)
+		h.RLock()
+		node := h.nodes[i]
+		h.RUnlock()
+
+		if node == nil || h.hasTombstone(id) {
+			continue
+		}
+
+		node.Lock()
+		var neighbors []uint64
+		if len(node.connections) > 0 {
+			neighbors = make([]uint64, len(node.connections[0]))
+			copy(neighbors, node.connections[0])
+		}
+		node.Unlock()
+
+		hasCloseNeighbor, err := h.hasNeighborWithin(id, neighbors, maxDistance)
+		if err != nil {
+			return err
+		}
+		if !hasCloseNeighbor {
+			continue
+		}
+
+		vec, err := h.vectorForID(ctx, id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				continue
+			}
+			return errors.Wrapf(err, "get vector of docID %d", id)
+		}
+
+		ids, _, err := h.SearchByVectorDistance(vec, maxDistance, -1, nil)
+		if err != nil {
+			return errors.Wrapf(err, "search duplicates of docID %d", id)
+		}
+
+		duplicates := make([]uint64, 0, len(ids))
+		for _, other := range ids {
+			if other != id {
+				duplicates = append(duplicates, other)
+			}
+		}
+		if len(duplicates) == 0 {
+			continue
+		}
+
+		if err := fn(id, duplicates); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *hnsw) hasNeighborWithin(id uint64, neighbors []uint64,
+	maxDistance float32,
+) (bool, error) {
+	for _, neighbor := range neighbors {
+		if h.hasTombstone(neighbor) {
+			continue
+		}
+
+		dist, ok, err := h.distBetweenNodes(id, neighbor)
+		if err != nil {
+			return false, err
+		}
+		if ok && dist <= maxDistance {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSearchDuplicates(t *testing.T) {
+	vectors := [][]float32{
+		{0, 0},
+		{0.1, 0},
+		{10, 0},
+		{-0.2, 0},
+		{50, 50},
+		{50, 50.1},
+	}
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "duplicates",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        128,
+		VectorCacheMaxObjects: 100000,
+	})
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	t.Run("all duplicates within distance", func(t *testing.T) {
+		found := map[uint64][]uint64{}
+		err := index.SearchDuplicates(context.Background(), 0.05,
+			func(id uint64, duplicates []uint64) error {
+				found[id] = duplicates
+				return nil
+			})
+		require.Nil(t, err)
+
+		assert.Len(t, found, 5)
+		assert.ElementsMatch(t, []uint64{1, 3}, found[0])
+		assert.ElementsMatch(t, []uint64{0}, found[1])
+		assert.ElementsMatch(t, []uint64{0}, found[3])
+		assert.ElementsMatch(t, []uint64{5}, found[4])
+		assert.ElementsMatch(t, []uint64{4}, found[5])
+	})
+
+	t.Run("deleted nodes are skipped", func(t *testing.T) {
+		require.Nil(t, index.Delete(5))
+
+		found := map[uint64][]uint64{}
+		err := index.SearchDuplicates(context.Background(), 0.05,
+			func(id uint64, duplicates []uint64) error {
+				found[id] = duplicates
+				return nil
+			})
+		require.Nil(t, err)
+
+		assert.NotContains(t, found, uint64(4))
+		assert.NotContains(t, found, uint64(5))
+	})
+
+	t.Run("callback errors abort the walk", func(t *testing.T) {
+		calls := 0
+		err := index.SearchDuplicates(context.Background(), 0.05,
+			func(id uint64, duplicates []uint64) error {
+				calls++
+				return errors.New("stop")
+			})
+		assert.EqualError(t, err, "stop")
+		assert.Equal(t, 1, calls)
+	})
+}
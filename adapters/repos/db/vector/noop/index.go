@@ -45,6 +45,18 @@ func (i *Index) SearchByVectorDistance(vector []float32, dist float32, maxLimit
 	return nil, nil, errors.Errorf("cannot vector-search on a class not vector-indexed")
 }
 
+func (i *Index) SearchByBestScore(positives, negatives [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("cannot vector-search on a class not vector-indexed")
+}
+
+func (i *Index) SearchByBestScoreDistance(positives, negatives [][]float32, dist float32, maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("cannot vector-search on a class not vector-indexed")
+}
+
+func (i *Index) SearchDuplicates(ctx context.Context, maxDistance float32, fn func(id uint64, duplicates []uint64) error) error {
+	return errors.Errorf("cannot vector-search on a class not vector-indexed")
+}
+
 func (i *Index) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
 	callback()
 	switch t := updated.(type) {
@@ -225,6 +225,49 @@ func (m *KMeans) Fit(data [][]float32) error { // init centers using min/max per
 	return nil
 }
 
+// FitMiniBatch fits the centers with mini-batch k-means. Every iteration
+// assigns a random sample of batchSize points to their nearest center and
+// moves each center towards its points with a learning rate that decreases
+// with the number of points the center has seen so far. It needs a fraction
+// of the distance calculations of Fit on large data, at the cost of slightly
+// less accurate centers. It always runs IterationThreshold iterations.
+func (m *KMeans) FitMiniBatch(data [][]float32, batchSize int) error {
+	dataSize := len(data)
+	if dataSize < m.K {
+		return errors.New("Too few data to fit KMeans")
+	}
+	if batchSize <= 0 || batchSize > dataSize {
+		batchSize = dataSize
+	}
+	m.initCenters(data)
+
+	seen := make([]int, m.K)
+	batch := make([]int, batchSize)
+	assigned := make([]uint64, batchSize)
+	for i := 0; i < m.IterationThreshold; i++ {
+		for j := range batch {
+			p := rand.Intn(dataSize)
+			for data[p] == nil {
+				p = rand.Intn(dataSize)
+			}
+			batch[j] = p
+			assigned[j] = m.Nearest(data[p])
+		}
+
+		for j, p := range batch {
+			ci := assigned[j]
+			seen[ci]++
+			rate := 1 / float32(seen[ci])
+			v := data[p][m.segment*m.dimensions : (m.segment+1)*m.dimensions]
+			for d := range m.centers[ci] {
+				m.centers[ci][d] += rate * (v[d] - m.centers[ci][d])
+			}
+		}
+	}
+
+	return nil
+}
+
 func (m *KMeans) clearData() {
 	m.data.points = nil
 	m.data.cc = nil
@@ -64,3 +64,34 @@ func Test_NoRaceRandomData(t *testing.T) {
 	kmeans.Fit(vectors)
 	assert.True(t, time.Since(before).Seconds() < 50)
 }
+
+func Test_NoRaceKMeansFitMiniBatch(t *testing.T) {
+	vectors := [][]float32{
+		{0, 5},
+		{0.1, 4.9},
+		{0.01, 5.1},
+		{10, 10},
+		{10.1, 9.9},
+		{9.9, 10.1},
+	}
+	// seed the centers with one point of each group, so the result does not
+	// depend on the random initialization
+	kmeans := ssdhelpers.NewKMeansWithCenters(2, 2, 0, [][]float32{{0, 5}, {10, 10}})
+	kmeans.IterationThreshold = 20
+
+	assert.Nil(t, kmeans.FitMiniBatch(vectors, 3))
+	assert.Equal(t, kmeans.Nearest(vectors[0]), kmeans.Nearest(vectors[1]))
+	assert.Equal(t, kmeans.Nearest(vectors[0]), kmeans.Nearest(vectors[2]))
+	assert.Equal(t, kmeans.Nearest(vectors[3]), kmeans.Nearest(vectors[4]))
+	assert.Equal(t, kmeans.Nearest(vectors[3]), kmeans.Nearest(vectors[5]))
+	assert.NotEqual(t, kmeans.Nearest(vectors[0]), kmeans.Nearest(vectors[3]))
+
+	centroid := kmeans.Centroid(kmeans.Nearest(vectors[3]))
+	assert.InDelta(t, 10, centroid[0], 0.2)
+	assert.InDelta(t, 10, centroid[1], 0.2)
+}
+
+func Test_NoRaceKMeansFitMiniBatchTooFewData(t *testing.T) {
+	kmeans := ssdhelpers.NewKMeans(3, 2, 0)
+	assert.NotNil(t, kmeans.FitMiniBatch([][]float32{{0, 1}, {1, 0}}, 10))
+}
@@ -27,6 +27,12 @@ type VectorIndex interface {
 	SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
 	SearchByVectorDistance(vector []float32, dist float32,
 		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchByBestScore(positives, negatives [][]float32, k int,
+		allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchByBestScoreDistance(positives, negatives [][]float32, dist float32,
+		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchDuplicates(ctx context.Context, maxDistance float32,
+		fn func(id uint64, duplicates []uint64) error) error
 	UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error
 	Drop(ctx context.Context) error
 	Shutdown(ctx context.Context) error
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package deduplications
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/deduplication"
+)
+
+const DefaultTxTTL = 60 * time.Second
+
+type DistributedRepo struct {
+	sync.RWMutex
+	txRemote  *cluster.TxManager
+	localRepo localRepo
+}
+
+type localRepo interface {
+	Get(ctx context.Context, id strfmt.UUID) (*models.Deduplication, error)
+	Put(ctx context.Context, deduplication models.Deduplication) error
+	GetResult(ctx context.Context, id strfmt.UUID) (*models.DeduplicationResult, error)
+	PutResult(ctx context.Context, id strfmt.UUID,
+		result models.DeduplicationResult) error
+}
+
+func NewDistributeRepo(remoteClient cluster.Client,
+	memberLister cluster.MemberLister, localRepo localRepo,
+	logger logrus.FieldLogger,
+) *DistributedRepo {
+	broadcaster := cluster.NewTxBroadcaster(memberLister, remoteClient)
+	txRemote := cluster.NewTxManager(broadcaster, logger)
+	repo := &DistributedRepo{
+		txRemote:  txRemote,
+		localRepo: localRepo,
+	}
+
+	repo.txRemote.SetCommitFn(repo.incomingCommit)
+
+	return repo
+}
+
+func (r *DistributedRepo) Get(ctx context.Context,
+	id strfmt.UUID,
+) (*models.Deduplication, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.localRepo.Get(ctx, id)
+}
+
+func (r *DistributedRepo) Put(ctx context.Context,
+	pl models.Deduplication,
+) error {
+	r.Lock()
+	defer r.Unlock()
+
+	tx, err := r.txRemote.BeginTransaction(ctx, deduplication.TransactionPut,
+		deduplication.TransactionPutPayload{
+			Deduplication: pl,
+		}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	err = r.txRemote.CommitWriteTransaction(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return r.localRepo.Put(ctx, pl)
+}
+
+func (r *DistributedRepo) GetResult(ctx context.Context,
+	id strfmt.UUID,
+) (*models.DeduplicationResult, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.localRepo.GetResult(ctx, id)
+}
+
+func (r *DistributedRepo) PutResult(ctx context.Context, id strfmt.UUID,
+	result models.DeduplicationResult,
+) error {
+	r.Lock()
+	defer r.Unlock()
+
+	tx, err := r.txRemote.BeginTransaction(ctx, deduplication.TransactionPutResult,
+		deduplication.TransactionPutResultPayload{
+			ID:     id,
+			Result: result,
+		}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	err = r.txRemote.CommitWriteTransaction(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return r.localRepo.PutResult(ctx, id, result)
+}
+
+func (r *DistributedRepo) incomingCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	switch tx.Type {
+	case deduplication.TransactionPut:
+		return r.localRepo.Put(ctx, tx.Payload.(deduplication.TransactionPutPayload).
+			Deduplication)
+	case deduplication.TransactionPutResult:
+		pl := tx.Payload.(deduplication.TransactionPutResultPayload)
+		return r.localRepo.PutResult(ctx, pl.ID, pl.Result)
+	default:
+		return errors.Errorf("unrecognized tx type: %s", tx.Type)
+	}
+}
+
+func (r *DistributedRepo) TxManager() *cluster.TxManager {
+	return r.txRemote
+}
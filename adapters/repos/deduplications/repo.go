@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package deduplications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/deduplication"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	deduplicationsBucket = []byte("deduplications")
+	resultsBucket        = []byte("deduplication_results")
+)
+
+type Repo struct {
+	logger  logrus.FieldLogger
+	baseDir string
+	db      *bolt.DB
+}
+
+func NewRepo(baseDir string, logger logrus.FieldLogger) (*Repo, error) {
+	r := &Repo{
+		baseDir: baseDir,
+		logger:  logger,
+	}
+
+	err := r.init()
+	return r, err
+}
+
+func (r *Repo) DBPath() string {
+	return fmt.Sprintf("%s/deduplications.db", r.baseDir)
+}
+
+func (r *Repo) keyFromID(id strfmt.UUID) []byte {
+	return []byte(id)
+}
+
+func (r *Repo) init() error {
+	if err := os.MkdirAll(r.baseDir, 0o777); err != nil {
+		return errors.Wrapf(err, "create root path directory at %s", r.baseDir)
+	}
+
+	boltdb, err := bolt.Open(r.DBPath(), 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open bolt at %s", r.DBPath())
+	}
+
+	err = boltdb.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(deduplicationsBucket); err != nil {
+			return errors.Wrapf(err, "create deduplications bucket '%s'",
+				string(helpers.ObjectsBucket))
+		}
+		if _, err := tx.CreateBucketIfNotExists(resultsBucket); err != nil {
+			return errors.Wrapf(err, "create results bucket '%s'",
+				string(resultsBucket))
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "create bolt buckets")
+	}
+
+	r.db = boltdb
+
+	return nil
+}
+
+func (r *Repo) Put(ctx context.Context, deduplication models.Deduplication) error {
+	deduplicationJSON, err := json.Marshal(deduplication)
+	if err != nil {
+		return errors.Wrap(err, "marshal deduplication to JSON")
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(deduplicationsBucket)
+		return b.Put(r.keyFromID(deduplication.ID), deduplicationJSON)
+	})
+}
+
+func (r *Repo) Get(ctx context.Context, id strfmt.UUID) (*models.Deduplication, error) {
+	var deduplicationJSON []byte
+	r.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(deduplicationsBucket)
+		deduplicationJSON = b.Get(r.keyFromID(id))
+		return nil
+	})
+
+	if len(deduplicationJSON) == 0 {
+		return nil, nil
+	}
+
+	var c models.Deduplication
+	err := json.Unmarshal(deduplicationJSON, &c)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse deduplication from JSON")
+	}
+
+	return &c, nil
+}
+
+func (r *Repo) PutResult(ctx context.Context, id strfmt.UUID,
+	result models.DeduplicationResult,
+) error {
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return errors.Wrap(err, "marshal deduplication result to JSON")
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(resultsBucket)
+		return b.Put(r.keyFromID(id), resultJSON)
+	})
+}
+
+func (r *Repo) GetResult(ctx context.Context,
+	id strfmt.UUID,
+) (*models.DeduplicationResult, error) {
+	var resultJSON []byte
+	r.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(resultsBucket)
+		resultJSON = b.Get(r.keyFromID(id))
+		return nil
+	})
+
+	if len(resultJSON) == 0 {
+		return nil, nil
+	}
+
+	var res models.DeduplicationResult
+	err := json.Unmarshal(resultJSON, &res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse deduplication result from JSON")
+	}
+
+	return &res, nil
+}
+
+var _ = deduplication.Repo(&Repo{})
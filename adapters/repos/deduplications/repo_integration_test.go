@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package deduplications
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_DeduplicationsRepo(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+
+	r, err := NewRepo(dirName, logger)
+	require.Nil(t, err)
+	_ = r
+
+	t.Run("asking for a non-existing deduplication", func(t *testing.T) {
+		res, err := r.Get(context.Background(), "wrong-id")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("storing deduplications", func(t *testing.T) {
+		err := r.Put(context.Background(), exampleOne())
+		require.Nil(t, err)
+
+		err = r.Put(context.Background(), exampleTwo())
+		require.Nil(t, err)
+	})
+
+	t.Run("retrieving stored deduplications", func(t *testing.T) {
+		expectedOne := exampleOne()
+		expectedTwo := exampleTwo()
+
+		res, err := r.Get(context.Background(), expectedOne.ID)
+		require.Nil(t, err)
+		assert.Equal(t, &expectedOne, res)
+
+		res, err = r.Get(context.Background(), expectedTwo.ID)
+		require.Nil(t, err)
+		assert.Equal(t, &expectedTwo, res)
+	})
+
+	t.Run("asking for a non-existing result", func(t *testing.T) {
+		res, err := r.GetResult(context.Background(), exampleOne().ID)
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("storing and retrieving a result", func(t *testing.T) {
+		result := models.DeduplicationResult{
+			Groups: []*models.DeduplicationGroup{{
+				Keep:       "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+				Duplicates: []strfmt.UUID{"9f119c4f-80da-4ae5-bfd1-e4b63054125f"},
+			}},
+		}
+
+		err := r.PutResult(context.Background(), exampleOne().ID, result)
+		require.Nil(t, err)
+
+		res, err := r.GetResult(context.Background(), exampleOne().ID)
+		require.Nil(t, err)
+		assert.Equal(t, &result, res)
+	})
+}
+
+var (
+	distanceOne = 0.01
+	distanceTwo = 0.2
+)
+
+func exampleOne() models.Deduplication {
+	return models.Deduplication{
+		ID:       "01ed111a-919c-4dd5-ab9e-7b247b11e18c",
+		Class:    "ExampleClassOne",
+		Distance: &distanceOne,
+	}
+}
+
+func exampleTwo() models.Deduplication {
+	return models.Deduplication{
+		ID:       "4fbaebf3-41a9-414b-ac1d-433d74d4ef2c",
+		Class:    "ExampleClassTwo",
+		Distance: &distanceTwo,
+	}
+}
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package roles
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const DefaultTxTTL = 60 * time.Second
+
+// DistributedRepo replicates every change of a role to all nodes of the
+// cluster using a cluster-wide transaction
+type DistributedRepo struct {
+	sync.RWMutex
+	txRemote  *cluster.TxManager
+	localRepo localRepo
+}
+
+type localRepo interface {
+	Put(ctx context.Context, role models.Role) error
+	Get(ctx context.Context, name string) (*models.Role, error)
+	List(ctx context.Context) ([]*models.Role, error)
+	Delete(ctx context.Context, name string) error
+}
+
+func NewDistributedRepo(remoteClient cluster.Client,
+	memberLister cluster.MemberLister, localRepo localRepo,
+	logger logrus.FieldLogger,
+) *DistributedRepo {
+	broadcaster := cluster.NewTxBroadcaster(memberLister, remoteClient)
+	txRemote := cluster.NewTxManager(broadcaster, logger)
+	repo := &DistributedRepo{
+		txRemote:  txRemote,
+		localRepo: localRepo,
+	}
+
+	repo.txRemote.SetCommitFn(repo.incomingCommit)
+
+	return repo
+}
+
+func (r *DistributedRepo) Get(ctx context.Context,
+	name string,
+) (*models.Role, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.localRepo.Get(ctx, name)
+}
+
+func (r *DistributedRepo) List(ctx context.Context) ([]*models.Role, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.localRepo.List(ctx)
+}
+
+func (r *DistributedRepo) Put(ctx context.Context,
+	role models.Role,
+) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.broadcast(ctx, rbac.TransactionPutRole,
+		rbac.TransactionPutRolePayload{Role: role}); err != nil {
+		return err
+	}
+
+	return r.localRepo.Put(ctx, role)
+}
+
+func (r *DistributedRepo) Delete(ctx context.Context, name string) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if err := r.broadcast(ctx, rbac.TransactionDeleteRole,
+		rbac.TransactionDeleteRolePayload{Name: name}); err != nil {
+		return err
+	}
+
+	return r.localRepo.Delete(ctx, name)
+}
+
+func (r *DistributedRepo) broadcast(ctx context.Context,
+	txType cluster.TransactionType, payload interface{},
+) error {
+	tx, err := r.txRemote.BeginTransaction(ctx, txType, payload, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	err = r.txRemote.CommitWriteTransaction(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return nil
+}
+
+func (r *DistributedRepo) incomingCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	switch tx.Type {
+	case rbac.TransactionPutRole:
+		return r.localRepo.Put(ctx, tx.Payload.(rbac.TransactionPutRolePayload).
+			Role)
+	case rbac.TransactionDeleteRole:
+		return r.localRepo.Delete(ctx, tx.Payload.(rbac.TransactionDeleteRolePayload).
+			Name)
+	default:
+		return errors.Errorf("unrecognized tx type: %s", tx.Type)
+	}
+}
+
+func (r *DistributedRepo) TxManager() *cluster.TxManager {
+	return r.txRemote
+}
+
+var _ = rbac.RoleRepo(&DistributedRepo{})
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package roles
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	bolt "go.etcd.io/bbolt"
+)
+
+var rolesBucket = []byte("roles")
+
+// Repo stores the roles of the local node. All roles are additionally kept in
+// memory, so they can be read on every authorization decision.
+type Repo struct {
+	logger  logrus.FieldLogger
+	baseDir string
+	db      *bolt.DB
+
+	sync.RWMutex
+	cache map[string]*models.Role
+}
+
+func NewRepo(baseDir string, logger logrus.FieldLogger) (*Repo, error) {
+	r := &Repo{
+		baseDir: baseDir,
+		logger:  logger,
+		cache:   map[string]*models.Role{},
+	}
+
+	err := r.init()
+	return r, err
+}
+
+func (r *Repo) DBPath() string {
+	return fmt.Sprintf("%s/roles.db", r.baseDir)
+}
+
+func (r *Repo) init() error {
+	if err := os.MkdirAll(r.baseDir, 0o777); err != nil {
+		return errors.Wrapf(err, "create root path directory at %s", r.baseDir)
+	}
+
+	boltdb, err := bolt.Open(r.DBPath(), 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open bolt at %s", r.DBPath())
+	}
+
+	err = boltdb.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(rolesBucket); err != nil {
+			return errors.Wrapf(err, "create roles bucket '%s'",
+				string(rolesBucket))
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "create bolt buckets")
+	}
+
+	r.db = boltdb
+
+	return r.loadCache()
+}
+
+func (r *Repo) loadCache() error {
+	return r.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(rolesBucket)
+		return b.ForEach(func(k, v []byte) error {
+			var role models.Role
+			if err := json.Unmarshal(v, &role); err != nil {
+				return errors.Wrapf(err, "parse role %q from JSON", k)
+			}
+			r.cache[role.Name] = &role
+			return nil
+		})
+	})
+}
+
+func (r *Repo) Put(ctx context.Context, role models.Role) error {
+	roleJSON, err := json.Marshal(role)
+	if err != nil {
+		return errors.Wrap(err, "marshal role to JSON")
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	err = r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(rolesBucket)
+		return b.Put([]byte(role.Name), roleJSON)
+	})
+	if err != nil {
+		return err
+	}
+
+	r.cache[role.Name] = &role
+	return nil
+}
+
+func (r *Repo) Get(ctx context.Context, name string) (*models.Role, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	role, ok := r.cache[name]
+	if !ok {
+		return nil, nil
+	}
+
+	return role, nil
+}
+
+func (r *Repo) List(ctx context.Context) ([]*models.Role, error) {
+	return r.Roles(), nil
+}
+
+func (r *Repo) Delete(ctx context.Context, name string) error {
+	r.Lock()
+	defer r.Unlock()
+
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(rolesBucket)
+		return b.Delete([]byte(name))
+	})
+	if err != nil {
+		return err
+	}
+
+	delete(r.cache, name)
+	return nil
+}
+
+// Roles returns all roles from memory. It is used as the role source of the
+// rbac authorizer.
+func (r *Repo) Roles() []*models.Role {
+	r.RLock()
+	defer r.RUnlock()
+
+	roles := make([]*models.Role, 0, len(r.cache))
+	for _, role := range r.cache {
+		roles = append(roles, role)
+	}
+	return roles
+}
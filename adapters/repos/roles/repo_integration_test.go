@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package roles
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_RolesRepo(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+
+	r, err := NewRepo(dirName, logger)
+	require.Nil(t, err)
+
+	t.Run("asking for a non-existing role", func(t *testing.T) {
+		res, err := r.Get(context.Background(), "wrong-name")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("storing roles", func(t *testing.T) {
+		require.Nil(t, r.Put(context.Background(), exampleOne()))
+		require.Nil(t, r.Put(context.Background(), exampleTwo()))
+	})
+
+	t.Run("retrieving stored roles", func(t *testing.T) {
+		expectedOne := exampleOne()
+		expectedTwo := exampleTwo()
+
+		res, err := r.Get(context.Background(), expectedOne.Name)
+		require.Nil(t, err)
+		assert.Equal(t, &expectedOne, res)
+
+		all, err := r.List(context.Background())
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []*models.Role{&expectedOne, &expectedTwo}, all)
+	})
+
+	t.Run("deleting a role", func(t *testing.T) {
+		require.Nil(t, r.Delete(context.Background(), exampleOne().Name))
+
+		res, err := r.Get(context.Background(), exampleOne().Name)
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
+		assert.Len(t, r.Roles(), 1)
+	})
+
+	t.Run("roles are loaded from disk on restart", func(t *testing.T) {
+		require.Nil(t, r.db.Close())
+
+		r, err = NewRepo(dirName, logger)
+		require.Nil(t, err)
+
+		expectedTwo := exampleTwo()
+		assert.ElementsMatch(t, []*models.Role{&expectedTwo}, r.Roles())
+	})
+}
+
+func exampleOne() models.Role {
+	return models.Role{
+		Name: "reader",
+		Permissions: []*models.RolePermission{
+			{Actions: []string{"get", "list"}, Resource: "traversal/*"},
+		},
+		Groups: []string{"analysts"},
+	}
+}
+
+func exampleTwo() models.Role {
+	return models.Role{
+		Name: "article-editor",
+		Permissions: []*models.RolePermission{
+			{Actions: []string{"*"}, Resource: "objects/Article*"},
+		},
+		Users: []string{"jane"},
+	}
+}
@@ -49,6 +49,14 @@ type ClientService interface {
 
 	BackupsRestoreStatus(params *BackupsRestoreStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsRestoreStatusOK, error)
 
+	BackupsSchedulesCreate(params *BackupsSchedulesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesCreateOK, error)
+
+	BackupsSchedulesDelete(params *BackupsSchedulesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesDeleteNoContent, error)
+
+	BackupsSchedulesGet(params *BackupsSchedulesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesGetOK, error)
+
+	BackupsSchedulesList(params *BackupsSchedulesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesListOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -208,6 +216,162 @@ func (a *Client) BackupsRestoreStatus(params *BackupsRestoreStatusParams, authIn
 	panic(msg)
 }
 
+/*
+BackupsSchedulesCreate Creates or replaces a schedule to periodically create backups of a set of classes. Backups created by the schedule are deleted according to its retention policy.
+*/
+func (a *Client) BackupsSchedulesCreate(params *BackupsSchedulesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsSchedulesCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.schedules.create",
+		Method:             "POST",
+		PathPattern:        "/backup-schedules",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsSchedulesCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsSchedulesCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.schedules.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BackupsSchedulesDelete Deletes a backup schedule. Backups which were already created by the schedule are kept.
+*/
+func (a *Client) BackupsSchedulesDelete(params *BackupsSchedulesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesDeleteNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsSchedulesDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.schedules.delete",
+		Method:             "DELETE",
+		PathPattern:        "/backup-schedules/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsSchedulesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsSchedulesDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.schedules.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BackupsSchedulesGet Returns a backup schedule including the backups it created which have not expired yet
+*/
+func (a *Client) BackupsSchedulesGet(params *BackupsSchedulesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsSchedulesGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.schedules.get",
+		Method:             "GET",
+		PathPattern:        "/backup-schedules/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsSchedulesGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsSchedulesGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.schedules.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BackupsSchedulesList Lists all backup schedules of the cluster
+*/
+func (a *Client) BackupsSchedulesList(params *BackupsSchedulesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsSchedulesListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.schedules.list",
+		Method:             "GET",
+		PathPattern:        "/backup-schedules",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsSchedulesListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsSchedulesListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.schedules.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
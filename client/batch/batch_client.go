@@ -47,6 +47,8 @@ type ClientService interface {
 
 	BatchReferencesCreate(params *BatchReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchReferencesCreateOK, error)
 
+	BatchSearch(params *BatchSearchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchSearchOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -173,6 +175,47 @@ func (a *Client) BatchReferencesCreate(params *BatchReferencesCreateParams, auth
 	panic(msg)
 }
 
+/*
+BatchSearch runs several independent searches in a single request
+
+Run several independent searches in a single request. The searches are executed concurrently with a shared deadline and every search gets its own result or error.
+*/
+func (a *Client) BatchSearch(params *BatchSearchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchSearchOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchSearchParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "batch.search",
+		Method:             "POST",
+		PathPattern:        "/batch/search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchSearchReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchSearchOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batch.search: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -41,11 +41,52 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	NodesDecommission(params *NodesDecommissionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDecommissionAccepted, error)
+
 	NodesGet(params *NodesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+NodesDecommission Starts decommissioning a node. Every shard replica the node holds is moved to another node, or re-replicated from another replica if the node is not reachable anymore. No new shards are assigned to the node while it is being drained. Once it holds no shards anymore, the node leaves the cluster. The progress is reported as part of the node status.
+*/
+func (a *Client) NodesDecommission(params *NodesDecommissionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDecommissionAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesDecommissionParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.decommission",
+		Method:             "POST",
+		PathPattern:        "/nodes/{name}/decommission",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesDecommissionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesDecommissionAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.decommission: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 NodesGet Returns status of Weaviate DB.
 */
@@ -18,6 +18,7 @@ package objects
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
@@ -61,10 +62,14 @@ type ClientService interface {
 
 	ObjectsDelete(params *ObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsDeleteNoContent, error)
 
+	ObjectsExport(params *ObjectsExportParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ObjectsExportOK, error)
+
 	ObjectsGet(params *ObjectsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsGetOK, error)
 
 	ObjectsHead(params *ObjectsHeadParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsHeadNoContent, error)
 
+	ObjectsImport(params *ObjectsImportParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsImportOK, error)
+
 	ObjectsList(params *ObjectsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsListOK, error)
 
 	ObjectsPatch(params *ObjectsPatchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsPatchNoContent, error)
@@ -492,6 +497,47 @@ func (a *Client) ObjectsDelete(params *ObjectsDeleteParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+ObjectsExport exports the objects of a class
+
+Export all objects of a class as a stream in NDJSON or Parquet format. Objects are returned in the order of their ids and can optionally be restricted by a where filter.
+*/
+func (a *Client) ObjectsExport(params *ObjectsExportParams, authInfo runtime.ClientAuthInfoWriter, writer io.Writer, opts ...ClientOption) (*ObjectsExportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsExportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.export",
+		Method:             "GET",
+		PathPattern:        "/export/{className}",
+		ProducesMediaTypes: []string{"application/json", "application/vnd.apache.parquet", "application/x-ndjson"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsExportReader{formats: a.formats, writer: writer},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsExportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.export: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsGet gets a specific object based on its UUID and a object UUID also available as websocket bus
 
@@ -574,6 +620,47 @@ func (a *Client) ObjectsHead(params *ObjectsHeadParams, authInfo runtime.ClientA
 	panic(msg)
 }
 
+/*
+ObjectsImport imports objects into a class
+
+Import a stream of objects in NDJSON or Parquet format, as produced by the export endpoint, into a class. All objects are moved to the target class and their properties can be renamed. Objects are added through the batch import, so ids and vectors are preserved.
+*/
+func (a *Client) ObjectsImport(params *ObjectsImportParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ObjectsImportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewObjectsImportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "objects.import",
+		Method:             "POST",
+		PathPattern:        "/import/{className}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/octet-stream", "application/vnd.apache.parquet", "application/x-ndjson"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ObjectsImportReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ObjectsImportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for objects.import: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ObjectsList gets a list of objects
 
@@ -41,8 +41,28 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	SchemaAliasesCreate(params *SchemaAliasesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaAliasesCreateOK, error)
+
+	SchemaAliasesDelete(params *SchemaAliasesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaAliasesDeleteNoContent, error)
+
+	SchemaAliasesList(params *SchemaAliasesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaAliasesListOK, error)
+
+	SchemaAliasesUpdate(params *SchemaAliasesUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaAliasesUpdateOK, error)
+
 	SchemaDump(params *SchemaDumpParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaDumpOK, error)
 
+	SchemaMigrationsAbort(params *SchemaMigrationsAbortParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaMigrationsAbortOK, error)
+
+	SchemaMigrationsCreate(params *SchemaMigrationsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaMigrationsCreateAccepted, error)
+
+	SchemaMigrationsGet(params *SchemaMigrationsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaMigrationsGetOK, error)
+
+	SchemaMigrationsList(params *SchemaMigrationsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaMigrationsListOK, error)
+
+	SchemaMigrationsPause(params *SchemaMigrationsPauseParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaMigrationsPauseOK, error)
+
+	SchemaMigrationsResume(params *SchemaMigrationsResumeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaMigrationsResumeOK, error)
+
 	SchemaObjectsCreate(params *SchemaObjectsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsCreateOK, error)
 
 	SchemaObjectsDelete(params *SchemaObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsDeleteOK, error)
@@ -60,6 +80,162 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+SchemaAliasesCreate Creates an alias for a class. Objects of the class can be read and written using the alias wherever a class name is expected.
+*/
+func (a *Client) SchemaAliasesCreate(params *SchemaAliasesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaAliasesCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaAliasesCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.aliases.create",
+		Method:             "POST",
+		PathPattern:        "/schema/aliases",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaAliasesCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaAliasesCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.aliases.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaAliasesDelete Deletes an alias. The class it points to is not affected.
+*/
+func (a *Client) SchemaAliasesDelete(params *SchemaAliasesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaAliasesDeleteNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaAliasesDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.aliases.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/aliases/{aliasName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaAliasesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaAliasesDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.aliases.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaAliasesList Lists all aliases and the classes they point to.
+*/
+func (a *Client) SchemaAliasesList(params *SchemaAliasesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaAliasesListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaAliasesListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.aliases.list",
+		Method:             "GET",
+		PathPattern:        "/schema/aliases",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaAliasesListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaAliasesListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.aliases.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaAliasesUpdate Points an existing alias to another class. All reads and writes using the alias are applied to the new class from then on.
+*/
+func (a *Client) SchemaAliasesUpdate(params *SchemaAliasesUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaAliasesUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaAliasesUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.aliases.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/aliases/{aliasName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaAliasesUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaAliasesUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.aliases.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaDump dumps the current the database schema
 */
@@ -99,6 +275,240 @@ func (a *Client) SchemaDump(params *SchemaDumpParams, authInfo runtime.ClientAut
 	panic(msg)
 }
 
+/*
+SchemaMigrationsAbort Aborts a migration. Writes are no longer applied to the shadow class and the shadow class is deleted. A migration cannot be aborted once the shadow class is taking the place of the class.
+*/
+func (a *Client) SchemaMigrationsAbort(params *SchemaMigrationsAbortParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaMigrationsAbortOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaMigrationsAbortParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.migrations.abort",
+		Method:             "DELETE",
+		PathPattern:        "/schema/migrations/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaMigrationsAbortReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaMigrationsAbortOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.migrations.abort: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaMigrationsCreate Starts migrating a class to a new definition. A shadow class with the new definition is created and all objects are copied to it in the background, while writes to the class are also applied to the shadow class. Once all objects have been copied, the shadow class takes the place of the class under its name.
+*/
+func (a *Client) SchemaMigrationsCreate(params *SchemaMigrationsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaMigrationsCreateAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaMigrationsCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.migrations.create",
+		Method:             "POST",
+		PathPattern:        "/schema/migrations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaMigrationsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaMigrationsCreateAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.migrations.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaMigrationsGet Returns the status of a migration
+*/
+func (a *Client) SchemaMigrationsGet(params *SchemaMigrationsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaMigrationsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaMigrationsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.migrations.get",
+		Method:             "GET",
+		PathPattern:        "/schema/migrations/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaMigrationsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaMigrationsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.migrations.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaMigrationsList Lists the migrations started on this node
+*/
+func (a *Client) SchemaMigrationsList(params *SchemaMigrationsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaMigrationsListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaMigrationsListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.migrations.list",
+		Method:             "GET",
+		PathPattern:        "/schema/migrations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaMigrationsListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaMigrationsListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.migrations.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaMigrationsPause Pauses copying objects to the shadow class. Writes to the class are still applied to the shadow class.
+*/
+func (a *Client) SchemaMigrationsPause(params *SchemaMigrationsPauseParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaMigrationsPauseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaMigrationsPauseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.migrations.pause",
+		Method:             "POST",
+		PathPattern:        "/schema/migrations/{id}/pause",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaMigrationsPauseReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaMigrationsPauseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.migrations.pause: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaMigrationsResume Resumes copying objects to the shadow class
+*/
+func (a *Client) SchemaMigrationsResume(params *SchemaMigrationsResumeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaMigrationsResumeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaMigrationsResumeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.migrations.resume",
+		Method:             "POST",
+		PathPattern:        "/schema/migrations/{id}/resume",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaMigrationsResumeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaMigrationsResumeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.migrations.resume: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsCreate creates a new object class in the schema
 */
@@ -21,9 +21,13 @@ import (
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/weaviate/weaviate/client/authz"
 	"github.com/weaviate/weaviate/client/backups"
 	"github.com/weaviate/weaviate/client/batch"
 	"github.com/weaviate/weaviate/client/classifications"
+	"github.com/weaviate/weaviate/client/cluster"
+	"github.com/weaviate/weaviate/client/clusterings"
+	"github.com/weaviate/weaviate/client/deduplications"
 	"github.com/weaviate/weaviate/client/graphql"
 	"github.com/weaviate/weaviate/client/meta"
 	"github.com/weaviate/weaviate/client/nodes"
@@ -75,9 +79,13 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Weaviate {
 
 	cli := new(Weaviate)
 	cli.Transport = transport
+	cli.Authz = authz.New(transport, formats)
 	cli.Backups = backups.New(transport, formats)
 	cli.Batch = batch.New(transport, formats)
 	cli.Classifications = classifications.New(transport, formats)
+	cli.Cluster = cluster.New(transport, formats)
+	cli.Clusterings = clusterings.New(transport, formats)
+	cli.Deduplications = deduplications.New(transport, formats)
 	cli.Graphql = graphql.New(transport, formats)
 	cli.Meta = meta.New(transport, formats)
 	cli.Nodes = nodes.New(transport, formats)
@@ -129,12 +137,20 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 
 // Weaviate is a client for weaviate
 type Weaviate struct {
+	Authz authz.ClientService
+
 	Backups backups.ClientService
 
 	Batch batch.ClientService
 
 	Classifications classifications.ClientService
 
+	Cluster cluster.ClientService
+
+	Clusterings clusterings.ClientService
+
+	Deduplications deduplications.ClientService
+
 	Graphql graphql.ClientService
 
 	Meta meta.ClientService
@@ -155,9 +171,13 @@ type Weaviate struct {
 // SetTransport changes the transport on the client and all its subresources
 func (c *Weaviate) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
+	c.Authz.SetTransport(transport)
 	c.Backups.SetTransport(transport)
 	c.Batch.SetTransport(transport)
 	c.Classifications.SetTransport(transport)
+	c.Cluster.SetTransport(transport)
+	c.Clusterings.SetTransport(transport)
+	c.Deduplications.SetTransport(transport)
 	c.Graphql.SetTransport(transport)
 	c.Meta.SetTransport(transport)
 	c.Nodes.SetTransport(transport)
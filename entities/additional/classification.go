@@ -35,6 +35,7 @@ type Properties struct {
 	ExplainScore       bool                   `json:"explainScore"`
 	IsConsistent       bool                   `json:"isConsistent"`
 	Group              bool                   `json:"group"`
+	Highlight          *HighlightParams       `json:"highlight"`
 
 	// The User is not interested in returning props, we can skip any costly
 	// operation that isn't required.
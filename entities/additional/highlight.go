@@ -0,0 +1,35 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+const (
+	DefaultHighlightFragmentSize = 100
+	DefaultHighlightPreTag       = "<em>"
+	DefaultHighlightPostTag      = "</em>"
+)
+
+// HighlightParams are the arguments of the highlight additional property.
+// Without properties, the properties searched by bm25 or hybrid are
+// highlighted.
+type HighlightParams struct {
+	Properties   []string `json:"properties"`
+	FragmentSize int      `json:"fragmentSize"`
+	PreTag       string   `json:"preTag"`
+	PostTag      string   `json:"postTag"`
+}
+
+// Highlight contains the snippets of a property in which the query terms
+// matched, with every matched token wrapped in the pre and post tags
+type Highlight struct {
+	Property  string   `json:"property"`
+	Fragments []string `json:"fragments"`
+}
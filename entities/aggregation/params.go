@@ -31,6 +31,7 @@ type Params struct {
 	Certainty        float64
 	NearVector       *searchparams.NearVector
 	NearObject       *searchparams.NearObject
+	Recommend        *searchparams.Recommend
 	Hybrid           *searchparams.HybridSearch
 	ModuleParams     map[string]interface{}
 }
@@ -38,6 +39,36 @@ type Params struct {
 type ParamProperty struct {
 	Name        schema.PropertyName `json:"name"`
 	Aggregators []Aggregator        `json:"aggregators"`
+	Histogram   *Histogram          `json:"histogram"`
+	// Percentiles (0 to 100) to approximate if the PercentilesAggregator is set
+	Percentiles []float64 `json:"percentiles"`
+}
+
+// Histogram buckets the values of a numerical or date property. Numerical
+// buckets are Interval wide, date buckets span one DateInterval in Timezone.
+// The Properties are aggregated separately for every bucket.
+type Histogram struct {
+	Interval     float64         `json:"interval"`
+	DateInterval DateInterval    `json:"dateInterval"`
+	Timezone     string          `json:"timezone"`
+	Properties   []ParamProperty `json:"properties"`
+}
+
+type DateInterval string
+
+const (
+	DateIntervalDay   DateInterval = "day"
+	DateIntervalWeek  DateInterval = "week"
+	DateIntervalMonth DateInterval = "month"
+)
+
+func ParseDateInterval(name string) (DateInterval, error) {
+	switch DateInterval(name) {
+	case DateIntervalDay, DateIntervalWeek, DateIntervalMonth:
+		return DateInterval(name), nil
+	default:
+		return "", fmt.Errorf("unrecognized date interval '%s'", name)
+	}
 }
 
 type Aggregator struct {
@@ -73,6 +104,13 @@ var (
 	PercentageFalseAggregator = Aggregator{Type: "percentageFalse"}
 )
 
+// Aggregators approximated by mergeable sketches, percentiles are used in
+// numerical and date props, cardinality additionally in text and uuid props
+var (
+	PercentilesAggregator = Aggregator{Type: "percentiles"}
+	CardinalityAggregator = Aggregator{Type: "cardinality"}
+)
+
 const TopOccurrencesType = "topOccurrences"
 
 // NewTopOccurrencesAggregator creates a TopOccurrencesAggregator, we cannot
@@ -118,6 +156,12 @@ func ParseAggregatorProp(name string) (Aggregator, error) {
 	case PercentageFalseAggregator.String():
 		return PercentageFalseAggregator, nil
 
+	// sketches
+	case PercentilesAggregator.String():
+		return PercentilesAggregator, nil
+	case CardinalityAggregator.String():
+		return CardinalityAggregator, nil
+
 	// string/text
 	case TopOccurrencesType:
 		return NewTopOccurrencesAggregator(ptInt(5)), nil // default to limit 5, can be overwritten
@@ -11,6 +11,8 @@
 
 package aggregation
 
+import "github.com/weaviate/weaviate/entities/sketch"
+
 type Result struct {
 	Groups []Group `json:"groups"`
 }
@@ -29,6 +31,33 @@ type Property struct {
 	SchemaType            string                 `json:"schemaType"`
 	ReferenceAggregation  Reference              `json:"referenceAggregation"`
 	DateAggregations      map[string]interface{} `json:"dateAggregation"`
+	// Buckets of a histogram, the lower bound of each bucket is set as the
+	// GroupedBy value
+	Buckets                []Group      `json:"buckets"`
+	PercentilesAggregation *Percentiles `json:"percentilesAggregation,omitempty"`
+	CardinalityAggregation *Cardinality `json:"cardinalityAggregation,omitempty"`
+}
+
+// Percentiles carries the sketch of a property until the results of all
+// shards are combined. Only then the requested percentiles are computed and
+// the sketch is dropped.
+type Percentiles struct {
+	Percentiles []float64       `json:"percentiles"`
+	Sketch      *sketch.TDigest `json:"sketch,omitempty"`
+	Values      []Percentile    `json:"values,omitempty"`
+}
+
+type Percentile struct {
+	Percentile float64 `json:"percentile"`
+	// Value is a float64 for numerical and an RFC3339 string for date props
+	Value interface{} `json:"value"`
+}
+
+// Cardinality carries the sketch of a property until the results of all
+// shards are combined, then the number of distinct values is estimated.
+type Cardinality struct {
+	Sketch *sketch.HyperLogLog `json:"sketch,omitempty"`
+	Value  int                 `json:"value"`
 }
 
 type Text struct {
@@ -27,7 +27,8 @@ type NodeDescriptor struct {
 type DistributedBackupDescriptor struct {
 	StartedAt     time.Time                  `json:"startedAt"`
 	CompletedAt   time.Time                  `json:"completedAt"`
-	ID            string                     `json:"id"` // User created backup id
+	ID            string                     `json:"id"`               // User created backup id
+	BaseID        string                     `json:"baseId,omitempty"` // backup an incremental backup is based on
 	Nodes         map[string]*NodeDescriptor `json:"nodes"`
 	Status        Status                     `json:"status"`  //
 	Version       string                     `json:"version"` //
@@ -180,6 +181,15 @@ type ShardDescriptor struct {
 	Node  string   `json:"node"`
 	Files []string `json:"files"`
 
+	// FileStats maps each file to its size and content hash. Incremental
+	// backups take files from their base if the hash is unchanged.
+	FileStats map[string]FileStat `json:"fileStats,omitempty"`
+	// FileSources maps files which have not been uploaded as part of this
+	// backup to the ID of the backup containing them. It is only set for
+	// incremental backups and always points to the backup which uploaded the
+	// file, never to an intermediate incremental backup.
+	FileSources map[string]string `json:"fileSources,omitempty"`
+
 	DocIDCounterPath      string `json:"docIdCounterPath"`
 	DocIDCounter          []byte `json:"docIdCounter"`
 	PropLengthTrackerPath string `json:"propLengthTrackerPath"`
@@ -188,6 +198,12 @@ type ShardDescriptor struct {
 	Version               []byte `json:"version"`
 }
 
+// FileStat identifies the content of a file
+type FileStat struct {
+	Size int64  `json:"size"`
+	Hash string `json:"hash"` // hex encoded sha256 sum
+}
+
 // ClassDescriptor contains everything needed to completely restore a class
 type ClassDescriptor struct {
 	Name          string            `json:"name"` // DB class name, also selected by user
@@ -201,7 +217,8 @@ type ClassDescriptor struct {
 type BackupDescriptor struct {
 	StartedAt     time.Time         `json:"startedAt"`
 	CompletedAt   time.Time         `json:"completedAt"`
-	ID            string            `json:"id"` // User created backup id
+	ID            string            `json:"id"`               // User created backup id
+	BaseID        string            `json:"baseId,omitempty"` // backup an incremental backup is based on
 	Classes       []ClassDescriptor `json:"classes"`
 	Status        string            `json:"status"`  // "STARTED|TRANSFERRING|TRANSFERRED|SUCCESS|FAILED"
 	Version       string            `json:"version"` //
@@ -306,6 +323,11 @@ func (d *BackupDescriptor) Validate() error {
 					return fmt.Errorf("invalid shard %q.%q: file number %d", c.Name, s.Name, i)
 				}
 			}
+			for fpath, id := range s.FileSources {
+				if id == "" || id == d.ID {
+					return fmt.Errorf("invalid shard %q.%q: source of file %q", c.Name, s.Name, fpath)
+				}
+			}
 		}
 	}
 	return nil
@@ -323,6 +345,7 @@ func (d *BackupDescriptor) ToDistributed() *DistributedBackupDescriptor {
 		StartedAt:     d.StartedAt,
 		CompletedAt:   d.CompletedAt,
 		ID:            d.ID,
+		BaseID:        d.BaseID,
 		Status:        Status(d.Status),
 		Version:       d.Version,
 		ServerVersion: d.ServerVersion,
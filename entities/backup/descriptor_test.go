@@ -178,6 +178,30 @@ func TestValidateBackup(t *testing.T) {
 				}},
 			}},
 		}, success: true},
+		{desc: BackupDescriptor{
+			ID: "1", BaseID: "0", Version: "1", ServerVersion: "1", StartedAt: timept,
+			Classes: []ClassDescriptor{{
+				Name: "n", Schema: bytes, ShardingState: bytes,
+				Shards: []ShardDescriptor{{
+					Name: "n", Node: "n",
+					PropLengthTrackerPath: "n", DocIDCounterPath: "n", ShardVersionPath: "n",
+					DocIDCounter: bytes, Version: bytes, PropLengthTracker: bytes, Files: []string{"file"},
+					FileSources: map[string]string{"file": "1"},
+				}},
+			}},
+		}},
+		{desc: BackupDescriptor{
+			ID: "1", BaseID: "0", Version: "1", ServerVersion: "1", StartedAt: timept,
+			Classes: []ClassDescriptor{{
+				Name: "n", Schema: bytes, ShardingState: bytes,
+				Shards: []ShardDescriptor{{
+					Name: "n", Node: "n",
+					PropLengthTrackerPath: "n", DocIDCounterPath: "n", ShardVersionPath: "n",
+					DocIDCounter: bytes, Version: bytes, PropLengthTracker: bytes, Files: []string{"file"},
+					FileSources: map[string]string{"file": "0"},
+				}},
+			}},
+		}, success: true},
 	}
 	for i, tc := range tests {
 		err := tc.desc.Validate()
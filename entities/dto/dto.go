@@ -32,9 +32,12 @@ type GetParams struct {
 	Properties            search.SelectProperties
 	NearVector            *searchparams.NearVector
 	NearObject            *searchparams.NearObject
+	Recommend             *searchparams.Recommend
 	KeywordRanking        *searchparams.KeywordRanking
 	HybridSearch          *searchparams.HybridSearch
 	GroupBy               *searchparams.GroupBy
+	Facets                *searchparams.Facets
+	MMR                   *searchparams.MMR
 	SearchVector          []float32
 	Group                 *GroupParams
 	ModuleParams          map[string]interface{}
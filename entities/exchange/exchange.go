@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package exchange encodes and decodes streams of objects in the formats
+// supported by the bulk export and import endpoints.
+package exchange
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type Format string
+
+const (
+	// FormatNDJSON writes one JSON encoded object per line
+	FormatNDJSON Format = "ndjson"
+	// FormatParquet writes a single parquet file with one row per object
+	FormatParquet Format = "parquet"
+)
+
+// ParseFormat returns the format identified by s. An empty string
+// defaults to NDJSON.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case "":
+		return FormatNDJSON, nil
+	case FormatNDJSON, FormatParquet:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unsupported format %q, must be one of [%s, %s]",
+			s, FormatNDJSON, FormatParquet)
+	}
+}
+
+// ContentType is the media type used when serving the format over HTTP
+func (f Format) ContentType() string {
+	if f == FormatParquet {
+		return "application/vnd.apache.parquet"
+	}
+	return "application/x-ndjson"
+}
+
+// Encoder writes objects to an underlying writer. Close must be called
+// once all objects are written, it does not close the underlying writer.
+type Encoder interface {
+	Encode(obj *models.Object) error
+	Close() error
+}
+
+// Decoder reads objects from an underlying reader. Decode returns io.EOF
+// once all objects were read.
+type Decoder interface {
+	Decode() (*models.Object, error)
+	Close() error
+}
+
+func NewEncoder(format Format, w io.Writer) (Encoder, error) {
+	switch format {
+	case FormatNDJSON:
+		return newNDJSONEncoder(w), nil
+	case FormatParquet:
+		return newParquetEncoder(w), nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func NewDecoder(format Format, r io.Reader) (Decoder, error) {
+	switch format {
+	case FormatNDJSON:
+		return newNDJSONDecoder(r), nil
+	case FormatParquet:
+		return newParquetDecoder(r)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
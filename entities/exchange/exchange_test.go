@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package exchange
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		in          string
+		expected    Format
+		expectedErr bool
+	}{
+		{in: "", expected: FormatNDJSON},
+		{in: "ndjson", expected: FormatNDJSON},
+		{in: "Parquet", expected: FormatParquet},
+		{in: "csv", expectedErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			f, err := ParseFormat(test.in)
+			if test.expectedErr {
+				assert.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, f)
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	objs := []*models.Object{
+		{
+			ID:                 "7c8183ae-150d-433f-92b6-ed095b000001",
+			Class:              "Article",
+			CreationTimeUnix:   1000,
+			LastUpdateTimeUnix: 2000,
+			Properties: map[string]interface{}{
+				"title":     "first",
+				"wordCount": float64(120),
+				"tags":      []interface{}{"a", "b"},
+			},
+			Vector: []float32{0.1, 0.2, 0.3},
+		},
+		{
+			ID:    "7c8183ae-150d-433f-92b6-ed095b000002",
+			Class: "Article",
+			Properties: map[string]interface{}{
+				"title": "second, without a vector",
+			},
+		},
+	}
+
+	for _, format := range []Format{FormatNDJSON, FormatParquet} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			enc, err := NewEncoder(format, &buf)
+			require.Nil(t, err)
+			for _, obj := range objs {
+				require.Nil(t, enc.Encode(obj))
+			}
+			require.Nil(t, enc.Close())
+
+			dec, err := NewDecoder(format, &buf)
+			require.Nil(t, err)
+			defer dec.Close()
+
+			var decoded []*models.Object
+			for {
+				obj, err := dec.Decode()
+				if err == io.EOF {
+					break
+				}
+				require.Nil(t, err)
+				decoded = append(decoded, obj)
+			}
+			assert.Equal(t, objs, decoded)
+		})
+	}
+}
+
+func TestDecodeInvalidInput(t *testing.T) {
+	t.Run("ndjson", func(t *testing.T) {
+		dec, err := NewDecoder(FormatNDJSON, bytes.NewBufferString("{\"class\":\"A\"}\nnot json\n"))
+		require.Nil(t, err)
+		_, err = dec.Decode()
+		require.Nil(t, err)
+		_, err = dec.Decode()
+		assert.NotNil(t, err)
+	})
+
+	t.Run("parquet", func(t *testing.T) {
+		_, err := NewDecoder(FormatParquet, bytes.NewBufferString("not a parquet file"))
+		assert.NotNil(t, err)
+	})
+}
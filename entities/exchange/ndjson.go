@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package exchange
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type ndjsonEncoder struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func newNDJSONEncoder(w io.Writer) *ndjsonEncoder {
+	bw := bufio.NewWriter(w)
+	return &ndjsonEncoder{w: bw, enc: json.NewEncoder(bw)}
+}
+
+// Encode writes obj followed by a newline
+func (e *ndjsonEncoder) Encode(obj *models.Object) error {
+	return e.enc.Encode(obj)
+}
+
+func (e *ndjsonEncoder) Close() error {
+	return e.w.Flush()
+}
+
+type ndjsonDecoder struct {
+	dec  *json.Decoder
+	line int
+}
+
+func newNDJSONDecoder(r io.Reader) *ndjsonDecoder {
+	return &ndjsonDecoder{dec: json.NewDecoder(r)}
+}
+
+func (d *ndjsonDecoder) Decode() (*models.Object, error) {
+	var obj models.Object
+	if err := d.dec.Decode(&obj); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("decode object %d: %w", d.line, err)
+	}
+	d.line++
+	return &obj, nil
+}
+
+func (d *ndjsonDecoder) Close() error {
+	return nil
+}
@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package exchange
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// parquetReadBatchSize is the number of rows read from the file at once
+const parquetReadBatchSize = 256
+
+// parquetRow is the schema of an exported parquet file. Properties are
+// stored as a JSON document, as their shape differs from class to class.
+type parquetRow struct {
+	ID                 string    `parquet:"name=id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Class              string    `parquet:"name=class, type=BYTE_ARRAY, convertedtype=UTF8"`
+	CreationTimeUnix   int64     `parquet:"name=creation_time_unix, type=INT64"`
+	LastUpdateTimeUnix int64     `parquet:"name=last_update_time_unix, type=INT64"`
+	Properties         string    `parquet:"name=properties, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Vector             []float32 `parquet:"name=vector, type=LIST, valuetype=FLOAT"`
+}
+
+type parquetEncoder struct {
+	w  io.Writer
+	pw *writer.ParquetWriter
+}
+
+func newParquetEncoder(w io.Writer) *parquetEncoder {
+	return &parquetEncoder{w: w}
+}
+
+// writer lazily creates the parquet writer, as it writes the file header
+// right away. This way nothing is written until the first object is
+// encoded or the encoder is closed.
+func (e *parquetEncoder) writer() (*writer.ParquetWriter, error) {
+	if e.pw == nil {
+		pw, err := writer.NewParquetWriterFromWriter(e.w, new(parquetRow), 1)
+		if err != nil {
+			return nil, fmt.Errorf("create parquet writer: %w", err)
+		}
+		e.pw = pw
+	}
+	return e.pw, nil
+}
+
+func (e *parquetEncoder) Encode(obj *models.Object) error {
+	pw, err := e.writer()
+	if err != nil {
+		return err
+	}
+
+	props, err := json.Marshal(obj.Properties)
+	if err != nil {
+		return fmt.Errorf("marshal properties of object %s: %w", obj.ID, err)
+	}
+
+	return pw.Write(parquetRow{
+		ID:                 obj.ID.String(),
+		Class:              obj.Class,
+		CreationTimeUnix:   obj.CreationTimeUnix,
+		LastUpdateTimeUnix: obj.LastUpdateTimeUnix,
+		Properties:         string(props),
+		Vector:             obj.Vector,
+	})
+}
+
+// Close flushes the remaining rows and writes the parquet footer
+func (e *parquetEncoder) Close() error {
+	pw, err := e.writer()
+	if err != nil {
+		return err
+	}
+	return pw.WriteStop()
+}
+
+// parquetDecoder spools its input to a temporary file, since the parquet
+// footer at the end of the file has to be read before any row
+type parquetDecoder struct {
+	path   string
+	file   source.ParquetFile
+	reader *reader.ParquetReader
+	rows   []parquetRow
+	left   int64
+	row    int
+}
+
+func newParquetDecoder(r io.Reader) (*parquetDecoder, error) {
+	f, err := os.CreateTemp("", "weaviate-import-*.parquet")
+	if err != nil {
+		return nil, fmt.Errorf("create temp file: %w", err)
+	}
+	d := &parquetDecoder{path: f.Name()}
+
+	_, err = io.Copy(f, r)
+	f.Close()
+	if err != nil {
+		d.Close()
+		return nil, fmt.Errorf("read parquet input: %w", err)
+	}
+
+	d.file, err = local.NewLocalFileReader(d.path)
+	if err != nil {
+		d.Close()
+		return nil, fmt.Errorf("open parquet input: %w", err)
+	}
+	d.reader, err = reader.NewParquetReader(d.file, new(parquetRow), 1)
+	if err != nil {
+		d.Close()
+		return nil, fmt.Errorf("open parquet input: %w", err)
+	}
+	d.left = d.reader.GetNumRows()
+	return d, nil
+}
+
+func (d *parquetDecoder) Decode() (*models.Object, error) {
+	if len(d.rows) == 0 {
+		if d.left == 0 {
+			return nil, io.EOF
+		}
+		n := d.left
+		if n > parquetReadBatchSize {
+			n = parquetReadBatchSize
+		}
+		rows := make([]parquetRow, n)
+		if err := d.reader.Read(&rows); err != nil {
+			return nil, fmt.Errorf("decode row %d: %w", d.row, err)
+		}
+		d.rows = rows
+		d.left -= n
+	}
+
+	row := d.rows[0]
+	d.rows = d.rows[1:]
+	d.row++
+
+	obj := &models.Object{
+		ID:                 strfmt.UUID(row.ID),
+		Class:              row.Class,
+		CreationTimeUnix:   row.CreationTimeUnix,
+		LastUpdateTimeUnix: row.LastUpdateTimeUnix,
+	}
+	if len(row.Vector) > 0 {
+		obj.Vector = row.Vector
+	}
+	if row.Properties != "" && row.Properties != "null" {
+		var props map[string]interface{}
+		if err := json.Unmarshal([]byte(row.Properties), &props); err != nil {
+			return nil, fmt.Errorf("decode properties of row %d: %w", d.row-1, err)
+		}
+		obj.Properties = props
+	}
+	return obj, nil
+}
+
+// Close removes the temporary file backing the decoder
+func (d *parquetDecoder) Close() error {
+	if d.reader != nil {
+		d.reader.ReadStop()
+	}
+	if d.file != nil {
+		d.file.Close()
+	}
+	return os.Remove(d.path)
+}
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestValidateCursor(t *testing.T) {
+	sort := []Sort{{Path: []string{"name"}, Order: "asc"}}
+
+	tests := []struct {
+		name        string
+		className   string
+		cursor      *Cursor
+		offset      int
+		filters     *LocalFilter
+		sort        []Sort
+		expectedErr string
+	}{
+		{
+			name:      "valid cursor",
+			className: "Foo",
+			cursor:    &Cursor{After: "7c8183ae-150d-433f-92b6-ed095b000001", Limit: 10},
+		},
+		{
+			name:      "valid cursor from the start",
+			className: "Foo",
+			cursor:    &Cursor{After: "", Limit: 10},
+		},
+		{
+			name:        "without class",
+			cursor:      &Cursor{After: "", Limit: 10},
+			expectedErr: "class parameter cannot be empty",
+		},
+		{
+			name:        "with offset and sort",
+			className:   "Foo",
+			cursor:      &Cursor{After: "", Limit: 10},
+			offset:      1,
+			sort:        sort,
+			expectedErr: "offset,sort cannot be set with after and limit parameters",
+		},
+		{
+			name:        "with where",
+			className:   "Foo",
+			cursor:      &Cursor{After: "", Limit: 10},
+			filters:     &LocalFilter{Root: &Clause{Operator: OperatorEqual}},
+			expectedErr: "where cannot be set with after and limit parameters",
+		},
+		{
+			name:        "with invalid after",
+			className:   "Foo",
+			cursor:      &Cursor{After: "not-a-uuid", Limit: 10},
+			expectedErr: "after parameter 'not-a-uuid' is not a valid uuid",
+		},
+		{
+			name:        "without limit",
+			className:   "Foo",
+			cursor:      &Cursor{After: "", Limit: LimitFlagNotSet},
+			expectedErr: "limit parameter must be set",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateCursor(schema.ClassName(test.className),
+				test.cursor, test.offset, test.filters, test.sort)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), test.expectedErr)
+			}
+		})
+	}
+}
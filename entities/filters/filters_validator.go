@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -109,6 +110,12 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 		return nil
 	}
 
+	if _, ok := schema.AsNested(prop.DataType); ok {
+		return errors.Errorf("property %q is a nested object, filter on one of "+
+			"its nested properties by their dotted path instead, e.g. %q",
+			propName, nestedPathExample(prop))
+	}
+
 	if isUUIDType(prop.DataType[0]) {
 		return validateUUIDType(propName, cw)
 	}
@@ -142,6 +149,13 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 	return nil
 }
 
+func nestedPathExample(prop *models.Property) string {
+	if nested := schema.FlattenNestedProperties(prop); len(nested) > 0 {
+		return nested[0].Name
+	}
+	return prop.Name + ".<nestedProperty>"
+}
+
 func valueNameFromDataType(dt schema.DataType) string {
 	return "value" + strings.ToUpper(string(dt[0])) + string(dt[1:])
 }
@@ -125,12 +125,12 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 			}
 			propertyName = schema.PropertyName(rawPropertyName)
 		} else {
-			propertyName, err = schema.ValidatePropertyName(rawPropertyName)
+			propertyName, err = schema.ValidatePropertyPath(rawPropertyName)
 			// Invalid property name?
 			// Try to parse it as as a reference or a length.
 			if err != nil {
 				untitlizedPropertyName := strings.ToLower(rawPropertyName[0:1]) + rawPropertyName[1:]
-				propertyName, err = schema.ValidatePropertyName(untitlizedPropertyName)
+				propertyName, err = schema.ValidatePropertyPath(untitlizedPropertyName)
 				if err != nil {
 					return nil, fmt.Errorf("Expected a valid property name in 'path' field for the filter, but got '%s'", rawPropertyName)
 				}
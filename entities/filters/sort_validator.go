@@ -65,6 +65,12 @@ func validateSortClause(sch schema.Schema, className schema.ClassName, sort Sort
 			return err
 		}
 
+		if _, ok := schema.AsNested(prop.DataType); ok {
+			return errors.Errorf("sorting by a nested object is not supported, "+
+				"sort by one of its nested properties by their dotted path instead, e.g. %q",
+				nestedPathExample(prop))
+		}
+
 		if isUUIDType(prop.DataType[0]) {
 			return fmt.Errorf("prop %q is of type uuid/uuid[]: "+
 				"sorting by uuid is currently not supported - if you believe it should be, "+
@@ -28,6 +28,9 @@ import (
 // swagger:model BackupCreateRequest
 type BackupCreateRequest struct {
 
+	// The ID of an earlier backup on the same backend to base this backup on. Only files which are not already part of the base backup are uploaded, restoring the backup fetches the remaining files from the base backup and its own bases.
+	BaseID string `json:"baseId,omitempty"`
+
 	// Custom configuration for the backup creation process
 	Config interface{} `json:"config,omitempty"`
 
@@ -34,6 +34,9 @@ type BackupCreateResponse struct {
 	// Backup backend name e.g. filesystem, gcs, s3.
 	Backend string `json:"backend,omitempty"`
 
+	// The ID of the backup this backup is based on, if it is incremental
+	BaseID string `json:"baseId,omitempty"`
+
 	// The list of classes for which the backup creation process was started
 	Classes []string `json:"classes"`
 
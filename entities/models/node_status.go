@@ -32,6 +32,9 @@ import (
 // swagger:model NodeStatus
 type NodeStatus struct {
 
+	// Progress of decommissioning the node, if it is being or has been decommissioned.
+	Decommission *NodeDecommission `json:"decommission,omitempty"`
+
 	// The gitHash of Weaviate.
 	GitHash string `json:"gitHash,omitempty"`
 
@@ -56,6 +59,10 @@ type NodeStatus struct {
 func (m *NodeStatus) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDecommission(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateShards(formats); err != nil {
 		res = append(res, err)
 	}
@@ -74,6 +81,25 @@ func (m *NodeStatus) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *NodeStatus) validateDecommission(formats strfmt.Registry) error {
+	if swag.IsZero(m.Decommission) { // not required
+		return nil
+	}
+
+	if m.Decommission != nil {
+		if err := m.Decommission.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("decommission")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("decommission")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *NodeStatus) validateShards(formats strfmt.Registry) error {
 	if swag.IsZero(m.Shards) { // not required
 		return nil
@@ -168,6 +194,10 @@ func (m *NodeStatus) validateStatus(formats strfmt.Registry) error {
 func (m *NodeStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateDecommission(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateShards(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -182,6 +212,22 @@ func (m *NodeStatus) ContextValidate(ctx context.Context, formats strfmt.Registr
 	return nil
 }
 
+func (m *NodeStatus) contextValidateDecommission(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Decommission != nil {
+		if err := m.Decommission.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("decommission")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("decommission")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *NodeStatus) contextValidateShards(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Shards); i++ {
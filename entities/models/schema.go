@@ -31,6 +31,9 @@ import (
 // swagger:model Schema
 type Schema struct {
 
+	// Alternative names of classes, mapped to the name of the class they refer to.
+	Aliases map[string]string `json:"aliases,omitempty"`
+
 	// Semantic classes that are available.
 	Classes []*Class `json:"classes"`
 
@@ -40,4 +40,7 @@ type BackupBackend interface {
 	PutObject(ctx context.Context, backupID, key string, byes []byte) error
 	// Initialize initializes backup provider and make sure that app have access rights to write into the object store.
 	Initialize(ctx context.Context, backupID string) error
+	// Delete removes all objects stored for the backup with the given ID.
+	// Deleting a backup which does not exist is not an error.
+	Delete(ctx context.Context, backupID string) error
 }
@@ -24,6 +24,17 @@ func (s *Schema) GetClass(className ClassName) *models.Class {
 	return class
 }
 
+// ResolveAlias returns the name of the class name refers to. If name is not
+// an alias, it is returned as is.
+func (s *Schema) ResolveAlias(name string) string {
+	if s.Objects != nil {
+		if class, ok := s.Objects.Aliases[name]; ok {
+			return class
+		}
+	}
+	return name
+}
+
 // FindClassByName will find either a Thing or Class by name.
 func (s *Schema) FindClassByName(className ClassName) *models.Class {
 	semSchemaClass, err := GetClassByName(s.Objects, string(className))
@@ -35,12 +35,21 @@ func GetClassByName(s *models.Schema, className string) (*models.Class, error) {
 	return nil, fmt.Errorf(ErrorNoSuchClass, className)
 }
 
-// GetPropertyByName returns the class by its name
+// GetPropertyByName returns the class by its name. A dotted name, such as
+// address.city, that points into an object or object[] property resolves to
+// the nested property, see [FlattenNestedProperties].
 func GetPropertyByName(c *models.Class, propName string) (*models.Property, error) {
+	path := strings.Split(propName, ".")
 	// For each class-property
 	for _, prop := range c.Properties {
 		// Check if the name of the property is the given name, that's the property we need
-		if prop.Name == strings.Split(propName, ".")[0] {
+		if prop.Name == path[0] {
+			if _, ok := AsNested(prop.DataType); ok && len(path) > 1 {
+				if nested := findNestedProperty(prop, propName); nested != nil {
+					return nested, nil
+				}
+				break
+			}
 			return prop, nil
 		}
 	}
@@ -113,7 +122,9 @@ func IsValidValueDataType(dt string) bool {
 		string(DataTypeIntArray),
 		string(DataTypeNumberArray),
 		string(DataTypeBooleanArray),
-		string(DataTypeDateArray):
+		string(DataTypeDateArray),
+		string(DataTypeObject),
+		string(DataTypeObjectArray):
 		return true
 	}
 	return false
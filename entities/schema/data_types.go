@@ -55,6 +55,11 @@ const (
 	DataTypeUUID DataType = "uuid"
 	// DataTypeUUIDArray is the array version of DataTypeUUID
 	DataTypeUUIDArray DataType = "uuid[]"
+	// DataTypeObject is a nested object, its structure is defined by the
+	// nested properties of the property
+	DataTypeObject DataType = "object"
+	// DataTypeObjectArray is the array version of DataTypeObject
+	DataTypeObjectArray DataType = "object[]"
 
 	// deprecated as of v1.19, replaced by DataTypeText + relevant tokenization setting
 	// DataTypeString The data type is a value of type string
@@ -79,6 +84,10 @@ var PrimitiveDataTypes []DataType = []DataType{
 	DataTypeUUID, DataTypeUUIDArray,
 }
 
+var NestedDataTypes []DataType = []DataType{
+	DataTypeObject, DataTypeObjectArray,
+}
+
 var DeprecatedPrimitiveDataTypes []DataType = []DataType{
 	// deprecated as of v1.19
 	DataTypeString, DataTypeStringArray,
@@ -89,6 +98,7 @@ type PropertyKind int
 const (
 	PropertyKindPrimitive PropertyKind = 1
 	PropertyKindRef       PropertyKind = 2
+	PropertyKindNested    PropertyKind = 3
 )
 
 type PropertyDataType interface {
@@ -98,11 +108,14 @@ type PropertyDataType interface {
 	IsReference() bool
 	Classes() []ClassName
 	ContainsClass(name ClassName) bool
+	IsNested() bool
+	AsNested() DataType
 }
 
 type propertyDataType struct {
 	kind          PropertyKind
 	primitiveType DataType
+	nestedType    DataType
 	classes       []ClassName
 }
 
@@ -132,6 +145,8 @@ func IsArrayType(dt DataType) (DataType, bool) {
 		return DataTypeDate, true
 	case DataTypeUUIDArray:
 		return DataTypeUUID, true
+	case DataTypeObjectArray:
+		return DataTypeObject, true
 
 	default:
 		return "", false
@@ -158,6 +173,18 @@ func (p *propertyDataType) IsReference() bool {
 	return p.kind == PropertyKindRef
 }
 
+func (p *propertyDataType) IsNested() bool {
+	return p.kind == PropertyKindNested
+}
+
+func (p *propertyDataType) AsNested() DataType {
+	if p.kind != PropertyKindNested {
+		panic("not nested type")
+	}
+
+	return p.nestedType
+}
+
 func (p *propertyDataType) Classes() []ClassName {
 	if p.kind != PropertyKindRef {
 		panic("not MultipleRef type")
@@ -212,6 +239,14 @@ func (s *Schema) FindPropertyDataTypeWithRefs(
 				}, nil
 			}
 		}
+		for _, dt := range NestedDataTypes {
+			if dataType[0] == dt.String() {
+				return &propertyDataType{
+					kind:       PropertyKindNested,
+					nestedType: dt,
+				}, nil
+			}
+		}
 		if len(dataType[0]) == 0 {
 			return nil, fmt.Errorf("dataType cannot be an empty string")
 		}
@@ -265,3 +300,14 @@ func AsPrimitive(dataType []string) (DataType, bool) {
 	}
 	return "", false
 }
+
+func AsNested(dataType []string) (DataType, bool) {
+	if len(dataType) == 1 {
+		for _, dt := range NestedDataTypes {
+			if dataType[0] == dt.String() {
+				return dt, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// FlattenNestedProperties returns the primitive properties nested within an
+// object or object[] property. Each of them is named by its dotted path, such
+// as address.city, which is how it is addressed in filters, sorting and BM25
+// and how its inverted index buckets are named.
+//
+// A primitive nested within an object[], at any depth, holds one value per
+// object of the array, so its data type becomes the array version of the
+// declared data type.
+func FlattenNestedProperties(prop *models.Property) []*models.Property {
+	dt, ok := AsNested(prop.DataType)
+	if !ok {
+		return nil
+	}
+
+	return flattenNestedProperties(prop.Name, dt == DataTypeObjectArray,
+		prop.NestedProperties)
+}
+
+func flattenNestedProperties(prefix string, inArray bool,
+	nestedProps []*models.NestedProperty,
+) []*models.Property {
+	var out []*models.Property
+	for _, nestedProp := range nestedProps {
+		name := prefix + "." + nestedProp.Name
+		if dt, ok := AsNested(nestedProp.DataType); ok {
+			out = append(out, flattenNestedProperties(name,
+				inArray || dt == DataTypeObjectArray, nestedProp.NestedProperties)...)
+			continue
+		}
+
+		dataType := nestedProp.DataType
+		if inArray && len(dataType) == 1 {
+			if arrayType, ok := arrayTypeOf(DataType(dataType[0])); ok {
+				dataType = arrayType.PropString()
+			}
+		}
+
+		out = append(out, &models.Property{
+			Name:            name,
+			DataType:        dataType,
+			Description:     nestedProp.Description,
+			Tokenization:    nestedProp.Tokenization,
+			IndexFilterable: nestedProp.IndexFilterable,
+			IndexSearchable: nestedProp.IndexSearchable,
+		})
+	}
+	return out
+}
+
+func findNestedProperty(prop *models.Property, path string) *models.Property {
+	for _, nestedProp := range FlattenNestedProperties(prop) {
+		if nestedProp.Name == path {
+			return nestedProp
+		}
+	}
+	return nil
+}
+
+func arrayTypeOf(dt DataType) (DataType, bool) {
+	switch dt {
+	case DataTypeText:
+		return DataTypeTextArray, true
+	case DataTypeInt:
+		return DataTypeIntArray, true
+	case DataTypeNumber:
+		return DataTypeNumberArray, true
+	case DataTypeBoolean:
+		return DataTypeBooleanArray, true
+	case DataTypeDate:
+		return DataTypeDateArray, true
+	case DataTypeUUID:
+		return DataTypeUUIDArray, true
+	default:
+		// already an array
+		return dt, false
+	}
+}
+
+// IsNestedPropertyPath returns if propName is the dotted path of a nested
+// property, such as address.city
+func IsNestedPropertyPath(propName string) bool {
+	return strings.Contains(propName, ".")
+}
+
+// NestedValues collects the values the dotted path of a nested property
+// refers to. value is the value of the top-level object or object[]
+// property and path the segments following its name, e.g. ["city"] for
+// address.city. Arrays along the path, whether arrays of objects or an
+// array at the end of the path, are expanded, so that every value is
+// returned individually.
+func NestedValues(value interface{}, path []string) []interface{} {
+	switch typed := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		var out []interface{}
+		for _, elem := range typed {
+			out = append(out, NestedValues(elem, path)...)
+		}
+		return out
+	case map[string]interface{}:
+		if len(path) == 0 {
+			return []interface{}{typed}
+		}
+		return NestedValues(typed[path[0]], path[1:])
+	default:
+		if len(path) > 0 {
+			return nil
+		}
+		return []interface{}{typed}
+	}
+}
+
+// MergeNestedProperties adds the nested properties of update which are not
+// yet part of existing, descending into nested properties present in both.
+// Properties present in both keep their existing definition. The returned
+// bool indicates whether anything was added.
+func MergeNestedProperties(existing, update []*models.NestedProperty,
+) ([]*models.NestedProperty, bool) {
+	merged := make([]*models.NestedProperty, len(existing))
+	copy(merged, existing)
+
+	changed := false
+	for _, updateProp := range update {
+		i := nestedPropertyIndex(merged, updateProp.Name)
+		if i < 0 {
+			merged = append(merged, updateProp)
+			changed = true
+			continue
+		}
+
+		existingProp := merged[i]
+		if _, ok := AsNested(existingProp.DataType); !ok {
+			continue
+		}
+		if _, ok := AsNested(updateProp.DataType); !ok {
+			continue
+		}
+
+		nested, nestedChanged := MergeNestedProperties(existingProp.NestedProperties,
+			updateProp.NestedProperties)
+		if nestedChanged {
+			copied := *existingProp
+			copied.NestedProperties = nested
+			merged[i] = &copied
+			changed = true
+		}
+	}
+
+	return merged, changed
+}
+
+func nestedPropertyIndex(nestedProps []*models.NestedProperty, name string) int {
+	for i := range nestedProps {
+		if strings.EqualFold(nestedProps[i].Name, name) {
+			return i
+		}
+	}
+	return -1
+}
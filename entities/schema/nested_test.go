@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func nestedTestClass() *models.Class {
+	return &models.Class{
+		Class: "Person",
+		Properties: []*models.Property{
+			{
+				Name:     "name",
+				DataType: DataTypeText.PropString(),
+			},
+			{
+				Name:     "address",
+				DataType: DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: DataTypeText.PropString(), Tokenization: "word"},
+					{
+						Name:     "geo",
+						DataType: DataTypeObject.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "zip", DataType: DataTypeInt.PropString()},
+						},
+					},
+				},
+			},
+			{
+				Name:     "pets",
+				DataType: DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "name", DataType: DataTypeText.PropString()},
+					{Name: "tags", DataType: DataTypeTextArray.PropString()},
+				},
+			},
+		},
+	}
+}
+
+func TestFlattenNestedProperties(t *testing.T) {
+	class := nestedTestClass()
+
+	t.Run("primitive property", func(t *testing.T) {
+		assert.Nil(t, FlattenNestedProperties(class.Properties[0]))
+	})
+
+	t.Run("object property", func(t *testing.T) {
+		leaves := FlattenNestedProperties(class.Properties[1])
+		require.Len(t, leaves, 2)
+		assert.Equal(t, "address.city", leaves[0].Name)
+		assert.Equal(t, DataTypeText.PropString(), leaves[0].DataType)
+		assert.Equal(t, "word", leaves[0].Tokenization)
+		assert.Equal(t, "address.geo.zip", leaves[1].Name)
+		assert.Equal(t, DataTypeInt.PropString(), leaves[1].DataType)
+	})
+
+	t.Run("object[] property", func(t *testing.T) {
+		leaves := FlattenNestedProperties(class.Properties[2])
+		require.Len(t, leaves, 2)
+		assert.Equal(t, "pets.name", leaves[0].Name)
+		assert.Equal(t, DataTypeTextArray.PropString(), leaves[0].DataType)
+		assert.Equal(t, "pets.tags", leaves[1].Name)
+		assert.Equal(t, DataTypeTextArray.PropString(), leaves[1].DataType)
+	})
+}
+
+func TestGetPropertyByNameNested(t *testing.T) {
+	class := nestedTestClass()
+
+	prop, err := GetPropertyByName(class, "address")
+	require.Nil(t, err)
+	assert.Equal(t, DataTypeObject.PropString(), prop.DataType)
+
+	prop, err = GetPropertyByName(class, "address.geo.zip")
+	require.Nil(t, err)
+	assert.Equal(t, "address.geo.zip", prop.Name)
+	assert.Equal(t, DataTypeInt.PropString(), prop.DataType)
+
+	_, err = GetPropertyByName(class, "address.country")
+	assert.NotNil(t, err)
+}
+
+func TestNestedValues(t *testing.T) {
+	pets := []interface{}{
+		map[string]interface{}{"name": "Rex", "tags": []interface{}{"good", "dog"}},
+		map[string]interface{}{"name": "Tom"},
+	}
+	address := map[string]interface{}{
+		"city": "Amsterdam",
+		"geo":  map[string]interface{}{"zip": int64(1011)},
+	}
+
+	assert.Equal(t, []interface{}{"Amsterdam"}, NestedValues(address, []string{"city"}))
+	assert.Equal(t, []interface{}{int64(1011)}, NestedValues(address, []string{"geo", "zip"}))
+	assert.Nil(t, NestedValues(address, []string{"country"}))
+	assert.Nil(t, NestedValues(address, []string{"city", "name"}))
+	assert.Equal(t, []interface{}{"Rex", "Tom"}, NestedValues(pets, []string{"name"}))
+	assert.Equal(t, []interface{}{"good", "dog"}, NestedValues(pets, []string{"tags"}))
+}
+
+func TestMergeNestedProperties(t *testing.T) {
+	existing := []*models.NestedProperty{
+		{Name: "city", DataType: DataTypeText.PropString()},
+		{
+			Name:     "geo",
+			DataType: DataTypeObject.PropString(),
+			NestedProperties: []*models.NestedProperty{
+				{Name: "zip", DataType: DataTypeInt.PropString()},
+			},
+		},
+	}
+
+	t.Run("nothing new", func(t *testing.T) {
+		merged, changed := MergeNestedProperties(existing, []*models.NestedProperty{
+			{Name: "city", DataType: DataTypeInt.PropString()},
+		})
+		assert.False(t, changed)
+		assert.Equal(t, existing, merged)
+	})
+
+	t.Run("new nested properties", func(t *testing.T) {
+		merged, changed := MergeNestedProperties(existing, []*models.NestedProperty{
+			{Name: "country", DataType: DataTypeText.PropString()},
+			{
+				Name:     "geo",
+				DataType: DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "lat", DataType: DataTypeNumber.PropString()},
+				},
+			},
+		})
+		assert.True(t, changed)
+		require.Len(t, merged, 3)
+		assert.Equal(t, "country", merged[2].Name)
+		require.Len(t, merged[1].NestedProperties, 2)
+		assert.Equal(t, "lat", merged[1].NestedProperties[1].Name)
+		// the existing nested properties are left untouched
+		assert.Len(t, existing[1].NestedProperties, 1)
+	})
+}
@@ -14,6 +14,7 @@ package schema
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 var (
@@ -48,6 +49,17 @@ func ValidatePropertyName(name string) (PropertyName, error) {
 		"which must be “/[_A-Za-z][_0-9A-Za-z]*/”.", name)
 }
 
+// ValidatePropertyPath validates that this string is either a valid property
+// name or the dotted path of a nested property, such as address.city
+func ValidatePropertyPath(path string) (PropertyName, error) {
+	for _, segment := range strings.Split(path, ".") {
+		if _, err := ValidatePropertyName(segment); err != nil {
+			return "", err
+		}
+	}
+	return PropertyName(path), nil
+}
+
 // ValidateReservedPropertyName validates that a string is not a reserved property name
 func ValidateReservedPropertyName(name string) error {
 	for i := range reservedPropertyNames {
@@ -0,0 +1,27 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package search
+
+import "github.com/go-openapi/strfmt"
+
+// Duplicate is an object found by a search for duplicates
+type Duplicate struct {
+	ID               strfmt.UUID `json:"id"`
+	CreationTimeUnix int64       `json:"creationTimeUnix"`
+}
+
+// DuplicatePair holds two objects whose vectors are within the distance of
+// a search for duplicates
+type DuplicatePair struct {
+	A Duplicate `json:"a"`
+	B Duplicate `json:"b"`
+}
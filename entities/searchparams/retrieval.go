@@ -73,8 +73,60 @@ type NearTextParams struct {
 	Autocorrect  bool
 }
 
+const (
+	// RecommendStrategyCentroid searches near the centroid of the positive
+	// examples, moved away from the centroid of the negative examples
+	RecommendStrategyCentroid = "centroid"
+	// RecommendStrategyBestScore scores every object by its distance to the
+	// closest positive minus its distance to the closest negative example
+	RecommendStrategyBestScore = "bestScore"
+)
+
+// Recommend searches for objects similar to the positive and dissimilar to
+// the negative examples, given as object IDs, vectors or both
+type Recommend struct {
+	Positive        []string    `json:"positive"`
+	Negative        []string    `json:"negative"`
+	PositiveVectors [][]float32 `json:"positiveVectors"`
+	NegativeVectors [][]float32 `json:"negativeVectors"`
+	Strategy        string      `json:"strategy"`
+	Certainty       float64     `json:"certainty"`
+	Distance        float64     `json:"distance"`
+	WithDistance    bool        `json:"-"`
+}
+
+// IsBestScore reports whether the search is a recommendation by best score,
+// which the vector index evaluates on the examples instead of a search vector
+func (r *Recommend) IsBestScore() bool {
+	return r != nil && r.Strategy == RecommendStrategyBestScore
+}
+
 type GroupBy struct {
 	Property        string
 	Groups          int
 	ObjectsPerGroup int
 }
+
+// Facets requests the counts of the indexed terms of each property across
+// all objects matched by a search
+type Facets struct {
+	Properties []string `json:"properties"`
+	Limit      int      `json:"limit"`
+	// Terms restricts the counts to the given terms of each property, in the
+	// order of Properties. It is set internally to count the most frequent
+	// terms across all shards exactly.
+	Terms [][]string `json:"terms,omitempty"`
+}
+
+// DefaultMMRLambda weighs relevance and diversity equally
+const DefaultMMRLambda = 0.5
+
+// MMR re-ranks the results of a vector or hybrid search by maximal marginal
+// relevance. FetchLimit candidates are retrieved from the index, of which the
+// ones are picked that balance the similarity to the query (weighted by
+// Lambda) against the similarity to the results already picked (weighted by
+// 1 - Lambda).
+type MMR struct {
+	Lambda     float64 `json:"lambda"`
+	FetchLimit int     `json:"fetchLimit"`
+}
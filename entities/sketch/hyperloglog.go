@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sketch
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/spaolacci/murmur3"
+)
+
+// DefaultPrecision uses 2^14 registers, for a standard error of about 0.8%
+const DefaultPrecision = 14
+
+// HyperLogLog estimates the number of distinct values, see Flajolet et al.,
+// "HyperLogLog: the analysis of a near-optimal cardinality estimation
+// algorithm". Sketches of the same precision are merged by keeping the
+// larger value of every register.
+type HyperLogLog struct {
+	Precision uint8   `json:"precision"`
+	Registers []uint8 `json:"registers"`
+}
+
+func NewHyperLogLog(precision uint8) *HyperLogLog {
+	return &HyperLogLog{
+		Precision: precision,
+		Registers: make([]uint8, 1<<precision),
+	}
+}
+
+func (h *HyperLogLog) AddString(value string) {
+	h.AddHash(murmur3.Sum64([]byte(value)))
+}
+
+func (h *HyperLogLog) AddFloat64(value float64) {
+	if value == 0 {
+		value = 0 // normalize -0
+	}
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, math.Float64bits(value))
+	h.AddHash(murmur3.Sum64(buf))
+}
+
+// AddHash adds a value by its 64 bit hash
+func (h *HyperLogLog) AddHash(hash uint64) {
+	index := hash >> (64 - h.Precision)
+	// the sentinel bit caps the rank if all remaining bits are zero
+	rest := hash<<h.Precision | 1<<(h.Precision-1)
+	rank := uint8(bits.LeadingZeros64(rest)) + 1
+	if rank > h.Registers[index] {
+		h.Registers[index] = rank
+	}
+}
+
+// Merge adds all values of other to h
+func (h *HyperLogLog) Merge(other *HyperLogLog) error {
+	if other == nil {
+		return nil
+	}
+	if other.Precision != h.Precision {
+		return fmt.Errorf("cannot merge hyperloglog of precision %d into %d",
+			other.Precision, h.Precision)
+	}
+
+	for i, rank := range other.Registers {
+		if rank > h.Registers[i] {
+			h.Registers[i] = rank
+		}
+	}
+	return nil
+}
+
+// Estimate returns the approximate number of distinct values
+func (h *HyperLogLog) Estimate() uint64 {
+	m := float64(len(h.Registers))
+	if m == 0 {
+		return 0
+	}
+
+	sum, zeros := 0.0, 0
+	for _, rank := range h.Registers {
+		sum += math.Ldexp(1, -int(rank))
+		if rank == 0 {
+			zeros++
+		}
+	}
+
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+
+	// linear counting is more accurate for small cardinalities
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return uint64(estimate + 0.5)
+}
@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sketch
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHyperLogLogEstimate(t *testing.T) {
+	for _, distinct := range []int{0, 10, 1000, 100000} {
+		t.Run(fmt.Sprint(distinct), func(t *testing.T) {
+			hll := NewHyperLogLog(DefaultPrecision)
+			for i := 0; i < distinct; i++ {
+				// every value is added twice, duplicates must not be counted
+				hll.AddString(fmt.Sprintf("value-%d", i))
+				hll.AddString(fmt.Sprintf("value-%d", i))
+			}
+
+			assert.InDelta(t, distinct, hll.Estimate(), 0.03*float64(distinct)+1)
+		})
+	}
+}
+
+func TestHyperLogLogMergeAcrossJSON(t *testing.T) {
+	combined := NewHyperLogLog(DefaultPrecision)
+	for shard := 0; shard < 3; shard++ {
+		hll := NewHyperLogLog(DefaultPrecision)
+		// the shards overlap by half of their values
+		for i := shard * 5000; i < shard*5000+10000; i++ {
+			hll.AddFloat64(float64(i))
+		}
+
+		bytes, err := json.Marshal(hll)
+		require.Nil(t, err)
+		var remote HyperLogLog
+		require.Nil(t, json.Unmarshal(bytes, &remote))
+		require.Nil(t, combined.Merge(&remote))
+	}
+
+	assert.InDelta(t, 20000, combined.Estimate(), 600)
+	assert.NotNil(t, combined.Merge(NewHyperLogLog(10)))
+}
@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package sketch contains mergeable data structures which approximate
+// aggregations in constant memory, so they can be computed per shard and
+// combined afterwards.
+package sketch
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+)
+
+// DefaultCompression bounds a TDigest to roughly 100 centroids, which keeps
+// the error of tail quantiles well below one percent
+const DefaultCompression = 100
+
+// TDigest approximates the quantiles of a distribution of values. It keeps
+// a small number of centroids which are most accurate at the tails, see
+// Dunning, "Computing Extremely Accurate Quantiles Using t-Digests".
+//
+// A TDigest can be marshalled to JSON and merged with other digests, which
+// is how the sketches of several shards are combined.
+type TDigest struct {
+	Compression float64    `json:"compression"`
+	Centroids   []Centroid `json:"centroids"`
+	Count       float64    `json:"count"`
+	Min         float64    `json:"min"`
+	Max         float64    `json:"max"`
+
+	// values are buffered and only merged into the centroids once the buffer
+	// is full, as merging requires sorting
+	unmerged []Centroid
+}
+
+// Centroid is the mean of Weight values which are close to each other
+type Centroid struct {
+	Mean   float64 `json:"m"`
+	Weight float64 `json:"w"`
+}
+
+func NewTDigest(compression float64) *TDigest {
+	return &TDigest{Compression: compression}
+}
+
+// Add adds value weight times
+func (t *TDigest) Add(value, weight float64) {
+	if weight <= 0 || math.IsNaN(value) {
+		return
+	}
+
+	if t.Count == 0 || value < t.Min {
+		t.Min = value
+	}
+	if t.Count == 0 || value > t.Max {
+		t.Max = value
+	}
+	t.unmerged = append(t.unmerged, Centroid{Mean: value, Weight: weight})
+	t.Count += weight
+
+	if len(t.unmerged) >= t.bufferSize() {
+		t.compress()
+	}
+}
+
+// Merge adds all values of other to t
+func (t *TDigest) Merge(other *TDigest) {
+	if other == nil || other.Count == 0 {
+		return
+	}
+
+	if t.Count == 0 || other.Min < t.Min {
+		t.Min = other.Min
+	}
+	if t.Count == 0 || other.Max > t.Max {
+		t.Max = other.Max
+	}
+	t.unmerged = append(t.unmerged, other.Centroids...)
+	t.unmerged = append(t.unmerged, other.unmerged...)
+	t.Count += other.Count
+	t.compress()
+}
+
+// Quantile returns the approximate value below which q (0 to 1) of all values
+// fall. It returns NaN if no values were added.
+func (t *TDigest) Quantile(q float64) float64 {
+	t.compress()
+
+	if len(t.Centroids) == 0 {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return t.Min
+	}
+	if q >= 1 {
+		return t.Max
+	}
+	if len(t.Centroids) == 1 {
+		return t.Centroids[0].Mean
+	}
+
+	// the mean of a centroid is assumed to sit in the middle of its weight,
+	// values in between are interpolated linearly
+	index := q * t.Count
+	first := t.Centroids[0]
+	if index < first.Weight/2 {
+		return t.Min + (first.Mean-t.Min)*index/(first.Weight/2)
+	}
+
+	cumulative := 0.0
+	for i := 0; i < len(t.Centroids)-1; i++ {
+		cur, next := t.Centroids[i], t.Centroids[i+1]
+		from := cumulative + cur.Weight/2
+		to := cumulative + cur.Weight + next.Weight/2
+		if index < to {
+			return cur.Mean + (next.Mean-cur.Mean)*(index-from)/(to-from)
+		}
+		cumulative += cur.Weight
+	}
+
+	last := t.Centroids[len(t.Centroids)-1]
+	from := t.Count - last.Weight/2
+	if last.Weight/2 == 0 {
+		return t.Max
+	}
+	return last.Mean + (t.Max-last.Mean)*(index-from)/(last.Weight/2)
+}
+
+func (t *TDigest) MarshalJSON() ([]byte, error) {
+	t.compress()
+
+	type digest TDigest
+	return json.Marshal((*digest)(t))
+}
+
+func (t *TDigest) compression() float64 {
+	if t.Compression <= 0 {
+		return DefaultCompression
+	}
+	return t.Compression
+}
+
+func (t *TDigest) bufferSize() int {
+	return int(5 * t.compression())
+}
+
+// compress merges the buffered values into the centroids. Neighbouring
+// centroids are merged as long as their combined weight stays within the
+// size limit of the scale function k(q) = δ/2π * asin(2q-1).
+func (t *TDigest) compress() {
+	if len(t.unmerged) == 0 {
+		return
+	}
+
+	all := append(t.Centroids, t.unmerged...)
+	t.unmerged = nil
+	sort.Slice(all, func(a, b int) bool {
+		return all[a].Mean < all[b].Mean
+	})
+
+	total := 0.0
+	for _, c := range all {
+		total += c.Weight
+	}
+
+	merged := make([]Centroid, 0, int(t.compression()))
+	cur := all[0]
+	weightSoFar := 0.0
+	limit := total * t.qLimit(0)
+	for _, next := range all[1:] {
+		if weightSoFar+cur.Weight+next.Weight <= limit {
+			cur.Mean += (next.Mean - cur.Mean) * next.Weight / (cur.Weight + next.Weight)
+			cur.Weight += next.Weight
+			continue
+		}
+
+		weightSoFar += cur.Weight
+		merged = append(merged, cur)
+		limit = total * t.qLimit(weightSoFar/total)
+		cur = next
+	}
+	t.Centroids = append(merged, cur)
+}
+
+// qLimit returns the largest quantile a centroid starting at quantile q may
+// reach
+func (t *TDigest) qLimit(q float64) float64 {
+	delta := t.compression()
+	k := delta/(2*math.Pi)*math.Asin(2*q-1) + 1
+	if k >= delta/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/delta) + 1) / 2
+}
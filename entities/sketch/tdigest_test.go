@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sketch
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTDigestQuantiles(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	values := make([]float64, 100000)
+	digest := NewTDigest(DefaultCompression)
+	for i := range values {
+		values[i] = r.ExpFloat64() * 100
+		digest.Add(values[i], 1)
+	}
+	sort.Float64s(values)
+
+	assert.LessOrEqual(t, len(digest.Centroids), 2*DefaultCompression)
+	for _, q := range []float64{0.001, 0.01, 0.25, 0.5, 0.75, 0.95, 0.99, 0.999} {
+		// the error of a t-digest is bounded in rank rather than value, and
+		// smallest at the tails
+		rank := float64(sort.SearchFloat64s(values, digest.Quantile(q))) / float64(len(values))
+		assert.InDelta(t, q, rank, 0.01*math.Min(q, 1-q)+0.001, "quantile %v", q)
+	}
+	assert.Equal(t, values[0], digest.Quantile(0))
+	assert.Equal(t, values[len(values)-1], digest.Quantile(1))
+}
+
+func TestTDigestMergeAcrossJSON(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	values := make([]float64, 0, 30000)
+	combined := NewTDigest(DefaultCompression)
+	for shard := 0; shard < 3; shard++ {
+		digest := NewTDigest(DefaultCompression)
+		for i := 0; i < 10000; i++ {
+			v := r.NormFloat64()*10 + float64(shard*20)
+			values = append(values, v)
+			digest.Add(v, 1)
+		}
+
+		// shards on remote nodes send their digests as JSON
+		bytes, err := json.Marshal(digest)
+		require.Nil(t, err)
+		var remote TDigest
+		require.Nil(t, json.Unmarshal(bytes, &remote))
+		combined.Merge(&remote)
+	}
+	sort.Float64s(values)
+
+	assert.Equal(t, float64(len(values)), combined.Count)
+	for _, q := range []float64{0.05, 0.5, 0.95, 0.99} {
+		exact := values[int(q*float64(len(values)))]
+		assert.InDelta(t, exact, combined.Quantile(q), 0.5, "quantile %v", q)
+	}
+}
+
+func TestTDigestWeightedAndEmpty(t *testing.T) {
+	digest := NewTDigest(DefaultCompression)
+	assert.True(t, math.IsNaN(digest.Quantile(0.5)))
+
+	_, err := json.Marshal(digest)
+	require.Nil(t, err)
+
+	digest.Add(1, 3)
+	digest.Add(10, 1)
+	assert.Equal(t, 1.0, digest.Quantile(0.25))
+	assert.Equal(t, 10.0, digest.Quantile(1))
+}
@@ -17,14 +17,53 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
-func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
-	if schema == nil {
+// EnrichSchemaTypes parses the maps and arrays of maps of the object which
+// were kept as they are because the object was unmarshalled without a class,
+// e.g. maps of geoCoordinates properties into *models.GeoCoordinates. All
+// other values already have their type and are kept.
+func (ko *Object) EnrichSchemaTypes(class *models.Class) error {
+	props, ok := ko.Object.Properties.(map[string]interface{})
+	if !ok || class == nil {
 		return nil
 	}
 
-	for propName, value := range schema {
+	for propName, value := range props {
+		switch typed := value.(type) {
+		case []interface{}:
+			if len(typed) == 0 || isArrayValue(typed) {
+				continue
+			}
+		case map[string]interface{}:
+		default:
+			continue
+		}
+
+		parsed, err := parseMapValue(propName, value, propertyDataType(class, propName))
+		if err != nil {
+			return err
+		}
+		props[propName] = parsed
+	}
+
+	return nil
+}
+
+// enrichSchemaTypes parses the property values of a freshly unmarshalled
+// object. Maps and arrays of maps are ambiguous, a nested object can look
+// like a geo coordinate, a phone number or a reference. They are therefore
+// only parsed according to the data type of their property in class and kept
+// as they are if the class is unknown.
+func (ko *Object) enrichSchemaTypes(props map[string]interface{}, class *models.Class) error {
+	if props == nil {
+		return nil
+	}
+
+	for propName, value := range props {
+		dataType := propertyDataType(class, propName)
+
 		switch typed := value.(type) {
 		case []interface{}:
 			if isArrayValue(typed) {
@@ -35,21 +74,21 @@ func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
 						return errors.Wrapf(err, "property %q of type string array", propName)
 					}
 
-					schema[propName] = parsed
+					props[propName] = parsed
 				case bool:
 					parsed, err := parseBoolArrayValue(typed)
 					if err != nil {
 						return errors.Wrapf(err, "property %q of type boolean array", propName)
 					}
 
-					schema[propName] = parsed
+					props[propName] = parsed
 				default:
 					parsed, err := parseStringArrayValue(typed)
 					if err != nil {
 						return errors.Wrapf(err, "property %q of type string array", propName)
 					}
 
-					schema[propName] = parsed
+					props[propName] = parsed
 				}
 			} else if len(typed) == 0 {
 				// empty arrays. Here we use []interface{} as a placeholder
@@ -57,22 +96,22 @@ func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
 				// actual type. in the future, we should persist the schema
 				// property type information alongside the value to avoid
 				// this situation
-				schema[propName] = typed
+				props[propName] = typed
 			} else {
-				parsed, err := parseCrossRef(typed)
+				parsed, err := parseMapValue(propName, typed, dataType)
 				if err != nil {
-					return errors.Wrapf(err, "property %q of type cross-ref", propName)
+					return err
 				}
 
-				schema[propName] = parsed
+				props[propName] = parsed
 			}
 		case map[string]interface{}:
-			parsed, err := parseMapProp(typed)
+			parsed, err := parseMapValue(propName, typed, dataType)
 			if err != nil {
-				return errors.Wrapf(err, "property %q of type map", propName)
+				return err
 			}
 
-			schema[propName] = parsed
+			props[propName] = parsed
 		default:
 			continue
 		}
@@ -81,22 +120,57 @@ func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
 	return nil
 }
 
-func parseMapProp(input map[string]interface{}) (interface{}, error) {
-	lat, latOK := input["latitude"]
-	lon, lonOK := input["longitude"]
-	_, phoneInputOK := input["input"]
-
-	if latOK && lonOK {
-		// this is a geoCoordinates prop
-		return parseGeoProp(lat, lon)
+// parseMapValue parses a map or an array of maps according to the data type
+// of its property. Nested objects and values of unknown properties are
+// returned as they are.
+func parseMapValue(propName string, value interface{}, dataType []string) (interface{}, error) {
+	if dataType == nil {
+		return value, nil
 	}
 
-	if phoneInputOK {
-		// this is a phone number
-		return parsePhoneNumber(input)
+	switch typed := value.(type) {
+	case []interface{}:
+		if !schema.IsRefDataType(dataType) {
+			return value, nil
+		}
+
+		parsed, err := parseCrossRef(typed)
+		if err != nil {
+			return nil, errors.Wrapf(err, "property %q of type cross-ref", propName)
+		}
+		return parsed, nil
+	case map[string]interface{}:
+		switch schema.DataType(dataType[0]) {
+		case schema.DataTypeGeoCoordinates:
+			parsed, err := parseGeoProp(typed["latitude"], typed["longitude"])
+			if err != nil {
+				return nil, errors.Wrapf(err, "property %q of type geoCoordinates", propName)
+			}
+			return parsed, nil
+		case schema.DataTypePhoneNumber:
+			parsed, err := parsePhoneNumber(typed)
+			if err != nil {
+				return nil, errors.Wrapf(err, "property %q of type phoneNumber", propName)
+			}
+			return parsed, nil
+		}
 	}
 
-	return nil, fmt.Errorf("unknown map prop which is not a geo prop or phone: %v", input)
+	return value, nil
+}
+
+// propertyDataType returns the data type of the top-level property, nil if
+// the class or the property are unknown
+func propertyDataType(class *models.Class, propName string) []string {
+	if class == nil {
+		return nil
+	}
+	for _, prop := range class.Properties {
+		if prop.Name == propName && len(prop.DataType) > 0 {
+			return prop.DataType
+		}
+	}
+	return nil
 }
 
 func parseGeoProp(lat interface{}, lon interface{}) (*models.GeoCoordinates, error) {
@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"strconv"
+	"strings"
 
 	"github.com/buger/jsonparser"
 	"github.com/google/uuid"
@@ -62,7 +63,12 @@ func parseAndExtractValueProp(data []byte, propName string, valueFn func(value [
 		return err
 	}
 
-	val, t, _, err := jsonparser.Get(propsBytes, propName)
+	// nested properties are addressed by their dotted path
+	return extractValues(propsBytes, strings.Split(propName, "."), valueFn)
+}
+
+func extractValues(data []byte, path []string, valueFn func(value []byte)) error {
+	val, t, _, err := jsonparser.Get(data, path[0])
 	// Some objects can have nil as value for the property, in this case skip the object
 	if err != nil {
 		if err.Error() == "Key path not found" {
@@ -71,6 +77,23 @@ func parseAndExtractValueProp(data []byte, propName string, valueFn func(value [
 		return err
 	}
 
+	if len(path) > 1 {
+		// descend into a nested object, or into every object of a nested
+		// object array
+		if t != jsonparser.Array {
+			return extractValues(val, path[1:], valueFn)
+		}
+		var nestedErr error
+		if _, err := jsonparser.ArrayEach(val, func(value []byte, dataType jsonparser.ValueType, offset int, _ error) {
+			if nestedErr == nil && dataType == jsonparser.Object {
+				nestedErr = extractValues(value, path[1:], valueFn)
+			}
+		}); err != nil {
+			return err
+		}
+		return nestedErr
+	}
+
 	if t == jsonparser.Array {
 		jsonparser.ArrayEach(val, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
 			valueFn(value)
@@ -71,9 +71,12 @@ func FromObject(object *models.Object, vector []float32) *Object {
 	}
 }
 
-func FromBinary(data []byte) (*Object, error) {
+// FromBinary unmarshals the object, parsing its property values according
+// to their data types in class. A nil class leaves ambiguous values, such as
+// maps, as they are, see enrichSchemaTypes.
+func FromBinary(data []byte, class *models.Class) (*Object, error) {
 	ko := &Object{}
-	if err := ko.UnmarshalBinary(data); err != nil {
+	if err := ko.unmarshalBinary(data, class); err != nil {
 		return nil, err
 	}
 
@@ -109,7 +112,7 @@ func FromBinaryUUIDOnly(data []byte) (*Object, error) {
 }
 
 func FromBinaryOptional(data []byte,
-	addProp additional.Properties,
+	addProp additional.Properties, class *models.Class,
 ) (*Object, error) {
 	if addProp.NoProps {
 		return FromBinaryUUIDOnly(data)
@@ -197,6 +200,7 @@ func FromBinaryOptional(data []byte,
 		schema,
 		meta,
 		vectorWeights,
+		class,
 	); err != nil {
 		return nil, errors.Wrap(err, "parse")
 	}
@@ -209,7 +213,7 @@ type bucket interface {
 }
 
 func ObjectsByDocID(bucket bucket, ids []uint64,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) ([]*Object, error) {
 	if bucket == nil {
 		return nil, fmt.Errorf("objects bucket not found")
@@ -232,7 +236,7 @@ func ObjectsByDocID(bucket bucket, ids []uint64,
 			continue
 		}
 
-		unmarshalled, err := FromBinaryOptional(res, additional)
+		unmarshalled, err := FromBinaryOptional(res, additional, class)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarshal data object at position %d", i)
 		}
@@ -442,6 +446,21 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 	return docID, err
 }
 
+// UpdateTimeFromBinary reads the last update time of an object from its
+// binary representation without unmarshalling the whole object
+func UpdateTimeFromBinary(in []byte) (int64, error) {
+	const offset = 1 + 8 + 1 + 16 + 8 // version, docID, kind, uuid, create time
+	if len(in) < offset+8 {
+		return 0, errors.Errorf("binary object too short: %d bytes", len(in))
+	}
+
+	if version := in[0]; version != 1 {
+		return 0, errors.Errorf("unsupported binary marshaller version %d", version)
+	}
+
+	return int64(binary.LittleEndian.Uint64(in[offset : offset+8])), nil
+}
+
 // MarshalBinary creates the binary representation of a kind object. Regardless
 // of the marshaller version the first byte is a uint8 indicating the version
 // followed by the payload which depends on the specific version
@@ -631,8 +650,13 @@ func parseValues(dt jsonparser.ValueType, value []byte) (interface{}, error) {
 }
 
 // UnmarshalBinary is the versioned way to unmarshal a kind object from binary,
-// see MarshalBinary for the exact contents of each version
+// see MarshalBinary for the exact contents of each version. Without a class
+// ambiguous property values are kept as they are, see FromBinary.
 func (ko *Object) UnmarshalBinary(data []byte) error {
+	return ko.unmarshalBinary(data, nil)
+}
+
+func (ko *Object) unmarshalBinary(data []byte, class *models.Class) error {
 	version := data[0]
 	if version != 1 {
 		return errors.Errorf("unsupported binary marshaller version %d", version)
@@ -691,6 +715,7 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		schema,
 		meta,
 		vectorWeights,
+		class,
 	)
 }
 
@@ -726,13 +751,14 @@ func VectorFromBinary(in []byte) ([]float32, error) {
 
 func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className string,
 	schemaB []byte, additionalB []byte, vectorWeightsB []byte,
+	class *models.Class,
 ) error {
 	var schema map[string]interface{}
 	if err := json.Unmarshal(schemaB, &schema); err != nil {
 		return err
 	}
 
-	if err := ko.enrichSchemaTypes(schema); err != nil {
+	if err := ko.enrichSchemaTypes(schema, class); err != nil {
 		return errors.Wrap(err, "enrich schema datatypes")
 	}
 
@@ -56,7 +56,7 @@ func TestStorageObjectMarshalling(t *testing.T) {
 	asBinary, err := before.MarshalBinary()
 	require.Nil(t, err)
 
-	after, err := FromBinary(asBinary)
+	after, err := FromBinary(asBinary, nil)
 	require.Nil(t, err)
 
 	t.Run("compare", func(t *testing.T) {
@@ -69,6 +69,12 @@ func TestStorageObjectMarshalling(t *testing.T) {
 		assert.Equal(t, uint64(7), id)
 	})
 
+	t.Run("extract only update time and compare", func(t *testing.T) {
+		updateTime, err := UpdateTimeFromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, int64(56789), updateTime)
+	})
+
 	t.Run("extract single text prop", func(t *testing.T) {
 		prop, ok, err := ParseAndExtractTextProp(asBinary, "name")
 		require.Nil(t, err)
@@ -141,7 +147,7 @@ func TestStorageObjectUnmarshallingSpecificProps(t *testing.T) {
 	require.Nil(t, err)
 
 	t.Run("without any optional", func(t *testing.T) {
-		after, err := FromBinaryOptional(asBinary, additional.Properties{})
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
 		require.Nil(t, err)
 
 		t.Run("compare", func(t *testing.T) {
@@ -304,7 +310,7 @@ func TestStorageArrayObjectMarshalling(t *testing.T) {
 	asBinary, err := before.MarshalBinary()
 	require.Nil(t, err)
 
-	after, err := FromBinary(asBinary)
+	after, err := FromBinary(asBinary, nil)
 	require.Nil(t, err)
 
 	t.Run("compare", func(t *testing.T) {
@@ -448,7 +454,7 @@ func TestStorageObjectMarshallingWithGroup(t *testing.T) {
 	asBinary, err := before.MarshalBinary()
 	require.Nil(t, err)
 
-	after, err := FromBinary(asBinary)
+	after, err := FromBinary(asBinary, nil)
 	require.Nil(t, err)
 
 	t.Run("compare", func(t *testing.T) {
@@ -499,3 +505,97 @@ func TestStorageObjectMarshallingWithGroup(t *testing.T) {
 		assert.Equal(t, "value2", group.Hits[1]["property1"])
 	})
 }
+
+func TestStorageObjectMarshallingWithClass(t *testing.T) {
+	class := &models.Class{
+		Class: "MyFavoriteClass",
+		Properties: []*models.Property{
+			{Name: "location", DataType: []string{string(schema.DataTypeGeoCoordinates)}},
+			{Name: "phone", DataType: []string{string(schema.DataTypePhoneNumber)}},
+			{Name: "ref", DataType: []string{"OtherClass"}},
+			{
+				Name:     "device",
+				DataType: []string{string(schema.DataTypeObject)},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "input", DataType: []string{string(schema.DataTypeText)}},
+				},
+			},
+			{
+				Name:     "places",
+				DataType: []string{string(schema.DataTypeObjectArray)},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "latitude", DataType: []string{string(schema.DataTypeNumber)}},
+					{Name: "longitude", DataType: []string{string(schema.DataTypeNumber)}},
+					{Name: "beacon", DataType: []string{string(schema.DataTypeText)}},
+				},
+			},
+		},
+	}
+
+	// the nested objects look like a phone number and like references to
+	// geo coordinates, only the schema tells them apart
+	device := map[string]interface{}{"input": "+49 171 1234567"}
+	places := []interface{}{
+		map[string]interface{}{
+			"latitude":  float64(52),
+			"longitude": float64(13),
+			"beacon":    "lighthouse",
+		},
+	}
+	before := FromObject(
+		&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"location": &models.GeoCoordinates{
+					Latitude:  ptFloat32(52),
+					Longitude: ptFloat32(13),
+				},
+				"phone": &models.PhoneNumber{Input: "+49 171 1234567", Valid: true},
+				"ref": models.MultipleRef{
+					{Beacon: "weaviate://localhost/OtherClass/8d5a3aa2-3c8d-4589-9ae1-3f638f506970"},
+				},
+				"device": device,
+				"places": places,
+			},
+		},
+		nil,
+	)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("with the class", func(t *testing.T) {
+		after, err := FromBinary(asBinary, class)
+		require.Nil(t, err)
+
+		props := after.Properties().(map[string]interface{})
+		assert.Equal(t, before.Properties().(map[string]interface{})["location"], props["location"])
+		assert.Equal(t, before.Properties().(map[string]interface{})["phone"], props["phone"])
+		assert.Equal(t, before.Properties().(map[string]interface{})["ref"], props["ref"])
+		assert.Equal(t, device, props["device"])
+		assert.Equal(t, places, props["places"])
+	})
+
+	t.Run("without the class", func(t *testing.T) {
+		after, err := FromBinary(asBinary, nil)
+		require.Nil(t, err)
+
+		props := after.Properties().(map[string]interface{})
+		assert.IsType(t, map[string]interface{}{}, props["location"])
+		assert.IsType(t, map[string]interface{}{}, props["phone"])
+		assert.IsType(t, []interface{}{}, props["ref"])
+		assert.Equal(t, device, props["device"])
+		assert.Equal(t, places, props["places"])
+
+		t.Run("enriched later", func(t *testing.T) {
+			require.Nil(t, after.EnrichSchemaTypes(class))
+
+			props := after.Properties().(map[string]interface{})
+			assert.Equal(t, before.Properties().(map[string]interface{})["location"], props["location"])
+			assert.Equal(t, before.Properties().(map[string]interface{})["ref"], props["ref"])
+			assert.Equal(t, device, props["device"])
+			assert.Equal(t, places, props["places"])
+		})
+	})
+}
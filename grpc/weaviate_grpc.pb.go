@@ -29,6 +29,9 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	BatchSearch(ctx context.Context, in *BatchSearchRequest, opts ...grpc.CallOption) (*BatchSearchReply, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Weaviate_ExportClient, error)
+	Import(ctx context.Context, opts ...grpc.CallOption) (Weaviate_ImportClient, error)
 }
 
 type weaviateClient struct {
@@ -48,11 +51,89 @@ func (c *weaviateClient) Search(ctx context.Context, in *SearchRequest, opts ...
 	return out, nil
 }
 
+func (c *weaviateClient) BatchSearch(ctx context.Context, in *BatchSearchRequest, opts ...grpc.CallOption) (*BatchSearchReply, error) {
+	out := new(BatchSearchReply)
+	err := c.cc.Invoke(ctx, "/weaviategrpc.Weaviate/BatchSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Weaviate_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], "/weaviategrpc.Weaviate/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_ExportClient interface {
+	Recv() (*ExportReply, error)
+	grpc.ClientStream
+}
+
+type weaviateExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateExportClient) Recv() (*ExportReply, error) {
+	m := new(ExportReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) Import(ctx context.Context, opts ...grpc.CallOption) (Weaviate_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[1], "/weaviategrpc.Weaviate/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateImportClient{stream}
+	return x, nil
+}
+
+type Weaviate_ImportClient interface {
+	Send(*ImportRequest) error
+	CloseAndRecv() (*ImportReply, error)
+	grpc.ClientStream
+}
+
+type weaviateImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateImportClient) Send(m *ImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *weaviateImportClient) CloseAndRecv() (*ImportReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
+	BatchSearch(context.Context, *BatchSearchRequest) (*BatchSearchReply, error)
+	Export(*ExportRequest, Weaviate_ExportServer) error
+	Import(Weaviate_ImportServer) error
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -62,6 +143,18 @@ type UnimplementedWeaviateServer struct{}
 func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*SearchReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+
+func (UnimplementedWeaviateServer) BatchSearch(context.Context, *BatchSearchRequest) (*BatchSearchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSearch not implemented")
+}
+
+func (UnimplementedWeaviateServer) Export(*ExportRequest, Weaviate_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+
+func (UnimplementedWeaviateServer) Import(Weaviate_ImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +186,71 @@ func _Weaviate_Search_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_BatchSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).BatchSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviategrpc.Weaviate/BatchSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).BatchSearch(ctx, req.(*BatchSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).Export(m, &weaviateExportServer{stream})
+}
+
+type Weaviate_ExportServer interface {
+	Send(*ExportReply) error
+	grpc.ServerStream
+}
+
+type weaviateExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateExportServer) Send(m *ExportReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Weaviate_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WeaviateServer).Import(&weaviateImportServer{stream})
+}
+
+type Weaviate_ImportServer interface {
+	SendAndClose(*ImportReply) error
+	Recv() (*ImportRequest, error)
+	grpc.ServerStream
+}
+
+type weaviateImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateImportServer) SendAndClose(m *ImportReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *weaviateImportServer) Recv() (*ImportRequest, error) {
+	m := new(ImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,7 +262,22 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _Weaviate_Search_Handler,
 		},
+		{
+			MethodName: "BatchSearch",
+			Handler:    _Weaviate_BatchSearch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _Weaviate_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _Weaviate_Import_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "weaviate.proto",
 }
@@ -203,6 +203,38 @@ func (a *azureClient) WriteToFile(ctx context.Context, backupID, key, destPath s
 	return nil
 }
 
+// Delete removes all objects stored for the backup
+func (a *azureClient) Delete(ctx context.Context, backupID string) error {
+	if backupID == "" {
+		return backup.NewErrInternal(errors.New("delete backup: empty backup id"))
+	}
+
+	prefix := a.makeObjectName(backupID) + "/"
+	pager := a.client.NewListBlobsFlatPager(a.config.Container,
+		&azblob.ListBlobsFlatOptions{Prefix: to.Ptr(prefix)})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list objects '%s'", prefix))
+		}
+		if page.Segment == nil {
+			continue
+		}
+
+		for _, item := range page.Segment.BlobItems {
+			if item.Name == nil {
+				continue
+			}
+			_, err := a.client.DeleteBlob(ctx, a.config.Container, *item.Name, nil)
+			if err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+				return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", *item.Name))
+			}
+		}
+	}
+
+	return nil
+}
+
 func (a *azureClient) SourceDataPath() string {
 	return a.dataPath
 }
@@ -152,6 +152,22 @@ func (m *Module) WriteToFile(ctx context.Context, backupID, key, destPath string
 	return nil
 }
 
+func (m *Module) Delete(ctx context.Context, backupID string) error {
+	if err := ctx.Err(); err != nil {
+		return backup.NewErrContextExpired(errors.Wrapf(err, "delete backup '%s'", backupID))
+	}
+	if backupID == "" {
+		return backup.NewErrInternal(errors.New("delete backup: empty backup id"))
+	}
+
+	backupPath := m.makeBackupDirPath(backupID)
+	if err := os.RemoveAll(backupPath); err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete backup '%s'", backupPath))
+	}
+
+	return nil
+}
+
 func (m *Module) SourceDataPath() string {
 	return m.dataPath
 }
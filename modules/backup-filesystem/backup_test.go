@@ -52,3 +52,23 @@ func TestBackend_StoreBackup(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestBackend_DeleteBackup(t *testing.T) {
+	ctx := context.Background()
+	module := New()
+	assert.Nil(t, module.initBackupBackend(ctx, t.TempDir()))
+
+	assert.Nil(t, module.PutObject(ctx, "backup-1", "backup_config.json", []byte("{}")))
+	assert.Nil(t, module.PutObject(ctx, "backup-2", "backup_config.json", []byte("{}")))
+
+	assert.Nil(t, module.Delete(ctx, "backup-1"))
+
+	_, err := module.GetObject(ctx, "backup-1", "backup_config.json")
+	assert.NotNil(t, err)
+	_, err = module.GetObject(ctx, "backup-2", "backup_config.json")
+	assert.Nil(t, err)
+
+	t.Run("deleting a non-existing backup is a no-op", func(t *testing.T) {
+		assert.Nil(t, module.Delete(ctx, "backup-1"))
+	})
+}
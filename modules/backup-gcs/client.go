@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
@@ -282,6 +283,35 @@ func (g *gcsClient) WriteToFile(ctx context.Context, backupID, key, destPath str
 	return nil
 }
 
+// Delete removes all objects stored for the backup
+func (g *gcsClient) Delete(ctx context.Context, backupID string) error {
+	if backupID == "" {
+		return backup.NewErrInternal(errors.New("delete backup: empty backup id"))
+	}
+
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return backup.NewErrInternal(errors.Wrap(err, "find bucket"))
+	}
+
+	prefix := g.makeObjectName(backupID) + "/"
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		}
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list objects '%s'", prefix))
+		}
+
+		err = bucket.Object(attrs.Name).Delete(ctx)
+		if err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", attrs.Name))
+		}
+	}
+}
+
 func (g *gcsClient) SourceDataPath() string {
 	return g.dataPath
 }
@@ -179,6 +179,56 @@ func (s *s3Client) WriteToFile(ctx context.Context, backupID, key, destPath stri
 	return nil
 }
 
+// Delete removes all objects stored for the backup
+func (s *s3Client) Delete(ctx context.Context, backupID string) error {
+	if backupID == "" {
+		return backup.NewErrInternal(errors.New("delete backup: empty backup id"))
+	}
+
+	prefix := s.makeObjectName(backupID) + "/"
+	opt := minio.ListObjectsOptions{Prefix: prefix, Recursive: true}
+
+	// listing stops as soon as the first removal fails
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var listErr error
+	objectsCh := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objectsCh)
+		for obj := range s.client.ListObjects(ctx, s.config.Bucket, opt) {
+			if obj.Err != nil {
+				listErr = obj.Err
+				return
+			}
+			select {
+			case objectsCh <- obj:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// all errors are consumed, otherwise the removal and thus the lister
+	// would block forever
+	var removeErr error
+	for rErr := range s.client.RemoveObjects(ctx, s.config.Bucket, objectsCh,
+		minio.RemoveObjectsOptions{}) {
+		if removeErr == nil {
+			removeErr = errors.Wrapf(rErr.Err, "delete object '%s'", rErr.ObjectName)
+			cancel()
+		}
+	}
+	if removeErr != nil {
+		return backup.NewErrInternal(removeErr)
+	}
+	if listErr != nil {
+		return backup.NewErrInternal(errors.Wrapf(listErr, "list objects '%s'", prefix))
+	}
+
+	return nil
+}
+
 func (s *s3Client) SourceDataPath() string {
 	return s.dataPath
 }
@@ -0,0 +1,286 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/generative-local/config"
+	"github.com/weaviate/weaviate/modules/generative-local/ent"
+)
+
+var compile, _ = regexp.Compile(`{([\w\s]*?)}`)
+
+var apiPaths = map[string]string{
+	config.ApiTypeOpenAI: "/chat/completions",
+	config.ApiTypeOllama: "/api/generate",
+}
+
+type local struct {
+	apiKey     string
+	httpClient *http.Client
+	logger     logrus.FieldLogger
+}
+
+func New(apiKey string, logger logrus.FieldLogger) *local {
+	return &local{
+		apiKey: apiKey,
+		httpClient: &http.Client{
+			// self-hosted models are typically slower than SaaS offerings
+			Timeout: 120 * time.Second,
+		},
+		logger: logger,
+	}
+}
+
+func (v *local) GenerateSingleResult(ctx context.Context, textProperties map[string]string, prompt string, cfg moduletools.ClassConfig) (*ent.GenerateResult, error) {
+	forPrompt, err := v.generateForPrompt(textProperties, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return v.Generate(ctx, cfg, forPrompt)
+}
+
+func (v *local) GenerateAllResults(ctx context.Context, textProperties []map[string]string, task string, cfg moduletools.ClassConfig) (*ent.GenerateResult, error) {
+	forTask, err := v.generatePromptForTask(textProperties, task)
+	if err != nil {
+		return nil, err
+	}
+	return v.Generate(ctx, cfg, forTask)
+}
+
+func (v *local) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string) (*ent.GenerateResult, error) {
+	settings := config.NewClassSettings(cfg)
+
+	endpointURL, err := url.JoinPath(settings.ApiEndpoint(), apiPaths[settings.ApiType()])
+	if err != nil {
+		return nil, errors.Wrap(err, "join API endpoint and path")
+	}
+
+	body, err := v.buildRequestBody(settings, prompt)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpointURL,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	if apiKey := v.getApiKey(ctx); apiKey != "" {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var content string
+	switch settings.ApiType() {
+	case config.ApiTypeOllama:
+		content, err = v.parseOllamaResponse(res.StatusCode, bodyBytes)
+	default:
+		content, err = v.parseOpenAIResponse(res.StatusCode, bodyBytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if content != "" {
+		trimmedResponse := strings.Trim(content, "\n")
+		return &ent.GenerateResult{
+			Result: &trimmedResponse,
+		}, nil
+	}
+
+	return &ent.GenerateResult{
+		Result: nil,
+	}, nil
+}
+
+func (v *local) buildRequestBody(settings requestSettings, prompt string) ([]byte, error) {
+	if template := settings.RequestTemplate(); template != "" {
+		return []byte(config.FillRequestTemplate(template, settings.Model(), prompt)), nil
+	}
+
+	switch settings.ApiType() {
+	case config.ApiTypeOllama:
+		input := ollamaGenerateInput{
+			Model:  settings.Model(),
+			Prompt: prompt,
+			Stream: false,
+			Options: ollamaOptions{
+				Temperature: settings.Temperature(),
+				NumPredict:  settings.MaxTokens(),
+			},
+		}
+		return json.Marshal(input)
+	default:
+		input := openAIChatInput{
+			Model: settings.Model(),
+			Messages: []message{
+				{
+					Role:    "user",
+					Content: prompt,
+				},
+			},
+			Temperature: settings.Temperature(),
+			MaxTokens:   settings.MaxTokens(),
+		}
+		return json.Marshal(input)
+	}
+}
+
+func (v *local) parseOpenAIResponse(statusCode int, bodyBytes []byte) (string, error) {
+	var resBody openAIChatResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return "", errors.Wrap(err, "unmarshal response body")
+	}
+
+	if statusCode != 200 || resBody.Error != nil {
+		if resBody.Error != nil {
+			return "", fmt.Errorf("connection to local inference API failed with status: %d error: %v",
+				statusCode, resBody.Error.Message)
+		}
+		return "", fmt.Errorf("connection to local inference API failed with status: %d", statusCode)
+	}
+
+	if len(resBody.Choices) > 0 {
+		return resBody.Choices[0].Message.Content, nil
+	}
+	return "", nil
+}
+
+func (v *local) parseOllamaResponse(statusCode int, bodyBytes []byte) (string, error) {
+	var resBody ollamaGenerateResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return "", errors.Wrap(err, "unmarshal response body")
+	}
+
+	if statusCode != 200 || resBody.Error != "" {
+		if resBody.Error != "" {
+			return "", fmt.Errorf("connection to local inference API failed with status: %d error: %v",
+				statusCode, resBody.Error)
+		}
+		return "", fmt.Errorf("connection to local inference API failed with status: %d", statusCode)
+	}
+
+	return resBody.Response, nil
+}
+
+func (v *local) generatePromptForTask(textProperties []map[string]string, task string) (string, error) {
+	marshal, err := json.Marshal(textProperties)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`'%v:
+%v`, task, string(marshal)), nil
+}
+
+func (v *local) generateForPrompt(textProperties map[string]string, prompt string) (string, error) {
+	all := compile.FindAll([]byte(prompt), -1)
+	for _, match := range all {
+		originalProperty := string(match)
+		replacedProperty := compile.FindStringSubmatch(originalProperty)[1]
+		replacedProperty = strings.TrimSpace(replacedProperty)
+		value := textProperties[replacedProperty]
+		if value == "" {
+			return "", errors.Errorf("Following property has empty value: '%v'. Make sure you spell the property name correctly, verify that the property exists and has a value", replacedProperty)
+		}
+		prompt = strings.ReplaceAll(prompt, originalProperty, value)
+	}
+	return prompt, nil
+}
+
+// getApiKey returns the optional API key. Self-hosted inference servers are
+// often deployed without authentication, so a missing key is not an error.
+func (v *local) getApiKey(ctx context.Context) string {
+	if len(v.apiKey) > 0 {
+		return v.apiKey
+	}
+	apiKey := ctx.Value("X-Local-Api-Key")
+	if apiKeyHeader, ok := apiKey.([]string); ok &&
+		len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
+		return apiKeyHeader[0]
+	}
+	return ""
+}
+
+type requestSettings interface {
+	ApiType() string
+	Model() string
+	Temperature() float64
+	MaxTokens() int
+	RequestTemplate() string
+}
+
+type openAIChatInput struct {
+	Model       string    `json:"model"`
+	Messages    []message `json:"messages"`
+	Temperature float64   `json:"temperature"`
+	MaxTokens   int       `json:"max_tokens,omitempty"`
+}
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatResponse struct {
+	Choices []choice        `json:"choices"`
+	Error   *openAIApiError `json:"error,omitempty"`
+}
+
+type choice struct {
+	Message message `json:"message"`
+}
+
+type openAIApiError struct {
+	Message string `json:"message"`
+}
+
+type ollamaGenerateInput struct {
+	Model   string        `json:"model"`
+	Prompt  string        `json:"prompt"`
+	Stream  bool          `json:"stream"`
+	Options ollamaOptions `json:"options"`
+}
+
+type ollamaOptions struct {
+	Temperature float64 `json:"temperature"`
+	NumPredict  int     `json:"num_predict,omitempty"`
+}
+
+type ollamaGenerateResponse struct {
+	Response string `json:"response"`
+	Error    string `json:"error,omitempty"`
+}
@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/generative-local/ent"
+)
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
+
+func TestGetAnswer(t *testing.T) {
+	textProperties := []map[string]string{{"prop": "My name is john"}}
+
+	t.Run("when the OpenAI compatible server has a successful answer", func(t *testing.T) {
+		handler := &testAnswerHandler{
+			t:            t,
+			expectedPath: "/v1/chat/completions",
+			answer: openAIChatResponse{
+				Choices: []choice{{Message: message{Role: "assistant", Content: "John"}}},
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("", nullLogger())
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{
+			"apiEndpoint": server.URL + "/v1",
+			"model":       "mistral-7b-instruct",
+		}}
+
+		res, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", cfg)
+
+		require.Nil(t, err)
+		assert.Equal(t, ent.GenerateResult{Result: ptString("John")}, *res)
+		assert.Equal(t, "mistral-7b-instruct", handler.lastBody["model"])
+		assert.Empty(t, handler.lastAuthorization)
+	})
+
+	t.Run("when the OpenAI compatible server has an error", func(t *testing.T) {
+		server := httptest.NewServer(&testAnswerHandler{
+			t:            t,
+			expectedPath: "/chat/completions",
+			status:       http.StatusInternalServerError,
+			answer: openAIChatResponse{
+				Error: &openAIApiError{Message: "some error from the server"},
+			},
+		})
+		defer server.Close()
+
+		c := New("", nullLogger())
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{
+			"apiEndpoint": server.URL,
+			"model":       "mistral-7b-instruct",
+		}}
+
+		_, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", cfg)
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to local inference API failed with status: 500 error: some error from the server")
+	})
+
+	t.Run("when the Ollama server has a successful answer", func(t *testing.T) {
+		handler := &testAnswerHandler{
+			t:            t,
+			expectedPath: "/api/generate",
+			answer:       ollamaGenerateResponse{Response: "John\n"},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("", nullLogger())
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{
+			"apiEndpoint": server.URL,
+			"apiType":     "ollama",
+			"model":       "llama2",
+		}}
+
+		res, err := c.GenerateSingleResult(context.Background(), textProperties[0], "What is the name in {prop}?", cfg)
+
+		require.Nil(t, err)
+		assert.Equal(t, ent.GenerateResult{Result: ptString("John")}, *res)
+		assert.Equal(t, "What is the name in My name is john?", handler.lastBody["prompt"])
+		assert.Equal(t, false, handler.lastBody["stream"])
+	})
+
+	t.Run("when the Ollama server has an error", func(t *testing.T) {
+		server := httptest.NewServer(&testAnswerHandler{
+			t:            t,
+			expectedPath: "/api/generate",
+			status:       http.StatusNotFound,
+			answer:       ollamaGenerateResponse{Error: "model 'llama2' not found"},
+		})
+		defer server.Close()
+
+		c := New("", nullLogger())
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{
+			"apiEndpoint": server.URL,
+			"apiType":     "ollama",
+			"model":       "llama2",
+		}}
+
+		_, err := c.GenerateAllResults(context.Background(), textProperties, "What is my name?", cfg)
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to local inference API failed with status: 404 error: model 'llama2' not found")
+	})
+
+	t.Run("when a request template and api key are configured", func(t *testing.T) {
+		handler := &testAnswerHandler{
+			t:            t,
+			expectedPath: "/api/generate",
+			answer:       ollamaGenerateResponse{Response: "John"},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		c := New("", nullLogger())
+		cfg := fakeClassConfig{classConfig: map[string]interface{}{
+			"apiEndpoint":     server.URL,
+			"apiType":         "ollama",
+			"model":           "llama2",
+			"requestTemplate": `{"model": "{{model}}", "prompt": "{{prompt}}", "stream": false, "raw": true}`,
+		}}
+		ctx := context.WithValue(context.Background(), "X-Local-Api-Key", []string{"secret"})
+
+		_, err := c.GenerateSingleResult(ctx, textProperties[0], "Say \"{prop}\"", cfg)
+
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"model":  "llama2",
+			"prompt": "Say \"My name is john\"",
+			"stream": false,
+			"raw":    true,
+		}, handler.lastBody)
+		assert.Equal(t, "Bearer secret", handler.lastAuthorization)
+	})
+}
+
+type testAnswerHandler struct {
+	t            *testing.T
+	expectedPath string
+	status       int
+	answer       interface{}
+
+	lastBody          map[string]interface{}
+	lastAuthorization string
+}
+
+func (f *testAnswerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	assert.Equal(f.t, f.expectedPath, r.URL.Path)
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &f.lastBody))
+	f.lastAuthorization = r.Header.Get("Authorization")
+
+	outBytes, err := json.Marshal(f.answer)
+	require.Nil(f.t, err)
+
+	if f.status != 0 {
+		w.WriteHeader(f.status)
+	}
+	w.Write(outBytes)
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func ptString(in string) *string {
+	return &in
+}
@@ -0,0 +1,228 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+const (
+	apiEndpointProperty     = "apiEndpoint"
+	apiTypeProperty         = "apiType"
+	modelProperty           = "model"
+	temperatureProperty     = "temperature"
+	maxTokensProperty       = "maxTokens"
+	requestTemplateProperty = "requestTemplate"
+)
+
+const (
+	// ApiTypeOpenAI targets servers implementing the OpenAI chat completions
+	// API, such as vLLM, LocalAI or llama.cpp server
+	ApiTypeOpenAI = "openai"
+	// ApiTypeOllama targets the Ollama generate API
+	ApiTypeOllama = "ollama"
+)
+
+const (
+	// PromptPlaceholder is replaced with the JSON-escaped prompt when a
+	// custom request template is used
+	PromptPlaceholder = "{{prompt}}"
+	// ModelPlaceholder is replaced with the JSON-escaped model name when a
+	// custom request template is used
+	ModelPlaceholder = "{{model}}"
+)
+
+var (
+	DefaultApiType     = ApiTypeOpenAI
+	DefaultTemperature = 0.0
+	DefaultMaxTokens   = 0
+)
+
+var availableApiTypes = []string{ApiTypeOpenAI, ApiTypeOllama}
+
+type classSettings struct {
+	cfg moduletools.ClassConfig
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg}
+}
+
+func (ic *classSettings) Validate(class *models.Class) error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+
+	var errorMessages []string
+	if ic.ApiEndpoint() == "" {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s cannot be empty", apiEndpointProperty))
+	}
+	if !contains(availableApiTypes, ic.ApiType()) {
+		errorMessages = append(errorMessages, fmt.Sprintf("wrong %s, available types are: %v", apiTypeProperty, availableApiTypes))
+	}
+	if ic.Model() == "" {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s cannot be empty", modelProperty))
+	}
+	temperature := ic.Temperature()
+	if temperature < 0 || temperature > 2 {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s has to be float value between 0 and 2", temperatureProperty))
+	}
+	if ic.MaxTokens() < 0 {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s has to be a positive integer value", maxTokensProperty))
+	}
+	if template := ic.RequestTemplate(); template != "" {
+		if err := validateRequestTemplate(template); err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s: %v", requestTemplateProperty, err))
+		}
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("%s", strings.Join(errorMessages, ", "))
+	}
+
+	return nil
+}
+
+func validateRequestTemplate(template string) error {
+	if !strings.Contains(template, PromptPlaceholder) {
+		return errors.Errorf("has to contain the %s placeholder", PromptPlaceholder)
+	}
+	body := FillRequestTemplate(template, "model", "prompt")
+	if !json.Valid([]byte(body)) {
+		return errors.New("has to be a valid JSON object")
+	}
+	return nil
+}
+
+// FillRequestTemplate replaces the placeholders of a custom request template
+// with the JSON-escaped model name and prompt. Placeholders are expected to
+// be used inside JSON strings, e.g. {"prompt": "{{prompt}}"}
+func FillRequestTemplate(template, model, prompt string) string {
+	return strings.NewReplacer(
+		ModelPlaceholder, escapeJSONString(model),
+		PromptPlaceholder, escapeJSONString(prompt),
+	).Replace(template)
+}
+
+func escapeJSONString(in string) string {
+	escaped, _ := json.Marshal(in)
+	return string(escaped[1 : len(escaped)-1])
+}
+
+func (ic *classSettings) getStringProperty(name, defaultValue string) string {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	value, ok := ic.cfg.ClassByModuleName("generative-local")[name]
+	if ok {
+		asString, ok := value.(string)
+		if ok {
+			return asString
+		}
+	}
+	return defaultValue
+}
+
+func (ic *classSettings) getFloatProperty(name string, defaultValue float64) float64 {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	val, ok := ic.cfg.ClassByModuleName("generative-local")[name]
+	if ok {
+		asFloat, ok := val.(float64)
+		if ok {
+			return asFloat
+		}
+		asNumber, ok := val.(json.Number)
+		if ok {
+			asFloat, _ := asNumber.Float64()
+			return asFloat
+		}
+		asInt, ok := val.(int)
+		if ok {
+			asFloat := float64(asInt)
+			return asFloat
+		}
+	}
+
+	return defaultValue
+}
+
+func (ic *classSettings) getIntProperty(name string, defaultValue int) int {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return defaultValue
+	}
+
+	val, ok := ic.cfg.ClassByModuleName("generative-local")[name]
+	if ok {
+		asFloat, ok := val.(float64)
+		if ok {
+			return int(asFloat)
+		}
+		asNumber, ok := val.(json.Number)
+		if ok {
+			asInt64, _ := asNumber.Int64()
+			return int(asInt64)
+		}
+		asInt, ok := val.(int)
+		if ok {
+			return asInt
+		}
+	}
+
+	return defaultValue
+}
+
+func (ic *classSettings) ApiEndpoint() string {
+	return strings.TrimSuffix(ic.getStringProperty(apiEndpointProperty, ""), "/")
+}
+
+func (ic *classSettings) ApiType() string {
+	return strings.ToLower(ic.getStringProperty(apiTypeProperty, DefaultApiType))
+}
+
+func (ic *classSettings) Model() string {
+	return ic.getStringProperty(modelProperty, "")
+}
+
+func (ic *classSettings) Temperature() float64 {
+	return ic.getFloatProperty(temperatureProperty, DefaultTemperature)
+}
+
+func (ic *classSettings) MaxTokens() int {
+	return ic.getIntProperty(maxTokensProperty, DefaultMaxTokens)
+}
+
+func (ic *classSettings) RequestTemplate() string {
+	return ic.getStringProperty(requestTemplateProperty, "")
+}
+
+func contains[T comparable](s []T, e T) bool {
+	for _, v := range s {
+		if v == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name                string
+		cfg                 moduletools.ClassConfig
+		wantApiEndpoint     string
+		wantApiType         string
+		wantModel           string
+		wantTemperature     float64
+		wantMaxTokens       int
+		wantRequestTemplate string
+		wantErr             error
+	}{
+		{
+			name: "happy flow",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiEndpoint": "http://vllm:8000/v1/",
+					"model":       "mistral-7b-instruct",
+				},
+			},
+			wantApiEndpoint: "http://vllm:8000/v1",
+			wantApiType:     "openai",
+			wantModel:       "mistral-7b-instruct",
+			wantTemperature: 0.0,
+			wantMaxTokens:   0,
+			wantErr:         nil,
+		},
+		{
+			name: "custom values",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiEndpoint":     "http://ollama:11434",
+					"apiType":         "Ollama",
+					"model":           "llama2",
+					"temperature":     0.7,
+					"maxTokens":       512,
+					"requestTemplate": `{"model": "{{model}}", "prompt": "{{prompt}}", "stream": false}`,
+				},
+			},
+			wantApiEndpoint:     "http://ollama:11434",
+			wantApiType:         "ollama",
+			wantModel:           "llama2",
+			wantTemperature:     0.7,
+			wantMaxTokens:       512,
+			wantRequestTemplate: `{"model": "{{model}}", "prompt": "{{prompt}}", "stream": false}`,
+			wantErr:             nil,
+		},
+		{
+			name: "missing apiEndpoint and model",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{},
+			},
+			wantErr: errors.Errorf("apiEndpoint cannot be empty, model cannot be empty"),
+		},
+		{
+			name: "wrong apiType",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiEndpoint": "http://localhost:8000",
+					"apiType":     "grpc",
+					"model":       "llama2",
+				},
+			},
+			wantErr: errors.Errorf("wrong apiType, available types are: [openai ollama]"),
+		},
+		{
+			name: "wrong temperature",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiEndpoint": "http://localhost:8000",
+					"model":       "llama2",
+					"temperature": 3,
+				},
+			},
+			wantErr: errors.Errorf("temperature has to be float value between 0 and 2"),
+		},
+		{
+			name: "request template without prompt placeholder",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiEndpoint":     "http://localhost:8000",
+					"model":           "llama2",
+					"requestTemplate": `{"model": "{{model}}"}`,
+				},
+			},
+			wantErr: errors.Errorf("requestTemplate: has to contain the {{prompt}} placeholder"),
+		},
+		{
+			name: "request template which is not JSON",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiEndpoint":     "http://localhost:8000",
+					"model":           "llama2",
+					"requestTemplate": `prompt={{prompt}}`,
+				},
+			},
+			wantErr: errors.Errorf("requestTemplate: has to be a valid JSON object"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, ic.Validate(nil), tt.wantErr.Error())
+			} else {
+				assert.Nil(t, ic.Validate(nil))
+				assert.Equal(t, tt.wantApiEndpoint, ic.ApiEndpoint())
+				assert.Equal(t, tt.wantApiType, ic.ApiType())
+				assert.Equal(t, tt.wantModel, ic.Model())
+				assert.Equal(t, tt.wantTemperature, ic.Temperature())
+				assert.Equal(t, tt.wantMaxTokens, ic.MaxTokens())
+				assert.Equal(t, tt.wantRequestTemplate, ic.RequestTemplate())
+			}
+		})
+	}
+}
+
+func TestFillRequestTemplate(t *testing.T) {
+	template := `{"model": "{{model}}", "prompt": "{{prompt}}"}`
+
+	body := FillRequestTemplate(template, "llama2", "say \"hi\"\nand leave")
+
+	assert.Equal(t, `{"model": "llama2", "prompt": "say \"hi\"\nand leave"}`, body)
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
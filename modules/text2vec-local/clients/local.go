@@ -0,0 +1,214 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/modules/text2vec-local/config"
+	"github.com/weaviate/weaviate/modules/text2vec-local/ent"
+)
+
+var apiPaths = map[string]string{
+	config.ApiTypeOpenAI: "/embeddings",
+	config.ApiTypeOllama: "/api/embeddings",
+}
+
+type local struct {
+	apiKey     string
+	httpClient *http.Client
+	logger     logrus.FieldLogger
+}
+
+func New(apiKey string, logger logrus.FieldLogger) *local {
+	return &local{
+		apiKey: apiKey,
+		httpClient: &http.Client{
+			Timeout: 60 * time.Second,
+		},
+		logger: logger,
+	}
+}
+
+func (v *local) Vectorize(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, config)
+}
+
+func (v *local) VectorizeQuery(ctx context.Context, input []string,
+	config ent.VectorizationConfig,
+) (*ent.VectorizationResult, error) {
+	return v.vectorize(ctx, input, config)
+}
+
+func (v *local) vectorize(ctx context.Context, input []string, settings ent.VectorizationConfig) (*ent.VectorizationResult, error) {
+	endpointURL, err := url.JoinPath(settings.ApiEndpoint, apiPaths[settings.ApiType])
+	if err != nil {
+		return nil, errors.Wrap(err, "join API endpoint and path")
+	}
+
+	body, err := v.buildRequestBody(input, settings)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpointURL,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	if apiKey := v.getApiKey(ctx); apiKey != "" {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var vector []float32
+	switch settings.ApiType {
+	case config.ApiTypeOllama:
+		vector, err = v.parseOllamaResponse(res.StatusCode, bodyBytes)
+	default:
+		vector, err = v.parseOpenAIResponse(res.StatusCode, bodyBytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vector) == 0 {
+		return nil, errors.Errorf("empty embeddings response")
+	}
+
+	return &ent.VectorizationResult{
+		Text:       input[0],
+		Dimensions: len(vector),
+		Vector:     vector,
+	}, nil
+}
+
+func (v *local) buildRequestBody(input []string, settings ent.VectorizationConfig) ([]byte, error) {
+	if settings.RequestTemplate != "" {
+		return []byte(config.FillRequestTemplate(settings.RequestTemplate, settings.Model, input[0])), nil
+	}
+
+	switch settings.ApiType {
+	case config.ApiTypeOllama:
+		return json.Marshal(ollamaEmbeddingsRequest{
+			Model:  settings.Model,
+			Prompt: input[0],
+		})
+	default:
+		return json.Marshal(openAIEmbeddingsRequest{
+			Model: settings.Model,
+			Input: input,
+		})
+	}
+}
+
+func (v *local) parseOpenAIResponse(statusCode int, bodyBytes []byte) ([]float32, error) {
+	var resBody openAIEmbeddingsResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if statusCode != 200 || resBody.Error != nil {
+		if resBody.Error != nil {
+			return nil, fmt.Errorf("connection to local inference API failed with status: %d error: %v",
+				statusCode, resBody.Error.Message)
+		}
+		return nil, fmt.Errorf("connection to local inference API failed with status: %d", statusCode)
+	}
+
+	if len(resBody.Data) == 0 {
+		return nil, nil
+	}
+	return resBody.Data[0].Embedding, nil
+}
+
+func (v *local) parseOllamaResponse(statusCode int, bodyBytes []byte) ([]float32, error) {
+	var resBody ollamaEmbeddingsResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if statusCode != 200 || resBody.Error != "" {
+		if resBody.Error != "" {
+			return nil, fmt.Errorf("connection to local inference API failed with status: %d error: %v",
+				statusCode, resBody.Error)
+		}
+		return nil, fmt.Errorf("connection to local inference API failed with status: %d", statusCode)
+	}
+
+	return resBody.Embedding, nil
+}
+
+// getApiKey returns the optional API key. Self-hosted inference servers are
+// often deployed without authentication, so a missing key is not an error.
+func (v *local) getApiKey(ctx context.Context) string {
+	if len(v.apiKey) > 0 {
+		return v.apiKey
+	}
+	apiKey := ctx.Value("X-Local-Api-Key")
+	if apiKeyHeader, ok := apiKey.([]string); ok &&
+		len(apiKeyHeader) > 0 && len(apiKeyHeader[0]) > 0 {
+		return apiKeyHeader[0]
+	}
+	return ""
+}
+
+type openAIEmbeddingsRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type openAIEmbeddingsResponse struct {
+	Data  []embeddingData `json:"data,omitempty"`
+	Error *openAIApiError `json:"error,omitempty"`
+}
+
+type embeddingData struct {
+	Embedding []float32 `json:"embedding"`
+	Index     int       `json:"index"`
+}
+
+type openAIApiError struct {
+	Message string `json:"message"`
+}
+
+type ollamaEmbeddingsRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+}
+
+type ollamaEmbeddingsResponse struct {
+	Embedding []float32 `json:"embedding,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/modules/text2vec-local/ent"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("when all is fine with an OpenAI compatible server", func(t *testing.T) {
+		handler := &fakeHandler{
+			t:            t,
+			expectedPath: "/v1/embeddings",
+			response: openAIEmbeddingsResponse{
+				Data: []embeddingData{{Embedding: []float32{0.1, 0.2, 0.3}}},
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("", nullLogger())
+
+		expected := &ent.VectorizationResult{
+			Text:       "This is my text",
+			Vector:     []float32{0.1, 0.2, 0.3},
+			Dimensions: 3,
+		}
+		res, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{
+				ApiEndpoint: server.URL + "/v1",
+				ApiType:     "openai",
+				Model:       "bge-small-en",
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+		assert.Equal(t, "bge-small-en", handler.lastBody["model"])
+		assert.Equal(t, []interface{}{"This is my text"}, handler.lastBody["input"])
+		assert.Empty(t, handler.lastAuthorization)
+	})
+
+	t.Run("when all is fine with an Ollama server", func(t *testing.T) {
+		handler := &fakeHandler{
+			t:            t,
+			expectedPath: "/api/embeddings",
+			response:     ollamaEmbeddingsResponse{Embedding: []float32{0.1, 0.2, 0.3}},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("", nullLogger())
+
+		res, err := c.VectorizeQuery(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{
+				ApiEndpoint: server.URL,
+				ApiType:     "ollama",
+				Model:       "nomic-embed-text",
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3}, res.Vector)
+		assert.Equal(t, "This is my text", handler.lastBody["prompt"])
+	})
+
+	t.Run("when a request template is configured", func(t *testing.T) {
+		handler := &fakeHandler{
+			t:            t,
+			expectedPath: "/api/embeddings",
+			response:     ollamaEmbeddingsResponse{Embedding: []float32{0.1, 0.2, 0.3}},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("", nullLogger())
+
+		_, err := c.Vectorize(context.Background(), []string{"search_document: \"text\""},
+			ent.VectorizationConfig{
+				ApiEndpoint:     server.URL,
+				ApiType:         "ollama",
+				Model:           "nomic-embed-text",
+				RequestTemplate: `{"model": "{{model}}", "prompt": "{{input}}", "keep_alive": "1h"}`,
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"model":      "nomic-embed-text",
+			"prompt":     "search_document: \"text\"",
+			"keep_alive": "1h",
+		}, handler.lastBody)
+	})
+
+	t.Run("when the context is expired", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t, expectedPath: "/embeddings"})
+		defer server.Close()
+		c := New("", nullLogger())
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
+		defer cancel()
+
+		_, err := c.Vectorize(ctx, []string{"This is my text"}, ent.VectorizationConfig{
+			ApiEndpoint: server.URL,
+		})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:            t,
+			expectedPath: "/embeddings",
+			status:       http.StatusInternalServerError,
+			response: openAIEmbeddingsResponse{
+				Error: &openAIApiError{Message: "nope, not gonna happen"},
+			},
+		})
+		defer server.Close()
+		c := New("", nullLogger())
+
+		_, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{ApiEndpoint: server.URL, ApiType: "openai"})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to local inference API failed with status: 500 error: nope, not gonna happen")
+	})
+
+	t.Run("when the Ollama server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:            t,
+			expectedPath: "/api/embeddings",
+			status:       http.StatusNotFound,
+			response:     ollamaEmbeddingsResponse{Error: "model 'nomic-embed-text' not found"},
+		})
+		defer server.Close()
+		c := New("", nullLogger())
+
+		_, err := c.Vectorize(context.Background(), []string{"This is my text"},
+			ent.VectorizationConfig{ApiEndpoint: server.URL, ApiType: "ollama"})
+
+		require.NotNil(t, err)
+		assert.EqualError(t, err, "connection to local inference API failed with status: 404 error: model 'nomic-embed-text' not found")
+	})
+
+	t.Run("when key is passed using X-Local-Api-Key header", func(t *testing.T) {
+		handler := &fakeHandler{
+			t:            t,
+			expectedPath: "/embeddings",
+			response: openAIEmbeddingsResponse{
+				Data: []embeddingData{{Embedding: []float32{0.1, 0.2, 0.3}}},
+			},
+		}
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		c := New("", nullLogger())
+		ctxWithValue := context.WithValue(context.Background(),
+			"X-Local-Api-Key", []string{"some-key"})
+
+		_, err := c.Vectorize(ctxWithValue, []string{"This is my text"},
+			ent.VectorizationConfig{ApiEndpoint: server.URL, ApiType: "openai"})
+
+		require.Nil(t, err)
+		assert.Equal(t, "Bearer some-key", handler.lastAuthorization)
+	})
+}
+
+type fakeHandler struct {
+	t            *testing.T
+	expectedPath string
+	status       int
+	response     interface{}
+
+	lastBody          map[string]interface{}
+	lastAuthorization string
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPost, r.Method)
+	assert.Equal(f.t, f.expectedPath, r.URL.Path)
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &f.lastBody))
+	f.lastAuthorization = r.Header.Get("Authorization")
+
+	outBytes, err := json.Marshal(f.response)
+	require.Nil(f.t, err)
+
+	if f.status != 0 {
+		w.WriteHeader(f.status)
+	}
+	w.Write(outBytes)
+}
+
+func nullLogger() logrus.FieldLogger {
+	l, _ := test.NewNullLogger()
+	return l
+}
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	class := &models.Class{
+		Class: "Document",
+		Properties: []*models.Property{
+			{Name: "content", DataType: []string{"text"}},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		cfg                 moduletools.ClassConfig
+		wantApiEndpoint     string
+		wantApiType         string
+		wantModel           string
+		wantRequestTemplate string
+		wantErr             error
+	}{
+		{
+			name: "happy flow",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiEndpoint": "http://vllm:8000/v1/",
+					"model":       "bge-small-en",
+				},
+			},
+			wantApiEndpoint: "http://vllm:8000/v1",
+			wantApiType:     "openai",
+			wantModel:       "bge-small-en",
+		},
+		{
+			name: "custom values",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiEndpoint":     "http://ollama:11434",
+					"apiType":         "ollama",
+					"model":           "nomic-embed-text",
+					"requestTemplate": `{"model": "{{model}}", "prompt": "{{input}}"}`,
+				},
+			},
+			wantApiEndpoint:     "http://ollama:11434",
+			wantApiType:         "ollama",
+			wantModel:           "nomic-embed-text",
+			wantRequestTemplate: `{"model": "{{model}}", "prompt": "{{input}}"}`,
+		},
+		{
+			name: "empty apiEndpoint",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"model": "nomic-embed-text",
+				},
+			},
+			wantErr: errors.Errorf("apiEndpoint cannot be empty"),
+		},
+		{
+			name: "wrong apiType and empty model",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiEndpoint": "http://localhost:8000",
+					"apiType":     "grpc",
+				},
+			},
+			wantErr: errors.Errorf("wrong apiType, available types are: [openai ollama], " +
+				"model cannot be empty"),
+		},
+		{
+			name: "request template without input placeholder",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"apiEndpoint":     "http://localhost:8000",
+					"model":           "nomic-embed-text",
+					"requestTemplate": `{"model": "{{model}}"}`,
+				},
+			},
+			wantErr: errors.Errorf("requestTemplate: has to contain the {{input}} placeholder"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, ic.Validate(class), tt.wantErr.Error())
+			} else {
+				assert.Nil(t, ic.Validate(class))
+				assert.Equal(t, tt.wantApiEndpoint, ic.ApiEndpoint())
+				assert.Equal(t, tt.wantApiType, ic.ApiType())
+				assert.Equal(t, tt.wantModel, ic.Model())
+				assert.Equal(t, tt.wantRequestTemplate, ic.RequestTemplate())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
@@ -28,6 +28,17 @@ func CreateBackup(t *testing.T, className, backend, backupID string) (*backups.B
 	return Client(t).Backups.BackupsCreate(params, nil)
 }
 
+func CreateIncrementalBackup(t *testing.T, className, backend, backupID, baseID string) (*backups.BackupsCreateOK, error) {
+	params := backups.NewBackupsCreateParams().
+		WithBackend(backend).
+		WithBody(&models.BackupCreateRequest{
+			ID:      backupID,
+			Include: []string{className},
+			BaseID:  baseID,
+		})
+	return Client(t).Backups.BackupsCreate(params, nil)
+}
+
 func CreateBackupStatus(t *testing.T, backend, backupID string) (*backups.BackupsCreateStatusOK, error) {
 	params := backups.NewBackupsCreateStatusParams().
 		WithBackend(backend).
@@ -28,6 +28,10 @@ func BackupJourneyTests_SingleNode(t *testing.T, weaviateEndpoint, backend, clas
 	t.Run("single node backup", func(t *testing.T) {
 		singleNodeBackupJourneyTest(t, weaviateEndpoint, backend, className, backupID)
 	})
+
+	t.Run("incremental backup", func(t *testing.T) {
+		incrementalBackupJourneyTest(t, weaviateEndpoint, backend, className, backupID)
+	})
 }
 
 // BackupJourneyTests_Cluster this method gathers all backup related e2e tests to be run on a cluster
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package journey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/test/helper"
+	moduleshelper "github.com/weaviate/weaviate/test/helper/modules"
+)
+
+func incrementalBackupJourneyTest(t *testing.T, weaviateEndpoint, backend, className, backupID string) {
+	if weaviateEndpoint != "" {
+		helper.SetupClient(weaviateEndpoint)
+	}
+
+	baseID := backupID + "-base"
+	incrementalID := backupID + "-incremental"
+
+	t.Run("add test data", func(t *testing.T) {
+		addTestClass(t, className)
+		addTestObjects(t, className)
+	})
+
+	t.Run("create base backup", func(t *testing.T) {
+		resp, err := helper.CreateBackup(t, className, backend, baseID)
+		helper.AssertRequestOk(t, resp, err, nil)
+		waitForBackup(t, backend, baseID)
+	})
+
+	t.Run("add more test data", func(t *testing.T) {
+		addTestObjects(t, className)
+	})
+
+	t.Run("create incremental backup", func(t *testing.T) {
+		resp, err := helper.CreateIncrementalBackup(t, className, backend, incrementalID, baseID)
+		helper.AssertRequestOk(t, resp, err, func() {
+			assert.Equal(t, baseID, resp.Payload.BaseID)
+		})
+		waitForBackup(t, backend, incrementalID)
+	})
+
+	t.Run("delete class for restoration", func(t *testing.T) {
+		helper.DeleteClass(t, className)
+	})
+
+	t.Run("restore incremental backup", func(t *testing.T) {
+		_, err := helper.RestoreBackup(t, className, backend, incrementalID)
+		require.Nil(t, err, "expected nil, got: %v", err)
+
+		var status string
+		for start := time.Now(); time.Since(start) < 21*time.Second; time.Sleep(time.Second) {
+			resp, err := helper.RestoreBackupStatus(t, backend, incrementalID)
+			helper.AssertRequestOk(t, resp, err, func() {
+				require.NotNil(t, resp.Payload)
+				require.NotNil(t, resp.Payload.Status)
+			})
+			if status = *resp.Payload.Status; status == string(backup.Success) {
+				break
+			}
+		}
+		require.Equal(t, string(backup.Success), status)
+	})
+
+	// objects added after the base backup must have been restored as well
+	count := moduleshelper.GetClassCount(t, className)
+	assert.Equal(t, int64(1000), count)
+
+	t.Run("cleanup", func(t *testing.T) {
+		helper.DeleteClass(t, className)
+	})
+}
+
+func waitForBackup(t *testing.T, backend, backupID string) {
+	var status string
+	for start := time.Now(); time.Since(start) < 21*time.Second; time.Sleep(time.Second) {
+		resp, err := helper.CreateBackupStatus(t, backend, backupID)
+		helper.AssertRequestOk(t, resp, err, func() {
+			require.NotNil(t, resp.Payload)
+			require.NotNil(t, resp.Payload.Status)
+		})
+		if status = *resp.Payload.Status; status == string(backup.Success) {
+			break
+		}
+	}
+	require.Equal(t, string(backup.Success), status)
+}
@@ -354,6 +354,19 @@ case $CONFIG in
         --write-timeout=600s
     ;;
 
+  local-all-local)
+      AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED=true \
+      DEFAULT_VECTORIZER_MODULE=text2vec-local \
+      CLUSTER_HOSTNAME="node1" \
+      ENABLE_MODULES="text2vec-local,generative-local" \
+      go_run ./cmd/weaviate-server \
+        --scheme http \
+        --host "127.0.0.1" \
+        --port 8080 \
+        --read-timeout=600s \
+        --write-timeout=600s
+    ;;
+
   local-huggingface)
       AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED=true \
       DEFAULT_VECTORIZER_MODULE=text2vec-huggingface \
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Sink persists audit records. Write is called sequentially.
+type Sink interface {
+	Write(rec *Record) error
+	Close() error
+}
+
+// Auditor writes records of all mutating operations to a sink. Read
+// operations are only recorded if configured.
+type Auditor struct {
+	sink         Sink
+	includeReads bool
+	logger       logrus.FieldLogger
+	writes       chan *Record
+	done         chan struct{}
+
+	// closeLock guards writes against sends after Close
+	closeLock sync.RWMutex
+	closed    bool
+}
+
+// bufferSize is the number of records which can be queued before requests
+// are blocked by the sink
+const bufferSize = 1024
+
+// New Auditor writing to sink. Records are written in the background, Close
+// flushes all queued records.
+func New(sink Sink, includeReads bool, logger logrus.FieldLogger) *Auditor {
+	a := &Auditor{
+		sink:         sink,
+		includeReads: includeReads,
+		logger:       logger,
+		writes:       make(chan *Record, bufferSize),
+		done:         make(chan struct{}),
+	}
+
+	go a.run()
+	return a
+}
+
+// IsRead reports whether verb does not change any data
+func IsRead(verb string) bool {
+	switch verb {
+	case "get", "list", "head", "validate":
+		return true
+	default:
+		return false
+	}
+}
+
+// ResultFromHTTPStatus maps the status of a response to the result of the
+// operation
+func ResultFromHTTPStatus(status int) string {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return ResultDenied
+	case status >= http.StatusBadRequest:
+		return ResultFailure
+	default:
+		return ResultSuccess
+	}
+}
+
+// Log queues rec to be written. The latency is measured from rec.Time.
+func (a *Auditor) Log(rec *Record) {
+	if !a.includeReads && IsRead(rec.Verb) {
+		return
+	}
+
+	rec.Lock()
+	rec.LatencyMs = float64(time.Since(rec.Time)) / float64(time.Millisecond)
+	if rec.Result == ResultSuccess && rec.Error != "" {
+		rec.Result = ResultFailure
+	}
+	if rec.Principal == "" {
+		rec.Principal = anonymousPrincipal
+	}
+	rec.Unlock()
+
+	a.closeLock.RLock()
+	defer a.closeLock.RUnlock()
+	if a.closed {
+		return
+	}
+	a.writes <- rec
+}
+
+func (a *Auditor) run() {
+	defer close(a.done)
+
+	for rec := range a.writes {
+		if err := a.sink.Write(rec); err != nil {
+			a.logger.WithField("action", "audit_log").
+				WithError(err).
+				Error("could not write audit record")
+		}
+	}
+}
+
+// Close writes all queued records and closes the sink. Records logged
+// afterwards are dropped.
+func (a *Auditor) Close() error {
+	a.closeLock.Lock()
+	a.closed = true
+	close(a.writes)
+	a.closeLock.Unlock()
+
+	<-a.done
+	return a.sink.Close()
+}
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestAuditor(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	t.Run("only mutating operations are recorded by default", func(t *testing.T) {
+		sink := &fakeSink{}
+		a := New(sink, false, logger)
+
+		a.Log(&Record{Time: time.Now(), Verb: "get", Result: ResultSuccess})
+		a.Log(&Record{Time: time.Now(), Verb: "delete", Result: ResultSuccess})
+		require.Nil(t, a.Close())
+
+		require.Len(t, sink.records, 1)
+		assert.Equal(t, "delete", sink.records[0].Verb)
+		assert.True(t, sink.closed)
+	})
+
+	t.Run("reads are recorded if configured", func(t *testing.T) {
+		sink := &fakeSink{}
+		a := New(sink, true, logger)
+
+		a.Log(&Record{Time: time.Now(), Verb: "get", Result: ResultSuccess})
+		a.Log(&Record{Time: time.Now(), Verb: "delete", Result: ResultSuccess})
+		require.Nil(t, a.Close())
+
+		assert.Len(t, sink.records, 2)
+	})
+
+	t.Run("records are completed before they are written", func(t *testing.T) {
+		sink := &fakeSink{}
+		a := New(sink, false, logger)
+
+		a.Log(&Record{
+			Time:   time.Now().Add(-50 * time.Millisecond),
+			Verb:   "create",
+			Result: ResultSuccess,
+			Error:  "something went wrong",
+		})
+		require.Nil(t, a.Close())
+
+		require.Len(t, sink.records, 1)
+		rec := sink.records[0]
+		assert.Equal(t, anonymousPrincipal, rec.Principal)
+		assert.Equal(t, ResultFailure, rec.Result)
+		assert.GreaterOrEqual(t, rec.LatencyMs, float64(50))
+	})
+
+	t.Run("sink errors do not block", func(t *testing.T) {
+		sink := &fakeSink{err: errors.New("disk full")}
+		a := New(sink, false, logger)
+
+		a.Log(&Record{Time: time.Now(), Verb: "create"})
+		require.Nil(t, a.Close())
+	})
+
+	t.Run("records logged after close are dropped", func(t *testing.T) {
+		sink := &fakeSink{}
+		a := New(sink, false, logger)
+		require.Nil(t, a.Close())
+
+		a.Log(&Record{Time: time.Now(), Verb: "create"})
+		assert.Len(t, sink.records, 0)
+	})
+}
+
+func TestResultFromHTTPStatus(t *testing.T) {
+	assert.Equal(t, ResultSuccess, ResultFromHTTPStatus(200))
+	assert.Equal(t, ResultSuccess, ResultFromHTTPStatus(204))
+	assert.Equal(t, ResultDenied, ResultFromHTTPStatus(401))
+	assert.Equal(t, ResultDenied, ResultFromHTTPStatus(403))
+	assert.Equal(t, ResultFailure, ResultFromHTTPStatus(422))
+	assert.Equal(t, ResultFailure, ResultFromHTTPStatus(500))
+}
+
+func TestRecordAnnotations(t *testing.T) {
+	t.Run("without a record in the context", func(t *testing.T) {
+		ctx := context.Background()
+
+		AnnotateClasses(ctx, "Article")
+		AnnotateObjectIDs(ctx, "id")
+		AnnotatePrincipal(ctx, &models.Principal{Username: "jane"})
+		AnnotateError(ctx, "error")
+
+		assert.Nil(t, FromContext(ctx))
+	})
+
+	t.Run("with a record in the context", func(t *testing.T) {
+		rec := &Record{}
+		ctx := NewContext(context.Background(), rec)
+
+		AnnotateClasses(ctx, "Article", "", "Author")
+		AnnotateClasses(ctx, "Article")
+		AnnotateObjectIDs(ctx, "first", "")
+		AnnotateObjectIDs(ctx, "second")
+		AnnotatePrincipal(ctx, &models.Principal{Username: "jane", Groups: []string{"editors"}})
+		AnnotateError(ctx, "first error")
+		AnnotateError(ctx, "second error")
+
+		assert.Equal(t, []string{"Article", "Author"}, rec.Classes)
+		assert.Equal(t, []string{"first", "second"}, rec.ObjectIDs)
+		assert.Equal(t, "jane", rec.Principal)
+		assert.Equal(t, []string{"editors"}, rec.Groups)
+		assert.Equal(t, "first error", rec.Error)
+	})
+
+	t.Run("with an anonymous principal", func(t *testing.T) {
+		rec := &Record{}
+		AnnotatePrincipal(NewContext(context.Background(), rec), nil)
+		assert.Equal(t, anonymousPrincipal, rec.Principal)
+	})
+}
+
+type fakeSink struct {
+	sync.Mutex
+	records []*Record
+	err     error
+	closed  bool
+}
+
+func (f *fakeSink) Write(rec *Record) error {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.err != nil {
+		return f.err
+	}
+	f.records = append(f.records, rec)
+	return nil
+}
+
+func (f *fakeSink) Close() error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.closed = true
+	return nil
+}
@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// FileSink writes records as JSON lines to a file. Once the file exceeds its
+// maximum size it is rotated: the current file is renamed to <path>.1, older
+// files are shifted and the oldest one beyond maxBackups is removed.
+type FileSink struct {
+	sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func NewFileSink(path string, maxSizeMB, maxBackups int) (*FileSink, error) {
+	s := &FileSink{
+		path:       path,
+		maxSize:    int64(maxSizeMB) * 1024 * 1024,
+		maxBackups: maxBackups,
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o777); err != nil {
+		return nil, errors.Wrapf(err, "create audit log directory for %s", path)
+	}
+
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "open audit log %s", s.path)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return errors.Wrapf(err, "stat audit log %s", s.path)
+	}
+
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+func (s *FileSink) Write(rec *Record) error {
+	rec.Lock()
+	line, err := json.Marshal(rec)
+	rec.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "marshal audit record")
+	}
+	line = append(line, '\n')
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return errors.Wrapf(err, "write audit log %s", s.path)
+	}
+	return nil
+}
+
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return errors.Wrapf(err, "close audit log %s", s.path)
+	}
+
+	if s.maxBackups > 0 {
+		os.Remove(s.backupPath(s.maxBackups))
+		for i := s.maxBackups - 1; i > 0; i-- {
+			err := os.Rename(s.backupPath(i), s.backupPath(i+1))
+			if err != nil && !os.IsNotExist(err) {
+				return errors.Wrapf(err, "rotate audit log %s", s.backupPath(i))
+			}
+		}
+		if err := os.Rename(s.path, s.backupPath(1)); err != nil {
+			return errors.Wrapf(err, "rotate audit log %s", s.path)
+		}
+	} else if err := os.Remove(s.path); err != nil {
+		return errors.Wrapf(err, "remove audit log %s", s.path)
+	}
+
+	return s.open()
+}
+
+func (s *FileSink) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
+
+func (s *FileSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.file.Close()
+}
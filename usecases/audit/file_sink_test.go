@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink(t *testing.T) {
+	t.Run("records are written as JSON lines", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit", "audit.jsonl")
+		sink, err := NewFileSink(path, 1, 2)
+		require.Nil(t, err)
+
+		require.Nil(t, sink.Write(exampleRecord("first")))
+		require.Nil(t, sink.Write(exampleRecord("second")))
+		require.Nil(t, sink.Close())
+
+		records := readRecords(t, path)
+		require.Len(t, records, 2)
+		assert.Equal(t, "first", records[0].Principal)
+		assert.Equal(t, "second", records[1].Principal)
+		assert.Equal(t, []string{"Article"}, records[0].Classes)
+	})
+
+	t.Run("an existing log is appended to", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.jsonl")
+		for _, principal := range []string{"first", "second"} {
+			sink, err := NewFileSink(path, 1, 2)
+			require.Nil(t, err)
+			require.Nil(t, sink.Write(exampleRecord(principal)))
+			require.Nil(t, sink.Close())
+		}
+
+		assert.Len(t, readRecords(t, path), 2)
+	})
+
+	t.Run("the log is rotated once it exceeds its size", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.jsonl")
+		sink, err := NewFileSink(path, 1, 2)
+		require.Nil(t, err)
+		// lower the limit, so every record ends up in a file of its own
+		sink.maxSize = 10
+
+		for _, principal := range []string{"first", "second", "third", "fourth"} {
+			require.Nil(t, sink.Write(exampleRecord(principal)))
+		}
+		require.Nil(t, sink.Close())
+
+		assert.Equal(t, "fourth", readRecords(t, path)[0].Principal)
+		assert.Equal(t, "third", readRecords(t, path+".1")[0].Principal)
+		assert.Equal(t, "second", readRecords(t, path+".2")[0].Principal)
+		_, err = os.Stat(path + ".3")
+		assert.True(t, os.IsNotExist(err), "only max backups are kept")
+	})
+}
+
+func exampleRecord(principal string) *Record {
+	return &Record{
+		Time:      time.Now(),
+		Protocol:  ProtocolREST,
+		Principal: principal,
+		Verb:      "delete",
+		Resource:  "objects/Article/c5f4a5a3-1b5a-4d3e-9b0a-1d1c1e1f1a1b",
+		Classes:   []string{"Article"},
+		Status:    204,
+		Result:    ResultSuccess,
+	}
+}
+
+func readRecords(t *testing.T, path string) []*Record {
+	f, err := os.Open(path)
+	require.Nil(t, err)
+	defer f.Close()
+
+	var records []*Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		rec := &Record{}
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), rec))
+		records = append(records, rec)
+	}
+	require.Nil(t, scanner.Err())
+	return records
+}
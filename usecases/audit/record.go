@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	ProtocolREST    = "rest"
+	ProtocolGraphQL = "graphql"
+	ProtocolGRPC    = "grpc"
+)
+
+const (
+	ResultSuccess = "success"
+	ResultDenied  = "denied"
+	ResultFailure = "failure"
+)
+
+const anonymousPrincipal = "anonymous"
+
+// Record describes a single authenticated operation. It is created by the
+// API layer when a request arrives, annotated with details that are only
+// known while the request is processed and written once it has completed.
+type Record struct {
+	Time      time.Time `json:"time"`
+	Protocol  string    `json:"protocol"`
+	Operation string    `json:"operation,omitempty"`
+	Principal string    `json:"principal"`
+	Groups    []string  `json:"groups,omitempty"`
+	Verb      string    `json:"verb"`
+	Resource  string    `json:"resource"`
+	Classes   []string  `json:"classes,omitempty"`
+	ObjectIDs []string  `json:"objectIds,omitempty"`
+	Status    int       `json:"status"`
+	Result    string    `json:"result"`
+	Error     string    `json:"error,omitempty"`
+	LatencyMs float64   `json:"latencyMs"`
+
+	// annotations can be made concurrently, e.g. when GraphQL resolves
+	// several classes at once
+	sync.Mutex
+}
+
+// SetPrincipal records the authenticated principal. A nil principal is
+// recorded as anonymous.
+func (r *Record) SetPrincipal(principal *models.Principal) {
+	r.Lock()
+	defer r.Unlock()
+
+	if principal == nil {
+		r.Principal = anonymousPrincipal
+		r.Groups = nil
+		return
+	}
+	r.Principal = principal.Username
+	r.Groups = principal.Groups
+}
+
+// AddClasses adds classes, each class is recorded only once
+func (r *Record) AddClasses(classes ...string) {
+	r.Lock()
+	defer r.Unlock()
+
+outer:
+	for _, class := range classes {
+		if class == "" {
+			continue
+		}
+		for _, existing := range r.Classes {
+			if existing == class {
+				continue outer
+			}
+		}
+		r.Classes = append(r.Classes, class)
+	}
+}
+
+func (r *Record) AddObjectIDs(ids ...string) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, id := range ids {
+		if id != "" {
+			r.ObjectIDs = append(r.ObjectIDs, id)
+		}
+	}
+}
+
+// SetError records the first error that occurred while processing the
+// request. It is used for protocols like GraphQL which report errors as part
+// of a successful response.
+func (r *Record) SetError(msg string) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.Error == "" {
+		r.Error = msg
+	}
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying rec, so the usecases can annotate it
+func NewContext(ctx context.Context, rec *Record) context.Context {
+	return context.WithValue(ctx, contextKey{}, rec)
+}
+
+// FromContext returns the record of the request or nil if the request is not
+// audited
+func FromContext(ctx context.Context) *Record {
+	rec, _ := ctx.Value(contextKey{}).(*Record)
+	return rec
+}
+
+// AnnotateClasses adds classes to the record of the request, if any
+func AnnotateClasses(ctx context.Context, classes ...string) {
+	if rec := FromContext(ctx); rec != nil {
+		rec.AddClasses(classes...)
+	}
+}
+
+// AnnotateObjectIDs adds object ids to the record of the request, if any
+func AnnotateObjectIDs(ctx context.Context, ids ...string) {
+	if rec := FromContext(ctx); rec != nil {
+		rec.AddObjectIDs(ids...)
+	}
+}
+
+// AnnotatePrincipal sets the principal on the record of the request, if any.
+// It is needed where the principal is resolved by the request handler itself.
+func AnnotatePrincipal(ctx context.Context, principal *models.Principal) {
+	if rec := FromContext(ctx); rec != nil {
+		rec.SetPrincipal(principal)
+	}
+}
+
+// AnnotateError sets the error on the record of the request, if any
+func AnnotateError(ctx context.Context, msg string) {
+	if rec := FromContext(ctx); rec != nil {
+		rec.SetError(msg)
+	}
+}
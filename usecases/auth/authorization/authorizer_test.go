@@ -16,6 +16,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -50,4 +51,19 @@ func Test_Authorizer(t *testing.T) {
 		_, ok := authorizer.(*adminlist.Authorizer)
 		assert.Equal(t, true, ok)
 	})
+	t.Run("when rbac is configured", func(t *testing.T) {
+		cfg := config.Config{
+			Authorization: config.Authorization{
+				RBAC: rbac.Config{
+					Enabled:   true,
+					RootUsers: []string{"root"},
+				},
+			},
+		}
+
+		authorizer := New(cfg)
+
+		_, ok := authorizer.(*rbac.Authorizer)
+		assert.Equal(t, true, ok)
+	})
 }
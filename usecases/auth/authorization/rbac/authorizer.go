@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+const AnonymousPrincipalUsername = "anonymous"
+
+// wildcard matches any action in a permission and any sequence of characters
+// in a resource pattern
+const wildcard = "*"
+
+// RoleSource provides the current roles. It is read on every authorization
+// decision, so it is expected to be served from memory.
+type RoleSource interface {
+	Roles() []*models.Role
+}
+
+// Authorizer allows access based on the permissions of the roles assigned to
+// the principal or its groups
+type Authorizer struct {
+	rootUsers  map[string]struct{}
+	rootGroups map[string]struct{}
+
+	// rolesLock guards roles, which is set after the server has started
+	// serving requests
+	rolesLock sync.RWMutex
+	roles     RoleSource
+}
+
+// New Authorizer using role-based access control. Until SetRoleSource is
+// called only root users and groups have access.
+func New(cfg Config) *Authorizer {
+	a := &Authorizer{
+		rootUsers:  make(map[string]struct{}, len(cfg.RootUsers)),
+		rootGroups: make(map[string]struct{}, len(cfg.RootGroups)),
+	}
+	for _, user := range cfg.RootUsers {
+		a.rootUsers[user] = struct{}{}
+	}
+	for _, group := range cfg.RootGroups {
+		a.rootGroups[group] = struct{}{}
+	}
+	return a
+}
+
+// SetRoleSource sets the source of the roles. The roles can only be read once
+// the persistence layer has been initialized, which happens after the
+// authorizer has been created.
+func (a *Authorizer) SetRoleSource(roles RoleSource) {
+	a.rolesLock.Lock()
+	defer a.rolesLock.Unlock()
+
+	a.roles = roles
+}
+
+func (a *Authorizer) roleSource() RoleSource {
+	a.rolesLock.RLock()
+	defer a.rolesLock.RUnlock()
+
+	return a.roles
+}
+
+// Authorize allows root users and groups any access. Everyone else needs a
+// role assigned to them, or one of their groups, with a permission that
+// matches both verb and resource.
+func (a *Authorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if principal == nil {
+		principal = newAnonymousPrincipal()
+	}
+
+	if a.isRoot(principal) {
+		return nil
+	}
+
+	if roles := a.roleSource(); roles != nil {
+		for _, role := range roles.Roles() {
+			if assignedTo(role, principal) && allows(role, verb, resource) {
+				return nil
+			}
+		}
+	}
+
+	return errors.NewForbidden(principal, verb, resource)
+}
+
+func (a *Authorizer) isRoot(principal *models.Principal) bool {
+	if _, ok := a.rootUsers[principal.Username]; ok {
+		return true
+	}
+	for _, group := range principal.Groups {
+		if _, ok := a.rootGroups[group]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func assignedTo(role *models.Role, principal *models.Principal) bool {
+	for _, user := range role.Users {
+		if user == principal.Username {
+			return true
+		}
+	}
+	for _, group := range role.Groups {
+		for _, principalGroup := range principal.Groups {
+			if group == principalGroup {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func allows(role *models.Role, verb, resource string) bool {
+	for _, perm := range role.Permissions {
+		if perm == nil || !matchPattern(perm.Resource, resource) {
+			continue
+		}
+		for _, action := range perm.Actions {
+			if action == wildcard || action == verb {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchPattern reports whether s matches pattern, in which the wildcard
+// matches any sequence of characters including the path separator
+func matchPattern(pattern, s string) bool {
+	// classic greedy wildcard matching with backtracking to the last wildcard
+	p, i := 0, 0
+	star, next := -1, 0
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && pattern[p] == '*':
+			star, next = p, i
+			p++
+		case p < len(pattern) && pattern[p] == s[i]:
+			p++
+			i++
+		case star >= 0:
+			next++
+			p, i = star+1, next
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+func newAnonymousPrincipal() *models.Principal {
+	return &models.Principal{
+		Username: AnonymousPrincipalUsername,
+	}
+}
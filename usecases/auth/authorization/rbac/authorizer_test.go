@@ -0,0 +1,208 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+func Test_RBAC_Authorizer(t *testing.T) {
+	cfg := Config{
+		Enabled:    true,
+		RootUsers:  []string{"root"},
+		RootGroups: []string{"admins"},
+	}
+
+	roles := &fakeRoleSource{roles: []*models.Role{
+		{
+			Name: "reader",
+			Permissions: []*models.RolePermission{
+				{Actions: []string{"get", "list"}, Resource: "traversal/*"},
+			},
+			Groups: []string{"analysts"},
+		},
+		{
+			Name: "article-editor",
+			Permissions: []*models.RolePermission{
+				{Actions: []string{"*"}, Resource: "objects/Article*"},
+				{Actions: []string{"create"}, Resource: "batch/objects/Article"},
+			},
+			Users: []string{"jane"},
+		},
+	}}
+
+	t.Run("without a role source", func(t *testing.T) {
+		a := New(cfg)
+
+		t.Run("root users are allowed", func(t *testing.T) {
+			err := a.Authorize(&models.Principal{Username: "root"}, "delete", "schema/things")
+			assert.Nil(t, err)
+		})
+
+		t.Run("root groups are allowed", func(t *testing.T) {
+			principal := &models.Principal{Username: "bob", Groups: []string{"admins"}}
+			err := a.Authorize(principal, "delete", "schema/things")
+			assert.Nil(t, err)
+		})
+
+		t.Run("everyone else is denied", func(t *testing.T) {
+			principal := &models.Principal{Username: "jane"}
+			err := a.Authorize(principal, "get", "objects/Article")
+			assert.Equal(t, errors.NewForbidden(principal, "get", "objects/Article"), err)
+		})
+	})
+
+	t.Run("with a role source", func(t *testing.T) {
+		a := New(cfg)
+		a.SetRoleSource(roles)
+
+		type testCase struct {
+			name      string
+			principal *models.Principal
+			verb      string
+			resource  string
+			allowed   bool
+		}
+
+		tests := []testCase{
+			{
+				name:      "role assigned to a group",
+				principal: &models.Principal{Username: "bob", Groups: []string{"analysts"}},
+				verb:      "get",
+				resource:  "traversal/Article",
+				allowed:   true,
+			},
+			{
+				name:      "role assigned to a group, but verb not granted",
+				principal: &models.Principal{Username: "bob", Groups: []string{"analysts"}},
+				verb:      "delete",
+				resource:  "traversal/Article",
+				allowed:   false,
+			},
+			{
+				name:      "role assigned to a user with wildcard action",
+				principal: &models.Principal{Username: "jane"},
+				verb:      "delete",
+				resource:  "objects/Article/c5f4a5a3-1b5a-4d3e-9b0a-1d1c1e1f1a1b",
+				allowed:   true,
+			},
+			{
+				name:      "role assigned to a user, but other class",
+				principal: &models.Principal{Username: "jane"},
+				verb:      "get",
+				resource:  "objects/Author",
+				allowed:   false,
+			},
+			{
+				name:      "exact resource",
+				principal: &models.Principal{Username: "jane"},
+				verb:      "create",
+				resource:  "batch/objects/Article",
+				allowed:   true,
+			},
+			{
+				name:      "exact resource does not match a longer one",
+				principal: &models.Principal{Username: "jane"},
+				verb:      "create",
+				resource:  "batch/objects/ArticleArchive",
+				allowed:   false,
+			},
+			{
+				name:      "role not assigned",
+				principal: &models.Principal{Username: "bob"},
+				verb:      "get",
+				resource:  "traversal/Article",
+				allowed:   false,
+			},
+			{
+				name:      "anonymous",
+				principal: nil,
+				verb:      "get",
+				resource:  "traversal/Article",
+				allowed:   false,
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				err := a.Authorize(test.principal, test.verb, test.resource)
+				if test.allowed {
+					assert.Nil(t, err)
+					return
+				}
+
+				principal := test.principal
+				if principal == nil {
+					principal = newAnonymousPrincipal()
+				}
+				assert.Equal(t, errors.NewForbidden(principal, test.verb, test.resource), err)
+			})
+		}
+	})
+
+	t.Run("setting the role source while authorizing", func(t *testing.T) {
+		a := New(cfg)
+		principal := &models.Principal{Username: "jane"}
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			a.SetRoleSource(roles)
+		}()
+
+		for i := 0; i < 100; i++ {
+			a.Authorize(principal, "get", "objects/Article")
+		}
+		<-done
+
+		assert.Nil(t, a.Authorize(principal, "get", "objects/Article"))
+	})
+}
+
+func Test_RBAC_MatchPattern(t *testing.T) {
+	type testCase struct {
+		pattern string
+		s       string
+		match   bool
+	}
+
+	tests := []testCase{
+		{"*", "", true},
+		{"*", "schema/things", true},
+		{"objects", "objects", true},
+		{"objects", "objects/Article", false},
+		{"objects/*", "objects/Article/123", true},
+		{"objects/*/123", "objects/Article/123", true},
+		{"objects/*/123", "objects/Article/456", false},
+		{"objects/Art*le", "objects/Article", true},
+		{"*/Article", "traversal/Article", true},
+		{"*/Article", "traversal/Articles", false},
+		{"**", "roles/reader", true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.match, matchPattern(test.pattern, test.s),
+			"pattern %q on %q", test.pattern, test.s)
+	}
+}
+
+type fakeRoleSource struct {
+	roles []*models.Role
+}
+
+func (f *fakeRoleSource) Roles() []*models.Role {
+	return f.roles
+}
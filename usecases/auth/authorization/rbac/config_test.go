@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Validation(t *testing.T) {
+	t.Run("with root users", func(t *testing.T) {
+		cfg := Config{
+			Enabled:   true,
+			RootUsers: []string{"alice"},
+		}
+
+		err := cfg.Validate()
+		assert.Nil(t, err)
+	})
+
+	t.Run("with root groups", func(t *testing.T) {
+		cfg := Config{
+			Enabled:    true,
+			RootGroups: []string{"admins"},
+		}
+
+		err := cfg.Validate()
+		assert.Nil(t, err)
+	})
+
+	t.Run("without any root subject", func(t *testing.T) {
+		cfg := Config{
+			Enabled: true,
+		}
+
+		err := cfg.Validate()
+		assert.Equal(t, fmt.Errorf("rbac: at least one root user or group is required"), err)
+	})
+}
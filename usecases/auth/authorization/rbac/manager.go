@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var roleNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// validActions are the verbs used throughout the usecases when authorizing a
+// request. The wildcard grants all of them.
+var validActions = map[string]struct{}{
+	"create":   {},
+	"get":      {},
+	"list":     {},
+	"update":   {},
+	"delete":   {},
+	"head":     {},
+	"validate": {},
+	"add":      {},
+	"restore":  {},
+	wildcard:   {},
+}
+
+// RoleRepo persists roles. Implementations are expected to replicate the
+// roles to all nodes, as every node makes authorization decisions locally.
+type RoleRepo interface {
+	Put(ctx context.Context, role models.Role) error
+	Get(ctx context.Context, name string) (*models.Role, error)
+	List(ctx context.Context) ([]*models.Role, error)
+	Delete(ctx context.Context, name string) error
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// Manager manages the roles used by the rbac Authorizer
+type Manager struct {
+	authorizer authorizer
+	repo       RoleRepo
+}
+
+// NewManager creates a manager which stores roles in repo
+func NewManager(authorizer authorizer, repo RoleRepo) *Manager {
+	return &Manager{
+		authorizer: authorizer,
+		repo:       repo,
+	}
+}
+
+// CreateRole creates a new role or replaces an existing one with the same
+// name
+func (m *Manager) CreateRole(ctx context.Context, principal *models.Principal,
+	role *models.Role,
+) (*models.Role, error) {
+	if role == nil {
+		return nil, NewErrInvalidUserInput("role is required")
+	}
+
+	err := m.authorizer.Authorize(principal, "create", fmt.Sprintf("roles/%s", role.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRole(role); err != nil {
+		return nil, err
+	}
+
+	if err := m.repo.Put(ctx, *role); err != nil {
+		return nil, fmt.Errorf("put role %q: %w", role.Name, err)
+	}
+
+	return role, nil
+}
+
+// GetRole returns the role with the given name
+func (m *Manager) GetRole(ctx context.Context, principal *models.Principal,
+	name string,
+) (*models.Role, error) {
+	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("roles/%s", name))
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := m.repo.Get(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("get role %q: %w", name, err)
+	}
+	if role == nil {
+		return nil, NewErrNotFound("role %q not found", name)
+	}
+
+	return role, nil
+}
+
+// ListRoles returns all roles ordered by name
+func (m *Manager) ListRoles(ctx context.Context, principal *models.Principal,
+) ([]*models.Role, error) {
+	if err := m.authorizer.Authorize(principal, "list", "roles/*"); err != nil {
+		return nil, err
+	}
+
+	roles, err := m.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list roles: %w", err)
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+	return roles, nil
+}
+
+// DeleteRole removes the role. Principals which were assigned the role lose
+// its permissions immediately.
+func (m *Manager) DeleteRole(ctx context.Context, principal *models.Principal,
+	name string,
+) error {
+	err := m.authorizer.Authorize(principal, "delete", fmt.Sprintf("roles/%s", name))
+	if err != nil {
+		return err
+	}
+
+	role, err := m.repo.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("get role %q: %w", name, err)
+	}
+	if role == nil {
+		return NewErrNotFound("role %q not found", name)
+	}
+
+	if err := m.repo.Delete(ctx, name); err != nil {
+		return fmt.Errorf("delete role %q: %w", name, err)
+	}
+
+	return nil
+}
+
+func validateRole(role *models.Role) error {
+	if !roleNameRegex.MatchString(role.Name) {
+		return NewErrInvalidUserInput("role name %q must match %s",
+			role.Name, roleNameRegex.String())
+	}
+
+	if len(role.Permissions) == 0 {
+		return NewErrInvalidUserInput("role %q: at least one permission is required",
+			role.Name)
+	}
+
+	for i, perm := range role.Permissions {
+		if perm == nil {
+			return NewErrInvalidUserInput("role %q: permission %d is empty", role.Name, i)
+		}
+		if perm.Resource == "" {
+			return NewErrInvalidUserInput("role %q: permission %d: resource is required",
+				role.Name, i)
+		}
+		if len(perm.Actions) == 0 {
+			return NewErrInvalidUserInput("role %q: permission %d: at least one action is required",
+				role.Name, i)
+		}
+		for _, action := range perm.Actions {
+			if _, ok := validActions[action]; !ok {
+				return NewErrInvalidUserInput("role %q: permission %d: unknown action %q",
+					role.Name, i, action)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,276 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_Manager(t *testing.T) {
+	ctx := context.Background()
+	m := NewManager(&fakeAuthorizer{}, newFakeRoleRepo())
+
+	t.Run("create roles", func(t *testing.T) {
+		_, err := m.CreateRole(ctx, nil, exampleRole("writer"))
+		require.Nil(t, err)
+		_, err = m.CreateRole(ctx, nil, exampleRole("reader"))
+		require.Nil(t, err)
+	})
+
+	t.Run("get a role", func(t *testing.T) {
+		role, err := m.GetRole(ctx, nil, "reader")
+		require.Nil(t, err)
+		assert.Equal(t, exampleRole("reader"), role)
+	})
+
+	t.Run("get a non-existing role", func(t *testing.T) {
+		_, err := m.GetRole(ctx, nil, "unknown")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("list roles ordered by name", func(t *testing.T) {
+		roles, err := m.ListRoles(ctx, nil)
+		require.Nil(t, err)
+		require.Len(t, roles, 2)
+		assert.Equal(t, "reader", roles[0].Name)
+		assert.Equal(t, "writer", roles[1].Name)
+	})
+
+	t.Run("delete a role", func(t *testing.T) {
+		require.Nil(t, m.DeleteRole(ctx, nil, "reader"))
+
+		_, err := m.GetRole(ctx, nil, "reader")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("delete a non-existing role", func(t *testing.T) {
+		err := m.DeleteRole(ctx, nil, "reader")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+}
+
+func Test_Manager_Validation(t *testing.T) {
+	type testCase struct {
+		name string
+		role *models.Role
+	}
+
+	tests := []testCase{
+		{
+			name: "no role",
+			role: nil,
+		},
+		{
+			name: "invalid name",
+			role: &models.Role{
+				Name:        "not a name",
+				Permissions: exampleRole("").Permissions,
+			},
+		},
+		{
+			name: "no permissions",
+			role: &models.Role{Name: "empty"},
+		},
+		{
+			name: "empty permission",
+			role: &models.Role{
+				Name:        "empty",
+				Permissions: []*models.RolePermission{nil},
+			},
+		},
+		{
+			name: "no resource",
+			role: &models.Role{
+				Name: "empty",
+				Permissions: []*models.RolePermission{
+					{Actions: []string{"get"}},
+				},
+			},
+		},
+		{
+			name: "no actions",
+			role: &models.Role{
+				Name: "empty",
+				Permissions: []*models.RolePermission{
+					{Resource: "objects/*"},
+				},
+			},
+		},
+		{
+			name: "unknown action",
+			role: &models.Role{
+				Name: "empty",
+				Permissions: []*models.RolePermission{
+					{Actions: []string{"read"}, Resource: "objects/*"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := NewManager(&fakeAuthorizer{}, newFakeRoleRepo())
+			_, err := m.CreateRole(context.Background(), nil, test.role)
+			assert.IsType(t, ErrInvalidUserInput{}, err)
+		})
+	}
+}
+
+func Test_Manager_Authorization(t *testing.T) {
+	type testCase struct {
+		methodName       string
+		additionalArgs   []interface{}
+		expectedVerb     string
+		expectedResource string
+	}
+
+	tests := []testCase{
+		{
+			methodName:       "CreateRole",
+			additionalArgs:   []interface{}{exampleRole("reader")},
+			expectedVerb:     "create",
+			expectedResource: "roles/reader",
+		},
+		{
+			methodName:       "GetRole",
+			additionalArgs:   []interface{}{"reader"},
+			expectedVerb:     "get",
+			expectedResource: "roles/reader",
+		},
+		{
+			methodName:       "ListRoles",
+			expectedVerb:     "list",
+			expectedResource: "roles/*",
+		},
+		{
+			methodName:       "DeleteRole",
+			additionalArgs:   []interface{}{"reader"},
+			expectedVerb:     "delete",
+			expectedResource: "roles/reader",
+		},
+	}
+
+	t.Run("verify that a test for every public method exists", func(t *testing.T) {
+		testedMethods := make([]string, len(tests))
+		for i, test := range tests {
+			testedMethods[i] = test.methodName
+		}
+
+		subjectType := reflect.TypeOf(&Manager{})
+		for i := 0; i < subjectType.NumMethod(); i++ {
+			assert.Contains(t, testedMethods, subjectType.Method(i).Name)
+		}
+	})
+
+	t.Run("verify the tested methods require correct permissions from the authorizer", func(t *testing.T) {
+		principal := &models.Principal{}
+		for _, test := range tests {
+			authorizer := &fakeAuthorizer{err: errors.New("just a test fake")}
+			m := NewManager(authorizer, newFakeRoleRepo())
+
+			args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
+			in := make([]reflect.Value, len(args))
+			for i, arg := range args {
+				in[i] = reflect.ValueOf(arg)
+			}
+			out := reflect.ValueOf(m).MethodByName(test.methodName).Call(in)
+
+			require.Len(t, authorizer.calls, 1, "authorizer must be called")
+			assert.Equal(t, authorizer.err, out[len(out)-1].Interface(),
+				"execution must abort with authorizer error")
+			assert.Equal(t, authorizeCall{principal, test.expectedVerb, test.expectedResource},
+				authorizer.calls[0], "correct parameters must have been used on authorizer")
+		}
+	})
+}
+
+func exampleRole(name string) *models.Role {
+	return &models.Role{
+		Name: name,
+		Permissions: []*models.RolePermission{
+			{Actions: []string{"get", "list"}, Resource: "traversal/*"},
+		},
+		Groups: []string{"analysts"},
+	}
+}
+
+type authorizeCall struct {
+	principal *models.Principal
+	verb      string
+	resource  string
+}
+
+type fakeAuthorizer struct {
+	err   error
+	calls []authorizeCall
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	a.calls = append(a.calls, authorizeCall{principal, verb, resource})
+	return a.err
+}
+
+type fakeRoleRepo struct {
+	sync.Mutex
+	roles map[string]models.Role
+}
+
+func newFakeRoleRepo() *fakeRoleRepo {
+	return &fakeRoleRepo{roles: map[string]models.Role{}}
+}
+
+func (f *fakeRoleRepo) Put(ctx context.Context, role models.Role) error {
+	f.Lock()
+	defer f.Unlock()
+	f.roles[role.Name] = role
+	return nil
+}
+
+func (f *fakeRoleRepo) Get(ctx context.Context, name string) (*models.Role, error) {
+	f.Lock()
+	defer f.Unlock()
+	role, ok := f.roles[name]
+	if !ok {
+		return nil, nil
+	}
+	return &role, nil
+}
+
+func (f *fakeRoleRepo) List(ctx context.Context) ([]*models.Role, error) {
+	f.Lock()
+	defer f.Unlock()
+	roles := make([]*models.Role, 0, len(f.roles))
+	for name := range f.roles {
+		role := f.roles[name]
+		roles = append(roles, &role)
+	}
+	return roles, nil
+}
+
+func (f *fakeRoleRepo) Delete(ctx context.Context, name string) error {
+	f.Lock()
+	defer f.Unlock()
+	if _, ok := f.roles[name]; !ok {
+		return fmt.Errorf("role %q not found", name)
+	}
+	delete(f.roles, name)
+	return nil
+}
@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const (
+	TransactionPutRole    cluster.TransactionType = "put_role"
+	TransactionDeleteRole cluster.TransactionType = "delete_role"
+)
+
+type TransactionPutRolePayload struct {
+	Role models.Role `json:"role"`
+}
+
+type TransactionDeleteRolePayload struct {
+	Name string `json:"name"`
+}
+
+func UnmarshalRoleTransaction(txType cluster.TransactionType,
+	payload json.RawMessage,
+) (interface{}, error) {
+	switch txType {
+	case TransactionPutRole:
+		var pl TransactionPutRolePayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case TransactionDeleteRole:
+		var pl TransactionDeleteRolePayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	default:
+		return nil, errors.Errorf("unrecognized role transaction type %q", txType)
+	}
+}
@@ -100,6 +100,77 @@ func Test_Authorization(t *testing.T) {
 	})
 }
 
+func Test_ScheduleManagerAuthorization(t *testing.T) {
+	type testCase struct {
+		methodName       string
+		additionalArgs   []interface{}
+		expectedVerb     string
+		expectedResource string
+	}
+
+	tests := []testCase{
+		{
+			methodName:       "CreateSchedule",
+			additionalArgs:   []interface{}{&models.BackupSchedule{ID: "123"}},
+			expectedVerb:     "add",
+			expectedResource: "backups/schedules/123",
+		},
+		{
+			methodName:       "GetSchedule",
+			additionalArgs:   []interface{}{"123"},
+			expectedVerb:     "get",
+			expectedResource: "backups/schedules/123",
+		},
+		{
+			methodName:       "ListSchedules",
+			expectedVerb:     "list",
+			expectedResource: "backups/schedules",
+		},
+		{
+			methodName:       "DeleteSchedule",
+			additionalArgs:   []interface{}{"123"},
+			expectedVerb:     "delete",
+			expectedResource: "backups/schedules/123",
+		},
+	}
+
+	t.Run("verify that a test for every public method exists", func(t *testing.T) {
+		testedMethods := make([]string, len(tests))
+		for i, test := range tests {
+			testedMethods[i] = test.methodName
+		}
+
+		for _, method := range allExportedMethods(&ScheduleManager{}) {
+			switch method {
+			case "Run":
+				continue
+			}
+			assert.Contains(t, testedMethods, method)
+		}
+	})
+
+	t.Run("verify the tested methods require correct permissions from the authorizer", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		for _, test := range tests {
+			t.Run(test.methodName, func(t *testing.T) {
+				authorizer := &authDenier{}
+				m := NewScheduleManager(authorizer, nil, nil, nil, logger)
+				require.NotNil(t, m)
+
+				args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
+				out, _ := callFuncByName(m, test.methodName, args...)
+
+				require.Len(t, authorizer.calls, 1, "authorizer must be called")
+				assert.Equal(t, errors.New("just a test fake"), out[len(out)-1].Interface(),
+					"execution must abort with authorizer error")
+				assert.Equal(t, authorizeCall{principal, test.expectedVerb, test.expectedResource},
+					authorizer.calls[0], "correct parameters must have been used on authorizer")
+			})
+		}
+	})
+}
+
 type authorizeCall struct {
 	principal *models.Principal
 	verb      string
@@ -41,6 +41,9 @@ const (
 	GlobalBackupFile  = "backup_config.json"
 	GlobalRestoreFile = "restore_config.json"
 	_TempDirectory    = ".backup.tmp"
+	// DependentsFile used by coordinator to record the incremental backups
+	// which depend on a backup
+	DependentsFile = "dependents.json"
 )
 
 type objStore struct {
@@ -145,18 +148,73 @@ func (s *coordStore) Meta(ctx context.Context, filename string) (*backup.Distrib
 	return &result, err
 }
 
+// Dependents returns the IDs of the incremental backups which have been
+// recorded as depending on this backup
+func (s *coordStore) Dependents(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := s.meta(ctx, DependentsFile, &ids); err != nil {
+		if _, ok := err.(backup.ErrNotFound); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ids, nil
+}
+
+// AddDependent records that the incremental backup id depends on this backup
+func (s *coordStore) AddDependent(ctx context.Context, id string) error {
+	ids, err := s.Dependents(ctx)
+	if err != nil {
+		return fmt.Errorf("get dependents: %w", err)
+	}
+	for _, x := range ids {
+		if x == id {
+			return nil
+		}
+	}
+	return s.putMeta(ctx, DependentsFile, append(ids, id))
+}
+
+// baseFile is a file contained in the base of an incremental backup
+type baseFile struct {
+	stat     backup.FileStat
+	backupID string // backup which uploaded the file
+}
+
+// baseFiles maps the path of each file of a base backup to its content
+type baseFiles map[string]baseFile
+
+func newBaseFiles(desc *backup.BackupDescriptor) baseFiles {
+	files := make(baseFiles, 64)
+	for _, cdesc := range desc.Classes {
+		for _, shard := range cdesc.Shards {
+			for fpath, stat := range shard.FileStats {
+				id := shard.FileSources[fpath]
+				if id == "" {
+					id = desc.ID
+				}
+				files[fpath] = baseFile{stat: stat, backupID: id}
+			}
+		}
+	}
+	return files
+}
+
 // uploader uploads backup artifacts. This includes db files and metadata
 type uploader struct {
 	sourcer   Sourcer
 	backend   nodeStore
 	backupID  string
 	setStatus func(st backup.Status)
+
+	// base contains the files of the base backup if the backup is incremental
+	base baseFiles
 }
 
 func newUploader(sourcer Sourcer, backend nodeStore,
 	backupID string, setstaus func(st backup.Status),
 ) *uploader {
-	return &uploader{sourcer, backend, backupID, setstaus}
+	return &uploader{sourcer: sourcer, backend: backend, backupID: backupID, setStatus: setstaus}
 }
 
 // all uploads all files in addition to the metadata file
@@ -187,7 +245,7 @@ Loop:
 			if cdesc.Error != nil {
 				return cdesc.Error
 			}
-			if err := u.class(ctx, desc.ID, cdesc); err != nil {
+			if err := u.class(ctx, desc.ID, &cdesc); err != nil {
 				return err
 			}
 			desc.Classes = append(desc.Classes, cdesc)
@@ -201,8 +259,9 @@ Loop:
 	return nil
 }
 
-// class uploads one class
-func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescriptor) (err error) {
+// class uploads one class. Files with the same content hash as in the base
+// backup are not uploaded again but referenced in desc.
+func (u *uploader) class(ctx context.Context, id string, desc *backup.ClassDescriptor) (err error) {
 	metric, err := monitoring.GetMetrics().BackupStoreDurations.GetMetricWithLabelValues(getType(u.backend.b), desc.Name)
 	if err == nil {
 		timer := prometheus.NewTimer(metric)
@@ -214,11 +273,19 @@ func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescri
 	}()
 	ctx, cancel := context.WithTimeout(ctx, storeTimeout)
 	defer cancel()
-	for _, shard := range desc.Shards {
+	for i := range desc.Shards {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
+		shard := &desc.Shards[i]
 		for _, fpath := range shard.Files {
+			if src, ok := u.fileSource(shard, fpath); ok {
+				if shard.FileSources == nil {
+					shard.FileSources = make(map[string]string, len(shard.Files))
+				}
+				shard.FileSources[fpath] = src
+				continue
+			}
 			if err := u.backend.PutFile(ctx, fpath, fpath); err != nil {
 				return err
 			}
@@ -227,6 +294,19 @@ func (u *uploader) class(ctx context.Context, id string, desc backup.ClassDescri
 	return nil
 }
 
+// fileSource returns the ID of the backup already containing the file
+func (u *uploader) fileSource(shard *backup.ShardDescriptor, fpath string) (string, bool) {
+	stat, ok := shard.FileStats[fpath]
+	if !ok {
+		return "", false
+	}
+	f, ok := u.base[fpath]
+	if !ok || stat.Hash == "" || f.stat != stat {
+		return "", false
+	}
+	return f.backupID, true
+}
+
 // fileWriter downloads files from object store and writes files to the destination folder destDir
 type fileWriter struct {
 	sourcer    Sourcer
@@ -290,7 +370,12 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir string, d
 			if err := os.MkdirAll(destDir, os.ModePerm); err != nil {
 				return fmt.Errorf("create folder %s: %w", destDir, err)
 			}
-			if err := fw.backend.WriteToFile(ctx, key, destPath); err != nil {
+			store := fw.backend.objStore
+			if id := part.FileSources[key]; id != "" {
+				// file is part of an earlier backup of the chain
+				store = objStore{b: store.b, BasePath: fmt.Sprintf("%s/%s", id, part.Node)}
+			}
+			if err := store.WriteToFile(ctx, key, destPath); err != nil {
 				return fmt.Errorf("write file %s: %w", destPath, err)
 			}
 		}
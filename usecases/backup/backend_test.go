@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestNewBaseFiles(t *testing.T) {
+	desc := &backup.BackupDescriptor{
+		ID:     "2",
+		BaseID: "1",
+		Classes: []backup.ClassDescriptor{{
+			Name: "C1",
+			Shards: []backup.ShardDescriptor{{
+				Name:  "S1",
+				Files: []string{"a", "b", "c"},
+				FileStats: map[string]backup.FileStat{
+					"a": {Size: 1, Hash: "h1"},
+					"b": {Size: 2, Hash: "h2"},
+					"c": {Size: 3, Hash: "h3"},
+				},
+				// file "a" was uploaded by backup 0 which backup 1 was based on
+				FileSources: map[string]string{"a": "0", "b": "1"},
+			}},
+		}},
+	}
+
+	got := newBaseFiles(desc)
+	want := baseFiles{
+		"a": {stat: backup.FileStat{Size: 1, Hash: "h1"}, backupID: "0"},
+		"b": {stat: backup.FileStat{Size: 2, Hash: "h2"}, backupID: "1"},
+		"c": {stat: backup.FileStat{Size: 3, Hash: "h3"}, backupID: "2"},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestUploaderIncremental(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		backupID = "2"
+		nodeHome = backupID + "/" + nodeName
+	)
+
+	desc := backup.ClassDescriptor{
+		Name: "C1",
+		Shards: []backup.ShardDescriptor{{
+			Name:  "S1",
+			Node:  nodeName,
+			Files: []string{"unchanged", "changed", "touched", "new", "nostat"},
+			FileStats: map[string]backup.FileStat{
+				"unchanged": {Size: 1, Hash: "h1"},
+				"changed":   {Size: 3, Hash: "h2b"},
+				"touched":   {Size: 1, Hash: "h3b"},
+				"new":       {Size: 1, Hash: "h4"},
+			},
+		}},
+	}
+	base := baseFiles{
+		"unchanged": {stat: backup.FileStat{Size: 1, Hash: "h1"}, backupID: "0"},
+		"changed":   {stat: backup.FileStat{Size: 2, Hash: "h2"}, backupID: "1"},
+		"touched":   {stat: backup.FileStat{Size: 1, Hash: "h3"}, backupID: "1"},
+		"nostat":    {stat: backup.FileStat{Size: 1, Hash: "h5"}, backupID: "1"},
+	}
+
+	sourcer := &fakeSourcer{}
+	sourcer.On("ReleaseBackup", mock.Anything, backupID, "C1").Return(nil)
+	backend := newFakeBackend()
+	backend.On("PutFile", mock.Anything, nodeHome, "changed", "changed").Return(nil).Once()
+	backend.On("PutFile", mock.Anything, nodeHome, "new", "new").Return(nil).Once()
+	backend.On("PutFile", mock.Anything, nodeHome, "touched", "touched").Return(nil).Once()
+	backend.On("PutFile", mock.Anything, nodeHome, "nostat", "nostat").Return(nil).Once()
+
+	store := nodeStore{objStore{b: backend, BasePath: nodeHome}}
+	u := newUploader(sourcer, store, backupID, func(st backup.Status) {})
+	u.base = base
+
+	require.Nil(t, u.class(ctx, backupID, &desc))
+	backend.AssertExpectations(t)
+	assert.Equal(t, map[string]string{"unchanged": "0"}, desc.Shards[0].FileSources)
+}
+
+func TestFileWriterIncremental(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		backupID = "2"
+		nodeHome = backupID + "/" + nodeName
+		rawbytes = []byte("raw")
+	)
+
+	desc := backup.ClassDescriptor{
+		Name: "C1",
+		Shards: []backup.ShardDescriptor{{
+			Name:                  "S1",
+			Node:                  nodeName,
+			Files:                 []string{"dir1/a", "dir1/b"},
+			FileSources:           map[string]string{"dir1/a": "0"},
+			DocIDCounterPath:      "dir1/counter.txt",
+			ShardVersionPath:      "dir1/version.txt",
+			PropLengthTrackerPath: "dir1/prop.txt",
+			DocIDCounter:          rawbytes,
+			Version:               rawbytes,
+			PropLengthTracker:     rawbytes,
+		}},
+	}
+
+	dir := t.TempDir()
+	backend := newFakeBackend()
+	backend.On("SourceDataPath").Return(dir)
+	backend.On("WriteToFile", ctx, "0/"+nodeName, "dir1/a", mock.Anything).Return(nil).Once()
+	backend.On("WriteToFile", ctx, nodeHome, "dir1/b", mock.Anything).Return(nil).Once()
+
+	store := nodeStore{objStore{b: backend, BasePath: nodeHome}}
+	fw := newFileWriter(&fakeSourcer{}, store, backupID)
+	require.Nil(t, fw.writeTempFiles(ctx, dir+"/C1", &desc))
+	backend.AssertExpectations(t)
+}
+
+func TestSchedulerIncrementalBackupValidation(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		backendName = "gcs"
+		logger, _   = test.NewNullLogger()
+	)
+
+	tests := []struct {
+		name   string
+		baseID string
+		meta   []byte
+		metErr error
+		errMsg string
+	}{
+		{name: "invalid id", baseID: "A", errMsg: "base backup"},
+		{name: "same id", baseID: "1", errMsg: "own base"},
+		{
+			name: "not found", baseID: "0",
+			metErr: backup.NewErrNotFound(ErrAny), errMsg: "not found",
+		},
+		{
+			name: "failed", baseID: "0",
+			meta:   []byte(`{"id":"0","status":"FAILED"}`),
+			errMsg: "status",
+		},
+		{
+			name: "success", baseID: "0",
+			meta: []byte(`{"id":"0","status":"SUCCESS"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backend := newFakeBackend()
+			backend.On("HomeDir", mock.Anything).Return("/" + test.baseID)
+			backend.On("GetObject", ctx, test.baseID, GlobalBackupFile).
+				Return(test.meta, test.metErr)
+			backend.On("GetObject", ctx, test.baseID, BackupFile).
+				Return(nil, backup.NewErrNotFound(ErrAny))
+			s := NewScheduler(&fakeAuthorizer{}, nil, nil,
+				&fakeBackupBackendProvider{backend, nil}, &fakeNodeResolver{}, logger)
+
+			err := s.validateBaseBackup(ctx, &BackupRequest{
+				ID: "1", Backend: backendName, BaseID: test.baseID,
+			})
+			if test.errMsg == "" {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.errMsg)
+		})
+	}
+}
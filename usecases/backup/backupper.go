@@ -135,6 +135,13 @@ func (b *backupper) backup(ctx context.Context,
 		ID:      req.ID,
 		Timeout: expiration,
 	}
+	var base baseFiles
+	if req.BaseID != "" {
+		var err error
+		if base, err = b.baseFiles(ctx, req.Backend, req.BaseID); err != nil {
+			return ret, fmt.Errorf("base backup %q: %w", req.BaseID, err)
+		}
+	}
 	// make sure there is no active backup
 	if prevID := b.lastOp.renew(id, store.HomeDir()); prevID != "" {
 		return ret, fmt.Errorf("backup %s already in progress", prevID)
@@ -151,9 +158,11 @@ func (b *backupper) backup(ctx context.Context,
 
 		}
 		provider := newUploader(b.sourcer, store, req.ID, b.lastOp.set)
+		provider.base = base
 		result := backup.BackupDescriptor{
 			StartedAt:     time.Now().UTC(),
 			ID:            id,
+			BaseID:        req.BaseID,
 			Classes:       make([]backup.ClassDescriptor, 0, len(req.Classes)),
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
@@ -175,3 +184,23 @@ func (b *backupper) backup(ctx context.Context,
 
 	return ret, nil
 }
+
+// baseFiles returns the files of the local node which are contained in the
+// base backup. It returns nil if this node is not part of the base backup.
+func (b *backupper) baseFiles(ctx context.Context, backend, id string) (baseFiles, error) {
+	store, err := nodeBackend(b.node, b.backends, backend, id)
+	if err != nil {
+		return nil, fmt.Errorf("no backup provider %q, did you enable the right module?", backend)
+	}
+	meta, err := store.Meta(ctx, id, false)
+	if err != nil {
+		if _, ok := err.(backup.ErrNotFound); ok {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get meta file: %w", err)
+	}
+	if meta.Status != string(backup.Success) {
+		return nil, fmt.Errorf("invalid status: %s", meta.Status)
+	}
+	return newBaseFiles(meta), nil
+}
@@ -70,6 +70,12 @@ type selector interface {
 	Backupable(_ context.Context, classes []string) error
 }
 
+// aliasResolver is implemented by selectors which know about class aliases
+type aliasResolver interface {
+	// ResolveAlias returns the class an alias points to
+	ResolveAlias(name string) string
+}
+
 // coordinator coordinates a distributed backup and restore operation (DBRO):
 //
 // - It determines what request to send to which shard.
@@ -139,6 +145,7 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 		StartedAt:     time.Now().UTC(),
 		Status:        backup.Started,
 		ID:            req.ID,
+		BaseID:        req.BaseID,
 		Nodes:         groups,
 		Version:       Version,
 		ServerVersion: config.ServerVersion,
@@ -287,6 +294,7 @@ func (c *coordinator) canCommit(ctx context.Context, method Op, backend string)
 					Backend:  backend,
 					Classes:  gr.Classes,
 					Duration: _BookingPeriod,
+					BaseID:   c.descriptor.BaseID,
 				},
 			}
 		}
@@ -118,6 +118,13 @@ func (fb *fakeBackend) Initialize(ctx context.Context, backupID string) error {
 	return args.Error(0)
 }
 
+func (fb *fakeBackend) Delete(ctx context.Context, backupID string) error {
+	fb.Lock()
+	defer fb.Unlock()
+	args := fb.Called(ctx, backupID)
+	return args.Error(0)
+}
+
 func (fb *fakeBackend) SourceDataPath() string {
 	fb.RLock()
 	defer fb.RUnlock()
@@ -105,6 +105,10 @@ type BackupRequest struct {
 	// Exclude means include all classes but those specified in Exclude
 	// The same class cannot appear in both Include and Exclude in the same request
 	Exclude []string
+
+	// BaseID is the ID of an earlier backup on the same backend. If set, only
+	// files which are not part of the base backup are uploaded.
+	BaseID string
 }
 
 func (m *Manager) Backup(ctx context.Context, pr *models.Principal, req *BackupRequest,
@@ -0,0 +1,475 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// scheduleTickInterval is the resolution at which schedules are checked. It
+// needs to be smaller than the smallest interval a cron expression can
+// express, which is one minute.
+const scheduleTickInterval = 30 * time.Second
+
+// cronParser accepts standard cron expressions with five fields as well as
+// descriptors such as @daily or @every 1h
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom |
+	cron.Month | cron.Dow | cron.Descriptor)
+
+// errBackupRequired is returned when deleting a backup which incremental
+// backups take files from
+var errBackupRequired = errors.New("required by incremental backup")
+
+// ScheduleRepo persists backup schedules. Implementations are expected to
+// replicate changes to all nodes of the cluster, so that any node can take
+// over executing the schedules.
+type ScheduleRepo interface {
+	Put(ctx context.Context, schedule models.BackupSchedule) error
+	Get(ctx context.Context, id string) (*models.BackupSchedule, error)
+	List(ctx context.Context) ([]*models.BackupSchedule, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type memberLister interface {
+	AllNames() []string
+	LocalName() string
+}
+
+// ScheduleManager manages backup schedules and executes them. Schedules are
+// only executed on a single node of the cluster, the coordinator, which is
+// the node with the lowest name among all live members.
+type ScheduleManager struct {
+	// runLock ensures only one run of all schedules happens at a time
+	runLock sync.Mutex
+
+	// deps
+	logger     logrus.FieldLogger
+	authorizer authorizer
+	repo       ScheduleRepo
+	scheduler  *Scheduler
+	members    memberLister
+	now        func() time.Time
+}
+
+// NewScheduleManager creates a manager which starts backups through scheduler
+func NewScheduleManager(authorizer authorizer, repo ScheduleRepo,
+	scheduler *Scheduler, members memberLister, logger logrus.FieldLogger,
+) *ScheduleManager {
+	return &ScheduleManager{
+		logger:     logger,
+		authorizer: authorizer,
+		repo:       repo,
+		scheduler:  scheduler,
+		members:    members,
+		now:        time.Now,
+	}
+}
+
+// CreateSchedule creates a new schedule or replaces an existing one with the
+// same ID. Backups created by a replaced schedule remain subject to its
+// retention policy.
+func (m *ScheduleManager) CreateSchedule(ctx context.Context, pr *models.Principal,
+	schedule *models.BackupSchedule,
+) (*models.BackupSchedule, error) {
+	path := fmt.Sprintf("backups/schedules/%s", schedule.ID)
+	if err := m.authorizer.Authorize(pr, "add", path); err != nil {
+		return nil, err
+	}
+
+	if err := m.validateSchedule(schedule); err != nil {
+		return nil, backup.NewErrUnprocessable(err)
+	}
+
+	m.runLock.Lock()
+	defer m.runLock.Unlock()
+
+	next := models.BackupSchedule{
+		ID:               schedule.ID,
+		Backend:          schedule.Backend,
+		Cron:             schedule.Cron,
+		Include:          schedule.Include,
+		Exclude:          schedule.Exclude,
+		Retention:        schedule.Retention,
+		CreationTimeUnix: m.now().UnixMilli(),
+	}
+
+	prev, err := m.repo.Get(ctx, schedule.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get schedule %q: %w", schedule.ID, err)
+	}
+	if prev != nil {
+		next.LastRunTimeUnix = prev.LastRunTimeUnix
+		next.Backups = prev.Backups
+	}
+
+	if err := m.repo.Put(ctx, next); err != nil {
+		return nil, fmt.Errorf("put schedule %q: %w", schedule.ID, err)
+	}
+
+	return &next, nil
+}
+
+// GetSchedule returns the schedule with the given ID
+func (m *ScheduleManager) GetSchedule(ctx context.Context, pr *models.Principal,
+	id string,
+) (*models.BackupSchedule, error) {
+	path := fmt.Sprintf("backups/schedules/%s", id)
+	if err := m.authorizer.Authorize(pr, "get", path); err != nil {
+		return nil, err
+	}
+
+	schedule, err := m.repo.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get schedule %q: %w", id, err)
+	}
+	if schedule == nil {
+		return nil, backup.NewErrNotFound(fmt.Errorf("schedule %q not found", id))
+	}
+
+	return schedule, nil
+}
+
+// ListSchedules returns all schedules ordered by ID
+func (m *ScheduleManager) ListSchedules(ctx context.Context, pr *models.Principal,
+) ([]*models.BackupSchedule, error) {
+	if err := m.authorizer.Authorize(pr, "list", "backups/schedules"); err != nil {
+		return nil, err
+	}
+
+	schedules, err := m.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list schedules: %w", err)
+	}
+
+	sort.Slice(schedules, func(i, j int) bool {
+		return schedules[i].ID < schedules[j].ID
+	})
+	return schedules, nil
+}
+
+// DeleteSchedule stops the schedule. Backups it created are not deleted.
+func (m *ScheduleManager) DeleteSchedule(ctx context.Context, pr *models.Principal,
+	id string,
+) error {
+	path := fmt.Sprintf("backups/schedules/%s", id)
+	if err := m.authorizer.Authorize(pr, "delete", path); err != nil {
+		return err
+	}
+
+	m.runLock.Lock()
+	defer m.runLock.Unlock()
+
+	schedule, err := m.repo.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get schedule %q: %w", id, err)
+	}
+	if schedule == nil {
+		return backup.NewErrNotFound(fmt.Errorf("schedule %q not found", id))
+	}
+
+	if err := m.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("delete schedule %q: %w", id, err)
+	}
+	return nil
+}
+
+func (m *ScheduleManager) validateSchedule(schedule *models.BackupSchedule) error {
+	if err := validateID(schedule.ID); err != nil {
+		return err
+	}
+	if schedule.Backend == "" {
+		return fmt.Errorf("backend must be set")
+	}
+	if _, err := m.scheduler.backends.BackupBackend(schedule.Backend); err != nil {
+		return fmt.Errorf("no backup backend %q: %w, did you enable the right module?",
+			schedule.Backend, err)
+	}
+	if _, err := cronParser.Parse(schedule.Cron); err != nil {
+		return fmt.Errorf("invalid cron expression %q: %w", schedule.Cron, err)
+	}
+	if len(schedule.Include) > 0 && len(schedule.Exclude) > 0 {
+		return errIncludeExclude
+	}
+	if dup := findDuplicate(schedule.Include); dup != "" {
+		return fmt.Errorf("class list 'include' contains duplicate: %s", dup)
+	}
+	if r := schedule.Retention; r != nil && (r.KeepLast < 0 || r.KeepDailyDays < 0) {
+		return fmt.Errorf("retention values must not be negative")
+	}
+	return nil
+}
+
+// Run executes due schedules until ctx is cancelled. It is meant to be
+// started on every node, only the coordinator will act on the schedules.
+func (m *ScheduleManager) Run(ctx context.Context) {
+	ticker := time.NewTicker(scheduleTickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if m.isCoordinator() {
+				m.runSchedules(ctx)
+			}
+		}
+	}
+}
+
+// isCoordinator returns true if the local node is the one responsible for
+// executing the schedules
+func (m *ScheduleManager) isCoordinator() bool {
+	names := m.members.AllNames()
+	if len(names) == 0 {
+		return false
+	}
+	sort.Strings(names)
+	return names[0] == m.members.LocalName()
+}
+
+func (m *ScheduleManager) runSchedules(ctx context.Context) {
+	m.runLock.Lock()
+	defer m.runLock.Unlock()
+
+	schedules, err := m.repo.List(ctx)
+	if err != nil {
+		m.logger.WithField("action", "backup_schedules").WithError(err).
+			Error("list backup schedules")
+		return
+	}
+
+	for _, schedule := range schedules {
+		if !m.runSchedule(ctx, schedule, m.now()) {
+			continue
+		}
+		if err := m.repo.Put(ctx, *schedule); err != nil {
+			m.logger.WithField("action", "backup_schedules").
+				WithField("schedule_id", schedule.ID).WithError(err).
+				Error("persist backup schedule")
+		}
+	}
+}
+
+// runSchedule updates the status of the backups created by the schedule,
+// deletes expired backups and starts a new backup if one is due. It returns
+// true if the schedule was changed.
+func (m *ScheduleManager) runSchedule(ctx context.Context,
+	schedule *models.BackupSchedule, now time.Time,
+) bool {
+	changed := m.refreshBackups(ctx, schedule)
+
+	if m.applyRetention(ctx, schedule, now) {
+		changed = true
+	}
+
+	if !scheduleDue(schedule, now) {
+		return changed
+	}
+
+	id := fmt.Sprintf("%s-%s", schedule.ID, now.UTC().Format("20060102150405"))
+	req := &BackupRequest{
+		ID:      id,
+		Backend: schedule.Backend,
+		Include: schedule.Include,
+		Exclude: schedule.Exclude,
+	}
+
+	schedule.LastRunTimeUnix = now.UnixMilli()
+	schedule.LastError = ""
+	res, err := m.scheduler.backup(ctx, req)
+	logOperation(m.logger, "scheduled_backup", id, schedule.Backend, now, err)
+	if err != nil {
+		schedule.LastError = err.Error()
+		return true
+	}
+
+	status := string(backup.Started)
+	if res.Status != nil {
+		status = *res.Status
+	}
+	schedule.Backups = append(schedule.Backups, &models.BackupScheduleBackup{
+		ID:            id,
+		Status:        status,
+		StartTimeUnix: now.UnixMilli(),
+	})
+	return true
+}
+
+// refreshBackups updates the status of all unfinished backups
+func (m *ScheduleManager) refreshBackups(ctx context.Context,
+	schedule *models.BackupSchedule,
+) bool {
+	changed := false
+	for _, b := range schedule.Backups {
+		if backupFinished(b) {
+			continue
+		}
+
+		store, err := coordBackend(m.scheduler.backends, schedule.Backend, b.ID)
+		if err != nil {
+			continue
+		}
+		req := &StatusRequest{OpCreate, b.ID, schedule.Backend}
+		st, err := m.scheduler.backupper.OnStatus(ctx, store, req)
+		if err != nil {
+			continue
+		}
+		if status := string(st.Status); status != b.Status {
+			b.Status = status
+			changed = true
+		}
+	}
+	return changed
+}
+
+// applyRetention deletes all backups which are not retained by the policy of
+// the schedule
+func (m *ScheduleManager) applyRetention(ctx context.Context,
+	schedule *models.BackupSchedule, now time.Time,
+) bool {
+	expired := expiredBackups(schedule.Retention, schedule.Backups, now)
+	if len(expired) == 0 {
+		return false
+	}
+
+	kept := make([]*models.BackupScheduleBackup, 0, len(schedule.Backups))
+	for _, b := range schedule.Backups {
+		if _, ok := expired[b.ID]; !ok {
+			kept = append(kept, b)
+			continue
+		}
+
+		if err := m.deleteBackup(ctx, schedule.Backend, b.ID); err != nil {
+			if errors.Is(err, errBackupRequired) {
+				// deleted once the incremental backups based on it are gone
+				m.logger.WithField("action", "backup_schedule_retention").
+					WithField("schedule_id", schedule.ID).
+					WithField("backup_id", b.ID).Debug(err)
+				kept = append(kept, b)
+				continue
+			}
+			m.logger.WithField("action", "backup_schedule_retention").
+				WithField("schedule_id", schedule.ID).
+				WithField("backup_id", b.ID).WithError(err).
+				Error("delete expired backup")
+			kept = append(kept, b)
+			continue
+		}
+	}
+
+	changed := len(kept) != len(schedule.Backups)
+	schedule.Backups = kept
+	return changed
+}
+
+// deleteBackup deletes the backup from the backend, unless an incremental
+// backup still takes files from it
+func (m *ScheduleManager) deleteBackup(ctx context.Context, backend, id string) error {
+	if id == "" {
+		return errors.New("empty backup id")
+	}
+	caps, err := m.scheduler.backends.BackupBackend(backend)
+	if err != nil {
+		return fmt.Errorf("no backup backend %q: %w", backend, err)
+	}
+	dep, err := m.scheduler.requiredBy(ctx, backend, id)
+	if err != nil {
+		return err
+	}
+	if dep != "" {
+		return fmt.Errorf("backup %q: %w by %q", id, errBackupRequired, dep)
+	}
+	return caps.Delete(ctx, id)
+}
+
+// scheduleDue returns true if the next occurrence of the schedule after its
+// last run, or its creation if it never ran, is not in the future. Runs
+// missed while no coordinator was available are caught up on only once.
+func scheduleDue(schedule *models.BackupSchedule, now time.Time) bool {
+	sched, err := cronParser.Parse(schedule.Cron)
+	if err != nil {
+		return false
+	}
+
+	last := schedule.LastRunTimeUnix
+	if last == 0 {
+		last = schedule.CreationTimeUnix
+	}
+	return !sched.Next(time.UnixMilli(last).UTC()).After(now)
+}
+
+func backupFinished(b *models.BackupScheduleBackup) bool {
+	return b.Status == string(backup.Success) || b.Status == string(backup.Failed)
+}
+
+// expiredBackups returns the IDs of the backups which are not retained by the
+// policy. Unfinished backups are never expired, failed backups always are.
+// Without a policy all successful backups are retained.
+func expiredBackups(retention *models.BackupScheduleRetention,
+	backups []*models.BackupScheduleBackup, now time.Time,
+) map[string]struct{} {
+	expired := map[string]struct{}{}
+
+	var successful []*models.BackupScheduleBackup
+	for _, b := range backups {
+		switch {
+		case b.Status == string(backup.Failed):
+			expired[b.ID] = struct{}{}
+		case b.Status == string(backup.Success):
+			successful = append(successful, b)
+		}
+	}
+
+	if retention == nil || (retention.KeepLast == 0 && retention.KeepDailyDays == 0) {
+		return expired
+	}
+
+	// newest first
+	sort.SliceStable(successful, func(i, j int) bool {
+		return successful[i].StartTimeUnix > successful[j].StartTimeUnix
+	})
+
+	year, month, day := now.UTC().Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	oldestDay := today.AddDate(0, 0, -int(retention.KeepDailyDays)+1)
+	daysKept := map[time.Time]struct{}{}
+
+	for i, b := range successful {
+		keep := int64(i) < retention.KeepLast
+
+		if retention.KeepDailyDays > 0 {
+			year, month, day := time.UnixMilli(b.StartTimeUnix).UTC().Date()
+			bday := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+			if _, ok := daysKept[bday]; !ok && !bday.Before(oldestDay) {
+				daysKept[bday] = struct{}{}
+				keep = true
+			}
+		}
+
+		if !keep {
+			expired[b.ID] = struct{}{}
+		}
+	}
+
+	return expired
+}
@@ -0,0 +1,375 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestScheduleManager_CRUD(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	newManager := func() (*ScheduleManager, *fakeScheduleRepo) {
+		repo := newFakeScheduleRepo()
+		m := newFakeScheduleManager(repo, newFakeBackend(), now)
+		return m, repo
+	}
+
+	t.Run("create and get", func(t *testing.T) {
+		m, _ := newManager()
+		created, err := m.CreateSchedule(ctx, nil, &models.BackupSchedule{
+			ID:        "nightly",
+			Backend:   "s3",
+			Cron:      "0 2 * * *",
+			Retention: &models.BackupScheduleRetention{KeepLast: 3},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, now.UnixMilli(), created.CreationTimeUnix)
+
+		got, err := m.GetSchedule(ctx, nil, "nightly")
+		require.Nil(t, err)
+		assert.Equal(t, created, got)
+	})
+
+	t.Run("replace keeps history", func(t *testing.T) {
+		m, repo := newManager()
+		repo.schedules["nightly"] = models.BackupSchedule{
+			ID: "nightly", Backend: "s3", Cron: "0 2 * * *", LastRunTimeUnix: 42,
+			Backups: []*models.BackupScheduleBackup{{ID: "nightly-1"}},
+		}
+		created, err := m.CreateSchedule(ctx, nil, &models.BackupSchedule{
+			ID: "nightly", Backend: "s3", Cron: "@daily",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "@daily", created.Cron)
+		assert.Equal(t, int64(42), created.LastRunTimeUnix)
+		assert.Len(t, created.Backups, 1)
+	})
+
+	t.Run("invalid schedules", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			schedule models.BackupSchedule
+		}{
+			{"invalid id", models.BackupSchedule{ID: "A B", Backend: "s3", Cron: "@daily"}},
+			{"no backend", models.BackupSchedule{ID: "a", Cron: "@daily"}},
+			{"invalid cron", models.BackupSchedule{ID: "a", Backend: "s3", Cron: "every day"}},
+			{"include and exclude", models.BackupSchedule{
+				ID: "a", Backend: "s3", Cron: "@daily",
+				Include: []string{"A"}, Exclude: []string{"B"},
+			}},
+			{"negative retention", models.BackupSchedule{
+				ID: "a", Backend: "s3", Cron: "@daily",
+				Retention: &models.BackupScheduleRetention{KeepLast: -1},
+			}},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				m, repo := newManager()
+				_, err := m.CreateSchedule(ctx, nil, &test.schedule)
+				assert.IsType(t, backup.ErrUnprocessable{}, err)
+				assert.Empty(t, repo.schedules)
+			})
+		}
+	})
+
+	t.Run("list is ordered", func(t *testing.T) {
+		m, repo := newManager()
+		for _, id := range []string{"c", "a", "b"} {
+			repo.schedules[id] = models.BackupSchedule{ID: id}
+		}
+		schedules, err := m.ListSchedules(ctx, nil)
+		require.Nil(t, err)
+		require.Len(t, schedules, 3)
+		for i, id := range []string{"a", "b", "c"} {
+			assert.Equal(t, id, schedules[i].ID)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		m, _ := newManager()
+		_, err := m.GetSchedule(ctx, nil, "missing")
+		assert.IsType(t, backup.ErrNotFound{}, err)
+		err = m.DeleteSchedule(ctx, nil, "missing")
+		assert.IsType(t, backup.ErrNotFound{}, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		m, repo := newManager()
+		repo.schedules["a"] = models.BackupSchedule{ID: "a"}
+		require.Nil(t, m.DeleteSchedule(ctx, nil, "a"))
+		assert.Empty(t, repo.schedules)
+	})
+}
+
+func TestScheduleManager_IsCoordinator(t *testing.T) {
+	m := &ScheduleManager{members: &fakeMembers{
+		names: []string{"node-2", "node-1", "node-3"},
+		local: "node-1",
+	}}
+	assert.True(t, m.isCoordinator())
+
+	m.members = &fakeMembers{names: []string{"node-2", "node-1"}, local: "node-2"}
+	assert.False(t, m.isCoordinator())
+
+	m.members = &fakeMembers{local: "node-1"}
+	assert.False(t, m.isCoordinator())
+}
+
+func TestScheduleDue(t *testing.T) {
+	created := time.Date(2023, 5, 1, 12, 30, 0, 0, time.UTC)
+	schedule := &models.BackupSchedule{
+		Cron:             "0 2 * * *",
+		CreationTimeUnix: created.UnixMilli(),
+	}
+
+	assert.False(t, scheduleDue(schedule, created.Add(time.Hour)))
+	assert.True(t, scheduleDue(schedule, time.Date(2023, 5, 2, 2, 0, 0, 0, time.UTC)))
+
+	schedule.LastRunTimeUnix = time.Date(2023, 5, 2, 2, 0, 0, 0, time.UTC).UnixMilli()
+	assert.False(t, scheduleDue(schedule, time.Date(2023, 5, 2, 23, 0, 0, 0, time.UTC)))
+	// missed runs are only caught up on once
+	assert.True(t, scheduleDue(schedule, time.Date(2023, 5, 9, 23, 0, 0, 0, time.UTC)))
+
+	schedule.Cron = "not a cron expression"
+	assert.False(t, scheduleDue(schedule, time.Date(2023, 5, 9, 23, 0, 0, 0, time.UTC)))
+}
+
+func TestExpiredBackups(t *testing.T) {
+	now := time.Date(2023, 5, 10, 12, 0, 0, 0, time.UTC)
+	at := func(day, hour int) int64 {
+		return time.Date(2023, 5, day, hour, 0, 0, 0, time.UTC).UnixMilli()
+	}
+	success := string(backup.Success)
+	backups := []*models.BackupScheduleBackup{
+		{ID: "d10-h06", Status: success, StartTimeUnix: at(10, 6)},
+		{ID: "d10-h00", Status: success, StartTimeUnix: at(10, 0)},
+		{ID: "d09-h18", Status: success, StartTimeUnix: at(9, 18)},
+		{ID: "d09-h06", Status: success, StartTimeUnix: at(9, 6)},
+		{ID: "d08-h06", Status: success, StartTimeUnix: at(8, 6)},
+		{ID: "d07-h06", Status: string(backup.Failed), StartTimeUnix: at(7, 6)},
+		{ID: "d06-h06", Status: string(backup.Transferring), StartTimeUnix: at(6, 6)},
+		{ID: "d01-h06", Status: success, StartTimeUnix: at(1, 6)},
+	}
+
+	tests := []struct {
+		name      string
+		retention *models.BackupScheduleRetention
+		expired   []string
+	}{
+		{
+			name:    "no retention only expires failed",
+			expired: []string{"d07-h06"},
+		},
+		{
+			name:      "empty retention only expires failed",
+			retention: &models.BackupScheduleRetention{},
+			expired:   []string{"d07-h06"},
+		},
+		{
+			name:      "keep last",
+			retention: &models.BackupScheduleRetention{KeepLast: 2},
+			expired:   []string{"d09-h18", "d09-h06", "d08-h06", "d07-h06", "d01-h06"},
+		},
+		{
+			name:      "keep daily",
+			retention: &models.BackupScheduleRetention{KeepDailyDays: 2},
+			expired:   []string{"d10-h00", "d09-h06", "d08-h06", "d07-h06", "d01-h06"},
+		},
+		{
+			name:      "keep last and daily",
+			retention: &models.BackupScheduleRetention{KeepLast: 3, KeepDailyDays: 3},
+			expired:   []string{"d09-h06", "d07-h06", "d01-h06"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expired := expiredBackups(test.retention, backups, now)
+			var ids []string
+			for id := range expired {
+				ids = append(ids, id)
+			}
+			assert.ElementsMatch(t, test.expired, ids)
+		})
+	}
+}
+
+func TestScheduleManager_ApplyRetention(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 5, 10, 12, 0, 0, 0, time.UTC)
+	notFound := backup.NewErrNotFound(ErrAny)
+
+	t.Run("failed deletes are retried", func(t *testing.T) {
+		backend := newFakeBackend()
+		backend.On("GetObject", ctx, mock.Anything, DependentsFile).Return(nil, notFound)
+		backend.On("Delete", ctx, "s-1").Return(nil)
+		backend.On("Delete", ctx, "s-2").Return(errors.New("network error"))
+		m := newFakeScheduleManager(newFakeScheduleRepo(), backend, now)
+
+		schedule := &models.BackupSchedule{
+			ID:        "s",
+			Backend:   "s3",
+			Retention: &models.BackupScheduleRetention{KeepLast: 1},
+			Backups: []*models.BackupScheduleBackup{
+				{ID: "s-3", Status: string(backup.Success), StartTimeUnix: 3},
+				{ID: "s-2", Status: string(backup.Success), StartTimeUnix: 2},
+				{ID: "s-1", Status: string(backup.Failed), StartTimeUnix: 1},
+			},
+		}
+
+		assert.True(t, m.applyRetention(ctx, schedule, now))
+		backend.AssertExpectations(t)
+		// backups which could not be deleted are retried on the next run
+		require.Len(t, schedule.Backups, 2)
+		assert.Equal(t, "s-3", schedule.Backups[0].ID)
+		assert.Equal(t, "s-2", schedule.Backups[1].ID)
+	})
+
+	t.Run("bases of incremental backups are kept", func(t *testing.T) {
+		backend := newFakeBackend()
+		backend.On("GetObject", ctx, "s-1", DependentsFile).
+			Return([]byte(`["inc-1"]`), nil)
+		backend.On("GetObject", ctx, "inc-1", GlobalBackupFile).
+			Return([]byte(`{"id":"inc-1","status":"SUCCESS"}`), nil)
+		backend.On("GetObject", ctx, "s-2", DependentsFile).
+			Return([]byte(`["inc-2","inc-3"]`), nil)
+		// deleted or failed dependents don't require their base
+		backend.On("GetObject", ctx, "inc-2", GlobalBackupFile).Return(nil, notFound)
+		backend.On("GetObject", ctx, "inc-2", BackupFile).Return(nil, notFound)
+		backend.On("GetObject", ctx, "inc-3", GlobalBackupFile).
+			Return([]byte(`{"id":"inc-3","status":"FAILED"}`), nil)
+		backend.On("Delete", ctx, "s-2").Return(nil)
+		m := newFakeScheduleManager(newFakeScheduleRepo(), backend, now)
+
+		schedule := &models.BackupSchedule{
+			ID:        "s",
+			Backend:   "s3",
+			Retention: &models.BackupScheduleRetention{KeepLast: 1},
+			Backups: []*models.BackupScheduleBackup{
+				{ID: "s-3", Status: string(backup.Success), StartTimeUnix: 3},
+				{ID: "s-2", Status: string(backup.Success), StartTimeUnix: 2},
+				{ID: "s-1", Status: string(backup.Success), StartTimeUnix: 1},
+			},
+		}
+
+		assert.True(t, m.applyRetention(ctx, schedule, now))
+		backend.AssertExpectations(t)
+		backend.AssertNotCalled(t, "Delete", ctx, "s-1")
+		require.Len(t, schedule.Backups, 2)
+		assert.Equal(t, "s-3", schedule.Backups[0].ID)
+		assert.Equal(t, "s-1", schedule.Backups[1].ID)
+	})
+}
+
+func TestSchedulerAddDependent(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	backend := newFakeBackend()
+	// inc-2 is based on inc-1, which is based on full
+	backend.On("GetObject", ctx, "inc-1", DependentsFile).
+		Return([]byte(`["other"]`), nil)
+	backend.On("GetObject", ctx, "inc-1", GlobalBackupFile).
+		Return([]byte(`{"id":"inc-1","baseId":"full","status":"SUCCESS"}`), nil)
+	backend.On("GetObject", ctx, "full", DependentsFile).
+		Return([]byte(`["inc-1"]`), nil)
+	backend.On("GetObject", ctx, "full", GlobalBackupFile).
+		Return([]byte(`{"id":"full","status":"SUCCESS"}`), nil)
+	backend.On("PutObject", mock.Anything, "inc-1", DependentsFile,
+		[]byte(`["other","inc-2"]`)).Return(nil)
+	backend.On("PutObject", mock.Anything, "full", DependentsFile,
+		[]byte(`["inc-1","inc-2"]`)).Return(nil)
+	s := NewScheduler(&fakeAuthorizer{}, nil, nil,
+		&fakeBackupBackendProvider{backend, nil}, &fakeNodeResolver{}, logger)
+
+	err := s.addDependent(ctx, &BackupRequest{ID: "inc-2", Backend: "s3", BaseID: "inc-1"})
+	require.Nil(t, err)
+	backend.AssertExpectations(t)
+}
+
+func newFakeScheduleManager(repo ScheduleRepo, backend *fakeBackend,
+	now time.Time,
+) *ScheduleManager {
+	logger, _ := test.NewNullLogger()
+	provider := &fakeBackupBackendProvider{backend, nil}
+	scheduler := NewScheduler(&fakeAuthorizer{}, nil, nil, provider,
+		&fakeNodeResolver{}, logger)
+	m := NewScheduleManager(&fakeAuthorizer{}, repo, scheduler, &fakeMembers{}, logger)
+	m.now = func() time.Time { return now }
+	return m
+}
+
+type fakeMembers struct {
+	names []string
+	local string
+}
+
+func (f *fakeMembers) AllNames() []string { return f.names }
+func (f *fakeMembers) LocalName() string  { return f.local }
+
+type fakeScheduleRepo struct {
+	sync.Mutex
+	schedules map[string]models.BackupSchedule
+}
+
+func newFakeScheduleRepo() *fakeScheduleRepo {
+	return &fakeScheduleRepo{schedules: map[string]models.BackupSchedule{}}
+}
+
+func (r *fakeScheduleRepo) Put(ctx context.Context, schedule models.BackupSchedule) error {
+	r.Lock()
+	defer r.Unlock()
+	r.schedules[schedule.ID] = schedule
+	return nil
+}
+
+func (r *fakeScheduleRepo) Get(ctx context.Context, id string) (*models.BackupSchedule, error) {
+	r.Lock()
+	defer r.Unlock()
+	schedule, ok := r.schedules[id]
+	if !ok {
+		return nil, nil
+	}
+	return &schedule, nil
+}
+
+func (r *fakeScheduleRepo) List(ctx context.Context) ([]*models.BackupSchedule, error) {
+	r.Lock()
+	defer r.Unlock()
+	out := make([]*models.BackupSchedule, 0, len(r.schedules))
+	for id := range r.schedules {
+		schedule := r.schedules[id]
+		out = append(out, &schedule)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID > out[j].ID })
+	return out, nil
+}
+
+func (r *fakeScheduleRepo) Delete(ctx context.Context, id string) error {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.schedules, id)
+	return nil
+}
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const (
+	TransactionPutSchedule    cluster.TransactionType = "put_backup_schedule"
+	TransactionDeleteSchedule cluster.TransactionType = "delete_backup_schedule"
+	TransactionReadSchedules  cluster.TransactionType = "read_backup_schedules"
+)
+
+type TransactionPutSchedulePayload struct {
+	Schedule models.BackupSchedule `json:"schedule"`
+}
+
+type TransactionDeleteSchedulePayload struct {
+	ID string `json:"id"`
+}
+
+// TransactionReadSchedulesPayload is empty when the transaction is opened and
+// filled with the schedules of the responding node
+type TransactionReadSchedulesPayload struct {
+	Schedules []*models.BackupSchedule `json:"schedules"`
+}
+
+func UnmarshalScheduleTransaction(txType cluster.TransactionType,
+	payload json.RawMessage,
+) (interface{}, error) {
+	switch txType {
+	case TransactionPutSchedule:
+		var pl TransactionPutSchedulePayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case TransactionDeleteSchedule:
+		var pl TransactionDeleteSchedulePayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case TransactionReadSchedules:
+		var pl TransactionReadSchedulesPayload
+		if len(payload) == 0 {
+			return pl, nil
+		}
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	default:
+		return nil, errors.Errorf("unrecognized backup schedule transaction type %q", txType)
+	}
+}
@@ -72,6 +72,13 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 	if err := s.authorizer.Authorize(pr, "add", path); err != nil {
 		return nil, err
 	}
+	return s.backup(ctx, req)
+}
+
+// backup starts the backup without authorizing the request. The caller is
+// responsible for authorization.
+func (s *Scheduler) backup(ctx context.Context, req *BackupRequest,
+) (*models.BackupCreateResponse, error) {
 	store, err := coordBackend(s.backends, req.Backend, req.ID)
 	if err != nil {
 		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", req.Backend, err)
@@ -86,11 +93,17 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 	if err := store.Initialize(ctx); err != nil {
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
 	}
+	if req.BaseID != "" {
+		if err := s.addDependent(ctx, req); err != nil {
+			return nil, backup.NewErrUnprocessable(err)
+		}
+	}
 	breq := Request{
 		Method:  OpCreate,
 		ID:      req.ID,
 		Backend: req.Backend,
 		Classes: classes,
+		BaseID:  req.BaseID,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -101,6 +114,7 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 			Classes: classes,
 			ID:      req.ID,
 			Backend: req.Backend,
+			BaseID:  req.BaseID,
 			Status:  &status,
 			Path:    st.Path,
 		}, nil
@@ -212,6 +226,8 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if len(req.Include) > 0 && len(req.Exclude) > 0 {
 		return nil, errIncludeExclude
 	}
+	req.Include = s.resolveAliases(req.Include)
+	req.Exclude = s.resolveAliases(req.Exclude)
 	if dup := findDuplicate(req.Include); dup != "" {
 		return nil, fmt.Errorf("class list 'include' contains duplicate: %s", dup)
 	}
@@ -239,9 +255,111 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if _, ok := err.(backup.ErrNotFound); !ok {
 		return nil, fmt.Errorf("check if backup %q exists at %q: %w", req.ID, destPath, err)
 	}
+	if req.BaseID != "" {
+		if err := s.validateBaseBackup(ctx, req); err != nil {
+			return nil, err
+		}
+	}
 	return classes, nil
 }
 
+// resolveAliases replaces aliases in classes by the classes they point to
+func (s *Scheduler) resolveAliases(classes []string) []string {
+	r, ok := s.backupper.selector.(aliasResolver)
+	if !ok || len(classes) == 0 {
+		return classes
+	}
+	resolved := make([]string, len(classes))
+	for i, c := range classes {
+		resolved[i] = r.ResolveAlias(c)
+	}
+	return resolved
+}
+
+// validateBaseBackup makes sure the base of an incremental backup has been
+// completed successfully
+func (s *Scheduler) validateBaseBackup(ctx context.Context, req *BackupRequest) error {
+	if err := validateID(req.BaseID); err != nil {
+		return fmt.Errorf("base backup: %w", err)
+	}
+	if req.BaseID == req.ID {
+		return fmt.Errorf("backup %q cannot be its own base", req.ID)
+	}
+	store, err := coordBackend(s.backends, req.Backend, req.BaseID)
+	if err != nil {
+		return err
+	}
+	meta, err := store.Meta(ctx, GlobalBackupFile)
+	if err != nil {
+		if _, ok := err.(backup.ErrNotFound); ok {
+			return fmt.Errorf("base backup %q not found at %q", req.BaseID, store.HomeDir())
+		}
+		return fmt.Errorf("find base backup %q: %w", req.BaseID, err)
+	}
+	if meta.Status != backup.Success {
+		return fmt.Errorf("invalid base backup %q status: %s", req.BaseID, meta.Status)
+	}
+	return nil
+}
+
+// addDependent records the incremental backup as a dependent of every backup
+// it may take files from, i.e. its base and the bases of its base. Those
+// backups are not deleted as long as the incremental backup exists.
+func (s *Scheduler) addDependent(ctx context.Context, req *BackupRequest) error {
+	seen := map[string]struct{}{req.ID: {}}
+	for id := req.BaseID; id != ""; {
+		if _, ok := seen[id]; ok {
+			break
+		}
+		seen[id] = struct{}{}
+		store, err := coordBackend(s.backends, req.Backend, id)
+		if err != nil {
+			return err
+		}
+		if err := store.AddDependent(ctx, req.ID); err != nil {
+			return fmt.Errorf("base backup %q: %w", id, err)
+		}
+		meta, err := store.Meta(ctx, GlobalBackupFile)
+		if err != nil {
+			return fmt.Errorf("base backup %q: %w", id, err)
+		}
+		id = meta.BaseID
+	}
+	return nil
+}
+
+// requiredBy returns the ID of an incremental backup which takes files from
+// the backup id and has not failed. It returns an empty string if no such
+// backup exists.
+func (s *Scheduler) requiredBy(ctx context.Context, backend, id string) (string, error) {
+	store, err := coordBackend(s.backends, backend, id)
+	if err != nil {
+		return "", err
+	}
+	dependents, err := store.Dependents(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get dependents of backup %q: %w", id, err)
+	}
+	for _, dep := range dependents {
+		depStore, err := coordBackend(s.backends, backend, dep)
+		if err != nil {
+			return "", err
+		}
+		meta, err := depStore.Meta(ctx, GlobalBackupFile)
+		if err != nil {
+			if _, ok := err.(backup.ErrNotFound); ok {
+				// the dependent backup has been deleted
+				continue
+			}
+			return "", fmt.Errorf("get dependent backup %q: %w", dep, err)
+		}
+		if meta.Status != backup.Failed {
+			return dep, nil
+		}
+	}
+	return "", nil
+}
+
 func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore, req *BackupRequest) (*backup.DistributedBackupDescriptor, error) {
 	if !store.b.IsExternal() && s.restorer.nodeResolver.NodeCount() > 1 {
 		return nil, errLocalBackendDBRO
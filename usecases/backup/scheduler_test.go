@@ -718,3 +718,24 @@ func TestFirstDuplicate(t *testing.T) {
 		}
 	}
 }
+
+type fakeAliasSelector struct {
+	fakeSelector
+	aliases map[string]string
+}
+
+func (s *fakeAliasSelector) ResolveAlias(name string) string {
+	if class, ok := s.aliases[name]; ok {
+		return class
+	}
+	return name
+}
+
+func TestSchedulerResolveAliases(t *testing.T) {
+	s := newFakeScheduler(nil).scheduler()
+	assert.Equal(t, []string{"Alias", "B"}, s.resolveAliases([]string{"Alias", "B"}))
+
+	s.backupper.selector = &fakeAliasSelector{aliases: map[string]string{"Alias": "A"}}
+	assert.Equal(t, []string{"A", "B"}, s.resolveAliases([]string{"Alias", "B"}))
+	assert.Nil(t, s.resolveAliases(nil))
+}
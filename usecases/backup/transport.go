@@ -40,6 +40,9 @@ type Request struct {
 	// Classes is list of class which need to be backed up
 	Classes []string
 
+	// BaseID is the ID of the backup an incremental backup is based on
+	BaseID string
+
 	// Duration
 	Duration time.Duration
 }
@@ -390,7 +390,8 @@ func (f *fakeRemoteClient) MergeObject(ctx context.Context, hostName, indexName,
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
 	shardName string, vector []float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
-	cursor *filters.Cursor, groupBy *searchparams.GroupBy, additional additional.Properties,
+	cursor *filters.Cursor, groupBy *searchparams.GroupBy, recommend *searchparams.Recommend,
+	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
 }
@@ -423,6 +424,24 @@ func (f *fakeRemoteClient) FindDocIDs(ctx context.Context, hostName, indexName,
 	return nil, nil
 }
 
+func (f *fakeRemoteClient) Facets(ctx context.Context, hostName, indexName, shardName string,
+	filters *filters.LocalFilter, ids []strfmt.UUID, params searchparams.Facets,
+) ([]search.Facet, error) {
+	return nil, nil
+}
+
+func (f *fakeRemoteClient) FindDuplicates(ctx context.Context, hostName, indexName, shardName string,
+	maxDistance float32,
+) ([]search.DuplicatePair, error) {
+	return nil, nil
+}
+
+func (f *fakeRemoteClient) SearchDuplicates(ctx context.Context, hostName, indexName, shardName string,
+	vectors [][]float32, maxDistance float32,
+) ([][]search.Duplicate, error) {
+	return nil, nil
+}
+
 func (f *fakeRemoteClient) DeleteObjectBatch(ctx context.Context, hostName, indexName, shardName string,
 	docIDs []uint64, dryRun bool,
 ) objects.BatchSimpleObjects {
@@ -535,3 +554,9 @@ func (c *fakeReplicationClient) OverwriteObjects(ctx context.Context,
 ) ([]replica.RepairResponse, error) {
 	return nil, nil
 }
+
+func (c *fakeReplicationClient) HashTreeLevel(ctx context.Context,
+	host, index, shard string, level int, nodes []int,
+) ([]uint64, error) {
+	return nil, nil
+}
@@ -111,6 +111,7 @@ func (d *spaceMsg) unmarshal(data []byte) (err error) {
 type delegate struct {
 	Name      string
 	dataPath  string
+	raftPort  int // advertised in the node meta if set
 	diskUsage func(path string) (DiskUsage, error)
 	log       logrus.FieldLogger
 	sync.Mutex
@@ -134,7 +135,20 @@ func (d *delegate) init() error {
 // when broadcasting an alive message. It's length is limited to
 // the given byte size. This metadata is available in the Node structure.
 func (d *delegate) NodeMeta(limit int) (meta []byte) {
-	return nil
+	if d.raftPort == 0 || limit < 2 {
+		return nil
+	}
+	meta = make([]byte, 2)
+	binary.BigEndian.PutUint16(meta, uint16(d.raftPort))
+	return meta
+}
+
+// raftPortFromMeta returns the raft port a member advertised in its node meta
+func raftPortFromMeta(meta []byte) (int, bool) {
+	if len(meta) < 2 {
+		return 0, false
+	}
+	return int(binary.BigEndian.Uint16(meta)), true
 }
 
 // LocalState is used for a TCP Push/Pull. This is sent to
@@ -265,3 +265,15 @@ func TestDelegateLocalState(t *testing.T) {
 	assert.Greater(t, got.LastTimeMilli, now)
 	assert.Equal(t, DiskUsage{6, 2}, got.DiskUsage)
 }
+
+func TestDelegateNodeMeta(t *testing.T) {
+	d := delegate{Name: "N0"}
+	assert.Nil(t, d.NodeMeta(512), "no raft port to advertise")
+	_, ok := raftPortFromMeta(nil)
+	assert.False(t, ok)
+
+	d.raftPort = 8300
+	port, ok := raftPortFromMeta(d.NodeMeta(512))
+	assert.True(t, ok)
+	assert.Equal(t, 8300, port)
+}
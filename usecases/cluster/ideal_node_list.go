@@ -19,6 +19,12 @@ import (
 	"time"
 )
 
+// removedLister is implemented by member lists which know about nodes that
+// have been removed from the cluster on purpose, see State.RemoveNode
+type removedLister interface {
+	Removed(node string) bool
+}
+
 type IdealClusterState struct {
 	memberNames  []string
 	currentState MemberLister
@@ -42,11 +48,18 @@ func (ics *IdealClusterState) Validate() error {
 		actual[name] = struct{}{}
 	}
 
+	removed, _ := ics.currentState.(removedLister)
+
 	var missing []string
 	for _, name := range ics.memberNames {
-		if _, ok := actual[name]; !ok {
-			missing = append(missing, name)
+		if _, ok := actual[name]; ok {
+			continue
+		}
+		if removed != nil && removed.Removed(name) {
+			// removed nodes are not expected to come back
+			continue
 		}
+		missing = append(missing, name)
 	}
 
 	if len(missing) > 0 {
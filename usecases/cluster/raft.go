@@ -0,0 +1,470 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// raftApplyTimeout bounds the time a command may take to be committed if
+	// the caller's context has no deadline
+	raftApplyTimeout = 30 * time.Second
+
+	// raftJoinInterval is the interval at which a new node tries to join an
+	// existing raft cluster or to bootstrap a new one
+	raftJoinInterval = time.Second
+
+	// raftSnapshotsRetained is the number of snapshots kept on disk
+	raftSnapshotsRetained = 3
+)
+
+var (
+	ErrRaftNotReady = errors.New("raft is not ready")
+	ErrNoRaftLeader = errors.New("raft cluster has no leader")
+)
+
+// RaftFSM is the state machine replicated by raft. Commands are applied in
+// the same order on every node, implementations must therefore be
+// deterministic.
+type RaftFSM interface {
+	// ApplyCommand applies the command committed at index. An error means
+	// that the command had no effect, it is returned to the submitter.
+	ApplyCommand(index uint64, cmd []byte) error
+
+	// Snapshot returns the serialized state of the state machine
+	Snapshot() ([]byte, error)
+
+	// Restore replaces the state of the state machine by a snapshot
+	Restore(snapshot []byte) error
+}
+
+type raftClient interface {
+	// Apply submits a command to the leader at host and returns the index at
+	// which the command has been committed
+	Apply(ctx context.Context, host string, cmd []byte) (uint64, error)
+
+	// ReadIndex returns an index of the leader at host. Once a node applied
+	// this index its state reflects all writes acknowledged before the call.
+	ReadIndex(ctx context.Context, host string) (uint64, error)
+
+	// Join asks the node at host to add a node to the raft cluster
+	Join(ctx context.Context, host, id, addr string) error
+}
+
+type raftMembers interface {
+	LocalName() string
+	AllNames() []string
+	NodeHostname(nodeName string) (string, bool)
+	raftAddress(nodeName string) (string, bool)
+}
+
+// Raft is an embedded raft node. It orders commands, like schema changes,
+// through a log replicated to all nodes of the cluster. Commands can be
+// submitted on any node, followers forward them to the leader.
+//
+// Nodes find each other through memberlist. A node without raft state joins
+// the existing raft cluster, or bootstraps a new one if it is the first of
+// bootstrapExpect nodes.
+type Raft struct {
+	members         raftMembers
+	client          raftClient
+	dataPath        string
+	bootstrapExpect int
+	logger          logrus.FieldLogger
+
+	sync.RWMutex // protects fields below
+	raft         *raft.Raft
+	store        *raftStore
+	transport    *raft.NetworkTransport
+	done         chan struct{}
+}
+
+func NewRaft(state *State, client raftClient, dataPath string,
+	logger logrus.FieldLogger,
+) *Raft {
+	return newRaft(state, client, dataPath, state.config.RaftBootstrapExpect, logger)
+}
+
+func newRaft(members raftMembers, client raftClient, dataPath string,
+	bootstrapExpect int, logger logrus.FieldLogger,
+) *Raft {
+	if bootstrapExpect < 1 {
+		bootstrapExpect = 1
+	}
+	return &Raft{
+		members:         members,
+		client:          client,
+		dataPath:        path.Join(dataPath, "raft"),
+		bootstrapExpect: bootstrapExpect,
+		logger:          logger.WithField("action", "raft"),
+	}
+}
+
+// Open starts the raft node. Committed commands are applied to fsm, starting
+// with the ones which have not been part of the last snapshot.
+func (r *Raft) Open(fsm RaftFSM) error {
+	r.Lock()
+	defer r.Unlock()
+
+	name := r.members.LocalName()
+	addr, ok := r.members.raftAddress(name)
+	if !ok {
+		return errors.Errorf("resolve raft address of local node %q", name)
+	}
+	advertise, err := net.ResolveTCPAddr("tcp", addr)
+	if err != nil {
+		return errors.Wrapf(err, "resolve raft address %q", addr)
+	}
+
+	logOutput := newLogParser(r.logger)
+	store, err := newRaftStore(r.dataPath)
+	if err != nil {
+		return err
+	}
+	snapshots, err := raft.NewFileSnapshotStore(r.dataPath, raftSnapshotsRetained, logOutput)
+	if err != nil {
+		store.Close()
+		return errors.Wrap(err, "create snapshot store")
+	}
+	transport, err := raft.NewTCPTransport(fmt.Sprintf(":%d", advertise.Port),
+		advertise, 3, 10*time.Second, logOutput)
+	if err != nil {
+		store.Close()
+		return errors.Wrap(err, "create raft transport")
+	}
+
+	existing, err := raft.HasExistingState(store, store, snapshots)
+	if err != nil {
+		transport.Close()
+		store.Close()
+		return errors.Wrap(err, "check existing raft state")
+	}
+
+	conf := raft.DefaultConfig()
+	conf.LocalID = raft.ServerID(name)
+	conf.LogOutput = logOutput
+	conf.LogLevel = "INFO"
+	r.raft, err = raft.NewRaft(conf, &raftFSM{fsm}, store, store, snapshots, transport)
+	if err != nil {
+		transport.Close()
+		store.Close()
+		return errors.Wrap(err, "start raft")
+	}
+	r.store, r.transport = store, transport
+	r.done = make(chan struct{})
+
+	if !existing {
+		go r.join(r.done, name, addr)
+	}
+	return nil
+}
+
+// Shutdown stops the raft node
+func (r *Raft) Shutdown() error {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.raft == nil {
+		return nil
+	}
+	close(r.done)
+	if err := r.raft.Shutdown().Error(); err != nil {
+		return errors.Wrap(err, "shutdown raft")
+	}
+	r.transport.Close()
+	r.raft = nil
+	return r.store.Close()
+}
+
+// Execute submits cmd and returns once it has been applied to the local
+// state machine. Followers forward the command to the leader.
+func (r *Raft) Execute(ctx context.Context, cmd []byte) error {
+	node, err := r.node()
+	if err != nil {
+		return err
+	}
+	if node.State() == raft.Leader {
+		_, err := r.apply(ctx, node, cmd)
+		return err
+	}
+
+	host, err := r.leaderHost(node)
+	if err != nil {
+		return err
+	}
+	index, err := r.client.Apply(ctx, host, cmd)
+	if err != nil {
+		return err
+	}
+	return r.waitApplied(ctx, node, index)
+}
+
+// Apply commits cmd if this node is the leader. It returns the index of the
+// command, once it has been applied to the state machine of the leader.
+func (r *Raft) Apply(ctx context.Context, cmd []byte) (uint64, error) {
+	node, err := r.node()
+	if err != nil {
+		return 0, err
+	}
+	return r.apply(ctx, node, cmd)
+}
+
+func (r *Raft) apply(ctx context.Context, node *raft.Raft, cmd []byte) (uint64, error) {
+	f := node.Apply(cmd, timeout(ctx))
+	if err := f.Error(); err != nil {
+		return 0, err
+	}
+	if err, ok := f.Response().(error); ok && err != nil {
+		return f.Index(), err
+	}
+	return f.Index(), nil
+}
+
+// Barrier returns once the local state machine reflects all commands
+// committed before the call. Reads following a barrier are linearizable.
+func (r *Raft) Barrier(ctx context.Context) error {
+	node, err := r.node()
+	if err != nil {
+		return err
+	}
+	if node.State() == raft.Leader {
+		_, err := r.readIndex(ctx, node)
+		return err
+	}
+
+	host, err := r.leaderHost(node)
+	if err != nil {
+		return err
+	}
+	index, err := r.client.ReadIndex(ctx, host)
+	if err != nil {
+		return err
+	}
+	return r.waitApplied(ctx, node, index)
+}
+
+// ReadIndex confirms that this node is still the leader and returns its
+// applied index
+func (r *Raft) ReadIndex(ctx context.Context) (uint64, error) {
+	node, err := r.node()
+	if err != nil {
+		return 0, err
+	}
+	return r.readIndex(ctx, node)
+}
+
+func (r *Raft) readIndex(ctx context.Context, node *raft.Raft) (uint64, error) {
+	if err := node.VerifyLeader().Error(); err != nil {
+		return 0, err
+	}
+	if err := node.Barrier(timeout(ctx)).Error(); err != nil {
+		return 0, err
+	}
+	return node.AppliedIndex(), nil
+}
+
+// Join adds a node to the raft cluster. Followers forward the request to the
+// leader.
+func (r *Raft) Join(ctx context.Context, id, addr string) error {
+	node, err := r.node()
+	if err != nil {
+		return err
+	}
+	if node.State() != raft.Leader {
+		host, err := r.leaderHost(node)
+		if err != nil {
+			return err
+		}
+		return r.client.Join(ctx, host, id, addr)
+	}
+
+	f := node.GetConfiguration()
+	if err := f.Error(); err != nil {
+		return errors.Wrap(err, "get raft configuration")
+	}
+	for _, srv := range f.Configuration().Servers {
+		if srv.ID == raft.ServerID(id) && srv.Address == raft.ServerAddress(addr) {
+			return nil
+		}
+	}
+	r.logger.WithField("node", id).WithField("address", addr).Info("add voter")
+	return node.AddVoter(raft.ServerID(id), raft.ServerAddress(addr), 0, timeout(ctx)).Error()
+}
+
+// Leader returns the name of the current leader or an empty string if there
+// is none
+func (r *Raft) Leader() string {
+	node, err := r.node()
+	if err != nil {
+		return ""
+	}
+	_, id := node.LeaderWithID()
+	return string(id)
+}
+
+func (r *Raft) node() (*raft.Raft, error) {
+	r.RLock()
+	defer r.RUnlock()
+	if r.raft == nil {
+		return nil, ErrRaftNotReady
+	}
+	return r.raft, nil
+}
+
+func (r *Raft) leaderHost(node *raft.Raft) (string, error) {
+	_, id := node.LeaderWithID()
+	if id == "" {
+		return "", ErrNoRaftLeader
+	}
+	host, ok := r.members.NodeHostname(string(id))
+	if !ok {
+		return "", errors.Errorf("resolve hostname of leader %q", id)
+	}
+	return host, nil
+}
+
+func (r *Raft) waitApplied(ctx context.Context, node *raft.Raft, index uint64) error {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for node.AppliedIndex() < index {
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "wait for index %d to be applied", index)
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// join makes a node without raft state part of a raft cluster. It asks the
+// other members to add it to their cluster. If there is none and this node is
+// the first of at least bootstrapExpect members, a new cluster is
+// bootstrapped with all members.
+func (r *Raft) join(done <-chan struct{}, name, addr string) {
+	ticker := time.NewTicker(raftJoinInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		if r.Leader() != "" {
+			return
+		}
+
+		names := r.members.AllNames()
+		sort.Strings(names)
+		for _, member := range names {
+			if member == name {
+				continue
+			}
+			host, ok := r.members.NodeHostname(member)
+			if !ok {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), raftJoinInterval)
+			err := r.client.Join(ctx, host, name, addr)
+			cancel()
+			if err == nil {
+				r.logger.WithField("node", member).Info("joined raft cluster")
+				return
+			}
+		}
+
+		if len(names) < r.bootstrapExpect || names[0] != name {
+			continue
+		}
+		servers := make([]raft.Server, 0, len(names))
+		for _, member := range names {
+			if memberAddr, ok := r.members.raftAddress(member); ok {
+				servers = append(servers, raft.Server{
+					ID:      raft.ServerID(member),
+					Address: raft.ServerAddress(memberAddr),
+				})
+			}
+		}
+		node, err := r.node()
+		if err != nil {
+			return
+		}
+		err = node.BootstrapCluster(raft.Configuration{Servers: servers}).Error()
+		if err != nil && !errors.Is(err, raft.ErrCantBootstrap) {
+			r.logger.WithError(err).Error("bootstrap raft cluster")
+			continue
+		}
+		r.logger.WithField("servers", len(servers)).Info("bootstrapped raft cluster")
+		return
+	}
+}
+
+func timeout(ctx context.Context) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
+	}
+	return raftApplyTimeout
+}
+
+// raftFSM adapts a RaftFSM to the interface expected by raft
+type raftFSM struct {
+	fsm RaftFSM
+}
+
+func (f *raftFSM) Apply(l *raft.Log) interface{} {
+	if l.Type != raft.LogCommand {
+		return nil
+	}
+	return f.fsm.ApplyCommand(l.Index, l.Data)
+}
+
+func (f *raftFSM) Snapshot() (raft.FSMSnapshot, error) {
+	data, err := f.fsm.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &raftSnapshot{data}, nil
+}
+
+func (f *raftFSM) Restore(snapshot io.ReadCloser) error {
+	defer snapshot.Close()
+	data, err := io.ReadAll(snapshot)
+	if err != nil {
+		return errors.Wrap(err, "read snapshot")
+	}
+	return f.fsm.Restore(data)
+}
+
+type raftSnapshot struct {
+	data []byte
+}
+
+func (s *raftSnapshot) Persist(sink raft.SnapshotSink) error {
+	if _, err := sink.Write(s.data); err != nil {
+		sink.Cancel()
+		return err
+	}
+	return sink.Close()
+}
+
+func (s *raftSnapshot) Release() {}
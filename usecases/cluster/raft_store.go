@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	raftLogsBucket   = []byte("logs")
+	raftStableBucket = []byte("stable")
+)
+
+// raftStore persists the raft log and the raft metadata (current term, last
+// vote) in a bolt database. It implements both raft.LogStore and
+// raft.StableStore.
+type raftStore struct {
+	db *bolt.DB
+}
+
+func newRaftStore(dir string) (*raftStore, error) {
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return nil, errors.Wrapf(err, "create raft directory at %s", dir)
+	}
+
+	dbPath := path.Join(dir, "raft.db")
+	db, err := bolt.Open(dbPath, 0o600, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open bolt at %s", dbPath)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{raftLogsBucket, raftStableBucket} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return errors.Wrapf(err, "create bucket %q", string(b))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "create bolt buckets")
+	}
+
+	return &raftStore{db: db}, nil
+}
+
+func (s *raftStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *raftStore) FirstIndex() (uint64, error) {
+	var idx uint64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if k, _ := tx.Bucket(raftLogsBucket).Cursor().First(); k != nil {
+			idx = binary.BigEndian.Uint64(k)
+		}
+		return nil
+	})
+	return idx, err
+}
+
+func (s *raftStore) LastIndex() (uint64, error) {
+	var idx uint64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if k, _ := tx.Bucket(raftLogsBucket).Cursor().Last(); k != nil {
+			idx = binary.BigEndian.Uint64(k)
+		}
+		return nil
+	})
+	return idx, err
+}
+
+func (s *raftStore) GetLog(index uint64, log *raft.Log) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(raftLogsBucket).Get(uint64Key(index))
+		if v == nil {
+			return raft.ErrLogNotFound
+		}
+		return json.Unmarshal(v, log)
+	})
+}
+
+func (s *raftStore) StoreLog(log *raft.Log) error {
+	return s.StoreLogs([]*raft.Log{log})
+}
+
+func (s *raftStore) StoreLogs(logs []*raft.Log) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(raftLogsBucket)
+		for _, log := range logs {
+			v, err := json.Marshal(log)
+			if err != nil {
+				return errors.Wrapf(err, "marshal log %d", log.Index)
+			}
+			if err := b.Put(uint64Key(log.Index), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *raftStore) DeleteRange(min, max uint64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		c := tx.Bucket(raftLogsBucket).Cursor()
+		for k, _ := c.Seek(uint64Key(min)); k != nil; k, _ = c.Next() {
+			if binary.BigEndian.Uint64(k) > max {
+				break
+			}
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *raftStore) Set(key []byte, val []byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(raftStableBucket).Put(key, val)
+	})
+}
+
+func (s *raftStore) Get(key []byte) ([]byte, error) {
+	var val []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket(raftStableBucket).Get(key); v != nil {
+			val = append([]byte{}, v...)
+		}
+		return nil
+	})
+	return val, err
+}
+
+func (s *raftStore) SetUint64(key []byte, val uint64) error {
+	return s.Set(key, uint64Key(val))
+}
+
+func (s *raftStore) GetUint64(key []byte) (uint64, error) {
+	val, err := s.Get(key)
+	if err != nil || len(val) == 0 {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(val), nil
+}
+
+func uint64Key(v uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, v)
+	return buf
+}
+
+var (
+	_ = raft.LogStore(&raftStore{})
+	_ = raft.StableStore(&raftStore{})
+)
@@ -0,0 +1,274 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaft(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	c := newFakeRaftCluster(t, "node1", "node2", "node3")
+	defer c.shutdown()
+
+	for _, name := range []string{"node1", "node2", "node3"} {
+		c.open(t, name, 3)
+	}
+	leader := c.waitForLeader(t)
+	var follower string
+	for name := range c.nodes {
+		if name != leader {
+			follower = name
+			break
+		}
+	}
+
+	t.Run("execute on leader", func(t *testing.T) {
+		require.Nil(t, c.nodes[leader].Execute(ctx, []byte("cmd1")))
+		assert.Equal(t, []string{"cmd1"}, c.fsms[leader].commands())
+	})
+
+	t.Run("execute on follower", func(t *testing.T) {
+		require.Nil(t, c.nodes[follower].Execute(ctx, []byte("cmd2")))
+		assert.Equal(t, []string{"cmd1", "cmd2"}, c.fsms[follower].commands())
+	})
+
+	t.Run("error of the state machine", func(t *testing.T) {
+		err := c.nodes[follower].Execute(ctx, []byte("fail"))
+		assert.EqualError(t, err, "command failed")
+	})
+
+	t.Run("barrier", func(t *testing.T) {
+		for name, node := range c.nodes {
+			require.Nil(t, node.Barrier(ctx))
+			assert.Equal(t, []string{"cmd1", "cmd2"}, c.fsms[name].commands())
+		}
+	})
+
+	t.Run("late node receives the log", func(t *testing.T) {
+		c.add(t, "node4")
+		c.open(t, "node4", 3)
+		assert.Eventually(t, func() bool {
+			return len(c.fsms["node4"].commands()) == 2
+		}, 10*time.Second, 50*time.Millisecond)
+
+		require.Nil(t, c.nodes["node4"].Execute(ctx, []byte("cmd3")))
+		assert.Equal(t, []string{"cmd1", "cmd2", "cmd3"}, c.fsms["node4"].commands())
+	})
+}
+
+func TestRaftSnapshot(t *testing.T) {
+	ctx := context.Background()
+	c := newFakeRaftCluster(t, "node1")
+	c.open(t, "node1", 1)
+	c.waitForLeader(t)
+
+	require.Nil(t, c.nodes["node1"].Execute(ctx, []byte("cmd1")))
+	require.Nil(t, c.nodes["node1"].raft.Snapshot().Error())
+	require.Nil(t, c.nodes["node1"].Execute(ctx, []byte("cmd2")))
+	c.shutdown()
+
+	// restart with existing state
+	c.fsms["node1"] = &fakeRaftFSM{}
+	c.open(t, "node1", 1)
+	defer c.shutdown()
+	c.waitForLeader(t)
+	require.Nil(t, c.nodes["node1"].Barrier(ctx))
+	assert.Equal(t, []string{"cmd1", "cmd2"}, c.fsms["node1"].commands())
+	assert.Equal(t, 1, c.fsms["node1"].restores)
+}
+
+type fakeRaftCluster struct {
+	sync.Mutex
+	dir   string
+	ports map[string]int
+	nodes map[string]*Raft
+	fsms  map[string]*fakeRaftFSM
+}
+
+func newFakeRaftCluster(t *testing.T, names ...string) *fakeRaftCluster {
+	c := &fakeRaftCluster{
+		dir:   t.TempDir(),
+		ports: map[string]int{},
+		nodes: map[string]*Raft{},
+		fsms:  map[string]*fakeRaftFSM{},
+	}
+	for _, name := range names {
+		c.add(t, name)
+	}
+	return c
+}
+
+func (c *fakeRaftCluster) add(t *testing.T, name string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.Nil(t, l.Close())
+
+	c.Lock()
+	defer c.Unlock()
+	c.ports[name] = port
+	c.fsms[name] = &fakeRaftFSM{}
+}
+
+func (c *fakeRaftCluster) open(t *testing.T, name string, bootstrapExpect int) {
+	logger, _ := test.NewNullLogger()
+	members := &fakeRaftMembers{cluster: c, local: name}
+	node := newRaft(members, &fakeRaftClient{c}, fmt.Sprintf("%s/%s", c.dir, name),
+		bootstrapExpect, logger)
+	require.Nil(t, node.Open(c.fsms[name]))
+
+	c.Lock()
+	defer c.Unlock()
+	c.nodes[name] = node
+}
+
+func (c *fakeRaftCluster) node(name string) (*Raft, bool) {
+	c.Lock()
+	defer c.Unlock()
+	node, ok := c.nodes[name]
+	return node, ok
+}
+
+func (c *fakeRaftCluster) waitForLeader(t *testing.T) string {
+	var leader string
+	require.Eventually(t, func() bool {
+		c.Lock()
+		defer c.Unlock()
+		for _, node := range c.nodes {
+			if leader = node.Leader(); leader != "" {
+				return true
+			}
+		}
+		return false
+	}, 10*time.Second, 50*time.Millisecond)
+	return leader
+}
+
+func (c *fakeRaftCluster) shutdown() {
+	c.Lock()
+	defer c.Unlock()
+	for name, node := range c.nodes {
+		node.Shutdown()
+		delete(c.nodes, name)
+	}
+}
+
+type fakeRaftMembers struct {
+	cluster *fakeRaftCluster
+	local   string
+}
+
+func (m *fakeRaftMembers) LocalName() string { return m.local }
+
+func (m *fakeRaftMembers) AllNames() []string {
+	m.cluster.Lock()
+	defer m.cluster.Unlock()
+	names := make([]string, 0, len(m.cluster.ports))
+	for name := range m.cluster.ports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NodeHostname uses node names as hostnames, see fakeRaftClient
+func (m *fakeRaftMembers) NodeHostname(nodeName string) (string, bool) {
+	m.cluster.Lock()
+	defer m.cluster.Unlock()
+	_, ok := m.cluster.ports[nodeName]
+	return nodeName, ok
+}
+
+func (m *fakeRaftMembers) raftAddress(nodeName string) (string, bool) {
+	m.cluster.Lock()
+	defer m.cluster.Unlock()
+	port, ok := m.cluster.ports[nodeName]
+	return fmt.Sprintf("127.0.0.1:%d", port), ok
+}
+
+// fakeRaftClient calls the Raft instance with the name host directly
+type fakeRaftClient struct {
+	cluster *fakeRaftCluster
+}
+
+func (f *fakeRaftClient) Apply(ctx context.Context, host string, cmd []byte) (uint64, error) {
+	node, ok := f.cluster.node(host)
+	if !ok {
+		return 0, fmt.Errorf("unknown host %q", host)
+	}
+	return node.Apply(ctx, cmd)
+}
+
+func (f *fakeRaftClient) ReadIndex(ctx context.Context, host string) (uint64, error) {
+	node, ok := f.cluster.node(host)
+	if !ok {
+		return 0, fmt.Errorf("unknown host %q", host)
+	}
+	return node.ReadIndex(ctx)
+}
+
+func (f *fakeRaftClient) Join(ctx context.Context, host, id, addr string) error {
+	node, ok := f.cluster.node(host)
+	if !ok {
+		return fmt.Errorf("unknown host %q", host)
+	}
+	return node.Join(ctx, id, addr)
+}
+
+type fakeRaftFSM struct {
+	sync.Mutex
+	cmds     []string
+	restores int
+}
+
+func (f *fakeRaftFSM) ApplyCommand(index uint64, cmd []byte) error {
+	if string(cmd) == "fail" {
+		return errors.New("command failed")
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.cmds = append(f.cmds, string(cmd))
+	return nil
+}
+
+func (f *fakeRaftFSM) Snapshot() ([]byte, error) {
+	f.Lock()
+	defer f.Unlock()
+	return json.Marshal(f.cmds)
+}
+
+func (f *fakeRaftFSM) Restore(snapshot []byte) error {
+	f.Lock()
+	defer f.Unlock()
+	f.restores++
+	return json.Unmarshal(snapshot, &f.cmds)
+}
+
+func (f *fakeRaftFSM) commands() []string {
+	f.Lock()
+	defer f.Unlock()
+	return append([]string{}, f.cmds...)
+}
@@ -12,9 +12,15 @@
 package cluster
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/memberlist"
 	"github.com/pkg/errors"
@@ -25,6 +31,23 @@ type State struct {
 	config   Config
 	list     *memberlist.Memberlist
 	delegate delegate
+
+	// draining nodes are being decommissioned and don't receive new shards,
+	// removed nodes have been decommissioned and are no longer expected to be
+	// members. Both are persisted in nodesPath to survive restarts.
+	nodesLock sync.RWMutex
+	nodesPath string
+	draining  map[string]bool
+	removed   map[string]bool
+}
+
+// nodesFile is the file in the data path holding the draining and removed
+// nodes
+const nodesFile = "cluster_nodes.json"
+
+type persistedNodes struct {
+	Draining []string `json:"draining"`
+	Removed  []string `json:"removed"`
 }
 
 type Config struct {
@@ -33,6 +56,13 @@ type Config struct {
 	DataBindPort            int    `json:"dataBindPort" yaml:"dataBindPort"`
 	Join                    string `json:"join" yaml:"join"`
 	IgnoreStartupSchemaSync bool   `json:"ignoreStartupSchemaSync" yaml:"ignoreStartupSchemaSync"`
+
+	// RaftEnabled replaces schema transactions by a replicated raft log.
+	// The raft port is advertised to the other members and defaults to two
+	// higher than the gossip port.
+	RaftEnabled         bool `json:"raftEnabled" yaml:"raftEnabled"`
+	RaftBindPort        int  `json:"raftBindPort" yaml:"raftBindPort"`
+	RaftBootstrapExpect int  `json:"raftBootstrapExpect" yaml:"raftBootstrapExpect"`
 }
 
 func Init(userConfig Config, dataPath string, logger logrus.FieldLogger) (_ *State, err error) {
@@ -52,11 +82,23 @@ func Init(userConfig Config, dataPath string, logger logrus.FieldLogger) (_ *Sta
 	if err := state.delegate.init(); err != nil {
 		logger.WithField("action", "init_state.delete_init").Error(err)
 	}
+	if dataPath != "" {
+		state.nodesPath = filepath.Join(dataPath, nodesFile)
+		if err := state.loadNodes(); err != nil {
+			return nil, errors.Wrap(err, "load draining and removed nodes")
+		}
+	}
 	cfg.Delegate = &state.delegate
 	cfg.Events = events{&state.delegate}
 	if userConfig.GossipBindPort != 0 {
 		cfg.BindPort = userConfig.GossipBindPort
 	}
+	if userConfig.RaftEnabled {
+		state.delegate.raftPort = userConfig.RaftBindPort
+		if state.delegate.raftPort == 0 {
+			state.delegate.raftPort = cfg.BindPort + 2
+		}
+	}
 
 	if state.list, err = memberlist.Create(cfg); err != nil {
 		logger.WithField("action", "memberlist_init").
@@ -142,9 +184,133 @@ func (s *State) AllNames() []string {
 }
 
 // Candidates returns list of nodes (names) sorted by the
-// free amount of disk space in descending order
+// free amount of disk space in descending order. Draining nodes are excluded.
 func (s *State) Candidates() []string {
-	return s.delegate.sortCandidates(s.AllNames())
+	names := s.AllNames()
+	s.nodesLock.RLock()
+	i := 0
+	for _, name := range names {
+		if !s.draining[name] {
+			names[i] = name
+			i++
+		}
+	}
+	s.nodesLock.RUnlock()
+	return s.delegate.sortCandidates(names[:i])
+}
+
+// SetDraining marks a node as being decommissioned. A draining node is no
+// longer a candidate for new shards.
+func (s *State) SetDraining(node string, draining bool) error {
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+	if s.draining == nil {
+		s.draining = make(map[string]bool)
+	}
+	if draining {
+		s.draining[node] = true
+	} else {
+		delete(s.draining, node)
+	}
+	return s.saveNodes()
+}
+
+// Draining returns true if the node is being decommissioned
+func (s *State) Draining(node string) bool {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+	return s.draining[node]
+}
+
+// RemoveNode removes a decommissioned node from the cluster. Transactions no
+// longer wait for it, even if it is dead and never leaves the memberlist
+// gracefully.
+func (s *State) RemoveNode(node string) error {
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+	if s.removed == nil {
+		s.removed = make(map[string]bool)
+	}
+	delete(s.draining, node)
+	s.removed[node] = true
+	return s.saveNodes()
+}
+
+// Removed returns true if the node has been removed from the cluster
+func (s *State) Removed(node string) bool {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+	return s.removed[node]
+}
+
+func (s *State) loadNodes() error {
+	data, err := os.ReadFile(s.nodesPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	var pn persistedNodes
+	if err := json.Unmarshal(data, &pn); err != nil {
+		return errors.Wrapf(err, "unmarshal %s", s.nodesPath)
+	}
+
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+	s.draining = make(map[string]bool, len(pn.Draining))
+	for _, node := range pn.Draining {
+		s.draining[node] = true
+	}
+	s.removed = make(map[string]bool, len(pn.Removed))
+	for _, node := range pn.Removed {
+		s.removed[node] = true
+	}
+	return nil
+}
+
+// saveNodes persists the draining and removed nodes. The caller must hold
+// nodesLock.
+func (s *State) saveNodes() error {
+	if s.nodesPath == "" {
+		return nil
+	}
+
+	pn := persistedNodes{Draining: []string{}, Removed: []string{}}
+	for node := range s.draining {
+		pn.Draining = append(pn.Draining, node)
+	}
+	for node := range s.removed {
+		pn.Removed = append(pn.Removed, node)
+	}
+	sort.Strings(pn.Draining)
+	sort.Strings(pn.Removed)
+
+	data, err := json.Marshal(pn)
+	if err != nil {
+		return errors.Wrap(err, "marshal draining and removed nodes")
+	}
+
+	// write to a temporary file first, so that a crash can't leave a
+	// truncated file behind
+	tmp := s.nodesPath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o666); err != nil {
+		return errors.Wrap(err, "write draining and removed nodes")
+	}
+	if err := os.Rename(tmp, s.nodesPath); err != nil {
+		return errors.Wrap(err, "write draining and removed nodes")
+	}
+	return nil
+}
+
+// Leave gracefully leaves the memberlist, so that the other members remove
+// the local node from their lists.
+func (s *State) Leave(timeout time.Duration) error {
+	if err := s.list.Leave(timeout); err != nil {
+		return errors.Wrap(err, "leave memberlist")
+	}
+	return s.list.Shutdown()
 }
 
 // All node names (not their hostnames!) for live members, including self.
@@ -172,6 +338,23 @@ func (s *State) NodeHostname(nodeName string) (string, bool) {
 	return "", false
 }
 
+// raftAddress returns the address of the raft transport of a live member
+func (s *State) raftAddress(nodeName string) (string, bool) {
+	for _, mem := range s.list.Members() {
+		if mem.Name == nodeName {
+			port, ok := raftPortFromMeta(mem.Meta)
+			if !ok {
+				// members which don't advertise their raft port use the
+				// default, see Config
+				port = int(mem.Port) + 2
+			}
+			return fmt.Sprintf("%s:%d", mem.Addr.String(), port), true
+		}
+	}
+
+	return "", false
+}
+
 func (s *State) SchemaSyncIgnored() bool {
 	return s.config.IgnoreStartupSchemaSync
 }
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatePersistsDrainingAndRemovedNodes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), nodesFile)
+
+	st := &State{nodesPath: path}
+	require.Nil(t, st.SetDraining("N1", true))
+	require.Nil(t, st.SetDraining("N2", true))
+	require.Nil(t, st.RemoveNode("N2"))
+
+	restarted := &State{nodesPath: path}
+	require.Nil(t, restarted.loadNodes())
+	assert.True(t, restarted.Draining("N1"))
+	assert.False(t, restarted.Draining("N2"))
+	assert.True(t, restarted.Removed("N2"))
+	assert.False(t, restarted.Removed("N1"))
+
+	require.Nil(t, restarted.SetDraining("N1", false))
+	restarted = &State{nodesPath: path}
+	require.Nil(t, restarted.loadNodes())
+	assert.False(t, restarted.Draining("N1"))
+}
+
+func TestIdealClusterStateIgnoresRemovedNodes(t *testing.T) {
+	members := &fakeRemovedLister{
+		names:   []string{"N1", "N2"},
+		removed: map[string]bool{},
+	}
+	ics := &IdealClusterState{currentState: members}
+	ics.extendList([]string{"N1", "N2", "N3"})
+
+	assert.NotNil(t, ics.Validate(), "N3 is missing")
+
+	members.removed["N3"] = true
+	assert.Nil(t, ics.Validate())
+}
+
+type fakeRemovedLister struct {
+	names   []string
+	removed map[string]bool
+}
+
+func (f *fakeRemovedLister) AllNames() []string {
+	return f.names
+}
+
+func (f *fakeRemovedLister) Hostnames() []string {
+	return nil
+}
+
+func (f *fakeRemovedLister) Removed(node string) bool {
+	return f.removed[node]
+}
@@ -0,0 +1,249 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clustering
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/additional"
+	libfilters "github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+const (
+	DefaultMaxIterations   = 50
+	DefaultBatchSize       = 1024
+	DefaultRepresentatives = 1
+)
+
+type Clusterer struct {
+	schemaGetter schemaUC.SchemaGetter
+	repo         Repo
+	vectorRepo   VectorRepo
+	objects      ObjectsManager
+	authorizer   authorizer
+	timeout      time.Duration
+	logger       logrus.FieldLogger
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// New Clusterer. A clustering fails if it has not completed within timeout.
+func New(sg schemaUC.SchemaGetter, cr Repo, vr VectorRepo, om ObjectsManager,
+	authorizer authorizer, timeout time.Duration, logger logrus.FieldLogger,
+) *Clusterer {
+	return &Clusterer{
+		logger:       logger,
+		schemaGetter: sg,
+		repo:         cr,
+		vectorRepo:   vr,
+		objects:      om,
+		authorizer:   authorizer,
+		timeout:      timeout,
+	}
+}
+
+// Repo to manage clustering state, should be consistent, not used to store
+// acutal data object vectors, see VectorRepo
+type Repo interface {
+	Put(ctx context.Context, clustering models.Clustering) error
+	Get(ctx context.Context, id strfmt.UUID) (*models.Clustering, error)
+}
+
+// VectorRepo is used to page through the vectors of the clustered class
+type VectorRepo interface {
+	Query(ctx context.Context, q *objects.QueryInput) (search.Results, *objects.Error)
+}
+
+// ObjectsManager writes the cluster IDs back to the objects, subject to the
+// same authorization and routing as a write made by the user
+type ObjectsManager interface {
+	MergeObject(ctx context.Context, principal *models.Principal,
+		updates *models.Object, repl *additional.ReplicationProperties) *objects.Error
+}
+
+func (c *Clusterer) Schedule(ctx context.Context, principal *models.Principal,
+	params models.Clustering,
+) (*models.Clustering, error) {
+	err := c.authorizer.Authorize(principal, "create", "clusterings/*")
+	if err != nil {
+		return nil, err
+	}
+
+	setDefaults(&params)
+
+	normalize, err := c.validate(params)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := c.extractFilter(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.WriteProperty != "" {
+		path := fmt.Sprintf("objects/%s", params.Class)
+		if err := c.authorizer.Authorize(principal, "update", path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.assignNewID(&params); err != nil {
+		return nil, fmt.Errorf("clustering: assign id: %v", err)
+	}
+
+	params.Status = models.ClusteringStatusRunning
+	params.Meta = &models.ClusteringMeta{
+		Started: strfmt.DateTime(time.Now()),
+	}
+
+	if err := c.repo.Put(ctx, params); err != nil {
+		return nil, fmt.Errorf("clustering: put: %v", err)
+	}
+
+	// asynchronously trigger the clustering
+	go c.run(principal, params, filter, normalize)
+
+	return &params, nil
+}
+
+func (c *Clusterer) Get(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID,
+) (*models.Clustering, error) {
+	err := c.authorizer.Authorize(principal, "get", "clusterings/*")
+	if err != nil {
+		return nil, err
+	}
+
+	return c.repo.Get(ctx, id)
+}
+
+func setDefaults(params *models.Clustering) {
+	if params.Method == "" {
+		params.Method = models.ClusteringMethodKmeans
+	}
+	if params.MaxIterations == 0 {
+		params.MaxIterations = DefaultMaxIterations
+	}
+	if params.BatchSize == 0 {
+		params.BatchSize = DefaultBatchSize
+	}
+	if params.Representatives == 0 {
+		params.Representatives = DefaultRepresentatives
+	}
+}
+
+// validate checks the user input against the schema and returns whether the
+// vectors of the class have to be normalized before clustering, which is the
+// case for classes using the cosine distance
+func (c *Clusterer) validate(params models.Clustering) (bool, error) {
+	if params.Class == "" {
+		return false, fmt.Errorf("field 'class' cannot be empty")
+	}
+
+	sch := c.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(params.Class))
+	if class == nil {
+		return false, fmt.Errorf("class '%s' not found in schema", params.Class)
+	}
+
+	if params.K < 1 {
+		return false, fmt.Errorf("field 'k' must be at least 1, got %d", params.K)
+	}
+	if params.MaxIterations < 1 {
+		return false, fmt.Errorf("field 'maxIterations' must be at least 1, got %d",
+			params.MaxIterations)
+	}
+	if params.BatchSize < 1 {
+		return false, fmt.Errorf("field 'batchSize' must be at least 1, got %d",
+			params.BatchSize)
+	}
+	if params.Representatives < 0 {
+		return false, fmt.Errorf("field 'representatives' cannot be negative, got %d",
+			params.Representatives)
+	}
+
+	switch params.Method {
+	case models.ClusteringMethodKmeans, models.ClusteringMethodMiniBatchKmeans:
+	default:
+		return false, fmt.Errorf("unsupported method '%s'", params.Method)
+	}
+
+	if params.WriteProperty != "" {
+		prop, err := schema.GetPropertyByName(class, params.WriteProperty)
+		if err != nil {
+			return false, fmt.Errorf("field 'writeProperty': %v", err)
+		}
+		if len(prop.DataType) != 1 || prop.DataType[0] != string(schema.DataTypeInt) {
+			return false, fmt.Errorf("field 'writeProperty': property '%s' must be of "+
+				"type int, got %v", params.WriteProperty, prop.DataType)
+		}
+	}
+
+	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
+	if !ok {
+		return false, fmt.Errorf("class '%s' vector index: config is not hnsw.UserConfig: %T",
+			class.Class, class.VectorIndexConfig)
+	}
+
+	switch hnswConfig.Distance {
+	case "", hnsw.DistanceCosine:
+		return true, nil
+	case hnsw.DistanceL2Squared:
+		return false, nil
+	default:
+		return false, fmt.Errorf("clustering requires distance '%s' or '%s', class '%s' "+
+			"uses '%s'", hnsw.DistanceCosine, hnsw.DistanceL2Squared, class.Class,
+			hnswConfig.Distance)
+	}
+}
+
+func (c *Clusterer) extractFilter(params models.Clustering) (*libfilters.LocalFilter, error) {
+	if params.Where == nil {
+		return nil, nil
+	}
+
+	filter, err := filterext.Parse(params.Where, params.Class)
+	if err != nil {
+		return nil, fmt.Errorf("field 'where': %v", err)
+	}
+
+	if err := libfilters.ValidateFilters(c.schemaGetter.GetSchemaSkipAuth(), filter); err != nil {
+		return nil, fmt.Errorf("invalid where: %v", err)
+	}
+
+	return filter, nil
+}
+
+func (c *Clusterer) assignNewID(params *models.Clustering) error {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+
+	params.ID = strfmt.UUID(id.String())
+	return nil
+}
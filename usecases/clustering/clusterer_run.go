@@ -0,0 +1,288 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clustering
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	libfilters "github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// pageSize is the number of objects retrieved per cursor request while
+// loading the vectors of the clustered class
+const pageSize = 1000
+
+// the contents of this file deal with a single clustering run, from loading
+// the vectors to storing the final result
+
+func (c *Clusterer) run(principal *models.Principal, params models.Clustering,
+	filter *libfilters.LocalFilter, normalize bool,
+) {
+	ctx, cancel := contextWithTimeout(c.timeout)
+	defer cancel()
+
+	go c.monitorClustering(ctx, cancel, schema.ClassName(params.Class))
+
+	c.logBegin(params, filter)
+	ids, vectors, err := c.loadVectors(ctx, params.Class, filter, normalize)
+	if err != nil {
+		c.failRunWithError(params, errors.Wrap(err, "load vectors"))
+		return
+	}
+
+	if len(vectors) < int(params.K) {
+		c.failRunWithError(params, fmt.Errorf("found %d objects with a vector, "+
+			"need at least k=%d", len(vectors), params.K))
+		return
+	}
+	c.logVectorsLoaded(params, len(vectors))
+
+	kmeans := ssdhelpers.NewKMeans(int(params.K), len(vectors[0]), 0)
+	kmeans.IterationThreshold = int(params.MaxIterations)
+	if params.Method == models.ClusteringMethodMiniBatchKmeans {
+		err = kmeans.FitMiniBatch(vectors, int(params.BatchSize))
+	} else {
+		err = kmeans.Fit(vectors)
+	}
+	if err != nil {
+		c.failRunWithError(params, errors.Wrap(err, "fit"))
+		return
+	}
+
+	assignments, clusters, err := c.assign(kmeans, ids, vectors,
+		int(params.Representatives))
+	if err != nil {
+		c.failRunWithError(params, errors.Wrap(err, "assign clusters"))
+		return
+	}
+
+	if params.WriteProperty != "" {
+		if err := c.writeBack(ctx, principal, params, ids, assignments); err != nil {
+			c.failRunWithError(params, errors.Wrap(err, "write cluster ids"))
+			return
+		}
+	}
+
+	params.Clusters = clusters
+	params.Meta.Completed = strfmt.DateTime(time.Now())
+	params.Meta.Count = int64(len(vectors))
+	c.succeedRun(params)
+}
+
+func (c *Clusterer) monitorClustering(ctx context.Context, cancelFn context.CancelFunc,
+	className schema.ClassName,
+) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			schema := c.schemaGetter.GetSchemaSkipAuth()
+			class := schema.FindClassByName(className)
+			if class == nil {
+				cancelFn()
+				return
+			}
+		}
+	}
+}
+
+// loadVectors pages through all objects of the class matching the filter
+// and returns their ids and vectors. Objects without a vector are skipped.
+func (c *Clusterer) loadVectors(ctx context.Context, className string,
+	filter *libfilters.LocalFilter, normalize bool,
+) ([]strfmt.UUID, [][]float32, error) {
+	var (
+		ids     []strfmt.UUID
+		vectors [][]float32
+		after   string
+	)
+
+	for {
+		res, err := c.vectorRepo.Query(ctx, &objects.QueryInput{
+			Class:      className,
+			Limit:      pageSize,
+			Cursor:     &libfilters.Cursor{After: after, Limit: pageSize},
+			Filters:    filter,
+			Additional: additional.Properties{Vector: true},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, obj := range res {
+			if len(obj.Vector) == 0 {
+				continue
+			}
+			if len(vectors) > 0 && len(obj.Vector) != len(vectors[0]) {
+				return nil, nil, fmt.Errorf("object %s: vector has %d dimensions, "+
+					"expected %d", obj.ID, len(obj.Vector), len(vectors[0]))
+			}
+
+			vector := obj.Vector
+			if normalize {
+				vector = distancer.Normalize(vector)
+			}
+			ids = append(ids, obj.ID)
+			vectors = append(vectors, vector)
+		}
+
+		if len(res) < pageSize {
+			return ids, vectors, nil
+		}
+		after = res[len(res)-1].ID.String()
+	}
+}
+
+// assign determines the cluster of every vector, as well as the size and
+// the representatives of every cluster. The representatives of a cluster
+// are the objects closest to its centroid, ordered by ascending distance.
+func (c *Clusterer) assign(kmeans *ssdhelpers.KMeans, ids []strfmt.UUID,
+	vectors [][]float32, representatives int,
+) ([]int64, []*models.ClusteringCluster, error) {
+	type member struct {
+		pos  int
+		dist float32
+	}
+
+	members := make([][]member, kmeans.K)
+	assignments := make([]int64, len(vectors))
+	for i, vector := range vectors {
+		cluster := kmeans.Nearest(vector)
+		dist, _, err := kmeans.Distance.SingleDist(vector, kmeans.Centroid(cluster))
+		if err != nil {
+			return nil, nil, err
+		}
+		assignments[i] = int64(cluster)
+		members[cluster] = append(members[cluster], member{pos: i, dist: dist})
+	}
+
+	clusters := make([]*models.ClusteringCluster, kmeans.K)
+	for i := range clusters {
+		sort.Slice(members[i], func(a, b int) bool {
+			return members[i][a].dist < members[i][b].dist
+		})
+
+		reps := make([]strfmt.UUID, 0, representatives)
+		for _, m := range members[i] {
+			if len(reps) == representatives {
+				break
+			}
+			reps = append(reps, ids[m.pos])
+		}
+
+		centroid := make([]float32, len(kmeans.Centroid(uint64(i))))
+		copy(centroid, kmeans.Centroid(uint64(i)))
+
+		clusters[i] = &models.ClusteringCluster{
+			ID:              int64(i),
+			Centroid:        centroid,
+			Size:            int64(len(members[i])),
+			Representatives: reps,
+		}
+	}
+
+	return assignments, clusters, nil
+}
+
+// writeBack stores the cluster ID of every object in the write property.
+// Objects which have been deleted in the meantime are skipped.
+func (c *Clusterer) writeBack(ctx context.Context, principal *models.Principal,
+	params models.Clustering, ids []strfmt.UUID, assignments []int64,
+) error {
+	for i, id := range ids {
+		err := c.objects.MergeObject(ctx, principal, &models.Object{
+			Class: params.Class,
+			ID:    id,
+			Properties: map[string]interface{}{
+				params.WriteProperty: assignments[i],
+			},
+		}, nil)
+		if err != nil && !err.NotFound() {
+			return errors.Wrapf(err, "object %s", id)
+		}
+	}
+
+	return nil
+}
+
+func (c *Clusterer) succeedRun(params models.Clustering) {
+	params.Status = models.ClusteringStatusCompleted
+	ctx, cancel := contextWithTimeout(2 * time.Second)
+	defer cancel()
+	err := c.repo.Put(ctx, params)
+	if err != nil {
+		c.logExecutionError("store succeeded run", err, params)
+	}
+	c.logFinish(params)
+}
+
+func (c *Clusterer) failRunWithError(params models.Clustering, err error) {
+	params.Status = models.ClusteringStatusFailed
+	params.Error = fmt.Sprintf("clustering failed: %v", err)
+	err = c.repo.Put(context.Background(), params)
+	if err != nil {
+		c.logExecutionError("store failed run", err, params)
+	}
+	c.logFinish(params)
+}
+
+func contextWithTimeout(d time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), d)
+}
+
+// Logging helper methods
+func (c *Clusterer) logBase(params models.Clustering, event string) *logrus.Entry {
+	return c.logger.WithField("action", "clustering_run").
+		WithField("event", event).
+		WithField("clustering_id", params.ID).
+		WithField("class", params.Class).
+		WithField("method", params.Method)
+}
+
+func (c *Clusterer) logBegin(params models.Clustering, filter *libfilters.LocalFilter) {
+	c.logBase(params, "clustering_begin").
+		WithField("filter", filter).
+		Debug("clustering started")
+}
+
+func (c *Clusterer) logFinish(params models.Clustering) {
+	c.logBase(params, "clustering_finish").
+		WithField("status", params.Status).
+		Debug("clustering finished")
+}
+
+func (c *Clusterer) logVectorsLoaded(params models.Clustering, count int) {
+	c.logBase(params, "clustering_vectors_loaded").
+		WithField("vector_count", count).
+		Debug("loaded vectors to be clustered")
+}
+
+func (c *Clusterer) logExecutionError(event string, err error, params models.Clustering) {
+	c.logBase(params, event).
+		WithError(err).
+		Error("clustering failed")
+}
@@ -0,0 +1,264 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clustering
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	testhelper "github.com/weaviate/weaviate/test/helper"
+)
+
+func testSchema() schema.Schema {
+	return schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Point",
+					VectorIndexConfig: hnsw.UserConfig{
+						Distance: hnsw.DistanceL2Squared,
+					},
+					Properties: []*models.Property{
+						{
+							Name:     "cluster",
+							DataType: schema.DataTypeInt.PropString(),
+						},
+						{
+							Name:     "name",
+							DataType: schema.DataTypeText.PropString(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// testObjects returns two well separated groups of points, the ids of the
+// first group start with "a", the ids of the second group with "b"
+func testObjects() search.Results {
+	var out search.Results
+	for i := 0; i < 20; i++ {
+		out = append(out, search.Result{
+			ID:     strfmt.UUID(fmt.Sprintf("a0000000-0000-0000-0000-%012d", i)),
+			Vector: []float32{float32(i%5) * 0.01, float32(i%3) * 0.01},
+		}, search.Result{
+			ID:     strfmt.UUID(fmt.Sprintf("b0000000-0000-0000-0000-%012d", i)),
+			Vector: []float32{10 + float32(i%5)*0.01, 10 + float32(i%3)*0.01},
+		})
+	}
+	return out
+}
+
+func Test_Clusterer(t *testing.T) {
+	for _, method := range []string{
+		models.ClusteringMethodKmeans,
+		models.ClusteringMethodMiniBatchKmeans,
+	} {
+		t.Run(method, func(t *testing.T) {
+			sg := &fakeSchemaGetter{testSchema()}
+			repo := newFakeClusteringRepo()
+			objectsManager := newFakeObjectsManager()
+			logger, _ := test.NewNullLogger()
+			clusterer := New(sg, repo, newFakeVectorRepo(testObjects()),
+				objectsManager, &fakeAuthorizer{}, time.Minute, logger)
+
+			params := models.Clustering{
+				Class:           "Point",
+				K:               2,
+				Method:          method,
+				BatchSize:       8,
+				Representatives: 3,
+				WriteProperty:   "cluster",
+			}
+			res, err := clusterer.Schedule(context.Background(), nil, params)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			assert.Equal(t, models.ClusteringStatusRunning, res.Status)
+			assert.Equal(t, int64(DefaultMaxIterations), res.MaxIterations)
+
+			clustering := waitForStatusToNoLongerBeRunning(t, clusterer, res.ID)
+			require.Equal(t, models.ClusteringStatusCompleted, clustering.Status,
+				clustering.Error)
+			assert.Equal(t, int64(40), clustering.Meta.Count)
+			require.Len(t, clustering.Clusters, 2)
+
+			for _, cluster := range clustering.Clusters {
+				assert.Equal(t, int64(20), cluster.Size)
+				assert.Len(t, cluster.Centroid, 2)
+				require.Len(t, cluster.Representatives, 3)
+				prefix := cluster.Representatives[0][0]
+				for _, id := range cluster.Representatives {
+					assert.Equal(t, prefix, id[0], "representatives must share a group")
+				}
+			}
+
+			merged := objectsManager.mergedIDs()
+			require.Len(t, merged, 40)
+			for id, cluster := range merged {
+				assert.Equal(t, id[0] == clustering.Clusters[1].Representatives[0][0],
+					cluster == 1)
+			}
+		})
+	}
+}
+
+func Test_Clusterer_TooFewObjects(t *testing.T) {
+	sg := &fakeSchemaGetter{testSchema()}
+	repo := newFakeClusteringRepo()
+	logger, _ := test.NewNullLogger()
+	clusterer := New(sg, repo, newFakeVectorRepo(testObjects()[:3]),
+		newFakeObjectsManager(), &fakeAuthorizer{}, time.Minute, logger)
+
+	res, err := clusterer.Schedule(context.Background(), nil,
+		models.Clustering{Class: "Point", K: 4})
+	require.Nil(t, err)
+
+	clustering := waitForStatusToNoLongerBeRunning(t, clusterer, res.ID)
+	assert.Equal(t, models.ClusteringStatusFailed, clustering.Status)
+	assert.Contains(t, clustering.Error, "need at least k=4")
+}
+
+func Test_Clusterer_Authorization(t *testing.T) {
+	t.Run("create clusterings", func(t *testing.T) {
+		clusterer := New(&fakeSchemaGetter{testSchema()}, newFakeClusteringRepo(),
+			newFakeVectorRepo(nil), newFakeObjectsManager(),
+			&fakeAuthorizer{forbidden: map[string]string{"create": "clusterings/*"}},
+			time.Minute, nil)
+
+		_, err := clusterer.Schedule(context.Background(), nil,
+			models.Clustering{Class: "Point", K: 2})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "forbidden")
+	})
+
+	t.Run("update objects of the class", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		clusterer := New(&fakeSchemaGetter{testSchema()}, newFakeClusteringRepo(),
+			newFakeVectorRepo(nil), newFakeObjectsManager(),
+			&fakeAuthorizer{forbidden: map[string]string{"update": "objects/Point"}},
+			time.Minute, logger)
+
+		_, err := clusterer.Schedule(context.Background(), nil,
+			models.Clustering{Class: "Point", K: 2})
+		require.Nil(t, err, "no write property, no update")
+
+		_, err = clusterer.Schedule(context.Background(), nil,
+			models.Clustering{Class: "Point", K: 2, WriteProperty: "cluster"})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "forbidden")
+	})
+}
+
+func Test_Clusterer_Timeout(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	clusterer := New(&fakeSchemaGetter{testSchema()}, newFakeClusteringRepo(),
+		newFakeVectorRepo(testObjects()), newFakeObjectsManager(),
+		&fakeAuthorizer{}, time.Nanosecond, logger)
+
+	res, err := clusterer.Schedule(context.Background(), nil,
+		models.Clustering{Class: "Point", K: 2, WriteProperty: "cluster"})
+	require.Nil(t, err)
+
+	clustering := waitForStatusToNoLongerBeRunning(t, clusterer, res.ID)
+	assert.Equal(t, models.ClusteringStatusFailed, clustering.Status)
+	assert.Contains(t, clustering.Error, context.DeadlineExceeded.Error())
+}
+
+func Test_Clusterer_Validation(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      models.Clustering
+		expectedErr string
+	}{
+		{
+			name:        "missing class",
+			params:      models.Clustering{K: 2},
+			expectedErr: "field 'class' cannot be empty",
+		},
+		{
+			name:        "unknown class",
+			params:      models.Clustering{Class: "Foo", K: 2},
+			expectedErr: "class 'Foo' not found in schema",
+		},
+		{
+			name:        "k not set",
+			params:      models.Clustering{Class: "Point"},
+			expectedErr: "field 'k' must be at least 1, got 0",
+		},
+		{
+			name:        "unknown method",
+			params:      models.Clustering{Class: "Point", K: 2, Method: "dbscan"},
+			expectedErr: "unsupported method 'dbscan'",
+		},
+		{
+			name:        "unknown write property",
+			params:      models.Clustering{Class: "Point", K: 2, WriteProperty: "foo"},
+			expectedErr: "field 'writeProperty'",
+		},
+		{
+			name:        "write property not an int",
+			params:      models.Clustering{Class: "Point", K: 2, WriteProperty: "name"},
+			expectedErr: "property 'name' must be of type int",
+		},
+		{
+			name: "invalid filter",
+			params: models.Clustering{Class: "Point", K: 2, Where: &models.WhereFilter{
+				Operator:  "Equal",
+				Path:      []string{"foo"},
+				ValueText: ptString("bar"),
+			}},
+			expectedErr: "invalid where",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clusterer := New(&fakeSchemaGetter{testSchema()}, newFakeClusteringRepo(),
+				newFakeVectorRepo(nil), newFakeObjectsManager(), &fakeAuthorizer{},
+				time.Minute, nil)
+
+			_, err := clusterer.Schedule(context.Background(), nil, test.params)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func waitForStatusToNoLongerBeRunning(t *testing.T, clusterer *Clusterer,
+	id strfmt.UUID,
+) *models.Clustering {
+	var clustering *models.Clustering
+	testhelper.AssertEventuallyEqualWithFrequencyAndTimeout(t, true, func() interface{} {
+		var err error
+		clustering, err = clusterer.Get(context.Background(), nil, id)
+		require.Nil(t, err)
+		require.NotNil(t, clustering)
+
+		return clustering.Status != models.ClusteringStatusRunning
+	}, 100*time.Millisecond, 20*time.Second, "wait until status in no longer running")
+	return clustering
+}
+
+func ptString(in string) *string {
+	return &in
+}
@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clustering
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+func (f *fakeSchemaGetter) ShardingState(class string) *sharding.State {
+	panic("not implemented")
+}
+
+func (f *fakeSchemaGetter) Nodes() []string {
+	panic("not implemented")
+}
+
+func (f *fakeSchemaGetter) NodeName() string {
+	panic("not implemented")
+}
+
+func (f *fakeSchemaGetter) ClusterHealthScore() int {
+	panic("not implemented")
+}
+
+func (f *fakeSchemaGetter) ResolveParentNodes(string, string,
+) (map[string]string, error) {
+	panic("not implemented")
+}
+
+type fakeClusteringRepo struct {
+	sync.Mutex
+	db map[strfmt.UUID]models.Clustering
+}
+
+func newFakeClusteringRepo() *fakeClusteringRepo {
+	return &fakeClusteringRepo{
+		db: map[strfmt.UUID]models.Clustering{},
+	}
+}
+
+func (f *fakeClusteringRepo) Put(ctx context.Context, clustering models.Clustering) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.db[clustering.ID] = clustering
+	return nil
+}
+
+func (f *fakeClusteringRepo) Get(ctx context.Context, id strfmt.UUID) (*models.Clustering, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	clustering, ok := f.db[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &clustering, nil
+}
+
+// fakeVectorRepo serves the objects in ascending id order like the cursor
+// api does
+type fakeVectorRepo struct {
+	objects search.Results
+}
+
+func newFakeVectorRepo(objects search.Results) *fakeVectorRepo {
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].ID < objects[j].ID
+	})
+	return &fakeVectorRepo{objects: objects}
+}
+
+func (f *fakeVectorRepo) Query(ctx context.Context,
+	q *objects.QueryInput,
+) (search.Results, *objects.Error) {
+	if err := ctx.Err(); err != nil {
+		return nil, &objects.Error{Msg: "query", Code: objects.StatusInternalServerError, Err: err}
+	}
+	var out search.Results
+	for _, obj := range f.objects {
+		if len(out) == q.Cursor.Limit {
+			break
+		}
+		if obj.ID.String() > q.Cursor.After {
+			out = append(out, obj)
+		}
+	}
+	return out, nil
+}
+
+// fakeObjectsManager records every merge
+type fakeObjectsManager struct {
+	sync.Mutex
+	merged map[strfmt.UUID]int64
+}
+
+func newFakeObjectsManager() *fakeObjectsManager {
+	return &fakeObjectsManager{merged: map[strfmt.UUID]int64{}}
+}
+
+func (f *fakeObjectsManager) MergeObject(ctx context.Context,
+	principal *models.Principal, updates *models.Object,
+	repl *additional.ReplicationProperties,
+) *objects.Error {
+	f.Lock()
+	defer f.Unlock()
+
+	for _, value := range updates.Properties.(map[string]interface{}) {
+		f.merged[updates.ID] = value.(int64)
+	}
+	return nil
+}
+
+func (f *fakeObjectsManager) mergedIDs() map[strfmt.UUID]int64 {
+	f.Lock()
+	defer f.Unlock()
+
+	return f.merged
+}
+
+// fakeAuthorizer allows everything but the given verb and resource pairs
+type fakeAuthorizer struct {
+	forbidden map[string]string
+}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if r, ok := f.forbidden[verb]; ok && r == resource {
+		return fmt.Errorf("forbidden: %s %s", verb, resource)
+	}
+	return nil
+}
@@ -71,32 +71,38 @@ type Flags struct {
 
 // Config outline of the config file
 type Config struct {
-	Name                                string         `json:"name" yaml:"name"`
-	Debug                               bool           `json:"debug" yaml:"debug"`
-	QueryDefaults                       QueryDefaults  `json:"query_defaults" yaml:"query_defaults"`
-	QueryMaximumResults                 int64          `json:"query_maximum_results" yaml:"query_maximum_results"`
-	Contextionary                       Contextionary  `json:"contextionary" yaml:"contextionary"`
-	Authentication                      Authentication `json:"authentication" yaml:"authentication"`
-	Authorization                       Authorization  `json:"authorization" yaml:"authorization"`
-	Origin                              string         `json:"origin" yaml:"origin"`
-	Persistence                         Persistence    `json:"persistence" yaml:"persistence"`
-	DefaultVectorizerModule             string         `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
-	DefaultVectorDistanceMetric         string         `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
-	EnableModules                       string         `json:"enable_modules" yaml:"enable_modules"`
-	ModulesPath                         string         `json:"modules_path" yaml:"modules_path"`
-	AutoSchema                          AutoSchema     `json:"auto_schema" yaml:"auto_schema"`
-	Cluster                             cluster.Config `json:"cluster" yaml:"cluster"`
-	Monitoring                          Monitoring     `json:"monitoring" yaml:"monitoring"`
-	GRPC                                GRPC           `json:"grpc" yaml:"grpc"`
-	Profiling                           Profiling      `json:"profiling" yaml:"profiling"`
-	ResourceUsage                       ResourceUsage  `json:"resource_usage" yaml:"resource_usage"`
-	MaxImportGoroutinesFactor           float64        `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
-	MaximumConcurrentGetRequests        int            `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
-	TrackVectorDimensions               bool           `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
-	ReindexVectorDimensionsAtStartup    bool           `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
-	RecountPropertiesAtStartup          bool           `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
-	ReindexSetToRoaringsetAtStartup     bool           `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
-	IndexMissingTextFilterableAtStartup bool           `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
+	Name                                string            `json:"name" yaml:"name"`
+	Debug                               bool              `json:"debug" yaml:"debug"`
+	QueryDefaults                       QueryDefaults     `json:"query_defaults" yaml:"query_defaults"`
+	QueryMaximumResults                 int64             `json:"query_maximum_results" yaml:"query_maximum_results"`
+	Contextionary                       Contextionary     `json:"contextionary" yaml:"contextionary"`
+	Authentication                      Authentication    `json:"authentication" yaml:"authentication"`
+	Authorization                       Authorization     `json:"authorization" yaml:"authorization"`
+	Origin                              string            `json:"origin" yaml:"origin"`
+	Persistence                         Persistence       `json:"persistence" yaml:"persistence"`
+	DefaultVectorizerModule             string            `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
+	DefaultVectorDistanceMetric         string            `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
+	EnableModules                       string            `json:"enable_modules" yaml:"enable_modules"`
+	ModulesPath                         string            `json:"modules_path" yaml:"modules_path"`
+	AutoSchema                          AutoSchema        `json:"auto_schema" yaml:"auto_schema"`
+	Cluster                             cluster.Config    `json:"cluster" yaml:"cluster"`
+	Monitoring                          Monitoring        `json:"monitoring" yaml:"monitoring"`
+	GRPC                                GRPC              `json:"grpc" yaml:"grpc"`
+	Profiling                           Profiling         `json:"profiling" yaml:"profiling"`
+	ResourceUsage                       ResourceUsage     `json:"resource_usage" yaml:"resource_usage"`
+	MaxImportGoroutinesFactor           float64           `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
+	MaximumConcurrentGetRequests        int               `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
+	QueryResultsCache                   QueryResultsCache `json:"query_results_cache" yaml:"query_results_cache"`
+	AuditLog                            AuditLog          `json:"audit_log" yaml:"audit_log"`
+	ShardRebalancer                     ShardRebalancer   `json:"shard_rebalancer" yaml:"shard_rebalancer"`
+	AntiEntropy                         AntiEntropy       `json:"anti_entropy" yaml:"anti_entropy"`
+	HintedHandoff                       HintedHandoff     `json:"hinted_handoff" yaml:"hinted_handoff"`
+	VectorJobs                          VectorJobs        `json:"vector_jobs" yaml:"vector_jobs"`
+	TrackVectorDimensions               bool              `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
+	ReindexVectorDimensionsAtStartup    bool              `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
+	RecountPropertiesAtStartup          bool              `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
+	ReindexSetToRoaringsetAtStartup     bool              `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
+	IndexMissingTextFilterableAtStartup bool              `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
 }
 
 type moduleProvider interface {
@@ -177,6 +183,58 @@ type GRPC struct {
 	Port int `json:"port" yaml:"port"`
 }
 
+// QueryResultsCache configures the opt-in cache for Get and Aggregate query
+// results. Cached entries are invalidated as soon as any local shard of the
+// queried class is written to.
+type QueryResultsCache struct {
+	Enabled   bool `json:"enabled" yaml:"enabled"`
+	MaxSizeMB int  `json:"max_size_mb" yaml:"max_size_mb"`
+}
+
+// AuditLog configures the log of authenticated operations. Mutating
+// operations are always recorded, reads only if IncludeReads is set. The log
+// is rotated once it exceeds MaxSizeMB.
+type AuditLog struct {
+	Enabled      bool   `json:"enabled" yaml:"enabled"`
+	IncludeReads bool   `json:"include_reads" yaml:"include_reads"`
+	Path         string `json:"path" yaml:"path"`
+	MaxSizeMB    int    `json:"max_size_mb" yaml:"max_size_mb"`
+	MaxBackups   int    `json:"max_backups" yaml:"max_backups"`
+}
+
+// ShardRebalancer configures the automatic rebalancing of shards. Every
+// IntervalSeconds the shard replicas are counted per node and, if the counts
+// differ by more than one, a shard is moved to the node holding the fewest.
+type ShardRebalancer struct {
+	Enabled         bool `json:"enabled" yaml:"enabled"`
+	IntervalSeconds int  `json:"interval_seconds" yaml:"interval_seconds"`
+}
+
+// AntiEntropy configures the background repair of replicated shards. Every
+// IntervalSeconds the hash trees of all replicas of a shard are compared and
+// objects which differ are streamed at a rate of at most MaxBytesPerSecond.
+type AntiEntropy struct {
+	Enabled           bool `json:"enabled" yaml:"enabled"`
+	IntervalSeconds   int  `json:"interval_seconds" yaml:"interval_seconds"`
+	MaxBytesPerSecond int  `json:"max_bytes_per_second" yaml:"max_bytes_per_second"`
+}
+
+// HintedHandoff configures hints, writes which a replica missed because it
+// was unreachable. Up to MaxHints hints are kept on the coordinator and
+// replayed every ReplayIntervalSeconds to the replicas which are reachable.
+type HintedHandoff struct {
+	Enabled               bool `json:"enabled" yaml:"enabled"`
+	MaxHints              int  `json:"max_hints" yaml:"max_hints"`
+	ReplayIntervalSeconds int  `json:"replay_interval_seconds" yaml:"replay_interval_seconds"`
+}
+
+// VectorJobs configures the background jobs which process the vectors of a
+// whole class, clusterings and deduplications. A job fails if it has not
+// completed within TimeoutSeconds.
+type VectorJobs struct {
+	TimeoutSeconds int `json:"timeout_seconds" yaml:"timeout_seconds"`
+}
+
 type Profiling struct {
 	BlockProfileRate     int `json:"blockProfileRate" yaml:"blockProfileRate"`
 	MutexProfileFraction int `json:"mutexProfileFraction" yaml:"mutexProfileFraction"`
@@ -124,6 +124,20 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if enabled(os.Getenv("AUTHORIZATION_RBAC_ENABLED")) {
+		config.Authorization.RBAC.Enabled = true
+
+		usersString, ok := os.LookupEnv("AUTHORIZATION_RBAC_ROOT_USERS")
+		if ok {
+			config.Authorization.RBAC.RootUsers = strings.Split(usersString, ",")
+		}
+
+		groupsString, ok := os.LookupEnv("AUTHORIZATION_RBAC_ROOT_GROUPS")
+		if ok {
+			config.Authorization.RBAC.RootGroups = strings.Split(groupsString, ",")
+		}
+	}
+
 	clusterCfg, err := parseClusterConfig()
 	if err != nil {
 		return err
@@ -248,6 +262,106 @@ func FromEnv(config *Config) error {
 		config.MaximumConcurrentGetRequests = DefaultMaxConcurrentGetRequests
 	}
 
+	if enabled(os.Getenv("QUERY_RESULTS_CACHE_ENABLED")) {
+		config.QueryResultsCache.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"QUERY_RESULTS_CACHE_MAX_SIZE_MB",
+		func(val int) { config.QueryResultsCache.MaxSizeMB = val },
+		DefaultQueryResultsCacheMaxSizeMB,
+	); err != nil {
+		return err
+	}
+
+	if enabled(os.Getenv("AUDIT_LOG_ENABLED")) {
+		config.AuditLog.Enabled = true
+	}
+
+	if enabled(os.Getenv("AUDIT_LOG_INCLUDE_READS")) {
+		config.AuditLog.IncludeReads = true
+	}
+
+	if v := os.Getenv("AUDIT_LOG_PATH"); v != "" {
+		config.AuditLog.Path = v
+	}
+
+	if err := parsePositiveInt(
+		"AUDIT_LOG_MAX_SIZE_MB",
+		func(val int) { config.AuditLog.MaxSizeMB = val },
+		DefaultAuditLogMaxSizeMB,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"AUDIT_LOG_MAX_BACKUPS",
+		func(val int) { config.AuditLog.MaxBackups = val },
+		DefaultAuditLogMaxBackups,
+	); err != nil {
+		return err
+	}
+
+	if enabled(os.Getenv("SHARD_REBALANCER_ENABLED")) {
+		config.ShardRebalancer.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"SHARD_REBALANCER_INTERVAL_SECONDS",
+		func(val int) { config.ShardRebalancer.IntervalSeconds = val },
+		DefaultShardRebalancerIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
+	if enabled(os.Getenv("ANTI_ENTROPY_ENABLED")) {
+		config.AntiEntropy.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"ANTI_ENTROPY_INTERVAL_SECONDS",
+		func(val int) { config.AntiEntropy.IntervalSeconds = val },
+		DefaultAntiEntropyIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"ANTI_ENTROPY_MAX_BYTES_PER_SECOND",
+		func(val int) { config.AntiEntropy.MaxBytesPerSecond = val },
+		DefaultAntiEntropyMaxBytesPerSecond,
+	); err != nil {
+		return err
+	}
+
+	if enabled(os.Getenv("HINTED_HANDOFF_ENABLED")) {
+		config.HintedHandoff.Enabled = true
+	}
+
+	if err := parsePositiveInt(
+		"HINTED_HANDOFF_MAX_HINTS",
+		func(val int) { config.HintedHandoff.MaxHints = val },
+		DefaultHintedHandoffMaxHints,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"HINTED_HANDOFF_REPLAY_INTERVAL_SECONDS",
+		func(val int) { config.HintedHandoff.ReplayIntervalSeconds = val },
+		DefaultHintedHandoffReplayIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"VECTOR_JOBS_TIMEOUT_SECONDS",
+		func(val int) { config.VectorJobs.TimeoutSeconds = val },
+		DefaultVectorJobsTimeoutSeconds,
+	); err != nil {
+		return err
+	}
+
 	if err := parsePositiveInt(
 		"GRPC_PORT",
 		func(val int) { config.GRPC.Port = val },
@@ -331,6 +445,15 @@ const (
 	DefaultPersistenceMemtablesMaxDuration    = 45
 	DefaultMaxConcurrentGetRequests           = 0
 	DefaultGRPCPort                           = 50051
+	DefaultQueryResultsCacheMaxSizeMB         = 100
+	DefaultAuditLogMaxSizeMB                  = 100
+	DefaultAuditLogMaxBackups                 = 5
+	DefaultShardRebalancerIntervalSeconds     = 300
+	DefaultAntiEntropyIntervalSeconds         = 600
+	DefaultAntiEntropyMaxBytesPerSecond       = 10 * 1024 * 1024
+	DefaultHintedHandoffMaxHints              = 100000
+	DefaultHintedHandoffReplayIntervalSeconds = 10
+	DefaultVectorJobsTimeoutSeconds           = 1800
 )
 
 const VectorizerModuleNone = "none"
@@ -339,6 +462,9 @@ const VectorizerModuleNone = "none"
 // port value assigned with the use of DefaultLocalConfig
 const DefaultGossipBindPort = 7946
 
+// DefaultRaftBootstrapExpect lets a single node bootstrap the schema log
+const DefaultRaftBootstrapExpect = 1
+
 // TODO: This should be retrieved dynamically from all installed modules
 const VectorizerModuleText2VecContextionary = "text2vec-contextionary"
 
@@ -442,5 +568,26 @@ func parseClusterConfig() (cluster.Config, error) {
 	cfg.IgnoreStartupSchemaSync = enabled(
 		os.Getenv("CLUSTER_IGNORE_SCHEMA_SYNC"))
 
+	if enabled(os.Getenv("CLUSTER_RAFT_ENABLED")) {
+		cfg.RaftEnabled = true
+		cfg.RaftBindPort = cfg.GossipBindPort + 2
+		if v, ok := os.LookupEnv("CLUSTER_RAFT_BIND_PORT"); ok {
+			asInt, err := strconv.Atoi(v)
+			if err != nil {
+				return cfg, fmt.Errorf("parse CLUSTER_RAFT_BIND_PORT as int: %w", err)
+			}
+			cfg.RaftBindPort = asInt
+		}
+		cfg.RaftBootstrapExpect = DefaultRaftBootstrapExpect
+		if v := os.Getenv("CLUSTER_RAFT_BOOTSTRAP_EXPECT"); v != "" {
+			asInt, err := strconv.Atoi(v)
+			if err != nil || asInt < 1 {
+				return cfg, fmt.Errorf("CLUSTER_RAFT_BOOTSTRAP_EXPECT must be " +
+					"a positive integer")
+			}
+			cfg.RaftBootstrapExpect = asInt
+		}
+	}
+
 	return cfg, nil
 }
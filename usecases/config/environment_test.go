@@ -280,6 +280,66 @@ func TestEnvironmentParseClusterConfig(t *testing.T) {
 				IgnoreStartupSchemaSync: true,
 			},
 		},
+		{
+			name: "raft enabled",
+			envVars: map[string]string{
+				"CLUSTER_RAFT_ENABLED": "true",
+			},
+			expectedResult: cluster.Config{
+				GossipBindPort:      7946,
+				DataBindPort:        7947,
+				RaftEnabled:         true,
+				RaftBindPort:        7948,
+				RaftBootstrapExpect: 1,
+			},
+		},
+		{
+			name: "raft enabled with bootstrap expect",
+			envVars: map[string]string{
+				"CLUSTER_RAFT_ENABLED":          "true",
+				"CLUSTER_RAFT_BOOTSTRAP_EXPECT": "3",
+			},
+			expectedResult: cluster.Config{
+				GossipBindPort:      7946,
+				DataBindPort:        7947,
+				RaftEnabled:         true,
+				RaftBindPort:        7948,
+				RaftBootstrapExpect: 3,
+			},
+		},
+		{
+			name: "raft enabled with raft bind port",
+			envVars: map[string]string{
+				"CLUSTER_RAFT_ENABLED":     "true",
+				"CLUSTER_GOSSIP_BIND_PORT": "7100",
+				"CLUSTER_RAFT_BIND_PORT":   "8300",
+			},
+			expectedResult: cluster.Config{
+				GossipBindPort:      7100,
+				DataBindPort:        7101,
+				RaftEnabled:         true,
+				RaftBindPort:        8300,
+				RaftBootstrapExpect: 1,
+			},
+		},
+		{
+			name: "raft enabled with invalid raft bind port",
+			envVars: map[string]string{
+				"CLUSTER_RAFT_ENABLED":   "true",
+				"CLUSTER_RAFT_BIND_PORT": "raft",
+			},
+			expectedErr: errors.New("parse CLUSTER_RAFT_BIND_PORT as int: " +
+				"strconv.Atoi: parsing \"raft\": invalid syntax"),
+		},
+		{
+			name: "raft enabled with invalid bootstrap expect",
+			envVars: map[string]string{
+				"CLUSTER_RAFT_ENABLED":          "true",
+				"CLUSTER_RAFT_BOOTSTRAP_EXPECT": "0",
+			},
+			expectedErr: errors.New("CLUSTER_RAFT_BOOTSTRAP_EXPECT must be " +
+				"a positive integer"),
+		},
 	}
 
 	for _, test := range tests {
@@ -374,3 +434,283 @@ func TestEnvironmentGRPCPort(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentQueryResultsCache(t *testing.T) {
+	factors := []struct {
+		name            string
+		enabled         []string
+		maxSize         []string
+		expectedEnabled bool
+		expectedMaxSize int
+		expectedErr     bool
+	}{
+		{"not given", []string{}, []string{}, false, DefaultQueryResultsCacheMaxSizeMB, false},
+		{"enabled", []string{"true"}, []string{}, true, DefaultQueryResultsCacheMaxSizeMB, false},
+		{"enabled with size", []string{"on"}, []string{"512"}, true, 512, false},
+		{"disabled", []string{"false"}, []string{"512"}, false, 512, false},
+		{"zero size", []string{"true"}, []string{"0"}, false, -1, true},
+		{"not parsable", []string{"true"}, []string{"I'm not a number"}, false, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.enabled) == 1 {
+				t.Setenv("QUERY_RESULTS_CACHE_ENABLED", tt.enabled[0])
+			}
+			if len(tt.maxSize) == 1 {
+				t.Setenv("QUERY_RESULTS_CACHE_MAX_SIZE_MB", tt.maxSize[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expectedEnabled, conf.QueryResultsCache.Enabled)
+				require.Equal(t, tt.expectedMaxSize, conf.QueryResultsCache.MaxSizeMB)
+			}
+		})
+	}
+}
+
+func TestEnvironmentAuthorizationRBAC(t *testing.T) {
+	factors := []struct {
+		name               string
+		enabled            []string
+		rootUsers          []string
+		rootGroups         []string
+		expectedEnabled    bool
+		expectedRootUsers  []string
+		expectedRootGroups []string
+	}{
+		{"not given", []string{}, []string{}, []string{}, false, nil, nil},
+		{"enabled with users", []string{"true"}, []string{"alice,bob"}, []string{}, true, []string{"alice", "bob"}, nil},
+		{"enabled with groups", []string{"on"}, []string{}, []string{"admins"}, true, nil, []string{"admins"}},
+		{"disabled", []string{"false"}, []string{"alice"}, []string{"admins"}, false, nil, nil},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.enabled) == 1 {
+				t.Setenv("AUTHORIZATION_RBAC_ENABLED", tt.enabled[0])
+			}
+			if len(tt.rootUsers) == 1 {
+				t.Setenv("AUTHORIZATION_RBAC_ROOT_USERS", tt.rootUsers[0])
+			}
+			if len(tt.rootGroups) == 1 {
+				t.Setenv("AUTHORIZATION_RBAC_ROOT_GROUPS", tt.rootGroups[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			require.Nil(t, err)
+			require.Equal(t, tt.expectedEnabled, conf.Authorization.RBAC.Enabled)
+			require.Equal(t, tt.expectedRootUsers, conf.Authorization.RBAC.RootUsers)
+			require.Equal(t, tt.expectedRootGroups, conf.Authorization.RBAC.RootGroups)
+		})
+	}
+}
+
+func TestEnvironmentAuditLog(t *testing.T) {
+	factors := []struct {
+		name                 string
+		enabled              []string
+		includeReads         []string
+		path                 []string
+		maxSize              []string
+		expectedEnabled      bool
+		expectedIncludeReads bool
+		expectedPath         string
+		expectedMaxSize      int
+		expectedErr          bool
+	}{
+		{"not given", []string{}, []string{}, []string{}, []string{}, false, false, "", DefaultAuditLogMaxSizeMB, false},
+		{"enabled", []string{"true"}, []string{}, []string{}, []string{}, true, false, "", DefaultAuditLogMaxSizeMB, false},
+		{"enabled with reads", []string{"on"}, []string{"true"}, []string{"/var/log/audit.jsonl"}, []string{"10"}, true, true, "/var/log/audit.jsonl", 10, false},
+		{"zero size", []string{"true"}, []string{}, []string{}, []string{"0"}, false, false, "", -1, true},
+		{"not parsable", []string{"true"}, []string{}, []string{}, []string{"I'm not a number"}, false, false, "", -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.enabled) == 1 {
+				t.Setenv("AUDIT_LOG_ENABLED", tt.enabled[0])
+			}
+			if len(tt.includeReads) == 1 {
+				t.Setenv("AUDIT_LOG_INCLUDE_READS", tt.includeReads[0])
+			}
+			if len(tt.path) == 1 {
+				t.Setenv("AUDIT_LOG_PATH", tt.path[0])
+			}
+			if len(tt.maxSize) == 1 {
+				t.Setenv("AUDIT_LOG_MAX_SIZE_MB", tt.maxSize[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expectedEnabled, conf.AuditLog.Enabled)
+				require.Equal(t, tt.expectedIncludeReads, conf.AuditLog.IncludeReads)
+				require.Equal(t, tt.expectedPath, conf.AuditLog.Path)
+				require.Equal(t, tt.expectedMaxSize, conf.AuditLog.MaxSizeMB)
+				require.Equal(t, DefaultAuditLogMaxBackups, conf.AuditLog.MaxBackups)
+			}
+		})
+	}
+}
+
+func TestEnvironmentShardRebalancer(t *testing.T) {
+	factors := []struct {
+		name             string
+		enabled          []string
+		interval         []string
+		expectedEnabled  bool
+		expectedInterval int
+		expectedErr      bool
+	}{
+		{"not given", []string{}, []string{}, false, DefaultShardRebalancerIntervalSeconds, false},
+		{"enabled", []string{"true"}, []string{}, true, DefaultShardRebalancerIntervalSeconds, false},
+		{"enabled with interval", []string{"on"}, []string{"60"}, true, 60, false},
+		{"zero interval", []string{"true"}, []string{"0"}, false, -1, true},
+		{"not parsable", []string{"true"}, []string{"I'm not a number"}, false, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.enabled) == 1 {
+				t.Setenv("SHARD_REBALANCER_ENABLED", tt.enabled[0])
+			}
+			if len(tt.interval) == 1 {
+				t.Setenv("SHARD_REBALANCER_INTERVAL_SECONDS", tt.interval[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expectedEnabled, conf.ShardRebalancer.Enabled)
+				require.Equal(t, tt.expectedInterval, conf.ShardRebalancer.IntervalSeconds)
+			}
+		})
+	}
+}
+
+func TestEnvironmentAntiEntropy(t *testing.T) {
+	factors := []struct {
+		name              string
+		enabled           []string
+		interval          []string
+		bandwidth         []string
+		expectedEnabled   bool
+		expectedInterval  int
+		expectedBandwidth int
+		expectedErr       bool
+	}{
+		{"not given", []string{}, []string{}, []string{}, false, DefaultAntiEntropyIntervalSeconds, DefaultAntiEntropyMaxBytesPerSecond, false},
+		{"enabled", []string{"true"}, []string{}, []string{}, true, DefaultAntiEntropyIntervalSeconds, DefaultAntiEntropyMaxBytesPerSecond, false},
+		{"enabled with limits", []string{"on"}, []string{"60"}, []string{"1024"}, true, 60, 1024, false},
+		{"zero interval", []string{"true"}, []string{"0"}, []string{}, false, -1, -1, true},
+		{"zero bandwidth", []string{"true"}, []string{}, []string{"0"}, false, -1, -1, true},
+		{"not parsable", []string{"true"}, []string{}, []string{"I'm not a number"}, false, -1, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.enabled) == 1 {
+				t.Setenv("ANTI_ENTROPY_ENABLED", tt.enabled[0])
+			}
+			if len(tt.interval) == 1 {
+				t.Setenv("ANTI_ENTROPY_INTERVAL_SECONDS", tt.interval[0])
+			}
+			if len(tt.bandwidth) == 1 {
+				t.Setenv("ANTI_ENTROPY_MAX_BYTES_PER_SECOND", tt.bandwidth[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expectedEnabled, conf.AntiEntropy.Enabled)
+				require.Equal(t, tt.expectedInterval, conf.AntiEntropy.IntervalSeconds)
+				require.Equal(t, tt.expectedBandwidth, conf.AntiEntropy.MaxBytesPerSecond)
+			}
+		})
+	}
+}
+
+func TestEnvironmentHintedHandoff(t *testing.T) {
+	factors := []struct {
+		name             string
+		enabled          []string
+		maxHints         []string
+		interval         []string
+		expectedEnabled  bool
+		expectedMaxHints int
+		expectedInterval int
+		expectedErr      bool
+	}{
+		{"not given", []string{}, []string{}, []string{}, false, DefaultHintedHandoffMaxHints, DefaultHintedHandoffReplayIntervalSeconds, false},
+		{"enabled", []string{"true"}, []string{}, []string{}, true, DefaultHintedHandoffMaxHints, DefaultHintedHandoffReplayIntervalSeconds, false},
+		{"enabled with limits", []string{"on"}, []string{"500"}, []string{"30"}, true, 500, 30, false},
+		{"zero max hints", []string{"true"}, []string{"0"}, []string{}, false, -1, -1, true},
+		{"zero interval", []string{"true"}, []string{}, []string{"0"}, false, -1, -1, true},
+		{"not parsable", []string{"true"}, []string{"I'm not a number"}, []string{}, false, -1, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.enabled) == 1 {
+				t.Setenv("HINTED_HANDOFF_ENABLED", tt.enabled[0])
+			}
+			if len(tt.maxHints) == 1 {
+				t.Setenv("HINTED_HANDOFF_MAX_HINTS", tt.maxHints[0])
+			}
+			if len(tt.interval) == 1 {
+				t.Setenv("HINTED_HANDOFF_REPLAY_INTERVAL_SECONDS", tt.interval[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expectedEnabled, conf.HintedHandoff.Enabled)
+				require.Equal(t, tt.expectedMaxHints, conf.HintedHandoff.MaxHints)
+				require.Equal(t, tt.expectedInterval, conf.HintedHandoff.ReplayIntervalSeconds)
+			}
+		})
+	}
+}
+
+func TestEnvironmentVectorJobsTimeout(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    int
+		expectedErr bool
+	}{
+		{"not given", []string{}, DefaultVectorJobsTimeoutSeconds, false},
+		{"given", []string{"3600"}, 3600, false},
+		{"zero", []string{"0"}, -1, true},
+		{"not parsable", []string{"I'm not a number"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("VECTOR_JOBS_TIMEOUT_SECONDS", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.VectorJobs.TimeoutSeconds)
+			}
+		})
+	}
+}
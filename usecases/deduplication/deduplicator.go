@@ -0,0 +1,232 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package deduplication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+type Deduplicator struct {
+	schemaGetter schemaUC.SchemaGetter
+	repo         Repo
+	vectorRepo   VectorRepo
+	objects      ObjectsManager
+	authorizer   authorizer
+	timeout      time.Duration
+	logger       logrus.FieldLogger
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// New Deduplicator. A deduplication fails if it has not completed within
+// timeout.
+func New(sg schemaUC.SchemaGetter, dr Repo, vr VectorRepo, om ObjectsManager,
+	authorizer authorizer, timeout time.Duration, logger logrus.FieldLogger,
+) *Deduplicator {
+	return &Deduplicator{
+		logger:       logger,
+		schemaGetter: sg,
+		repo:         dr,
+		vectorRepo:   vr,
+		objects:      om,
+		authorizer:   authorizer,
+		timeout:      timeout,
+	}
+}
+
+// Repo to manage deduplication state and results, should be consistent, not
+// used to store actual data objects, see VectorRepo
+type Repo interface {
+	Put(ctx context.Context, deduplication models.Deduplication) error
+	Get(ctx context.Context, id strfmt.UUID) (*models.Deduplication, error)
+	PutResult(ctx context.Context, id strfmt.UUID,
+		result models.DeduplicationResult) error
+	GetResult(ctx context.Context, id strfmt.UUID) (*models.DeduplicationResult, error)
+}
+
+// VectorRepo finds the duplicates in the vector index
+type VectorRepo interface {
+	// FindDuplicates returns groups of objects ordered by creation time,
+	// oldest first. All objects of a group are within maxDistance of the
+	// oldest one.
+	FindDuplicates(ctx context.Context, className string,
+		maxDistance float32) ([][]strfmt.UUID, error)
+}
+
+// ObjectsManager applies the chosen action to the duplicates. Going through
+// it rather than the vector repo subjects every write to the same
+// authorization and routing as a write made by the user.
+type ObjectsManager interface {
+	DeleteObject(ctx context.Context, principal *models.Principal, class string,
+		id strfmt.UUID, repl *additional.ReplicationProperties) error
+	AddObjectReference(ctx context.Context, principal *models.Principal,
+		input *objects.AddReferenceInput,
+		repl *additional.ReplicationProperties) *objects.Error
+}
+
+func (d *Deduplicator) Schedule(ctx context.Context, principal *models.Principal,
+	params models.Deduplication,
+) (*models.Deduplication, error) {
+	err := d.authorizer.Authorize(principal, "create", "deduplications/*")
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Action == "" {
+		params.Action = models.DeduplicationActionNone
+	}
+
+	if err := d.validate(params); err != nil {
+		return nil, err
+	}
+
+	if err := d.authorizeAction(principal, params); err != nil {
+		return nil, err
+	}
+
+	if err := d.assignNewID(&params); err != nil {
+		return nil, fmt.Errorf("deduplication: assign id: %v", err)
+	}
+
+	params.Status = models.DeduplicationStatusRunning
+	params.Meta = &models.DeduplicationMeta{
+		Started: strfmt.DateTime(time.Now()),
+	}
+
+	if err := d.repo.Put(ctx, params); err != nil {
+		return nil, fmt.Errorf("deduplication: put: %v", err)
+	}
+
+	// asynchronously trigger the deduplication
+	go d.run(principal, params)
+
+	return &params, nil
+}
+
+func (d *Deduplicator) Get(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID,
+) (*models.Deduplication, error) {
+	err := d.authorizer.Authorize(principal, "get", "deduplications/*")
+	if err != nil {
+		return nil, err
+	}
+
+	return d.repo.Get(ctx, id)
+}
+
+// GetResult returns the groups of duplicates of a completed deduplication,
+// or nil if the deduplication does not exist or has not completed yet
+func (d *Deduplicator) GetResult(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID,
+) (*models.DeduplicationResult, error) {
+	err := d.authorizer.Authorize(principal, "get", "deduplications/*")
+	if err != nil {
+		return nil, err
+	}
+
+	return d.repo.GetResult(ctx, id)
+}
+
+// authorizeAction makes sure the principal may apply the chosen action to
+// the objects of the class
+func (d *Deduplicator) authorizeAction(principal *models.Principal,
+	params models.Deduplication,
+) error {
+	path := fmt.Sprintf("objects/%s", params.Class)
+	switch params.Action {
+	case models.DeduplicationActionDelete:
+		return d.authorizer.Authorize(principal, "delete", path)
+	case models.DeduplicationActionReference:
+		return d.authorizer.Authorize(principal, "update", path)
+	default:
+		return nil
+	}
+}
+
+func (d *Deduplicator) validate(params models.Deduplication) error {
+	if params.Class == "" {
+		return fmt.Errorf("field 'class' cannot be empty")
+	}
+
+	sch := d.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(params.Class))
+	if class == nil {
+		return fmt.Errorf("class '%s' not found in schema", params.Class)
+	}
+
+	if params.Distance == nil {
+		return fmt.Errorf("field 'distance' must be set")
+	}
+	if *params.Distance < 0 {
+		return fmt.Errorf("field 'distance' cannot be negative, got %v",
+			*params.Distance)
+	}
+
+	switch params.Action {
+	case models.DeduplicationActionNone, models.DeduplicationActionDelete:
+		if params.ReferenceProperty != "" {
+			return fmt.Errorf("field 'referenceProperty' can only be set "+
+				"with action '%s'", models.DeduplicationActionReference)
+		}
+		return nil
+	case models.DeduplicationActionReference:
+		return validateReferenceProperty(class, params.ReferenceProperty)
+	default:
+		return fmt.Errorf("unsupported action '%s'", params.Action)
+	}
+}
+
+// validateReferenceProperty makes sure the property can point to objects of
+// the deduplicated class itself
+func validateReferenceProperty(class *models.Class, propName string) error {
+	if propName == "" {
+		return fmt.Errorf("field 'referenceProperty' must be set with action '%s'",
+			models.DeduplicationActionReference)
+	}
+
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return fmt.Errorf("field 'referenceProperty': %v", err)
+	}
+
+	for _, dataType := range prop.DataType {
+		if dataType == class.Class {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("field 'referenceProperty': property '%s' must be a "+
+		"reference to class '%s', got %v", propName, class.Class, prop.DataType)
+}
+
+func (d *Deduplicator) assignNewID(params *models.Deduplication) error {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+
+	params.ID = strfmt.UUID(id.String())
+	return nil
+}
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package deduplication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// the contents of this file deal with a single deduplication run, from
+// searching the duplicates to storing the final result
+
+func (d *Deduplicator) run(principal *models.Principal, params models.Deduplication) {
+	ctx, cancel := contextWithTimeout(d.timeout)
+	defer cancel()
+
+	go d.monitorDeduplication(ctx, cancel, schema.ClassName(params.Class))
+
+	d.logBegin(params)
+	groups, err := d.vectorRepo.FindDuplicates(ctx, params.Class,
+		float32(*params.Distance))
+	if err != nil {
+		d.failRunWithError(params, errors.Wrap(err, "find duplicates"))
+		return
+	}
+	d.logGroupsFound(params, len(groups))
+
+	result := models.DeduplicationResult{
+		Groups: make([]*models.DeduplicationGroup, len(groups)),
+	}
+	duplicates := 0
+	for i, group := range groups {
+		result.Groups[i] = &models.DeduplicationGroup{
+			Keep:       group[0],
+			Duplicates: group[1:],
+		}
+		duplicates += len(group) - 1
+	}
+
+	if err := d.applyAction(ctx, principal, params, result.Groups); err != nil {
+		d.failRunWithError(params, err)
+		return
+	}
+
+	if err := d.repo.PutResult(ctx, params.ID, result); err != nil {
+		d.failRunWithError(params, errors.Wrap(err, "store result"))
+		return
+	}
+
+	params.Meta.Completed = strfmt.DateTime(time.Now())
+	params.Meta.Groups = int64(len(groups))
+	params.Meta.Duplicates = int64(duplicates)
+	d.succeedRun(params)
+}
+
+func (d *Deduplicator) monitorDeduplication(ctx context.Context,
+	cancelFn context.CancelFunc, className schema.ClassName,
+) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			schema := d.schemaGetter.GetSchemaSkipAuth()
+			class := schema.FindClassByName(className)
+			if class == nil {
+				cancelFn()
+				return
+			}
+		}
+	}
+}
+
+// applyAction deletes or references all but the oldest object of every
+// group, depending on the chosen action. Only duplicates of the oldest
+// object are part of a group, so an object is never deleted because it is
+// close to another duplicate only.
+func (d *Deduplicator) applyAction(ctx context.Context, principal *models.Principal,
+	params models.Deduplication, groups []*models.DeduplicationGroup,
+) error {
+	switch params.Action {
+	case models.DeduplicationActionDelete:
+		for _, group := range groups {
+			for _, id := range group.Duplicates {
+				err := d.objects.DeleteObject(ctx, principal, params.Class, id, nil)
+				var errNotFound objects.ErrNotFound
+				if err != nil && !errors.As(err, &errNotFound) {
+					return errors.Wrapf(err, "delete duplicate %s", id)
+				}
+			}
+		}
+	case models.DeduplicationActionReference:
+		for _, group := range groups {
+			ref := crossref.NewLocalhost(params.Class, group.Keep).SingleRef()
+			for _, id := range group.Duplicates {
+				err := d.objects.AddObjectReference(ctx, principal, &objects.AddReferenceInput{
+					Class:    params.Class,
+					ID:       id,
+					Property: params.ReferenceProperty,
+					Ref:      *ref,
+				}, nil)
+				if err != nil && !err.NotFound() {
+					return errors.Wrapf(err, "reference duplicate %s", id)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (d *Deduplicator) succeedRun(params models.Deduplication) {
+	params.Status = models.DeduplicationStatusCompleted
+	ctx, cancel := contextWithTimeout(2 * time.Second)
+	defer cancel()
+	err := d.repo.Put(ctx, params)
+	if err != nil {
+		d.logExecutionError("store succeeded run", err, params)
+	}
+	d.logFinish(params)
+}
+
+func (d *Deduplicator) failRunWithError(params models.Deduplication, err error) {
+	params.Status = models.DeduplicationStatusFailed
+	params.Error = fmt.Sprintf("deduplication failed: %v", err)
+	err = d.repo.Put(context.Background(), params)
+	if err != nil {
+		d.logExecutionError("store failed run", err, params)
+	}
+	d.logFinish(params)
+}
+
+func contextWithTimeout(d time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), d)
+}
+
+// Logging helper methods
+func (d *Deduplicator) logBase(params models.Deduplication, event string) *logrus.Entry {
+	return d.logger.WithField("action", "deduplication_run").
+		WithField("event", event).
+		WithField("deduplication_id", params.ID).
+		WithField("class", params.Class).
+		WithField("deduplication_action", params.Action)
+}
+
+func (d *Deduplicator) logBegin(params models.Deduplication) {
+	d.logBase(params, "deduplication_begin").
+		WithField("distance", *params.Distance).
+		Debug("deduplication started")
+}
+
+func (d *Deduplicator) logFinish(params models.Deduplication) {
+	d.logBase(params, "deduplication_finish").
+		WithField("status", params.Status).
+		Debug("deduplication finished")
+}
+
+func (d *Deduplicator) logGroupsFound(params models.Deduplication, count int) {
+	d.logBase(params, "deduplication_groups_found").
+		WithField("group_count", count).
+		Debug("found groups of duplicates")
+}
+
+func (d *Deduplicator) logExecutionError(event string, err error, params models.Deduplication) {
+	d.logBase(params, event).
+		WithError(err).
+		Error("deduplication failed")
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package deduplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	testhelper "github.com/weaviate/weaviate/test/helper"
+)
+
+func testSchema() schema.Schema {
+	return schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Article",
+					Properties: []*models.Property{
+						{
+							Name:     "duplicateOf",
+							DataType: []string{"Article"},
+						},
+						{
+							Name:     "title",
+							DataType: schema.DataTypeText.PropString(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var testGroups = [][]strfmt.UUID{
+	{
+		"00000000-0000-0000-0000-000000000001",
+		"00000000-0000-0000-0000-000000000002",
+		"00000000-0000-0000-0000-000000000003",
+	},
+	{
+		"00000000-0000-0000-0000-000000000004",
+		"00000000-0000-0000-0000-000000000005",
+	},
+}
+
+func Test_Deduplicator(t *testing.T) {
+	schedule := func(t *testing.T, params models.Deduplication,
+	) (*Deduplicator, *fakeObjectsManager, *models.Deduplication) {
+		objectsManager := newFakeObjectsManager()
+		logger, _ := test.NewNullLogger()
+		deduplicator := New(&fakeSchemaGetter{testSchema()},
+			newFakeDeduplicationRepo(), newFakeVectorRepo(testGroups),
+			objectsManager, &fakeAuthorizer{}, time.Minute, logger)
+
+		res, err := deduplicator.Schedule(context.Background(), nil, params)
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, models.DeduplicationStatusRunning, res.Status)
+
+		deduplication := waitForStatusToNoLongerBeRunning(t, deduplicator, res.ID)
+		require.Equal(t, models.DeduplicationStatusCompleted, deduplication.Status,
+			deduplication.Error)
+		assert.Equal(t, int64(2), deduplication.Meta.Groups)
+		assert.Equal(t, int64(3), deduplication.Meta.Duplicates)
+
+		return deduplicator, objectsManager, deduplication
+	}
+
+	t.Run("without action", func(t *testing.T) {
+		deduplicator, objectsManager, deduplication := schedule(t, models.Deduplication{
+			Class:    "Article",
+			Distance: ptFloat64(0.01),
+		})
+		assert.Equal(t, models.DeduplicationActionNone, deduplication.Action)
+
+		result, err := deduplicator.GetResult(context.Background(), nil, deduplication.ID)
+		require.Nil(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, []*models.DeduplicationGroup{
+			{
+				Keep:       testGroups[0][0],
+				Duplicates: testGroups[0][1:],
+			},
+			{
+				Keep:       testGroups[1][0],
+				Duplicates: testGroups[1][1:],
+			},
+		}, result.Groups)
+		assert.Empty(t, objectsManager.deleted)
+		assert.Empty(t, objectsManager.references)
+	})
+
+	t.Run("with action delete", func(t *testing.T) {
+		_, objectsManager, _ := schedule(t, models.Deduplication{
+			Class:    "Article",
+			Distance: ptFloat64(0.01),
+			Action:   models.DeduplicationActionDelete,
+		})
+
+		assert.ElementsMatch(t, []strfmt.UUID{
+			testGroups[0][1], testGroups[0][2], testGroups[1][1],
+		}, objectsManager.deleted)
+	})
+
+	t.Run("with action reference", func(t *testing.T) {
+		_, objectsManager, _ := schedule(t, models.Deduplication{
+			Class:             "Article",
+			Distance:          ptFloat64(0.01),
+			Action:            models.DeduplicationActionReference,
+			ReferenceProperty: "duplicateOf",
+		})
+
+		first := "weaviate://localhost/Article/" + testGroups[0][0].String()
+		second := "weaviate://localhost/Article/" + testGroups[1][0].String()
+		assert.Equal(t, map[strfmt.UUID]string{
+			testGroups[0][1]: first,
+			testGroups[0][2]: first,
+			testGroups[1][1]: second,
+		}, objectsManager.references)
+	})
+}
+
+func Test_Deduplicator_Authorization(t *testing.T) {
+	tests := []struct {
+		name      string
+		action    string
+		forbidden map[string]string
+	}{
+		{
+			name:      "create deduplications",
+			action:    models.DeduplicationActionNone,
+			forbidden: map[string]string{"create": "deduplications/*"},
+		},
+		{
+			name:      "delete objects of the class",
+			action:    models.DeduplicationActionDelete,
+			forbidden: map[string]string{"delete": "objects/Article"},
+		},
+		{
+			name:      "update objects of the class",
+			action:    models.DeduplicationActionReference,
+			forbidden: map[string]string{"update": "objects/Article"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objectsManager := newFakeObjectsManager()
+			deduplicator := New(&fakeSchemaGetter{testSchema()},
+				newFakeDeduplicationRepo(), newFakeVectorRepo(testGroups),
+				objectsManager, &fakeAuthorizer{forbidden: test.forbidden},
+				time.Minute, nil)
+
+			params := models.Deduplication{
+				Class:    "Article",
+				Distance: ptFloat64(0.01),
+				Action:   test.action,
+			}
+			if test.action == models.DeduplicationActionReference {
+				params.ReferenceProperty = "duplicateOf"
+			}
+			_, err := deduplicator.Schedule(context.Background(), nil, params)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), "forbidden")
+		})
+	}
+}
+
+func Test_Deduplicator_Validation(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      models.Deduplication
+		expectedErr string
+	}{
+		{
+			name:        "missing class",
+			params:      models.Deduplication{Distance: ptFloat64(0.1)},
+			expectedErr: "field 'class' cannot be empty",
+		},
+		{
+			name:        "unknown class",
+			params:      models.Deduplication{Class: "Foo", Distance: ptFloat64(0.1)},
+			expectedErr: "class 'Foo' not found in schema",
+		},
+		{
+			name:        "distance not set",
+			params:      models.Deduplication{Class: "Article"},
+			expectedErr: "field 'distance' must be set",
+		},
+		{
+			name:        "negative distance",
+			params:      models.Deduplication{Class: "Article", Distance: ptFloat64(-1)},
+			expectedErr: "field 'distance' cannot be negative",
+		},
+		{
+			name: "unknown action",
+			params: models.Deduplication{
+				Class: "Article", Distance: ptFloat64(0.1), Action: "merge",
+			},
+			expectedErr: "unsupported action 'merge'",
+		},
+		{
+			name: "reference property without reference action",
+			params: models.Deduplication{
+				Class: "Article", Distance: ptFloat64(0.1),
+				ReferenceProperty: "duplicateOf",
+			},
+			expectedErr: "field 'referenceProperty' can only be set with action 'reference'",
+		},
+		{
+			name: "reference action without property",
+			params: models.Deduplication{
+				Class: "Article", Distance: ptFloat64(0.1),
+				Action: models.DeduplicationActionReference,
+			},
+			expectedErr: "field 'referenceProperty' must be set",
+		},
+		{
+			name: "reference property not a reference to the class",
+			params: models.Deduplication{
+				Class: "Article", Distance: ptFloat64(0.1),
+				Action:            models.DeduplicationActionReference,
+				ReferenceProperty: "title",
+			},
+			expectedErr: "property 'title' must be a reference to class 'Article'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deduplicator := New(&fakeSchemaGetter{testSchema()},
+				newFakeDeduplicationRepo(), newFakeVectorRepo(nil),
+				newFakeObjectsManager(), &fakeAuthorizer{}, time.Minute, nil)
+
+			_, err := deduplicator.Schedule(context.Background(), nil, test.params)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func waitForStatusToNoLongerBeRunning(t *testing.T, deduplicator *Deduplicator,
+	id strfmt.UUID,
+) *models.Deduplication {
+	var deduplication *models.Deduplication
+	testhelper.AssertEventuallyEqualWithFrequencyAndTimeout(t, true, func() interface{} {
+		var err error
+		deduplication, err = deduplicator.Get(context.Background(), nil, id)
+		require.Nil(t, err)
+		require.NotNil(t, deduplication)
+
+		return deduplication.Status != models.DeduplicationStatusRunning
+	}, 100*time.Millisecond, 20*time.Second, "wait until status in no longer running")
+	return deduplication
+}
+
+func ptFloat64(in float64) *float64 {
+	return &in
+}
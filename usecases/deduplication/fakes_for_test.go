@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package deduplication
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeSchemaGetter struct {
+	schema schema.Schema
+}
+
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+func (f *fakeSchemaGetter) ShardingState(class string) *sharding.State {
+	panic("not implemented")
+}
+
+func (f *fakeSchemaGetter) Nodes() []string {
+	panic("not implemented")
+}
+
+func (f *fakeSchemaGetter) NodeName() string {
+	panic("not implemented")
+}
+
+func (f *fakeSchemaGetter) ClusterHealthScore() int {
+	panic("not implemented")
+}
+
+func (f *fakeSchemaGetter) ResolveParentNodes(string, string,
+) (map[string]string, error) {
+	panic("not implemented")
+}
+
+type fakeDeduplicationRepo struct {
+	sync.Mutex
+	db      map[strfmt.UUID]models.Deduplication
+	results map[strfmt.UUID]models.DeduplicationResult
+}
+
+func newFakeDeduplicationRepo() *fakeDeduplicationRepo {
+	return &fakeDeduplicationRepo{
+		db:      map[strfmt.UUID]models.Deduplication{},
+		results: map[strfmt.UUID]models.DeduplicationResult{},
+	}
+}
+
+func (f *fakeDeduplicationRepo) Put(ctx context.Context, deduplication models.Deduplication) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.db[deduplication.ID] = deduplication
+	return nil
+}
+
+func (f *fakeDeduplicationRepo) Get(ctx context.Context, id strfmt.UUID) (*models.Deduplication, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	deduplication, ok := f.db[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &deduplication, nil
+}
+
+func (f *fakeDeduplicationRepo) PutResult(ctx context.Context, id strfmt.UUID,
+	result models.DeduplicationResult,
+) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.results[id] = result
+	return nil
+}
+
+func (f *fakeDeduplicationRepo) GetResult(ctx context.Context,
+	id strfmt.UUID,
+) (*models.DeduplicationResult, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	result, ok := f.results[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &result, nil
+}
+
+// fakeVectorRepo returns the configured groups
+type fakeVectorRepo struct {
+	groups [][]strfmt.UUID
+}
+
+func newFakeVectorRepo(groups [][]strfmt.UUID) *fakeVectorRepo {
+	return &fakeVectorRepo{groups: groups}
+}
+
+func (f *fakeVectorRepo) FindDuplicates(ctx context.Context, className string,
+	maxDistance float32,
+) ([][]strfmt.UUID, error) {
+	return f.groups, nil
+}
+
+// fakeObjectsManager records every deletion and reference
+type fakeObjectsManager struct {
+	sync.Mutex
+	deleted    []strfmt.UUID
+	references map[strfmt.UUID]string
+}
+
+func newFakeObjectsManager() *fakeObjectsManager {
+	return &fakeObjectsManager{references: map[strfmt.UUID]string{}}
+}
+
+func (f *fakeObjectsManager) DeleteObject(ctx context.Context,
+	principal *models.Principal, class string, id strfmt.UUID,
+	repl *additional.ReplicationProperties,
+) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+func (f *fakeObjectsManager) AddObjectReference(ctx context.Context,
+	principal *models.Principal, input *objects.AddReferenceInput,
+	repl *additional.ReplicationProperties,
+) *objects.Error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.references[input.ID] = input.Ref.Beacon.String()
+	return nil
+}
+
+// fakeAuthorizer allows everything but the given verb and resource pairs
+type fakeAuthorizer struct {
+	forbidden map[string]string
+}
+
+func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if r, ok := f.forbidden[verb]; ok && r == resource {
+		return fmt.Errorf("forbidden: %s %s", verb, resource)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package deduplication
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const (
+	TransactionPut       cluster.TransactionType = "put_single"
+	TransactionPutResult cluster.TransactionType = "put_result"
+)
+
+type TransactionPutPayload struct {
+	Deduplication models.Deduplication `json:"deduplication"`
+}
+
+type TransactionPutResultPayload struct {
+	ID     strfmt.UUID                `json:"id"`
+	Result models.DeduplicationResult `json:"result"`
+}
+
+func UnmarshalTransaction(txType cluster.TransactionType,
+	payload json.RawMessage,
+) (interface{}, error) {
+	switch txType {
+	case TransactionPut:
+		return unmarshalPut(payload)
+	case TransactionPutResult:
+		return unmarshalPutResult(payload)
+
+	default:
+		return nil, errors.Errorf("unrecognized deduplication transaction type %q", txType)
+
+	}
+}
+
+func unmarshalPut(payload json.RawMessage) (interface{}, error) {
+	var pl TransactionPutPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+func unmarshalPutResult(payload json.RawMessage) (interface{}, error) {
+	var pl TransactionPutResultPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
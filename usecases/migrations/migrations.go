@@ -0,0 +1,588 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package migrations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	DefaultTxTTL = 60 * time.Second
+	pageSize     = 100
+
+	// maxReleaseBackoff is the longest pause between two attempts to release
+	// the writes held back on the nodes
+	maxReleaseBackoff = 30 * time.Second
+)
+
+var (
+	// ErrInvalidMigration the requested migration cannot be performed
+	ErrInvalidMigration = errors.New("invalid class migration")
+	// ErrMigrationNotFound no migration with the given id exists on this node
+	ErrMigrationNotFound = errors.New("class migration not found")
+
+	errAborted = errors.New("class migration aborted")
+)
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type schemaManager interface {
+	GetSchemaSkipAuth() schema.Schema
+	AddClass(ctx context.Context, principal *models.Principal, class *models.Class) error
+	DeleteClass(ctx context.Context, principal *models.Principal, class string, force bool) error
+	SwapClass(ctx context.Context, alias, class, drop string) error
+	ResolveAlias(name string) string
+}
+
+type objectsManager interface {
+	GetObject(ctx context.Context, principal *models.Principal, class string,
+		id strfmt.UUID, additional additional.Properties,
+		repl *additional.ReplicationProperties) (*models.Object, error)
+	DeleteObject(ctx context.Context, principal *models.Principal, class string,
+		id strfmt.UUID, repl *additional.ReplicationProperties) error
+	Query(ctx context.Context, principal *models.Principal,
+		params *objects.QueryParams) ([]*models.Object, *objects.Error)
+}
+
+type batchManager interface {
+	AddObjects(ctx context.Context, principal *models.Principal, objects []*models.Object,
+		fields []*string, repl *additional.ReplicationProperties) (objects.BatchObjects, error)
+}
+
+// Manager migrates classes to a new definition while they are in use.
+//
+// A class is migrated in the following steps:
+//   - A shadow class with the new definition is created
+//   - Every node starts mirroring writes to the class into the shadow class
+//   - All objects are copied into the shadow class in the background,
+//     properties are renamed and converted on the way. Objects are
+//     re-vectorized if the vectorizer configuration has changed.
+//   - Writes are held back on every node while the class name is pointed to
+//     the shadow class using an alias and the original class is dropped
+//
+// Migrations are tracked in memory by the node which started them.
+type Manager struct {
+	authorizer authorizer
+	schema     schemaManager
+	objects    objectsManager
+	batch      batchManager
+	txRemote   *cluster.TxManager
+	logger     logrus.FieldLogger
+
+	sync.Mutex
+	migrations map[string]*migration
+	gates      map[string]*gate // by class
+}
+
+type migration struct {
+	status *models.ClassMigration
+	mirror *mirror
+	paused bool
+	abort  bool
+	wake   chan struct{}
+}
+
+// NewManager returns a new instance of Manager
+func NewManager(remoteClient cluster.Client, memberLister cluster.MemberLister,
+	authorizer authorizer, schema schemaManager, logger logrus.FieldLogger,
+) *Manager {
+	broadcaster := cluster.NewTxBroadcaster(memberLister, remoteClient)
+	m := &Manager{
+		authorizer: authorizer,
+		schema:     schema,
+		txRemote:   cluster.NewTxManager(broadcaster, logger),
+		logger:     logger,
+		migrations: make(map[string]*migration),
+		gates:      make(map[string]*gate),
+	}
+	m.txRemote.SetCommitFn(m.incomingCommit)
+	return m
+}
+
+// SetObjectsManagers sets the managers objects are read and written with.
+// They are set separately, as they route their writes through the Manager.
+func (m *Manager) SetObjectsManagers(objects objectsManager, batch batchManager) {
+	m.objects = objects
+	m.batch = batch
+}
+
+// Create starts migrating a class in the background and returns its status
+func (m *Manager) Create(ctx context.Context, principal *models.Principal,
+	req *models.ClassMigration,
+) (*models.ClassMigration, error) {
+	if err := m.authorizer.Authorize(principal, "update", "schema/objects"); err != nil {
+		return nil, err
+	}
+	if req == nil || req.Class == "" || req.Definition == nil {
+		return nil, fmt.Errorf("%w: class and definition are required", ErrInvalidMigration)
+	}
+
+	alias := req.Class
+	source := m.class(m.schema.ResolveAlias(alias))
+	if source == nil {
+		return nil, fmt.Errorf("%w: class %q not found", ErrInvalidMigration, alias)
+	}
+	if m.gate(source.Class).migrating() {
+		return nil, fmt.Errorf("%w: class %q is already being migrated",
+			ErrInvalidMigration, alias)
+	}
+
+	shadow, err := copyClass(req.Definition)
+	if err != nil {
+		return nil, err
+	}
+	shadow.Class = fmt.Sprintf("%s_%d", alias, time.Now().UnixMilli())
+	if _, err := newTransformation(source, shadow, req.PropertyRenames); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMigration, err)
+	}
+	if err := m.schema.AddClass(ctx, principal, shadow); err != nil {
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: create shadow class: %v", ErrInvalidMigration, err)
+	}
+
+	// the transformation depends on the defaults set when adding the class
+	if created := m.class(shadow.Class); created != nil {
+		shadow = created
+	}
+	transform, err := newTransformation(source, shadow, req.PropertyRenames)
+	if err != nil {
+		m.dropShadow(ctx, principal, shadow.Class)
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMigration, err)
+	}
+
+	mg := &migration{
+		status: &models.ClassMigration{
+			ID:              uuid.New().String(),
+			Class:           alias,
+			Definition:      req.Definition,
+			PropertyRenames: req.PropertyRenames,
+			ShadowClass:     shadow.Class,
+			Status:          models.ClassMigrationStatusSTARTED,
+			StartTimeUnix:   time.Now().UnixMilli(),
+		},
+		mirror: &mirror{
+			source:    source.Class,
+			shadow:    shadow.Class,
+			transform: transform,
+			principal: principal,
+		},
+		wake: make(chan struct{}, 1),
+	}
+	mg.mirror.id = mg.status.ID
+
+	m.Lock()
+	m.migrations[mg.status.ID] = mg
+	result := copyStatus(mg.status)
+	m.Unlock()
+
+	if err := m.broadcast(ctx, TransactionStartMigration, TransactionStartMigrationPayload{
+		ID:             mg.mirror.id,
+		Source:         mg.mirror.source,
+		Shadow:         mg.mirror.shadow,
+		Transformation: transform,
+		Principal:      principal,
+	}); err != nil {
+		m.stop(ctx, mg)
+		m.finish(mg, models.ClassMigrationStatusFAILED, err)
+		return nil, err
+	}
+
+	go m.run(context.Background(), mg)
+
+	return result, nil
+}
+
+// Get returns the status of a migration
+func (m *Manager) Get(ctx context.Context, principal *models.Principal,
+	id string,
+) (*models.ClassMigration, error) {
+	if err := m.authorizer.Authorize(principal, "list", "schema/*"); err != nil {
+		return nil, err
+	}
+	m.Lock()
+	defer m.Unlock()
+	mg, ok := m.migrations[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrMigrationNotFound, id)
+	}
+	return copyStatus(mg.status), nil
+}
+
+// List returns the status of all migrations, most recent first
+func (m *Manager) List(ctx context.Context, principal *models.Principal,
+) ([]*models.ClassMigration, error) {
+	if err := m.authorizer.Authorize(principal, "list", "schema/*"); err != nil {
+		return nil, err
+	}
+	m.Lock()
+	defer m.Unlock()
+	out := make([]*models.ClassMigration, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		out = append(out, copyStatus(mg.status))
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].StartTimeUnix != out[j].StartTimeUnix {
+			return out[i].StartTimeUnix > out[j].StartTimeUnix
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out, nil
+}
+
+// Pause stops copying objects once the current page is copied. Writes are
+// still mirrored while the migration is paused.
+func (m *Manager) Pause(ctx context.Context, principal *models.Principal,
+	id string,
+) (*models.ClassMigration, error) {
+	return m.control(principal, id, func(mg *migration) {
+		mg.paused = true
+	})
+}
+
+// Resume continues copying objects of a paused migration
+func (m *Manager) Resume(ctx context.Context, principal *models.Principal,
+	id string,
+) (*models.ClassMigration, error) {
+	return m.control(principal, id, func(mg *migration) {
+		mg.paused = false
+	})
+}
+
+// Abort stops a migration and drops its shadow class. A migration can no
+// longer be aborted once the shadow class is being swapped in.
+func (m *Manager) Abort(ctx context.Context, principal *models.Principal,
+	id string,
+) (*models.ClassMigration, error) {
+	return m.control(principal, id, func(mg *migration) {
+		mg.abort = true
+	})
+}
+
+// control changes the state of a migration which is copying objects
+func (m *Manager) control(principal *models.Principal, id string,
+	change func(mg *migration),
+) (*models.ClassMigration, error) {
+	if err := m.authorizer.Authorize(principal, "update", "schema/objects"); err != nil {
+		return nil, err
+	}
+	m.Lock()
+	defer m.Unlock()
+	mg, ok := m.migrations[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrMigrationNotFound, id)
+	}
+	switch mg.status.Status {
+	case models.ClassMigrationStatusSTARTED, models.ClassMigrationStatusCOPYING,
+		models.ClassMigrationStatusPAUSED:
+	default:
+		return nil, fmt.Errorf("%w: migration %q is %s", ErrInvalidMigration,
+			id, mg.status.Status)
+	}
+	change(mg)
+	select {
+	case mg.wake <- struct{}{}:
+	default:
+	}
+	return copyStatus(mg.status), nil
+}
+
+// run copies all objects and swaps in the shadow class
+func (m *Manager) run(ctx context.Context, mg *migration) {
+	err := m.copyAll(ctx, mg)
+	if err == nil {
+		err = m.swap(ctx, mg)
+		if err == nil {
+			m.finish(mg, models.ClassMigrationStatusSUCCESS, nil)
+			return
+		}
+	}
+
+	m.stop(ctx, mg)
+	if errors.Is(err, errAborted) {
+		m.finish(mg, models.ClassMigrationStatusABORTED, nil)
+		return
+	}
+	m.finish(mg, models.ClassMigrationStatusFAILED, err)
+}
+
+// copyAll copies all objects of the source class page by page
+func (m *Manager) copyAll(ctx context.Context, mg *migration) error {
+	after := ""
+	for {
+		if m.wait(mg) {
+			return errAborted
+		}
+		n, last, err := m.copyPage(ctx, mg.mirror, after)
+		if err != nil {
+			return err
+		}
+		m.Lock()
+		mg.status.ObjectsCopied += int64(n)
+		m.Unlock()
+		if n < pageSize {
+			return nil
+		}
+		after = last
+	}
+}
+
+// wait blocks while the migration is paused and reports if it was aborted
+func (m *Manager) wait(mg *migration) bool {
+	for {
+		m.Lock()
+		if mg.abort {
+			m.Unlock()
+			return true
+		}
+		if !mg.paused {
+			mg.status.Status = models.ClassMigrationStatusCOPYING
+			m.Unlock()
+			return false
+		}
+		mg.status.Status = models.ClassMigrationStatusPAUSED
+		m.Unlock()
+		<-mg.wake
+	}
+}
+
+// copyPage copies the objects following the one with id after. Mirrored
+// writes are held back meanwhile, so that an outdated version of an object
+// never overwrites a more recent one.
+func (m *Manager) copyPage(ctx context.Context, mi *mirror,
+	after string,
+) (int, string, error) {
+	mi.Lock()
+	defer mi.Unlock()
+
+	limit := int64(pageSize)
+	params := &objects.QueryParams{
+		Class:      mi.source,
+		Limit:      &limit,
+		Additional: additional.Properties{Vector: true},
+	}
+	if after != "" {
+		params.After = &after
+	}
+	objs, qerr := m.objects.Query(ctx, mi.principal, params)
+	if qerr != nil {
+		return 0, "", fmt.Errorf("read objects of class %q: %w", mi.source, qerr)
+	}
+	if len(objs) == 0 {
+		return 0, "", nil
+	}
+	if err := m.copyObjects(ctx, mi, objs); err != nil {
+		return 0, "", err
+	}
+	for _, obj := range objs {
+		delete(mi.failed, obj.ID)
+	}
+	return len(objs), objs[len(objs)-1].ID.String(), nil
+}
+
+// swap holds back writes on every node while the alias is pointed to the
+// shadow class and the source class is dropped. Every node mirrors the
+// writes which failed to be mirrored before once writes are held back, the
+// swap fails if that is not possible.
+func (m *Manager) swap(ctx context.Context, mg *migration) error {
+	m.Lock()
+	mg.status.Status = models.ClassMigrationStatusSWAPPING
+	alias := mg.status.Class
+	m.Unlock()
+
+	mi := mg.mirror
+	if err := m.broadcast(ctx, TransactionFreezeMigration, TransactionFreezeMigrationPayload{
+		ID: mi.id, Source: mi.source,
+	}); err != nil {
+		return err
+	}
+	if err := m.schema.SwapClass(ctx, alias, mi.shadow, mi.source); err != nil {
+		return fmt.Errorf("swap class: %w", err)
+	}
+	m.release(ctx, mi)
+	return nil
+}
+
+// stop ends mirroring writes and drops the shadow class
+func (m *Manager) stop(ctx context.Context, mg *migration) {
+	mi := mg.mirror
+	m.release(ctx, mi)
+	m.dropShadow(ctx, mi.principal, mi.shadow)
+}
+
+// release stops mirroring writes on every node, which releases the writes
+// held back by a freeze as well. Nodes would hold back writes forever
+// otherwise, so it is retried until it succeeds. Nodes which are down are
+// skipped, they don't keep any state about the migration after a restart.
+func (m *Manager) release(ctx context.Context, mi *mirror) {
+	payload := TransactionStopMigrationPayload{ID: mi.id, Source: mi.source}
+	backoff := time.Second
+	for {
+		err := m.broadcastTolerateNodeFailures(ctx, TransactionStopMigration, payload)
+		if err == nil {
+			return
+		}
+		m.logger.WithField("action", "class_migration").
+			WithField("migration", mi.id).
+			Warnf("release writes, retrying in %s: %v", backoff, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxReleaseBackoff {
+			backoff = maxReleaseBackoff
+		}
+	}
+}
+
+func (m *Manager) dropShadow(ctx context.Context, principal *models.Principal, class string) {
+	if err := m.schema.DeleteClass(ctx, principal, class, true); err != nil {
+		m.logger.WithField("action", "class_migration").
+			WithField("class", class).Error(err)
+	}
+}
+
+func (m *Manager) finish(mg *migration, status string, err error) {
+	m.Lock()
+	defer m.Unlock()
+	mg.status.Status = status
+	mg.status.CompletionTimeUnix = time.Now().UnixMilli()
+	if err != nil {
+		mg.status.Error = err.Error()
+		m.logger.WithField("action", "class_migration").
+			WithField("migration", mg.status.ID).Error(err)
+	}
+}
+
+func (m *Manager) class(name string) *models.Class {
+	sch := m.schema.GetSchemaSkipAuth()
+	return sch.FindClassByName(schema.ClassName(name))
+}
+
+func (m *Manager) broadcast(ctx context.Context,
+	txType cluster.TransactionType, payload interface{},
+) error {
+	tx, err := m.txRemote.BeginTransaction(ctx, txType, payload, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+	return m.commit(ctx, tx, payload)
+}
+
+// broadcastTolerateNodeFailures is like broadcast, but only the nodes which
+// are alive need to take part in the transaction
+func (m *Manager) broadcastTolerateNodeFailures(ctx context.Context,
+	txType cluster.TransactionType, payload interface{},
+) error {
+	tx, err := m.txRemote.BeginTransactionTolerateNodeFailures(ctx, txType,
+		payload, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+	return m.commit(ctx, tx, payload)
+}
+
+func (m *Manager) commit(ctx context.Context, tx *cluster.Transaction,
+	payload interface{},
+) error {
+	err := m.txRemote.CommitWriteTransaction(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("commit cluster-wide transaction: %w", err)
+	}
+
+	// the transaction is only committed on remote nodes
+	return m.apply(tx.Type, payload)
+}
+
+func (m *Manager) incomingCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	return m.apply(tx.Type, tx.Payload)
+}
+
+func (m *Manager) apply(txType cluster.TransactionType, payload interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTxTTL)
+	defer cancel()
+
+	switch txType {
+	case TransactionStartMigration:
+		pl := payload.(TransactionStartMigrationPayload)
+		return m.gate(pl.Source).setMirror(ctx, &mirror{
+			id:        pl.ID,
+			source:    pl.Source,
+			shadow:    pl.Shadow,
+			transform: pl.Transformation,
+			principal: pl.Principal,
+		})
+	case TransactionFreezeMigration:
+		pl := payload.(TransactionFreezeMigrationPayload)
+		g := m.gate(pl.Source)
+		mi, err := g.freeze(ctx, pl.ID)
+		if err != nil {
+			return err
+		}
+		// writes stay held back until the migration is stopped, which
+		// happens once the swap completed or failed
+		if err := m.retryFailed(ctx, mi); err != nil {
+			g.thaw(pl.ID)
+			return err
+		}
+		return nil
+	case TransactionStopMigration:
+		pl := payload.(TransactionStopMigrationPayload)
+		m.gate(pl.Source).unsetMirror(pl.ID)
+		return nil
+	default:
+		return fmt.Errorf("unrecognized tx type: %s", txType)
+	}
+}
+
+func (m *Manager) TxManager() *cluster.TxManager {
+	return m.txRemote
+}
+
+func copyStatus(status *models.ClassMigration) *models.ClassMigration {
+	cp := *status
+	return &cp
+}
+
+func copyClass(class *models.Class) (*models.Class, error) {
+	raw, err := json.Marshal(class)
+	if err != nil {
+		return nil, err
+	}
+	var cp models.Class
+	if err := json.Unmarshal(raw, &cp); err != nil {
+		return nil, err
+	}
+	return &cp, nil
+}
@@ -0,0 +1,448 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+type fakeAuthorizer struct{}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return nil
+}
+
+type fakeMembers struct{}
+
+func (f *fakeMembers) AllNames() []string  { return []string{"node1"} }
+func (f *fakeMembers) Hostnames() []string { return nil }
+
+type fakeSchema struct {
+	sync.Mutex
+	classes map[string]*models.Class
+	aliases map[string]string
+}
+
+func (f *fakeSchema) GetSchemaSkipAuth() schema.Schema {
+	f.Lock()
+	defer f.Unlock()
+	sch := &models.Schema{}
+	for _, class := range f.classes {
+		sch.Classes = append(sch.Classes, class)
+	}
+	return schema.Schema{Objects: sch}
+}
+
+func (f *fakeSchema) AddClass(ctx context.Context, principal *models.Principal,
+	class *models.Class,
+) error {
+	f.Lock()
+	defer f.Unlock()
+	f.classes[class.Class] = class
+	return nil
+}
+
+func (f *fakeSchema) DeleteClass(ctx context.Context, principal *models.Principal,
+	class string, force bool,
+) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.classes, class)
+	return nil
+}
+
+func (f *fakeSchema) SwapClass(ctx context.Context, alias, class, drop string) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.classes, drop)
+	f.aliases[alias] = class
+	return nil
+}
+
+func (f *fakeSchema) ResolveAlias(name string) string {
+	f.Lock()
+	defer f.Unlock()
+	if class, ok := f.aliases[name]; ok {
+		return class
+	}
+	return name
+}
+
+// fakeObjects stores objects per class. If pages is set, every query waits
+// for a value on it. Deleting an object marked as failing returns an error.
+type fakeObjects struct {
+	sync.Mutex
+	classes map[string]map[strfmt.UUID]*models.Object
+	pages   chan struct{}
+	failing map[strfmt.UUID]bool
+}
+
+func (f *fakeObjects) fail(id strfmt.UUID, fail bool) {
+	f.Lock()
+	defer f.Unlock()
+	if f.failing == nil {
+		f.failing = map[strfmt.UUID]bool{}
+	}
+	f.failing[id] = fail
+}
+
+func (f *fakeObjects) put(obj *models.Object) {
+	f.Lock()
+	defer f.Unlock()
+	if f.classes[obj.Class] == nil {
+		f.classes[obj.Class] = map[strfmt.UUID]*models.Object{}
+	}
+	f.classes[obj.Class][obj.ID] = obj
+}
+
+func (f *fakeObjects) remove(class string, id strfmt.UUID) {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.classes[class], id)
+}
+
+func (f *fakeObjects) get(class string, id strfmt.UUID) *models.Object {
+	f.Lock()
+	defer f.Unlock()
+	return f.classes[class][id]
+}
+
+func (f *fakeObjects) count(class string) int {
+	f.Lock()
+	defer f.Unlock()
+	return len(f.classes[class])
+}
+
+func (f *fakeObjects) GetObject(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, additional additional.Properties,
+	repl *additional.ReplicationProperties,
+) (*models.Object, error) {
+	if obj := f.get(class, id); obj != nil {
+		return obj, nil
+	}
+	return nil, objects.NewErrNotFound("no object with id '%s'", id)
+}
+
+func (f *fakeObjects) DeleteObject(ctx context.Context, principal *models.Principal,
+	class string, id strfmt.UUID, repl *additional.ReplicationProperties,
+) error {
+	f.Lock()
+	failing := f.failing[id]
+	f.Unlock()
+	if failing {
+		return fmt.Errorf("delete object %v: unavailable", id)
+	}
+	if f.get(class, id) == nil {
+		return objects.NewErrNotFound("object %v could not be found", id)
+	}
+	f.remove(class, id)
+	return nil
+}
+
+func (f *fakeObjects) Query(ctx context.Context, principal *models.Principal,
+	params *objects.QueryParams,
+) ([]*models.Object, *objects.Error) {
+	if f.pages != nil {
+		<-f.pages
+	}
+	f.Lock()
+	defer f.Unlock()
+	ids := make([]string, 0, len(f.classes[params.Class]))
+	for id := range f.classes[params.Class] {
+		if params.After == nil || id.String() > *params.After {
+			ids = append(ids, id.String())
+		}
+	}
+	sort.Strings(ids)
+	if len(ids) > int(*params.Limit) {
+		ids = ids[:*params.Limit]
+	}
+	out := make([]*models.Object, len(ids))
+	for i, id := range ids {
+		out[i] = f.classes[params.Class][strfmt.UUID(id)]
+	}
+	return out, nil
+}
+
+func (f *fakeObjects) AddObjects(ctx context.Context, principal *models.Principal,
+	objs []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+) (objects.BatchObjects, error) {
+	res := make(objects.BatchObjects, len(objs))
+	for i, obj := range objs {
+		f.put(obj)
+		res[i] = objects.BatchObject{OriginalIndex: i, Object: obj, UUID: obj.ID}
+	}
+	return res, nil
+}
+
+func newTestManager(t *testing.T, count int) (*Manager, *fakeSchema, *fakeObjects) {
+	sch := &fakeSchema{
+		classes: map[string]*models.Class{
+			"Article": {
+				Class:      "Article",
+				Vectorizer: "none",
+				Properties: []*models.Property{
+					{Name: "title", DataType: schema.DataTypeText.PropString()},
+					{Name: "count", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		},
+		aliases: map[string]string{},
+	}
+	objs := &fakeObjects{classes: map[string]map[strfmt.UUID]*models.Object{}}
+	for i := 0; i < count; i++ {
+		objs.put(&models.Object{
+			Class: "Article",
+			ID:    uuidOf(i),
+			Properties: map[string]interface{}{
+				"title": fmt.Sprintf("article %d", i),
+				"count": fmt.Sprint(i),
+			},
+			Vector: []float32{float32(i)},
+		})
+	}
+
+	logger, _ := test.NewNullLogger()
+	m := NewManager(nil, &fakeMembers{}, &fakeAuthorizer{}, sch, logger)
+	m.SetObjectsManagers(objs, objs)
+	return m, sch, objs
+}
+
+func newMigration() *models.ClassMigration {
+	return &models.ClassMigration{
+		Class: "Article",
+		Definition: &models.Class{
+			Class:      "Article",
+			Vectorizer: "none",
+			Properties: []*models.Property{
+				{Name: "headline", DataType: schema.DataTypeText.PropString()},
+				{Name: "count", DataType: schema.DataTypeInt.PropString()},
+			},
+		},
+		PropertyRenames: map[string]string{"title": "headline"},
+	}
+}
+
+func uuidOf(i int) strfmt.UUID {
+	return strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+}
+
+func waitForStatus(t *testing.T, m *Manager, id, status string) *models.ClassMigration {
+	var res *models.ClassMigration
+	require.Eventually(t, func() bool {
+		var err error
+		res, err = m.Get(context.Background(), nil, id)
+		require.Nil(t, err)
+		return res.Status == status
+	}, 5*time.Second, 5*time.Millisecond)
+	return res
+}
+
+func TestMigration(t *testing.T) {
+	ctx := context.Background()
+	m, sch, objs := newTestManager(t, 250)
+
+	res, err := m.Create(ctx, nil, newMigration())
+	require.Nil(t, err)
+	assert.Equal(t, models.ClassMigrationStatusSTARTED, res.Status)
+	shadow := res.ShadowClass
+
+	res = waitForStatus(t, m, res.ID, models.ClassMigrationStatusSUCCESS)
+	assert.Equal(t, int64(250), res.ObjectsCopied)
+	assert.NotZero(t, res.CompletionTimeUnix)
+
+	assert.Equal(t, shadow, sch.ResolveAlias("Article"))
+	assert.Nil(t, m.class("Article"))
+	assert.Equal(t, 250, objs.count(shadow))
+	obj := objs.get(shadow, uuidOf(42))
+	assert.Equal(t, map[string]interface{}{
+		"headline": "article 42",
+		"count":    float64(42),
+	}, obj.Properties)
+	assert.Equal(t, models.C11yVector{42}, obj.Vector)
+
+	target, done := m.RouteWrite(ctx, "Article")
+	done()
+	assert.Equal(t, shadow, target)
+
+	list, err := m.List(ctx, nil)
+	require.Nil(t, err)
+	assert.Len(t, list, 1)
+}
+
+func TestMigrationMirrorsWrites(t *testing.T) {
+	ctx := context.Background()
+	m, _, objs := newTestManager(t, 10)
+	objs.pages = make(chan struct{})
+
+	res, err := m.Create(ctx, nil, newMigration())
+	require.Nil(t, err)
+	shadow := res.ShadowClass
+
+	t.Run("write", func(t *testing.T) {
+		target, done := m.RouteWrite(ctx, "Article")
+		assert.Equal(t, "Article", target)
+		objs.put(&models.Object{
+			Class:      "Article",
+			ID:         uuidOf(100),
+			Properties: map[string]interface{}{"title": "new", "count": "1"},
+		})
+		done(uuidOf(100))
+
+		obj := objs.get(shadow, uuidOf(100))
+		require.NotNil(t, obj)
+		assert.Equal(t, map[string]interface{}{
+			"headline": "new",
+			"count":    float64(1),
+		}, obj.Properties)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, done := m.RouteWrite(ctx, "Article")
+		objs.remove("Article", uuidOf(100))
+		done(uuidOf(100))
+
+		assert.Nil(t, objs.get(shadow, uuidOf(100)))
+	})
+
+	close(objs.pages)
+	waitForStatus(t, m, res.ID, models.ClassMigrationStatusSUCCESS)
+	assert.Equal(t, 10, objs.count(shadow))
+}
+
+func TestMigrationRetriesFailedMirrors(t *testing.T) {
+	ctx := context.Background()
+
+	// mirrorFailedDelete writes an object and deletes it again, the delete
+	// cannot be mirrored into the shadow class
+	mirrorFailedDelete := func(t *testing.T, m *Manager, objs *fakeObjects, shadow string) {
+		_, done := m.RouteWrite(ctx, "Article")
+		objs.put(&models.Object{
+			Class:      "Article",
+			ID:         uuidOf(100),
+			Properties: map[string]interface{}{"title": "new", "count": "1"},
+		})
+		done(uuidOf(100))
+		require.NotNil(t, objs.get(shadow, uuidOf(100)))
+
+		objs.fail(uuidOf(100), true)
+		_, done = m.RouteWrite(ctx, "Article")
+		objs.remove("Article", uuidOf(100))
+		done(uuidOf(100))
+		require.NotNil(t, objs.get(shadow, uuidOf(100)))
+	}
+
+	t.Run("retried before the swap", func(t *testing.T) {
+		m, sch, objs := newTestManager(t, 10)
+		objs.pages = make(chan struct{})
+		res, err := m.Create(ctx, nil, newMigration())
+		require.Nil(t, err)
+		shadow := res.ShadowClass
+
+		mirrorFailedDelete(t, m, objs, shadow)
+		objs.fail(uuidOf(100), false)
+		close(objs.pages)
+
+		waitForStatus(t, m, res.ID, models.ClassMigrationStatusSUCCESS)
+		assert.Equal(t, shadow, sch.ResolveAlias("Article"))
+		assert.Nil(t, objs.get(shadow, uuidOf(100)))
+		assert.Equal(t, 10, objs.count(shadow))
+	})
+
+	t.Run("fails the migration", func(t *testing.T) {
+		m, sch, objs := newTestManager(t, 10)
+		objs.pages = make(chan struct{})
+		res, err := m.Create(ctx, nil, newMigration())
+		require.Nil(t, err)
+
+		mirrorFailedDelete(t, m, objs, res.ShadowClass)
+		close(objs.pages)
+
+		waitForStatus(t, m, res.ID, models.ClassMigrationStatusFAILED)
+		assert.Equal(t, "Article", sch.ResolveAlias("Article"))
+		assert.Nil(t, m.class(res.ShadowClass))
+
+		// writes are released once the migration stopped
+		target, done := m.RouteWrite(ctx, "Article")
+		done()
+		assert.Equal(t, "Article", target)
+	})
+}
+
+func TestMigrationPauseAndAbort(t *testing.T) {
+	ctx := context.Background()
+	m, sch, objs := newTestManager(t, 250)
+	objs.pages = make(chan struct{})
+
+	res, err := m.Create(ctx, nil, newMigration())
+	require.Nil(t, err)
+
+	_, err = m.Pause(ctx, nil, res.ID)
+	require.Nil(t, err)
+	res = waitForStatus(t, m, res.ID, models.ClassMigrationStatusPAUSED)
+	assert.Zero(t, res.ObjectsCopied)
+
+	_, err = m.Resume(ctx, nil, res.ID)
+	require.Nil(t, err)
+	objs.pages <- struct{}{}
+	res = waitForStatus(t, m, res.ID, models.ClassMigrationStatusCOPYING)
+
+	_, err = m.Abort(ctx, nil, res.ID)
+	require.Nil(t, err)
+	close(objs.pages)
+	res = waitForStatus(t, m, res.ID, models.ClassMigrationStatusABORTED)
+	assert.Less(t, res.ObjectsCopied, int64(250))
+
+	assert.Nil(t, m.class(res.ShadowClass))
+	assert.Equal(t, "Article", sch.ResolveAlias("Article"))
+	target, done := m.RouteWrite(ctx, "Article")
+	done()
+	assert.Equal(t, "Article", target)
+
+	_, err = m.Resume(ctx, nil, res.ID)
+	assert.ErrorIs(t, err, ErrInvalidMigration)
+	_, err = m.Get(ctx, nil, "unknown")
+	assert.ErrorIs(t, err, ErrMigrationNotFound)
+}
+
+func TestMigrationValidation(t *testing.T) {
+	ctx := context.Background()
+	m, _, _ := newTestManager(t, 0)
+
+	t.Run("unknown class", func(t *testing.T) {
+		req := newMigration()
+		req.Class = "Unknown"
+		_, err := m.Create(ctx, nil, req)
+		assert.ErrorIs(t, err, ErrInvalidMigration)
+	})
+
+	t.Run("unsupported conversion", func(t *testing.T) {
+		req := newMigration()
+		req.Definition.Properties[1].DataType = schema.DataTypeGeoCoordinates.PropString()
+		_, err := m.Create(ctx, nil, req)
+		assert.ErrorIs(t, err, ErrInvalidMigration)
+	})
+}
@@ -0,0 +1,296 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// mirror copies writes to the source class of a migration into its shadow
+// class
+type mirror struct {
+	id        string
+	source    string
+	shadow    string
+	transform *Transformation
+	principal *models.Principal
+
+	// serializes copying objects into the shadow class, so that an object
+	// copied in the background never overwrites a more recent write
+	sync.Mutex
+	// failed holds the ids of the objects which could not be mirrored. They
+	// are mirrored again before the shadow class is swapped in.
+	failed map[strfmt.UUID]struct{}
+}
+
+// gate tracks the writes in flight to a class. It can be closed to hold
+// back new writes until the ones in flight are drained.
+type gate struct {
+	sync.Mutex
+	writes  int
+	opened  chan struct{} // nil while the gate is open
+	drained chan struct{} // closed once no write is in flight anymore
+	mirror  *mirror
+}
+
+// enter registers a write. If the gate is closed, it waits until the gate is
+// opened again and returns false, as the class might have been swapped.
+func (g *gate) enter(ctx context.Context) (*mirror, bool, error) {
+	g.Lock()
+	if g.opened == nil {
+		g.writes++
+		mi := g.mirror
+		g.Unlock()
+		return mi, true, nil
+	}
+	opened := g.opened
+	g.Unlock()
+
+	select {
+	case <-opened:
+		return nil, false, nil
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+}
+
+func (g *gate) leave() {
+	g.Lock()
+	defer g.Unlock()
+	g.writes--
+	if g.writes == 0 && g.drained != nil {
+		close(g.drained)
+		g.drained = nil
+	}
+}
+
+// close holds back new writes and waits for the writes in flight. The gate
+// is opened again if they don't complete before ctx expires.
+func (g *gate) close(ctx context.Context) error {
+	g.Lock()
+	if g.opened == nil {
+		g.opened = make(chan struct{})
+	}
+	drained := g.drained
+	if g.writes > 0 && drained == nil {
+		drained = make(chan struct{})
+		g.drained = drained
+	}
+	g.Unlock()
+
+	if drained == nil {
+		return nil
+	}
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		g.open()
+		return ctx.Err()
+	}
+}
+
+func (g *gate) open() {
+	g.Lock()
+	defer g.Unlock()
+	if g.opened != nil {
+		close(g.opened)
+		g.opened = nil
+	}
+}
+
+// setMirror starts mirroring writes once the writes in flight are drained
+func (g *gate) setMirror(ctx context.Context, mi *mirror) error {
+	if err := g.close(ctx); err != nil {
+		return err
+	}
+	defer g.open()
+
+	g.Lock()
+	defer g.Unlock()
+	if g.mirror != nil && g.mirror.id != mi.id {
+		return fmt.Errorf("class %q is already being migrated", mi.source)
+	}
+	g.mirror = mi
+	return nil
+}
+
+// unsetMirror stops mirroring writes and releases writes held back
+func (g *gate) unsetMirror(id string) {
+	g.Lock()
+	if g.mirror == nil || g.mirror.id != id {
+		g.Unlock()
+		return
+	}
+	g.mirror = nil
+	g.Unlock()
+	g.open()
+}
+
+// freeze holds back writes until the mirror is unset or thawed. It returns
+// the mirror of the migration.
+func (g *gate) freeze(ctx context.Context, id string) (*mirror, error) {
+	g.Lock()
+	mi := g.mirror
+	g.Unlock()
+	if mi == nil || mi.id != id {
+		return nil, fmt.Errorf("migration %q is not running", id)
+	}
+	return mi, g.close(ctx)
+}
+
+// thaw releases writes held back by freeze
+func (g *gate) thaw(id string) {
+	if g.mirroring(id) {
+		g.open()
+	}
+}
+
+func (g *gate) mirroring(id string) bool {
+	g.Lock()
+	defer g.Unlock()
+	return g.mirror != nil && g.mirror.id == id
+}
+
+func (g *gate) migrating() bool {
+	g.Lock()
+	defer g.Unlock()
+	return g.mirror != nil
+}
+
+// Route returns the class reads of class are applied to
+func (m *Manager) Route(class string) string {
+	return m.schema.ResolveAlias(class)
+}
+
+// RouteWrite returns the class writes to class are applied to. Writes to a
+// class which is being migrated are mirrored into its shadow class once
+// done is called. While the shadow class is swapped in, writes are held back.
+func (m *Manager) RouteWrite(ctx context.Context,
+	class string,
+) (string, func(ids ...strfmt.UUID)) {
+	for {
+		target := m.schema.ResolveAlias(class)
+		g := m.gate(target)
+		mi, ok, err := g.enter(ctx)
+		if err != nil {
+			// the write is bound to fail on the expired context as well
+			return target, func(...strfmt.UUID) {}
+		}
+		if !ok {
+			continue // the alias might point to another class by now
+		}
+		return target, func(ids ...strfmt.UUID) {
+			defer g.leave()
+			if mi != nil {
+				m.mirrorObjects(ctx, mi, ids)
+			}
+		}
+	}
+}
+
+func (m *Manager) gate(class string) *gate {
+	m.Lock()
+	defer m.Unlock()
+	g, ok := m.gates[class]
+	if !ok {
+		g = &gate{}
+		m.gates[class] = g
+	}
+	return g
+}
+
+// mirrorObjects copies the current state of the objects from the source into
+// the shadow class. Objects which cannot be copied are recorded to be
+// retried before the swap.
+func (m *Manager) mirrorObjects(ctx context.Context, mi *mirror, ids []strfmt.UUID) {
+	mi.Lock()
+	defer mi.Unlock()
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		if err := m.mirrorObject(ctx, mi, id); err != nil {
+			m.logger.WithField("action", "class_migration_mirror").
+				WithField("migration", mi.id).
+				WithField("id", id).Error(err)
+			if mi.failed == nil {
+				mi.failed = make(map[strfmt.UUID]struct{})
+			}
+			mi.failed[id] = struct{}{}
+			continue
+		}
+		delete(mi.failed, id)
+	}
+}
+
+// retryFailed mirrors the objects which could not be mirrored before. It
+// returns an error if any of them still cannot be mirrored.
+func (m *Manager) retryFailed(ctx context.Context, mi *mirror) error {
+	mi.Lock()
+	defer mi.Unlock()
+	for id := range mi.failed {
+		if err := m.mirrorObject(ctx, mi, id); err != nil {
+			return fmt.Errorf("mirror object %s: %w", id, err)
+		}
+		delete(mi.failed, id)
+	}
+	return nil
+}
+
+func (m *Manager) mirrorObject(ctx context.Context, mi *mirror, id strfmt.UUID) error {
+	obj, err := m.objects.GetObject(ctx, mi.principal, mi.source, id,
+		additional.Properties{Vector: true}, nil)
+	if err != nil {
+		var notFound objects.ErrNotFound
+		if !errors.As(err, &notFound) {
+			return err
+		}
+		err = m.objects.DeleteObject(ctx, mi.principal, mi.shadow, id, nil)
+		if err != nil && !errors.As(err, &notFound) {
+			return err
+		}
+		return nil
+	}
+	return m.copyObjects(ctx, mi, []*models.Object{obj})
+}
+
+// copyObjects writes the transformed objects into the shadow class
+func (m *Manager) copyObjects(ctx context.Context, mi *mirror, objs []*models.Object) error {
+	batch := make([]*models.Object, len(objs))
+	for i, obj := range objs {
+		transformed, err := mi.transform.apply(obj, mi.shadow)
+		if err != nil {
+			return fmt.Errorf("object %s: %w", obj.ID, err)
+		}
+		batch[i] = transformed
+	}
+
+	res, err := m.batch.AddObjects(ctx, mi.principal, batch, nil, nil)
+	if err != nil {
+		return err
+	}
+	for _, obj := range res {
+		if obj.Err != nil {
+			return fmt.Errorf("object %s: %w", obj.UUID, obj.Err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package migrations
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const (
+	TransactionStartMigration  cluster.TransactionType = "start_migration"
+	TransactionFreezeMigration cluster.TransactionType = "freeze_migration"
+	TransactionStopMigration   cluster.TransactionType = "stop_migration"
+)
+
+// TransactionStartMigrationPayload lets every node mirror writes to the
+// source class into the shadow class
+type TransactionStartMigrationPayload struct {
+	ID             string            `json:"id"`
+	Source         string            `json:"source"`
+	Shadow         string            `json:"shadow"`
+	Transformation *Transformation   `json:"transformation"`
+	Principal      *models.Principal `json:"principal"`
+}
+
+// TransactionFreezeMigrationPayload holds back writes to the source class
+// while the shadow class is swapped in
+type TransactionFreezeMigrationPayload struct {
+	ID     string `json:"id"`
+	Source string `json:"source"`
+}
+
+// TransactionStopMigrationPayload ends mirroring writes and releases writes
+// which have been held back
+type TransactionStopMigrationPayload struct {
+	ID     string `json:"id"`
+	Source string `json:"source"`
+}
+
+func UnmarshalMigrationTransaction(txType cluster.TransactionType,
+	payload json.RawMessage,
+) (interface{}, error) {
+	switch txType {
+	case TransactionStartMigration:
+		var pl TransactionStartMigrationPayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case TransactionFreezeMigration:
+		var pl TransactionFreezeMigrationPayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case TransactionStopMigration:
+		var pl TransactionStopMigrationPayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	default:
+		return nil, errors.Errorf("unrecognized migration transaction type %q", txType)
+	}
+}
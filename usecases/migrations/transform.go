@@ -0,0 +1,313 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package migrations
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// Transformation turns objects of the source class of a migration into
+// objects of its shadow class. It is computed by the node starting the
+// migration and shipped to all other nodes, so that every node mirrors
+// writes the same way.
+type Transformation struct {
+	Properties []PropertyTransformation `json:"properties"`
+
+	// KeepVectors is set if source and shadow class are vectorized the same
+	// way. Otherwise vectors are dropped, so objects are re-vectorized.
+	KeepVectors bool `json:"keepVectors"`
+}
+
+// PropertyTransformation copies a property of the source class into a
+// property of the shadow class, converting its value if the data types
+// differ
+type PropertyTransformation struct {
+	From     string   `json:"from"`
+	To       string   `json:"to"`
+	FromType []string `json:"fromType"`
+	ToType   []string `json:"toType"`
+}
+
+// conversions lists the data types a value can be converted to in addition
+// to its own type
+var conversions = map[schema.DataType][]schema.DataType{
+	schema.DataTypeText: {
+		schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeBoolean,
+		schema.DataTypeDate, schema.DataTypeUUID,
+	},
+	schema.DataTypeInt:     {schema.DataTypeText, schema.DataTypeNumber},
+	schema.DataTypeNumber:  {schema.DataTypeText, schema.DataTypeInt},
+	schema.DataTypeBoolean: {schema.DataTypeText},
+	schema.DataTypeDate:    {schema.DataTypeText},
+	schema.DataTypeUUID:    {schema.DataTypeText},
+}
+
+// newTransformation determines how objects of source are turned into objects
+// of shadow. Properties are matched by name unless renamed, properties which
+// are not part of shadow anymore are dropped.
+func newTransformation(source, shadow *models.Class,
+	renames map[string]string,
+) (*Transformation, error) {
+	targets := make(map[string]string, len(renames))
+	renamed := make(map[string]string, len(renames))
+	for from, to := range renames {
+		from, to = schema.LowercaseFirstLetter(from), schema.LowercaseFirstLetter(to)
+		if findProperty(source, from) == nil {
+			return nil, fmt.Errorf("renamed property %q does not exist in class %q",
+				from, source.Class)
+		}
+		if other, ok := targets[to]; ok {
+			return nil, fmt.Errorf("properties %q and %q are both renamed to %q",
+				other, from, to)
+		}
+		targets[to] = from
+		renamed[from] = to
+	}
+
+	t := &Transformation{
+		KeepVectors: keepVectors(source, shadow),
+	}
+	for _, prop := range source.Properties {
+		to, ok := renamed[prop.Name]
+		if !ok {
+			if _, ok := targets[prop.Name]; ok {
+				continue // name is taken by a renamed property
+			}
+			to = prop.Name
+		}
+		target := findProperty(shadow, to)
+		if target == nil {
+			continue
+		}
+		if !convertible(prop.DataType, target.DataType) {
+			return nil, fmt.Errorf("property %q: cannot convert data type %v to %v",
+				prop.Name, prop.DataType, target.DataType)
+		}
+		t.Properties = append(t.Properties, PropertyTransformation{
+			From:     prop.Name,
+			To:       target.Name,
+			FromType: prop.DataType,
+			ToType:   target.DataType,
+		})
+	}
+	return t, nil
+}
+
+// apply returns the object of class shadow which corresponds to obj
+func (t *Transformation) apply(obj *models.Object, shadow string) (*models.Object, error) {
+	props, _ := obj.Properties.(map[string]interface{})
+	out := make(map[string]interface{}, len(t.Properties))
+	for _, pt := range t.Properties {
+		value, ok := props[pt.From]
+		if !ok || value == nil {
+			continue
+		}
+		value, err := jsonValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", pt.From, err)
+		}
+		converted, err := convert(value, pt.FromType, pt.ToType)
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", pt.From, err)
+		}
+		out[pt.To] = converted
+	}
+
+	res := &models.Object{
+		Class:      shadow,
+		ID:         obj.ID,
+		Properties: out,
+	}
+	if t.KeepVectors {
+		res.Vector = obj.Vector
+	}
+	return res, nil
+}
+
+// keepVectors determines whether vectors of the source class are valid in
+// the shadow class. Classes without vectorizer always keep their vectors, as
+// they cannot be recomputed.
+func keepVectors(source, shadow *models.Class) bool {
+	if source.Vectorizer == "none" || source.Vectorizer == "" {
+		return true
+	}
+	return source.Vectorizer == shadow.Vectorizer &&
+		reflect.DeepEqual(source.ModuleConfig, shadow.ModuleConfig)
+}
+
+func findProperty(class *models.Class, name string) *models.Property {
+	for _, prop := range class.Properties {
+		if prop.Name == name {
+			return prop
+		}
+	}
+	return nil
+}
+
+// baseType returns the data type of single values of dt and whether dt is an
+// array type. Deprecated string types are treated as text.
+func baseType(dt []string) (schema.DataType, bool) {
+	if len(dt) == 1 {
+		switch schema.DataType(dt[0]) {
+		case schema.DataTypeText, schema.DataTypeString:
+			return schema.DataTypeText, false
+		case schema.DataTypeTextArray, schema.DataTypeStringArray:
+			return schema.DataTypeText, true
+		case schema.DataTypeIntArray:
+			return schema.DataTypeInt, true
+		case schema.DataTypeNumberArray:
+			return schema.DataTypeNumber, true
+		case schema.DataTypeBooleanArray:
+			return schema.DataTypeBoolean, true
+		case schema.DataTypeDateArray:
+			return schema.DataTypeDate, true
+		case schema.DataTypeUUIDArray:
+			return schema.DataTypeUUID, true
+		default:
+			return schema.DataType(dt[0]), false
+		}
+	}
+	return schema.DataType(fmt.Sprint(dt)), false
+}
+
+// convertible checks whether values of data type from can be converted to
+// data type to. Single values can be turned into arrays, but not vice versa.
+func convertible(from, to []string) bool {
+	fromBase, fromArray := baseType(from)
+	toBase, toArray := baseType(to)
+	if fromArray && !toArray {
+		return false
+	}
+	if fromBase == toBase {
+		return true
+	}
+	for _, dt := range conversions[fromBase] {
+		if dt == toBase {
+			return true
+		}
+	}
+	return false
+}
+
+// convert converts value of data type from to data type to. The value must
+// be in its JSON representation.
+func convert(value interface{}, from, to []string) (interface{}, error) {
+	fromBase, fromArray := baseType(from)
+	toBase, toArray := baseType(to)
+	switch {
+	case fromArray && toArray:
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected an array, but got %T", value)
+		}
+		out := make([]interface{}, len(items))
+		for i, item := range items {
+			converted, err := convertValue(item, fromBase, toBase)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = converted
+		}
+		return out, nil
+	case !fromArray && toArray:
+		converted, err := convertValue(value, fromBase, toBase)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{converted}, nil
+	case fromArray && !toArray:
+		return nil, fmt.Errorf("cannot convert an array to a single value")
+	default:
+		return convertValue(value, fromBase, toBase)
+	}
+}
+
+func convertValue(value interface{}, from, to schema.DataType) (interface{}, error) {
+	if from == to {
+		return value, nil
+	}
+
+	switch typed := value.(type) {
+	case string:
+		switch to {
+		case schema.DataTypeText:
+			return typed, nil
+		case schema.DataTypeInt:
+			i, err := strconv.ParseInt(typed, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert %q to an integer", typed)
+			}
+			return float64(i), nil
+		case schema.DataTypeNumber:
+			f, err := strconv.ParseFloat(typed, 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert %q to a number", typed)
+			}
+			return f, nil
+		case schema.DataTypeBoolean:
+			b, err := strconv.ParseBool(typed)
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert %q to a boolean", typed)
+			}
+			return b, nil
+		case schema.DataTypeDate:
+			if _, err := time.Parse(time.RFC3339Nano, typed); err != nil {
+				return nil, fmt.Errorf("cannot convert %q to a RFC3339 date", typed)
+			}
+			return typed, nil
+		case schema.DataTypeUUID:
+			if _, err := uuid.Parse(typed); err != nil {
+				return nil, fmt.Errorf("cannot convert %q to a uuid", typed)
+			}
+			return typed, nil
+		}
+	case float64:
+		switch to {
+		case schema.DataTypeText:
+			return strconv.FormatFloat(typed, 'f', -1, 64), nil
+		case schema.DataTypeNumber:
+			return typed, nil
+		case schema.DataTypeInt:
+			if typed != float64(int64(typed)) {
+				return nil, fmt.Errorf("cannot convert %v to an integer", typed)
+			}
+			return typed, nil
+		}
+	case bool:
+		if to == schema.DataTypeText {
+			return strconv.FormatBool(typed), nil
+		}
+	}
+	return nil, fmt.Errorf("cannot convert %v of type %s to %s", value, from, to)
+}
+
+// jsonValue returns the JSON representation of a property value as read
+// from the database, which is what writes expect
+func jsonValue(value interface{}) (interface{}, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package migrations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestTransformation(t *testing.T) {
+	source := &models.Class{
+		Class:      "Article",
+		Vectorizer: "text2vec-contextionary",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "count", DataType: schema.DataTypeText.PropString()},
+			{Name: "rating", DataType: schema.DataTypeInt.PropString()},
+			{Name: "tag", DataType: schema.DataTypeText.PropString()},
+			{Name: "obsolete", DataType: schema.DataTypeBoolean.PropString()},
+		},
+	}
+	shadow := &models.Class{
+		Class:      "Article_1",
+		Vectorizer: "text2vec-contextionary",
+		Properties: []*models.Property{
+			{Name: "headline", DataType: schema.DataTypeText.PropString()},
+			{Name: "count", DataType: schema.DataTypeInt.PropString()},
+			{Name: "rating", DataType: schema.DataTypeText.PropString()},
+			{Name: "tag", DataType: schema.DataTypeTextArray.PropString()},
+		},
+	}
+
+	tf, err := newTransformation(source, shadow, map[string]string{"title": "Headline"})
+	require.Nil(t, err)
+	assert.True(t, tf.KeepVectors)
+
+	obj := &models.Object{
+		Class: "Article",
+		ID:    "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+		Properties: map[string]interface{}{
+			"title":    "hello",
+			"count":    "7",
+			"rating":   float64(4),
+			"tag":      "news",
+			"obsolete": true,
+		},
+		Vector: []float32{1, 2, 3},
+	}
+	res, err := tf.apply(obj, "Article_1")
+	require.Nil(t, err)
+	assert.Equal(t, "Article_1", res.Class)
+	assert.Equal(t, obj.ID, res.ID)
+	assert.Equal(t, obj.Vector, res.Vector)
+	assert.Equal(t, map[string]interface{}{
+		"headline": "hello",
+		"count":    float64(7),
+		"rating":   "4",
+		"tag":      []interface{}{"news"},
+	}, res.Properties)
+
+	t.Run("value which cannot be converted", func(t *testing.T) {
+		obj := &models.Object{
+			Properties: map[string]interface{}{"count": "many"},
+		}
+		_, err := tf.apply(obj, "Article_1")
+		assert.ErrorContains(t, err, `property "count"`)
+	})
+
+	t.Run("changed vectorizer", func(t *testing.T) {
+		other := *shadow
+		other.Vectorizer = "text2vec-openai"
+		tf, err := newTransformation(source, &other, nil)
+		require.Nil(t, err)
+		assert.False(t, tf.KeepVectors)
+	})
+
+	t.Run("unknown renamed property", func(t *testing.T) {
+		_, err := newTransformation(source, shadow, map[string]string{"body": "text"})
+		assert.ErrorContains(t, err, `"body" does not exist`)
+	})
+
+	t.Run("unsupported conversion", func(t *testing.T) {
+		other := *shadow
+		other.Properties = []*models.Property{
+			{Name: "obsolete", DataType: schema.DataTypeDate.PropString()},
+		}
+		_, err := newTransformation(source, &other, nil)
+		assert.ErrorContains(t, err, "cannot convert")
+	})
+}
+
+func TestConvertible(t *testing.T) {
+	tests := []struct {
+		from, to schema.DataType
+		expected bool
+	}{
+		{schema.DataTypeString, schema.DataTypeText, true},
+		{schema.DataTypeText, schema.DataTypeDate, true},
+		{schema.DataTypeInt, schema.DataTypeNumberArray, true},
+		{schema.DataTypeIntArray, schema.DataTypeTextArray, true},
+		{schema.DataTypeIntArray, schema.DataTypeInt, false},
+		{schema.DataTypeBoolean, schema.DataTypeInt, false},
+		{schema.DataTypeGeoCoordinates, schema.DataTypeText, false},
+		{schema.DataTypeGeoCoordinates, schema.DataTypeGeoCoordinates, true},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected,
+			convertible(test.from.PropString(), test.to.PropString()),
+			"%s to %s", test.from, test.to)
+	}
+}
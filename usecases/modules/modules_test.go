@@ -534,3 +534,7 @@ func (m *dummyBackupModuleWithAltNames) PutObject(ctx context.Context, backupID,
 func (m *dummyBackupModuleWithAltNames) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
+
+func (m *dummyBackupModuleWithAltNames) Delete(ctx context.Context, backupID string) error {
+	return nil
+}
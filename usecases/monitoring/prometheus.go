@@ -42,6 +42,9 @@ type PrometheusMetrics struct {
 	QueriesDurations                   *prometheus.HistogramVec
 	QueriesFilteredVectorDurations     *prometheus.SummaryVec
 	QueryDimensions                    *prometheus.CounterVec
+	QueryCacheHits                     *prometheus.CounterVec
+	QueryCacheMisses                   *prometheus.CounterVec
+	QueryCacheSize                     prometheus.Gauge
 	GoroutinesCount                    *prometheus.GaugeVec
 	BackupRestoreDurations             *prometheus.SummaryVec
 	BackupStoreDurations               *prometheus.SummaryVec
@@ -52,6 +55,7 @@ type PrometheusMetrics struct {
 	BackupRestoreDataTransferred       *prometheus.CounterVec
 	BackupStoreDataTransferred         *prometheus.CounterVec
 	VectorDimensionsSum                *prometheus.GaugeVec
+	ReplicationPendingHints            *prometheus.GaugeVec
 
 	StartupProgress  *prometheus.GaugeVec
 	StartupDurations *prometheus.SummaryVec
@@ -108,6 +112,21 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Help: "Duration of queries in milliseconds",
 		}, []string{"class_name", "shard_name", "operation"}),
 
+		QueryCacheHits: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "query_results_cache_hits_total",
+			Help: "Number of queries served from the query results cache",
+		}, []string{"class_name", "query_type"}),
+
+		QueryCacheMisses: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "query_results_cache_misses_total",
+			Help: "Number of queries not found or outdated in the query results cache",
+		}, []string{"class_name", "query_type"}),
+
+		QueryCacheSize: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "query_results_cache_size_bytes",
+			Help: "Estimated size of all entries in the query results cache",
+		}),
+
 		GoroutinesCount: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "concurrent_goroutines",
 			Help: "Number of concurrently running goroutines",
@@ -179,6 +198,10 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_dimensions_sum",
 			Help: "Total dimensions in a shard",
 		}, []string{"class_name", "shard_name"}),
+		ReplicationPendingHints: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "replication_pending_hints",
+			Help: "Number of writes held for unreachable replicas",
+		}, []string{"node_name"}),
 
 		StartupProgress: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "startup_progress",
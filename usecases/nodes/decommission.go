@@ -0,0 +1,414 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+const (
+	DefaultTxTTL = 60 * time.Second
+	leaveTimeout = 10 * time.Second
+)
+
+type clusterState interface {
+	AllNames() []string
+	Candidates() []string
+	LocalName() string
+	SetDraining(node string, draining bool) error
+	Draining(node string) bool
+	RemoveNode(node string) error
+	Leave(timeout time.Duration) error
+}
+
+type shardingStates interface {
+	GetSchemaSkipAuth() schema.Schema
+	ShardingState(class string) *sharding.State
+}
+
+type shardMover interface {
+	Replace(ctx context.Context, class, shard, source, target, from string) error
+}
+
+// Decommissioner drains nodes before they are removed from the cluster.
+//
+// A node is decommissioned in the following steps:
+//   - The node is marked as draining on every node of the cluster, so it is
+//     no longer a candidate for new shards
+//   - Every shard replica the node holds is moved to the live node holding
+//     the fewest replicas. If the node is not reachable anymore, the shard is
+//     re-replicated from one of its other replicas instead
+//   - The node is removed from the cluster on every node, so transactions no
+//     longer wait for it, and the node itself leaves the memberlist if it is
+//     still alive
+//
+// Every node persists which nodes are draining or have been removed, so a
+// restart does not make a draining node a candidate again. The progress is
+// tracked in memory by the node which started decommissioning. If that node
+// restarts, the node stays draining until it is decommissioned again.
+//
+// Transactions tolerate node failures, as the node to decommission may be
+// dead.
+type Decommissioner struct {
+	authorizer authorizer
+	cluster    clusterState
+	schema     shardingStates
+	mover      shardMover
+	txRemote   *cluster.TxManager
+	logger     logrus.FieldLogger
+
+	sync.Mutex
+	statuses map[string]*models.NodeDecommission
+}
+
+// NewDecommissioner returns a new instance of Decommissioner
+func NewDecommissioner(remoteClient cluster.Client, memberLister cluster.MemberLister,
+	authorizer authorizer, clusterState clusterState, schema shardingStates,
+	mover shardMover, logger logrus.FieldLogger,
+) *Decommissioner {
+	broadcaster := cluster.NewTxBroadcaster(memberLister, remoteClient)
+	d := &Decommissioner{
+		authorizer: authorizer,
+		cluster:    clusterState,
+		schema:     schema,
+		mover:      mover,
+		txRemote:   cluster.NewTxManager(broadcaster, logger),
+		logger:     logger,
+		statuses:   make(map[string]*models.NodeDecommission),
+	}
+	d.txRemote.SetCommitFn(d.incomingCommit)
+	return d
+}
+
+// Decommission starts draining a node in the background and returns its status
+func (d *Decommissioner) Decommission(ctx context.Context,
+	principal *models.Principal, node string,
+) (*models.NodeDecommission, error) {
+	if err := d.authorizer.Authorize(principal, "delete", "nodes/"+node); err != nil {
+		return nil, err
+	}
+
+	states := d.shardingStates()
+	if !contains(d.cluster.AllNames(), node) && countReplicas(states)[node] == 0 {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("node %q not found", node))
+	}
+	if len(remove(d.cluster.Candidates(), node)) == 0 {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("node %q is the only node available to hold shards", node))
+	}
+
+	d.Lock()
+	if current, ok := d.statuses[node]; ok &&
+		current.Status == models.NodeDecommissionStatusDRAINING {
+		d.Unlock()
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("node %q is already being decommissioned", node))
+	}
+	status := &models.NodeDecommission{
+		Node:          node,
+		Status:        models.NodeDecommissionStatusDRAINING,
+		StartTimeUnix: time.Now().UnixMilli(),
+		Classes:       progress(states, node),
+	}
+	d.statuses[node] = status
+	result := copyStatus(status)
+	d.Unlock()
+
+	if err := d.broadcast(ctx, TransactionDrainNode,
+		TransactionDrainNodePayload{Node: node, Draining: true}); err != nil {
+		d.finish(status, err)
+		return nil, err
+	}
+
+	go func() {
+		d.finish(status, d.drain(context.Background(), status))
+	}()
+
+	return result, nil
+}
+
+// Status returns the decommissioning progress of a node or nil if the node is
+// not being decommissioned
+func (d *Decommissioner) Status(node string) *models.NodeDecommission {
+	d.Lock()
+	defer d.Unlock()
+	if status, ok := d.statuses[node]; ok {
+		return copyStatus(status)
+	}
+	if d.cluster.Draining(node) {
+		return &models.NodeDecommission{
+			Node:   node,
+			Status: models.NodeDecommissionStatusDRAINING,
+		}
+	}
+	return nil
+}
+
+// drain moves all shards away from the node and lets it leave the cluster
+func (d *Decommissioner) drain(ctx context.Context, status *models.NodeDecommission) error {
+	node := status.Node
+	failed := false
+	for _, cp := range status.Classes {
+		if err := d.drainClass(ctx, node, cp); err != nil {
+			failed = true
+			d.Lock()
+			cp.Error = err.Error()
+			d.Unlock()
+		}
+	}
+	if failed {
+		return fmt.Errorf("could not move all shards away from node %q", node)
+	}
+
+	return d.broadcast(ctx, TransactionRemoveNode,
+		TransactionRemoveNodePayload{Node: node})
+}
+
+// drainClass moves every replica of the class held by node to another node
+func (d *Decommissioner) drainClass(ctx context.Context, node string,
+	cp *models.NodeDecommissionClass,
+) error {
+	ss := d.schema.ShardingState(cp.Class)
+	if ss == nil {
+		return nil // class has been deleted in the meantime
+	}
+	shards := make([]string, 0, len(ss.Physical))
+	for name, phys := range ss.Physical {
+		if contains(phys.BelongsToNodes, node) {
+			shards = append(shards, name)
+		}
+	}
+	sort.Strings(shards)
+
+	for _, shard := range shards {
+		// every move updates the sharding state
+		if ss = d.schema.ShardingState(cp.Class); ss == nil {
+			return nil
+		}
+		replicas := ss.Physical[shard].BelongsToNodes
+		from, target, err := d.plan(node, replicas)
+		if err != nil {
+			return fmt.Errorf("shard %q: %w", shard, err)
+		}
+		if err := d.mover.Replace(ctx, cp.Class, shard, node, target, from); err != nil {
+			return fmt.Errorf("move shard %q to node %q: %w", shard, target, err)
+		}
+		d.Lock()
+		cp.ShardsMoved++
+		d.Unlock()
+	}
+	return nil
+}
+
+// plan determines the node to copy a shard from and the node to move it to.
+// The target is the live node holding the fewest replicas among the nodes
+// which don't hold the shard yet.
+func (d *Decommissioner) plan(node string, replicas []string) (from, target string, err error) {
+	live := d.cluster.AllNames()
+	if contains(live, node) {
+		from = node
+	} else {
+		for _, replica := range replicas {
+			if replica != node && contains(live, replica) {
+				from = replica
+				break
+			}
+		}
+	}
+	if from == "" {
+		return "", "", errors.New("no live replica to copy from")
+	}
+
+	counts := countReplicas(d.shardingStates())
+	for _, candidate := range d.cluster.Candidates() {
+		if candidate == node || contains(replicas, candidate) {
+			continue
+		}
+		if target == "" || counts[candidate] < counts[target] {
+			target = candidate
+		}
+	}
+	if target == "" {
+		return "", "", errors.New("no node available to take over the shard, " +
+			"add a node or decrease the replication factor")
+	}
+	return from, target, nil
+}
+
+// finish records the outcome of a decommission. A node which could not be
+// decommissioned stops draining, so that it can receive shards again.
+func (d *Decommissioner) finish(status *models.NodeDecommission, err error) {
+	if err != nil {
+		if rErr := d.broadcast(context.Background(), TransactionDrainNode,
+			TransactionDrainNodePayload{Node: status.Node, Draining: false}); rErr != nil {
+			d.logger.WithField("action", "node_decommission").
+				WithField("node", status.Node).Error(errors.Wrap(rErr, "stop draining"))
+		}
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	status.CompletionTimeUnix = time.Now().UnixMilli()
+	if err != nil {
+		status.Status = models.NodeDecommissionStatusFAILED
+		status.Error = err.Error()
+		d.logger.WithField("action", "node_decommission").
+			WithField("node", status.Node).Error(err)
+		return
+	}
+	status.Status = models.NodeDecommissionStatusDECOMMISSIONED
+}
+
+func (d *Decommissioner) shardingStates() map[string]*sharding.State {
+	sch := d.schema.GetSchemaSkipAuth()
+	if sch.Objects == nil {
+		return nil
+	}
+	states := make(map[string]*sharding.State, len(sch.Objects.Classes))
+	for _, class := range sch.Objects.Classes {
+		if ss := d.schema.ShardingState(class.Class); ss != nil {
+			states[class.Class] = ss
+		}
+	}
+	return states
+}
+
+func (d *Decommissioner) broadcast(ctx context.Context,
+	txType cluster.TransactionType, payload interface{},
+) error {
+	tx, err := d.txRemote.BeginTransactionTolerateNodeFailures(ctx, txType,
+		payload, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	err = d.txRemote.CommitWriteTransaction(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	// the transaction is only committed on remote nodes
+	return d.apply(tx.Type, payload)
+}
+
+func (d *Decommissioner) incomingCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	return d.apply(tx.Type, tx.Payload)
+}
+
+func (d *Decommissioner) apply(txType cluster.TransactionType, payload interface{}) error {
+	switch txType {
+	case TransactionDrainNode:
+		pl := payload.(TransactionDrainNodePayload)
+		return d.cluster.SetDraining(pl.Node, pl.Draining)
+	case TransactionRemoveNode:
+		pl := payload.(TransactionRemoveNodePayload)
+		if pl.Node != d.cluster.LocalName() {
+			return d.cluster.RemoveNode(pl.Node)
+		}
+		if err := d.cluster.SetDraining(pl.Node, false); err != nil {
+			return err
+		}
+		// leave asynchronously so the commit can still be acknowledged
+		go func() {
+			if err := d.cluster.Leave(leaveTimeout); err != nil {
+				d.logger.WithField("action", "node_decommission").
+					WithField("node", pl.Node).Error(err)
+			}
+		}()
+		return nil
+	default:
+		return errors.Errorf("unrecognized tx type: %s", txType)
+	}
+}
+
+func (d *Decommissioner) TxManager() *cluster.TxManager {
+	return d.txRemote
+}
+
+// progress returns an entry for every class the node holds shards of
+func progress(states map[string]*sharding.State, node string) []*models.NodeDecommissionClass {
+	classes := make([]*models.NodeDecommissionClass, 0, len(states))
+	for class, ss := range states {
+		total := int64(0)
+		for _, phys := range ss.Physical {
+			if contains(phys.BelongsToNodes, node) {
+				total++
+			}
+		}
+		if total > 0 {
+			classes = append(classes, &models.NodeDecommissionClass{
+				Class:       class,
+				ShardsTotal: total,
+			})
+		}
+	}
+	sort.Slice(classes, func(i, j int) bool {
+		return classes[i].Class < classes[j].Class
+	})
+	return classes
+}
+
+// countReplicas returns the number of shard replicas held by each node
+func countReplicas(states map[string]*sharding.State) map[string]int {
+	counts := make(map[string]int)
+	for _, ss := range states {
+		for _, phys := range ss.Physical {
+			for _, node := range phys.BelongsToNodes {
+				counts[node]++
+			}
+		}
+	}
+	return counts
+}
+
+func copyStatus(status *models.NodeDecommission) *models.NodeDecommission {
+	cp := *status
+	cp.Classes = make([]*models.NodeDecommissionClass, len(status.Classes))
+	for i, c := range status.Classes {
+		class := *c
+		cp.Classes[i] = &class
+	}
+	return &cp
+}
+
+func contains(xs []string, x string) bool {
+	for _, y := range xs {
+		if x == y {
+			return true
+		}
+	}
+	return false
+}
+
+func remove(xs []string, x string) []string {
+	out := make([]string, 0, len(xs))
+	for _, y := range xs {
+		if y != x {
+			out = append(out, y)
+		}
+	}
+	return out
+}
@@ -0,0 +1,267 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeAuthorizer struct{}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return nil
+}
+
+type fakeCluster struct {
+	sync.Mutex
+	live     []string
+	draining map[string]bool
+	removed  map[string]bool
+	left     bool
+}
+
+func (c *fakeCluster) AllNames() []string  { return c.live }
+func (c *fakeCluster) Hostnames() []string { return nil }
+func (c *fakeCluster) LocalName() string   { return c.live[0] }
+
+func (c *fakeCluster) Candidates() []string {
+	c.Lock()
+	defer c.Unlock()
+	var out []string
+	for _, name := range c.live {
+		if !c.draining[name] {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+func (c *fakeCluster) SetDraining(node string, draining bool) error {
+	c.Lock()
+	defer c.Unlock()
+	c.draining[node] = draining
+	return nil
+}
+
+func (c *fakeCluster) Draining(node string) bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.draining[node]
+}
+
+func (c *fakeCluster) RemoveNode(node string) error {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.draining, node)
+	c.removed[node] = true
+	return nil
+}
+
+func (c *fakeCluster) Removed(node string) bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.removed[node]
+}
+
+func (c *fakeCluster) Leave(timeout time.Duration) error {
+	c.Lock()
+	defer c.Unlock()
+	c.left = true
+	return nil
+}
+
+// fakeSchema holds the replicas of every shard per class
+type fakeSchema struct {
+	sync.Mutex
+	states map[string]map[string][]string
+}
+
+func (s *fakeSchema) GetSchemaSkipAuth() schema.Schema {
+	s.Lock()
+	defer s.Unlock()
+	sch := schema.Schema{Objects: &models.Schema{}}
+	for class := range s.states {
+		sch.Objects.Classes = append(sch.Objects.Classes, &models.Class{Class: class})
+	}
+	return sch
+}
+
+func (s *fakeSchema) ShardingState(class string) *sharding.State {
+	s.Lock()
+	defer s.Unlock()
+	shards, ok := s.states[class]
+	if !ok {
+		return nil
+	}
+	ss := &sharding.State{Physical: map[string]sharding.Physical{}}
+	for name, nodes := range shards {
+		ss.Physical[name] = sharding.Physical{
+			Name: name, BelongsToNodes: append([]string{}, nodes...),
+		}
+	}
+	return ss
+}
+
+type fakeMover struct {
+	schema *fakeSchema
+	err    error
+	moves  [][]string // class, shard, source, target, from
+}
+
+func (m *fakeMover) Replace(ctx context.Context, class, shard, source, target, from string) error {
+	m.moves = append(m.moves, []string{class, shard, source, target, from})
+	if m.err != nil {
+		return m.err
+	}
+	m.schema.Lock()
+	defer m.schema.Unlock()
+	nodes := m.schema.states[class][shard]
+	for i, node := range nodes {
+		if node == source {
+			nodes[i] = target
+		}
+	}
+	return nil
+}
+
+func newTestDecommissioner(live []string, states map[string]map[string][]string,
+) (*Decommissioner, *fakeCluster, *fakeMover) {
+	logger, _ := test.NewNullLogger()
+	cl := &fakeCluster{live: live, draining: map[string]bool{}, removed: map[string]bool{}}
+	sch := &fakeSchema{states: states}
+	mover := &fakeMover{schema: sch}
+	d := NewDecommissioner(nil, cl, &fakeAuthorizer{}, cl, sch, mover, logger)
+	return d, cl, mover
+}
+
+// waitForDecommission waits until decommissioning the node has completed
+func waitForDecommission(t *testing.T, d *Decommissioner, node string) *models.NodeDecommission {
+	var status *models.NodeDecommission
+	require.Eventually(t, func() bool {
+		status = d.Status(node)
+		return status.Status != models.NodeDecommissionStatusDRAINING
+	}, 5*time.Second, 5*time.Millisecond)
+	return status
+}
+
+func TestDecommission(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("LiveNode", func(t *testing.T) {
+		d, cl, mover := newTestDecommissioner([]string{"N1", "N2", "N3"},
+			map[string]map[string][]string{
+				"A": {"S1": {"N1"}, "S2": {"N2"}, "S3": {"N3", "N1"}},
+				"B": {"S1": {"N2"}},
+			})
+
+		started, err := d.Decommission(ctx, nil, "N2")
+		require.Nil(t, err)
+		assert.Equal(t, models.NodeDecommissionStatusDRAINING, started.Status)
+		assert.True(t, cl.Draining("N2"))
+
+		status := waitForDecommission(t, d, "N2")
+		assert.Equal(t, models.NodeDecommissionStatusDECOMMISSIONED, status.Status, status.Error)
+		assert.Equal(t, []*models.NodeDecommissionClass{
+			{Class: "A", ShardsTotal: 1, ShardsMoved: 1},
+			{Class: "B", ShardsTotal: 1, ShardsMoved: 1},
+		}, status.Classes)
+		// N3 holds fewer replicas than N1
+		assert.Equal(t, [][]string{
+			{"A", "S2", "N2", "N3", "N2"},
+			{"B", "S1", "N2", "N1", "N2"},
+		}, mover.moves)
+		assert.False(t, cl.Draining("N2"))
+		assert.True(t, cl.Removed("N2"))
+	})
+
+	t.Run("LocalNodeLeaves", func(t *testing.T) {
+		d, cl, _ := newTestDecommissioner([]string{"N1", "N2"},
+			map[string]map[string][]string{"A": {"S1": {"N1"}}})
+
+		_, err := d.Decommission(ctx, nil, "N1")
+		require.Nil(t, err)
+		waitForDecommission(t, d, "N1")
+		assert.Eventually(t, func() bool {
+			cl.Lock()
+			defer cl.Unlock()
+			return cl.left
+		}, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("DeadNode", func(t *testing.T) {
+		d, cl, mover := newTestDecommissioner([]string{"N1", "N2", "N3"},
+			map[string]map[string][]string{"A": {"S1": {"N4", "N2"}}})
+
+		_, err := d.Decommission(ctx, nil, "N4")
+		require.Nil(t, err)
+		status := waitForDecommission(t, d, "N4")
+		assert.Equal(t, models.NodeDecommissionStatusDECOMMISSIONED, status.Status, status.Error)
+		// the shard is copied from the remaining live replica
+		assert.Equal(t, [][]string{{"A", "S1", "N4", "N1", "N2"}}, mover.moves)
+		// the dead node can't leave by itself
+		assert.True(t, cl.Removed("N4"))
+		assert.False(t, cl.left)
+	})
+
+	t.Run("NoTarget", func(t *testing.T) {
+		d, cl, mover := newTestDecommissioner([]string{"N1", "N2"},
+			map[string]map[string][]string{"A": {"S1": {"N1", "N2"}}})
+
+		_, err := d.Decommission(ctx, nil, "N2")
+		require.Nil(t, err)
+		status := waitForDecommission(t, d, "N2")
+		assert.Equal(t, models.NodeDecommissionStatusFAILED, status.Status)
+		assert.Contains(t, status.Classes[0].Error, "decrease the replication factor")
+		assert.Empty(t, mover.moves)
+		// the node takes shards again
+		assert.False(t, cl.Draining("N2"))
+		assert.Contains(t, cl.Candidates(), "N2")
+		assert.False(t, cl.left)
+		assert.False(t, cl.Removed("N2"))
+	})
+
+	t.Run("MoveFails", func(t *testing.T) {
+		d, _, mover := newTestDecommissioner([]string{"N1", "N2"},
+			map[string]map[string][]string{"A": {"S1": {"N2"}}})
+		mover.err = errors.New("any")
+
+		_, err := d.Decommission(ctx, nil, "N2")
+		require.Nil(t, err)
+		status := waitForDecommission(t, d, "N2")
+		assert.Equal(t, models.NodeDecommissionStatusFAILED, status.Status)
+		assert.Equal(t, int64(0), status.Classes[0].ShardsMoved)
+		assert.Contains(t, status.Classes[0].Error, "any")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		d, _, _ := newTestDecommissioner([]string{"N1"},
+			map[string]map[string][]string{"A": {"S1": {"N1"}}})
+
+		_, err := d.Decommission(ctx, nil, "N9")
+		assert.IsType(t, enterrors.ErrNotFound{}, err)
+		_, err = d.Decommission(ctx, nil, "N1")
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+		assert.Nil(t, d.Status("N1"))
+	})
+}
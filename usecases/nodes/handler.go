@@ -27,17 +27,22 @@ type db interface {
 	GetNodeStatuses(ctx context.Context) ([]*models.NodeStatus, error)
 }
 
+type decommissioner interface {
+	Status(node string) *models.NodeDecommission
+}
+
 type Manager struct {
-	logger        logrus.FieldLogger
-	authorizer    authorizer
-	db            db
-	schemaManager *schemaUC.Manager
+	logger         logrus.FieldLogger
+	authorizer     authorizer
+	db             db
+	schemaManager  *schemaUC.Manager
+	decommissioner decommissioner
 }
 
 func NewManager(logger logrus.FieldLogger, authorizer authorizer,
-	db db, schemaManager *schemaUC.Manager,
+	db db, schemaManager *schemaUC.Manager, decommissioner decommissioner,
 ) *Manager {
-	return &Manager{logger, authorizer, db, schemaManager}
+	return &Manager{logger, authorizer, db, schemaManager, decommissioner}
 }
 
 func (m *Manager) GetNodeStatuses(ctx context.Context,
@@ -46,5 +51,12 @@ func (m *Manager) GetNodeStatuses(ctx context.Context,
 	if err := m.authorizer.Authorize(principal, "list", "nodes"); err != nil {
 		return nil, err
 	}
-	return m.db.GetNodeStatuses(ctx)
+	statuses, err := m.db.GetNodeStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range statuses {
+		status.Decommission = m.decommissioner.Status(status.Name)
+	}
+	return statuses, nil
 }
@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+const (
+	TransactionDrainNode  cluster.TransactionType = "drain_node"
+	TransactionRemoveNode cluster.TransactionType = "remove_node"
+)
+
+type TransactionDrainNodePayload struct {
+	Node     string `json:"node"`
+	Draining bool   `json:"draining"`
+}
+
+type TransactionRemoveNodePayload struct {
+	Node string `json:"node"`
+}
+
+func UnmarshalNodeTransaction(txType cluster.TransactionType,
+	payload json.RawMessage,
+) (interface{}, error) {
+	switch txType {
+	case TransactionDrainNode:
+		var pl TransactionDrainNodePayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	case TransactionRemoveNode:
+		var pl TransactionRemoveNodePayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, err
+		}
+		return pl, nil
+
+	default:
+		return nil, errors.Errorf("unrecognized node transaction type %q", txType)
+	}
+}
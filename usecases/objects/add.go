@@ -33,13 +33,19 @@ type schemaManager interface {
 	) (*models.Class, error)
 	AddClassProperty(ctx context.Context, principal *models.Principal,
 		class string, property *models.Property) error
+	MergeClassObjectProperty(ctx context.Context, principal *models.Principal,
+		class string, property *models.Property) error
 }
 
 // AddObject Class Instance to the connected DB.
 func (m *Manager) AddObject(ctx context.Context, principal *models.Principal,
 	object *models.Object, repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
-	err := m.authorizer.Authorize(principal, "create", "objects")
+	path := "objects"
+	if object != nil && object.Class != "" {
+		path = fmt.Sprintf("objects/%s", object.Class)
+	}
+	err := m.authorizer.Authorize(principal, "create", path)
 	if err != nil {
 		return nil, err
 	}
@@ -53,6 +59,10 @@ func (m *Manager) AddObject(ctx context.Context, principal *models.Principal,
 	m.metrics.AddObjectInc()
 	defer m.metrics.AddObjectDec()
 
+	class, done := routeWrite(ctx, m.router, object.Class)
+	defer func() { done(object.ID) }()
+	object.Class = class
+
 	return m.addObjectToConnectorAndSchema(ctx, principal, object, repl)
 }
 
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"testing"
 
@@ -46,12 +47,30 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedVerb:     "create",
 			expectedResource: "objects",
 		},
+		{
+			methodName: "AddObject",
+			additionalArgs: []interface{}{
+				&models.Object{Class: "class"},
+				(*additional.ReplicationProperties)(nil),
+			},
+			expectedVerb:     "create",
+			expectedResource: "objects/class",
+		},
 		{
 			methodName:       "ValidateObject",
 			additionalArgs:   []interface{}{(*models.Object)(nil)},
 			expectedVerb:     "validate",
 			expectedResource: "objects",
 		},
+		{
+			methodName: "ValidateObject",
+			additionalArgs: []interface{}{
+				&models.Object{Class: "class"},
+				(*additional.ReplicationProperties)(nil),
+			},
+			expectedVerb:     "validate",
+			expectedResource: "objects/class",
+		},
 		{
 			methodName:       "GetObject",
 			additionalArgs:   []interface{}{"", strfmt.UUID("foo"), additional.Properties{}},
@@ -118,6 +137,16 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedResource: "objects",
 		},
 
+		{
+			methodName: "Export",
+			additionalArgs: []interface{}{
+				ExportParams{Class: "foo"},
+				func(*models.Object) error { return nil },
+			},
+			expectedVerb:     "list",
+			expectedResource: "objects/foo",
+		},
+
 		{ // list objects is deprecated by query
 			methodName:       "GetObjects",
 			additionalArgs:   []interface{}{(*int64)(nil), (*int64)(nil), (*string)(nil), (*string)(nil), additional.Properties{}},
@@ -153,6 +182,10 @@ func Test_Kinds_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&Manager{}) {
+			if method == "SetClassRouter" {
+				// not user facing
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
@@ -218,6 +251,17 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedResource: "batch/objects",
 		},
 
+		{
+			methodName: "AddObjects",
+			additionalArgs: []interface{}{
+				[]*models.Object{{Class: "Foo"}, {Class: "Foo"}},
+				[]*string{},
+				&additional.ReplicationProperties{},
+			},
+			expectedVerb:     "create",
+			expectedResource: "batch/objects/Foo",
+		},
+
 		{
 			methodName: "AddReferences",
 			additionalArgs: []interface{}{
@@ -225,7 +269,20 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 				&additional.ReplicationProperties{},
 			},
 			expectedVerb:     "update",
-			expectedResource: "batch/*",
+			expectedResource: "objects",
+		},
+
+		{
+			methodName: "AddReferences",
+			additionalArgs: []interface{}{
+				[]*models.BatchReference{{
+					From: "weaviate://localhost/Foo/d18c8e5e-a339-4c15-8af6-56b0cfe33ce7/hasBar",
+					To:   "weaviate://localhost/d18c8e5e-a339-4c15-8af6-56b0cfe33ce7",
+				}},
+				&additional.ReplicationProperties{},
+			},
+			expectedVerb:     "update",
+			expectedResource: "objects/Foo",
 		},
 
 		{
@@ -239,6 +296,28 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedVerb:     "delete",
 			expectedResource: "batch/objects",
 		},
+
+		{
+			methodName: "DeleteObjects",
+			additionalArgs: []interface{}{
+				&models.BatchDeleteMatch{Class: "Foo"},
+				(*bool)(nil),
+				(*string)(nil),
+				&additional.ReplicationProperties{},
+			},
+			expectedVerb:     "delete",
+			expectedResource: "batch/objects/Foo",
+		},
+
+		{
+			methodName: "Import",
+			additionalArgs: []interface{}{
+				ImportParams{Class: "Foo"},
+				func() (*models.Object, error) { return nil, io.EOF },
+			},
+			expectedVerb:     "create",
+			expectedResource: "batch/objects/Foo",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -248,6 +327,10 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&BatchManager{}) {
+			if method == "SetClassRouter" {
+				// not user facing
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
@@ -15,6 +15,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -113,11 +114,15 @@ func (m *autoSchemaManager) updateClass(ctx context.Context, principal *models.P
 	className string, properties []*models.Property, existingProperties []*models.Property,
 ) error {
 	propertiesToAdd := []*models.Property{}
+	propertiesToMerge := []*models.Property{}
 	for _, prop := range properties {
 		found := false
 		for _, classProp := range existingProperties {
 			if classProp.Name == schema.LowercaseFirstLetter(prop.Name) {
 				found = true
+				if m.hasNewNestedProperties(classProp, prop) {
+					propertiesToMerge = append(propertiesToMerge, prop)
+				}
 				break
 			}
 		}
@@ -134,9 +139,29 @@ func (m *autoSchemaManager) updateClass(ctx context.Context, principal *models.P
 			return err
 		}
 	}
+	for _, mergeProp := range propertiesToMerge {
+		m.logger.
+			WithField("auto_schema", "updateClass").
+			Debugf("update class %s merge object property %s", className, mergeProp.Name)
+		err := m.schemaManager.MergeClassObjectProperty(ctx, principal, className, mergeProp)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (m *autoSchemaManager) hasNewNestedProperties(classProp, prop *models.Property) bool {
+	if _, ok := schema.AsNested(classProp.DataType); !ok {
+		return false
+	}
+	if _, ok := schema.AsNested(prop.DataType); !ok {
+		return false
+	}
+	_, changed := schema.MergeNestedProperties(classProp.NestedProperties, prop.NestedProperties)
+	return changed
+}
+
 func (m *autoSchemaManager) getProperties(object *models.Object) []*models.Property {
 	properties := []*models.Property{}
 	if props, ok := object.Properties.(map[string]interface{}); ok {
@@ -148,6 +173,9 @@ func (m *autoSchemaManager) getProperties(object *models.Object) []*models.Prope
 				DataType:    m.getDataTypes(dt),
 				Description: "This property was generated by Weaviate's auto-schema feature on " + now.Format(time.ANSIC),
 			}
+			if _, ok := schema.AsNested(property.DataType); ok {
+				property.NestedProperties = m.determineNestedProperties(value)
+			}
 			properties = append(properties, property)
 		}
 	}
@@ -186,6 +214,9 @@ func (m *autoSchemaManager) determineType(value interface{}) []schema.DataType {
 		if v["input"] != nil {
 			return []schema.DataType{schema.DataTypePhoneNumber}
 		}
+		if len(v) > 0 {
+			return []schema.DataType{schema.DataTypeObject}
+		}
 		return fallbackDataType
 	case []interface{}:
 		if len(v) > 0 {
@@ -193,6 +224,9 @@ func (m *autoSchemaManager) determineType(value interface{}) []schema.DataType {
 			for i := range v {
 				switch arrayVal := v[i].(type) {
 				case map[string]interface{}:
+					if _, ok := arrayVal["beacon"]; !ok && len(arrayVal) > 0 {
+						return []schema.DataType{schema.DataTypeObjectArray}
+					}
 					if len(arrayVal) > 0 {
 						for k, v := range arrayVal {
 							if k == "beacon" {
@@ -238,3 +272,86 @@ func (m *autoSchemaManager) determineType(value interface{}) []schema.DataType {
 		return fallbackDataType
 	}
 }
+
+// determineNestedProperties infers the nested properties of an object or
+// object[] value. For arrays the keys of all elements are combined.
+func (m *autoSchemaManager) determineNestedProperties(value interface{}) []*models.NestedProperty {
+	var objects []map[string]interface{}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		objects = append(objects, v)
+	case []interface{}:
+		for i := range v {
+			if obj, ok := v[i].(map[string]interface{}); ok {
+				objects = append(objects, obj)
+			}
+		}
+	}
+
+	nestedProps := []*models.NestedProperty{}
+	for _, obj := range objects {
+		names := make([]string, 0, len(obj))
+		for name := range obj {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		objectProps := []*models.NestedProperty{}
+		for _, name := range names {
+			dt, ok := m.determineNestedType(obj[name])
+			if !ok {
+				continue
+			}
+			nestedProp := &models.NestedProperty{
+				Name:     name,
+				DataType: []string{string(dt)},
+			}
+			if _, ok := schema.AsNested(nestedProp.DataType); ok {
+				nestedProp.NestedProperties = m.determineNestedProperties(obj[name])
+			}
+			objectProps = append(objectProps, nestedProp)
+		}
+		nestedProps, _ = schema.MergeNestedProperties(nestedProps, objectProps)
+	}
+	return nestedProps
+}
+
+// determineNestedType infers the data type of a nested value. Unlike
+// top-level properties, nested properties are limited to primitive types and
+// objects, so maps are always considered to be objects.
+func (m *autoSchemaManager) determineNestedType(value interface{}) (schema.DataType, bool) {
+	switch v := value.(type) {
+	case string:
+		if _, err := time.Parse(time.RFC3339, v); err == nil {
+			return schema.DataTypeDate, true
+		}
+		return schema.DataTypeText, true
+	case json.Number:
+		if schema.DataType(m.config.DefaultNumber) == schema.DataTypeInt {
+			return schema.DataTypeInt, true
+		}
+		return schema.DataTypeNumber, true
+	case bool:
+		return schema.DataTypeBoolean, true
+	case map[string]interface{}:
+		return schema.DataTypeObject, len(v) > 0
+	case []interface{}:
+		for i := range v {
+			dt, ok := m.determineNestedType(v[i])
+			if !ok {
+				continue
+			}
+			if dt == schema.DataTypeObject {
+				return schema.DataTypeObjectArray, true
+			}
+			if dt == schema.DataTypeObjectArray {
+				// arrays of arrays are not supported
+				return "", false
+			}
+			return schema.DataType(string(dt) + "[]"), true
+		}
+		return "", false
+	default:
+		return "", false
+	}
+}
@@ -150,6 +150,34 @@ func Test_autoSchemaManager_determineType(t *testing.T) {
 			},
 			want: []schema.DataType{schema.DataTypePhoneNumber},
 		},
+		{
+			name: "determine object",
+			fields: fields{
+				config: config.AutoSchema{
+					Enabled: true,
+				},
+			},
+			args: args{
+				value: map[string]interface{}{
+					"city": "Amsterdam",
+				},
+			},
+			want: []schema.DataType{schema.DataTypeObject},
+		},
+		{
+			name: "determine object array",
+			fields: fields{
+				config: config.AutoSchema{
+					Enabled: true,
+				},
+			},
+			args: args{
+				value: []interface{}{
+					map[string]interface{}{"city": "Amsterdam"},
+				},
+			},
+			want: []schema.DataType{schema.DataTypeObjectArray},
+		},
 		{
 			name: "determine cross reference",
 			fields: fields{
@@ -462,6 +490,79 @@ func Test_autoSchemaManager_autoSchema_update(t *testing.T) {
 	assert.Equal(t, "int[]", getProperty((schemaAfter.Objects.Classes)[0].Properties, "numberArray").DataType[0])
 }
 
+func Test_autoSchemaManager_autoSchema_nested(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Person",
+						Properties: []*models.Property{
+							{
+								Name:     "address",
+								DataType: schema.DataTypeObject.PropString(),
+								NestedProperties: []*models.NestedProperty{
+									{Name: "city", DataType: schema.DataTypeText.PropString()},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	autoSchemaManager := &autoSchemaManager{
+		schemaManager: schemaManager,
+		vectorRepo:    &fakeVectorRepo{},
+		config: config.AutoSchema{
+			Enabled:       true,
+			DefaultString: schema.DataTypeText.String(),
+			DefaultNumber: "int",
+			DefaultDate:   "date",
+		},
+		logger: logger,
+	}
+	obj := &models.Object{
+		Class: "Person",
+		Properties: map[string]interface{}{
+			"address": map[string]interface{}{
+				"city":    "Amsterdam",
+				"zipCode": json.Number("1011"),
+			},
+			"pets": []interface{}{
+				map[string]interface{}{"name": "Rex"},
+				map[string]interface{}{
+					"born": "2020-10-02T15:00:00Z",
+					"tags": []interface{}{"good", "dog"},
+				},
+			},
+		},
+	}
+
+	err := autoSchemaManager.autoSchema(context.Background(), &models.Principal{}, obj)
+	require.Nil(t, err)
+
+	props := schemaManager.GetSchemaResponse.Objects.Classes[0].Properties
+	require.Len(t, props, 2)
+
+	address := getProperty(props, "address")
+	require.NotNil(t, address)
+	assert.Equal(t, []*models.NestedProperty{
+		{Name: "city", DataType: schema.DataTypeText.PropString()},
+		{Name: "zipCode", DataType: schema.DataTypeInt.PropString()},
+	}, address.NestedProperties)
+
+	pets := getProperty(props, "pets")
+	require.NotNil(t, pets)
+	assert.Equal(t, schema.DataTypeObjectArray.PropString(), pets.DataType)
+	assert.Equal(t, []*models.NestedProperty{
+		{Name: "name", DataType: schema.DataTypeText.PropString()},
+		{Name: "born", DataType: schema.DataTypeDate.PropString()},
+		{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+	}, pets.NestedProperties)
+}
+
 func getProperty(properties []*models.Property, name string) *models.Property {
 	for _, prop := range properties {
 		if prop.Name == name {
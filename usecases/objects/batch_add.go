@@ -29,9 +29,10 @@ import (
 func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
-	err := b.authorizer.Authorize(principal, "create", "batch/objects")
-	if err != nil {
-		return nil, err
+	for _, path := range batchObjectsPaths(objects) {
+		if err := b.authorizer.Authorize(principal, "create", path); err != nil {
+			return nil, err
+		}
 	}
 
 	unlock, err := b.locks.LockConnector()
@@ -45,7 +46,28 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 	defer b.metrics.BatchOp("total_uc_level", before.UnixNano())
 	defer b.metrics.BatchDec()
 
-	return b.addObjects(ctx, principal, objects, fields, repl)
+	classes := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		if obj != nil {
+			classes = append(classes, obj.Class)
+		}
+	}
+	targets, done := routeWrites(ctx, b.router, classes)
+	for _, obj := range objects {
+		if obj != nil {
+			obj.Class = targets[obj.Class]
+		}
+	}
+
+	res, err := b.addObjects(ctx, principal, objects, fields, repl)
+	ids := make(map[string][]strfmt.UUID, len(targets))
+	for _, obj := range res {
+		if obj.Object != nil {
+			ids[obj.Object.Class] = append(ids[obj.Object.Class], obj.UUID)
+		}
+	}
+	done(ids)
+	return res, err
 }
 
 func (b *BatchManager) addObjects(ctx context.Context, principal *models.Principal,
@@ -183,3 +205,27 @@ func objectsChanToSlice(c chan BatchObject) BatchObjects {
 func unixNow() int64 {
 	return time.Now().UnixNano() / int64(time.Millisecond)
 }
+
+// batchObjectsPaths returns one authorization path per distinct class of the
+// batch, so permissions can be granted on individual classes. Objects without
+// a class fall back to the unscoped path.
+func batchObjectsPaths(objects []*models.Object) []string {
+	var paths []string
+	seen := map[string]struct{}{}
+	for _, obj := range objects {
+		path := "batch/objects"
+		if obj != nil && obj.Class != "" {
+			path = fmt.Sprintf("batch/objects/%s", obj.Class)
+		}
+		if _, ok := seen[path]; ok {
+			continue
+		}
+		seen[path] = struct{}{}
+		paths = append(paths, path)
+	}
+
+	if len(paths) == 0 {
+		return []string{"batch/objects"}
+	}
+	return paths
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -33,7 +34,11 @@ func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Prin
 	match *models.BatchDeleteMatch, dryRun *bool, output *string,
 	repl *additional.ReplicationProperties,
 ) (*BatchDeleteResponse, error) {
-	err := b.authorizer.Authorize(principal, "delete", "batch/objects")
+	path := "batch/objects"
+	if match != nil && match.Class != "" {
+		path = fmt.Sprintf("batch/objects/%s", match.Class)
+	}
+	err := b.authorizer.Authorize(principal, "delete", path)
 	if err != nil {
 		return nil, err
 	}
@@ -54,6 +59,13 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 	match *models.BatchDeleteMatch, dryRun *bool, output *string,
 	repl *additional.ReplicationProperties,
 ) (*BatchDeleteResponse, error) {
+	var deleted []strfmt.UUID
+	if match != nil {
+		var done func(ids ...strfmt.UUID)
+		match.Class, done = routeWrite(ctx, b.router, match.Class)
+		defer func() { done(deleted...) }()
+	}
+
 	params, err := b.validateBatchDelete(ctx, principal, match, dryRun, output)
 	if err != nil {
 		return nil, NewErrInvalidUserInput("validate: %v", err)
@@ -63,6 +75,11 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 	if err != nil {
 		return nil, NewErrInternal("batch delete objects: %#v", err)
 	}
+	if !result.DryRun {
+		for _, obj := range result.Objects {
+			deleted = append(deleted, obj.UUID)
+		}
+	}
 
 	return b.toResponse(match, params.Output, result)
 }
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	// DefaultImportBatchSize is the number of objects sent to the db at once
+	// during an import if no batch size is set explicitly
+	DefaultImportBatchSize = 100
+
+	// maxImportErrors limits how many individual errors are reported back
+	maxImportErrors = 100
+)
+
+// ImportParams describes how a stream of objects is imported
+type ImportParams struct {
+	// Class is the class all objects are imported into, regardless of the
+	// class they were exported from
+	Class string
+	// PropertyMapping renames properties of the imported objects, keyed by
+	// their source name. Properties without a mapping keep their name.
+	PropertyMapping map[string]string
+	BatchSize       int
+	Replication     *additional.ReplicationProperties
+}
+
+// ImportResult summarizes a finished import
+type ImportResult struct {
+	Imported int64
+	Failed   int64
+	// Errors holds the first maxImportErrors errors of failed objects
+	Errors []string
+}
+
+func (r *ImportResult) addErr(err error) {
+	r.Failed++
+	if len(r.Errors) < maxImportErrors {
+		r.Errors = append(r.Errors, err.Error())
+	}
+}
+
+// Import reads objects from next until it returns io.EOF and adds them in
+// batches. Objects that fail validation are counted in the result, while
+// errors of next or of an entire batch abort the import. The result
+// reflects all objects imported up to that point.
+func (b *BatchManager) Import(ctx context.Context, principal *models.Principal,
+	params ImportParams, next func() (*models.Object, error),
+) (*ImportResult, error) {
+	// fail early, before anything is read from the stream
+	path := "batch/objects"
+	if params.Class != "" {
+		path = fmt.Sprintf("batch/objects/%s", params.Class)
+	}
+	if err := b.authorizer.Authorize(principal, "create", path); err != nil {
+		return nil, err
+	}
+
+	if params.Class == "" {
+		return nil, NewErrInvalidUserInput("invalid param 'class': cannot be empty")
+	}
+
+	batchSize := params.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultImportBatchSize
+	}
+
+	res := &ImportResult{}
+	batch := make([]*models.Object, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		added, err := b.AddObjects(ctx, principal, batch, nil, params.Replication)
+		if err != nil {
+			return err
+		}
+		for _, obj := range added {
+			if obj.Err != nil {
+				res.addErr(fmt.Errorf("object %s: %w", obj.UUID, obj.Err))
+				continue
+			}
+			res.Imported++
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		obj, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return res, NewErrInvalidUserInput("read object: %v", err)
+		}
+
+		batch = append(batch, mapImportObject(obj, params))
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return res, err
+			}
+		}
+	}
+
+	return res, flush()
+}
+
+// mapImportObject moves obj to the target class and renames its properties
+func mapImportObject(obj *models.Object, params ImportParams) *models.Object {
+	obj.Class = params.Class
+	if len(params.PropertyMapping) == 0 {
+		return obj
+	}
+
+	props, ok := obj.Properties.(map[string]interface{})
+	if !ok {
+		return obj
+	}
+	mapped := make(map[string]interface{}, len(props))
+	for name, value := range props {
+		if target, ok := params.PropertyMapping[name]; ok {
+			name = target
+		}
+		mapped[name] = value
+	}
+	obj.Properties = mapped
+	return obj
+}
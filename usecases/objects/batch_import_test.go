@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_Import(t *testing.T) {
+	var (
+		vectorRepo      *fakeVectorRepo
+		modulesProvider *fakeModulesProvider
+		manager         *BatchManager
+	)
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Vectorizer:        config.VectorizerModuleNone,
+					Class:             "Target",
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{
+						{
+							Name:     "title",
+							DataType: schema.DataTypeText.PropString(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		cfg := &config.WeaviateConfig{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: sch,
+		}
+		logger, _ := test.NewNullLogger()
+		modulesProvider = getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		manager = NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			schemaManager, cfg, logger, &fakeAuthorizer{}, nil)
+	}
+
+	source := func(objs ...*models.Object) func() (*models.Object, error) {
+		return func() (*models.Object, error) {
+			if len(objs) == 0 {
+				return nil, io.EOF
+			}
+			obj := objs[0]
+			objs = objs[1:]
+			return obj, nil
+		}
+	}
+
+	ctx := context.Background()
+
+	t.Run("maps class and properties and batches", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Twice()
+
+		res, err := manager.Import(ctx, nil, ImportParams{
+			Class:           "Target",
+			PropertyMapping: map[string]string{"name": "title"},
+			BatchSize:       2,
+		}, source(
+			&models.Object{
+				ID: "7c8183ae-150d-433f-92b6-ed095b000001", Class: "Source",
+				Properties: map[string]interface{}{"name": "first"}, Vector: []float32{1},
+			},
+			&models.Object{
+				ID: "7c8183ae-150d-433f-92b6-ed095b000002", Class: "Source",
+				Properties: map[string]interface{}{"name": "second"}, Vector: []float32{2},
+			},
+			&models.Object{
+				ID: "7c8183ae-150d-433f-92b6-ed095b000003", Class: "Source",
+				Properties: map[string]interface{}{"name": "third"}, Vector: []float32{3},
+			},
+		))
+		require.Nil(t, err)
+		assert.Equal(t, &ImportResult{Imported: 3}, res)
+
+		vectorRepo.AssertExpectations(t)
+		first := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
+		require.Len(t, first, 2)
+		assert.Equal(t, "Target", first[0].Object.Class)
+		assert.Equal(t, map[string]interface{}{"title": "first"}, first[0].Object.Properties)
+		second := vectorRepo.Calls[1].Arguments[0].(BatchObjects)
+		require.Len(t, second, 1)
+		assert.Equal(t, map[string]interface{}{"title": "third"}, second[0].Object.Properties)
+	})
+
+	t.Run("counts invalid objects as failed", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+
+		res, err := manager.Import(ctx, nil, ImportParams{Class: "Target"}, source(
+			&models.Object{
+				ID:         "7c8183ae-150d-433f-92b6-ed095b000001",
+				Properties: map[string]interface{}{"title": "valid"}, Vector: []float32{1},
+			},
+			&models.Object{
+				ID:         "7c8183ae-150d-433f-92b6-ed095b000002",
+				Properties: map[string]interface{}{"unknown": "invalid"}, Vector: []float32{2},
+			},
+		))
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), res.Imported)
+		assert.Equal(t, int64(1), res.Failed)
+		require.Len(t, res.Errors, 1)
+		assert.Contains(t, res.Errors[0], "7c8183ae-150d-433f-92b6-ed095b000002")
+	})
+
+	t.Run("read error aborts the import", func(t *testing.T) {
+		reset()
+		next := func() (*models.Object, error) {
+			return nil, errors.New("unexpected end of input")
+		}
+
+		_, err := manager.Import(ctx, nil, ImportParams{Class: "Target"}, next)
+		assert.Equal(t, NewErrInvalidUserInput("read object: unexpected end of input"), err)
+	})
+
+	t.Run("without target class", func(t *testing.T) {
+		reset()
+		_, err := manager.Import(ctx, nil, ImportParams{}, source())
+		assert.NotNil(t, err)
+	})
+}
@@ -32,6 +32,7 @@ type BatchManager struct {
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
 	metrics           *Metrics
+	router            ClassRouter
 }
 
 type BatchVectorRepo interface {
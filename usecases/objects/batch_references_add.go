@@ -17,8 +17,10 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 )
 
@@ -26,9 +28,10 @@ import (
 func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Principal,
 	refs []*models.BatchReference, repl *additional.ReplicationProperties,
 ) (BatchReferences, error) {
-	err := b.authorizer.Authorize(principal, "update", "batch/*")
-	if err != nil {
-		return nil, err
+	for _, path := range batchReferencesPaths(refs) {
+		if err := b.authorizer.Authorize(principal, "update", path); err != nil {
+			return nil, err
+		}
 	}
 
 	unlock, err := b.locks.LockSchema()
@@ -51,6 +54,24 @@ func (b *BatchManager) addReferences(ctx context.Context, refs []*models.BatchRe
 	}
 
 	batchReferences := b.validateReferencesConcurrently(refs)
+
+	classes := make([]string, 0, len(batchReferences))
+	for _, ref := range batchReferences {
+		if ref.Err == nil {
+			classes = append(classes, string(ref.From.Class))
+		}
+	}
+	targets, done := routeWrites(ctx, b.router, classes)
+	ids := make(map[string][]strfmt.UUID, len(targets))
+	for _, ref := range batchReferences {
+		if ref.Err == nil {
+			target := targets[string(ref.From.Class)]
+			ref.From.Class = schema.ClassName(target)
+			ids[target] = append(ids[target], ref.From.TargetID)
+		}
+	}
+	defer done(ids)
+
 	if res, err := b.vectorRepo.AddBatchReferences(ctx, batchReferences, repl); err != nil {
 		return nil, NewErrInternal("could not add batch request to connector: %v", err)
 	} else {
@@ -140,3 +161,31 @@ func joinErrors(errors []error) error {
 
 	return fmt.Errorf(strings.Join(errorStrings, ", "))
 }
+
+// batchReferencesPaths are the paths of the classes whose objects the
+// references are added to. References with an invalid source fail validation
+// later on and are not authorized.
+func batchReferencesPaths(refs []*models.BatchReference) []string {
+	var paths []string
+	seen := map[string]struct{}{}
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		source, err := crossref.ParseSource(string(ref.From))
+		if err != nil || source.Class == "" {
+			continue
+		}
+		path := fmt.Sprintf("objects/%s", source.Class)
+		if _, ok := seen[path]; ok {
+			continue
+		}
+		seen[path] = struct{}{}
+		paths = append(paths, path)
+	}
+
+	if len(paths) == 0 {
+		return []string{"objects"}
+	}
+	return paths
+}
@@ -49,6 +49,9 @@ func (m *Manager) DeleteObject(ctx context.Context, principal *models.Principal,
 		return m.deleteObjectFromRepo(ctx, id)
 	}
 
+	class, done := routeWrite(ctx, m.router, class)
+	defer done(id)
+
 	ok, err := m.vectorRepo.Exists(ctx, class, id, repl)
 	if err != nil {
 		return NewErrInternal("check object existence: %v", err)
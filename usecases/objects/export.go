@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// DefaultExportPageSize is the number of objects fetched per cursor step
+// during an export if no page size is set explicitly
+const DefaultExportPageSize = 500
+
+// ExportParams describes which objects of a class are exported
+type ExportParams struct {
+	Class         string
+	Where         *models.WhereFilter
+	IncludeVector bool
+	PageSize      int
+}
+
+// Export streams all objects of a class, optionally restricted by a where
+// filter, to the write callback. Objects are paged through with the cursor
+// API in the order of their ids, so they are never all held in memory at
+// once. Export stops at the first error returned by write.
+func (m *Manager) Export(ctx context.Context, principal *models.Principal,
+	params ExportParams, write func(*models.Object) error,
+) *Error {
+	path := fmt.Sprintf("objects/%s", params.Class)
+	if err := m.authorizer.Authorize(principal, "list", path); err != nil {
+		return &Error{path, StatusForbidden, err}
+	}
+
+	params.Class = route(m.router, params.Class)
+	filter, err := m.exportFilter(principal, params)
+	if err != nil {
+		return &Error{"validate export", StatusBadRequest, err}
+	}
+
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultExportPageSize
+	}
+
+	after := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return &Error{"export interrupted", StatusInternalServerError, err}
+		}
+
+		res, rerr := m.exportPage(ctx, params, filter, after, pageSize)
+		if rerr != nil {
+			return rerr
+		}
+
+		for _, obj := range res.ObjectsWithVector(params.IncludeVector) {
+			if err := write(obj); err != nil {
+				return &Error{"write object " + obj.ID.String(), StatusInternalServerError, err}
+			}
+		}
+
+		if len(res) < pageSize {
+			return nil
+		}
+		after = res[len(res)-1].ID.String()
+	}
+}
+
+func (m *Manager) exportFilter(principal *models.Principal,
+	params ExportParams,
+) (*filters.LocalFilter, error) {
+	if params.Where == nil {
+		return nil, nil
+	}
+
+	s, err := m.schemaManager.GetSchema(principal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema: %w", err)
+	}
+	class := s.FindClassByName(schema.ClassName(params.Class))
+	if class == nil {
+		return nil, fmt.Errorf("class: %v doesn't exist", params.Class)
+	}
+
+	filter, err := filterext.Parse(params.Where, class.Class)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse where filter: %w", err)
+	}
+	if err := filters.ValidateFilters(s, filter); err != nil {
+		return nil, fmt.Errorf("invalid where filter: %w", err)
+	}
+	return filter, nil
+}
+
+// exportPage fetches a single page of objects. The connector lock is only
+// held per page, so that a long running export does not block schema
+// changes for its entire duration.
+func (m *Manager) exportPage(ctx context.Context, params ExportParams,
+	filter *filters.LocalFilter, after string, pageSize int,
+) (search.Results, *Error) {
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, &Error{"cannot lock", StatusInternalServerError, err}
+	}
+	defer unlock()
+
+	m.metrics.GetObjectInc()
+	defer m.metrics.GetObjectDec()
+
+	res, rerr := m.vectorRepo.Query(ctx, &QueryInput{
+		Class:      params.Class,
+		Limit:      pageSize,
+		Cursor:     &filters.Cursor{After: after, Limit: pageSize},
+		Filters:    filter,
+		Additional: additional.Properties{Vector: params.IncludeVector},
+	})
+	if rerr != nil {
+		return nil, rerr
+	}
+
+	if params.IncludeVector {
+		m.trackUsageList(res)
+	}
+	return res, nil
+}
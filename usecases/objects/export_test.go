@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestExport(t *testing.T) {
+	var (
+		cls = "MyClass"
+		id1 = strfmt.UUID("7c8183ae-150d-433f-92b6-ed095b000001")
+		id2 = strfmt.UUID("7c8183ae-150d-433f-92b6-ed095b000002")
+		id3 = strfmt.UUID("7c8183ae-150d-433f-92b6-ed095b000003")
+	)
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{{
+				Class: cls,
+				Properties: []*models.Property{{
+					Name:     "name",
+					DataType: schema.DataTypeText.PropString(),
+				}},
+			}},
+		},
+	}
+	result := func(id strfmt.UUID) search.Result {
+		return search.Result{ID: id, ClassName: cls, Vector: []float32{1, 2}, Dims: 2}
+	}
+	input := func(after string) *QueryInput {
+		return &QueryInput{
+			Class:      cls,
+			Limit:      2,
+			Cursor:     &filters.Cursor{After: after, Limit: 2},
+			Additional: additional.Properties{Vector: true},
+		}
+	}
+
+	t.Run("pages through all objects", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Query", input("")).
+			Return([]search.Result{result(id1), result(id2)}, (*Error)(nil)).Once()
+		m.repo.On("Query", input(id2.String())).
+			Return([]search.Result{result(id3)}, (*Error)(nil)).Once()
+		m.metrics.On("AddUsageDimensions", cls, "get_rest", "list_include_vector", 2)
+
+		var ids []strfmt.UUID
+		err := m.Export(context.Background(), nil,
+			ExportParams{Class: cls, IncludeVector: true, PageSize: 2},
+			func(obj *models.Object) error {
+				assert.Equal(t, models.C11yVector{1, 2}, obj.Vector)
+				ids = append(ids, obj.ID)
+				return nil
+			})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{id1, id2, id3}, ids)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.authorizer.Err = errors.New("any")
+		err := m.Export(context.Background(), nil, ExportParams{Class: cls},
+			func(*models.Object) error { return nil })
+		require.NotNil(t, err)
+		assert.Equal(t, StatusForbidden, err.Code)
+	})
+
+	t.Run("invalid where filter", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		where := &models.WhereFilter{
+			Path:      []string{"doesNotExist"},
+			Operator:  "Equal",
+			ValueText: ptString("foo"),
+		}
+		err := m.Export(context.Background(), nil, ExportParams{Class: cls, Where: where},
+			func(*models.Object) error { return nil })
+		require.NotNil(t, err)
+		assert.Equal(t, StatusBadRequest, err.Code)
+	})
+
+	t.Run("write error aborts export", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Query", input("")).
+			Return([]search.Result{result(id1), result(id2)}, (*Error)(nil)).Once()
+		m.metrics.On("AddUsageDimensions", cls, "get_rest", "list_include_vector", 2)
+
+		calls := 0
+		err := m.Export(context.Background(), nil,
+			ExportParams{Class: cls, IncludeVector: true, PageSize: 2},
+			func(obj *models.Object) error {
+				calls++
+				return errors.New("client went away")
+			})
+		require.NotNil(t, err)
+		assert.Equal(t, StatusInternalServerError, err.Code)
+		assert.Equal(t, 1, calls)
+	})
+}
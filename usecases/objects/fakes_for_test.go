@@ -115,6 +115,23 @@ func (f *fakeSchemaManager) AddClassProperty(ctx context.Context, principal *mod
 	return nil
 }
 
+func (f *fakeSchemaManager) MergeClassObjectProperty(ctx context.Context, principal *models.Principal,
+	class string, property *models.Property,
+) error {
+	classes := f.GetSchemaResponse.Objects.Classes
+	for _, c := range classes {
+		if c.Class == class {
+			for _, prop := range c.Properties {
+				if prop.Name == property.Name {
+					prop.NestedProperties, _ = schema.MergeNestedProperties(prop.NestedProperties,
+						property.NestedProperties)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 type fakeLocks struct {
 	Err error
 }
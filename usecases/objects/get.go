@@ -47,7 +47,7 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal, cl
 	m.metrics.GetObjectInc()
 	defer m.metrics.GetObjectDec()
 
-	res, err := m.getObjectFromRepo(ctx, class, id, additional, replProps)
+	res, err := m.getObjectFromRepo(ctx, route(m.router, class), id, additional, replProps)
 	if err != nil {
 		return nil, err
 	}
@@ -45,6 +45,7 @@ type Manager struct {
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
 	metrics           objectsMetrics
+	router            ClassRouter
 }
 
 type objectsMetrics interface {
@@ -75,6 +75,7 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 	if err != nil {
 		return nil, &Error{"offset or limit", StatusBadRequest, err}
 	}
+	q.Class = route(m.router, q.Class)
 	res, rerr := m.vectorRepo.Query(ctx, q)
 	if rerr != nil {
 		return nil, rerr
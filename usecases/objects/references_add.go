@@ -57,6 +57,10 @@ func (m *Manager) AddObjectReference(
 	}
 	defer unlock()
 
+	class, done := routeWrite(ctx, m.router, input.Class)
+	defer done(input.ID)
+	input.Class = class
+
 	validator := validation.New(m.vectorRepo.Exists, m.config, repl)
 	if err := input.validate(ctx, principal, validator, m.schemaManager); err != nil {
 		return &Error{"validate inputs", StatusBadRequest, err}
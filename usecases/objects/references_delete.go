@@ -43,6 +43,10 @@ func (m *Manager) DeleteObjectReference(
 	defer m.metrics.DeleteReferenceDec()
 
 	deprecatedEndpoint := input.Class == ""
+	class, done := routeWrite(ctx, m.router, input.Class)
+	defer done(input.ID)
+	input.Class = class
+
 	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{}, nil)
 	if err != nil {
 		errnf := ErrNotFound{}
@@ -47,6 +47,10 @@ func (m *Manager) UpdateObjectReferences(
 	m.metrics.UpdateReferenceInc()
 	defer m.metrics.UpdateReferenceDec()
 
+	class, done := routeWrite(ctx, m.router, input.Class)
+	defer done(input.ID)
+	input.Class = class
+
 	res, err := m.getObjectFromRepo(ctx, input.Class, input.ID, additional.Properties{}, nil)
 	if err != nil {
 		errnf := ErrNotFound{}
@@ -141,5 +145,9 @@ func validateReferenceSchema(class, property string, sch schema.Schema) error {
 		return fmt.Errorf("property '%s' is a primitive datatype, not a reference-type", property)
 	}
 
+	if dt.IsNested() {
+		return fmt.Errorf("property '%s' is a nested object datatype, not a reference-type", property)
+	}
+
 	return nil
 }
@@ -99,6 +99,15 @@ func Test_VObject_MarshalBinary(t *testing.T) {
 	})
 }
 
+// replicatedClass declares the reference property of the replicated objects,
+// whose properties are transferred as raw values
+var replicatedClass = &models.Class{
+	Class: "SomeClass",
+	Properties: []*models.Property{
+		{Name: "crossRef", DataType: []string{"OtherClass"}},
+	},
+}
+
 func Test_Replica_MarshalBinary(t *testing.T) {
 	now := time.Now()
 	vec := []float32{1, 2, 3, 4, 5}
@@ -141,6 +150,7 @@ func Test_Replica_MarshalBinary(t *testing.T) {
 		var received Replica
 		err = received.UnmarshalBinary(b)
 		require.Nil(t, err)
+		require.Nil(t, received.Object.EnrichSchemaTypes(replicatedClass))
 
 		assert.EqualValues(t, expected.Object, received.Object)
 		assert.EqualValues(t, expected.ID, received.ID)
@@ -243,6 +253,8 @@ func Test_Replicas_MarshalBinary(t *testing.T) {
 		require.Nil(t, err)
 
 		assert.Len(t, received, 2)
+		require.Nil(t, received[0].Object.EnrichSchemaTypes(replicatedClass))
+		require.Nil(t, received[1].Object.EnrichSchemaTypes(replicatedClass))
 		assert.EqualValues(t, expected[0].Object, received[0].Object)
 		assert.EqualValues(t, expected[0].ID, received[0].ID)
 		assert.EqualValues(t, expected[0].Deleted, received[0].Deleted)
@@ -271,6 +283,7 @@ func Test_Replicas_MarshalBinary(t *testing.T) {
 		require.Nil(t, err)
 
 		assert.Len(t, received, 2)
+		require.Nil(t, received[0].Object.EnrichSchemaTypes(replicatedClass))
 		assert.EqualValues(t, expected[0].Object, received[0].Object)
 		assert.EqualValues(t, expected[0].ID, received[0].ID)
 		assert.EqualValues(t, expected[0].Deleted, received[0].Deleted)
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// ClassRouter decides which class reads and writes of objects are applied
+// to. It allows a class to be replaced or mirrored while it is in use, see
+// package migrations.
+type ClassRouter interface {
+	// Route returns the class reads of class are applied to
+	Route(class string) string
+
+	// RouteWrite returns the class writes to class are applied to. done must
+	// be called with the ids of the written objects once the write has
+	// completed, successful or not.
+	RouteWrite(ctx context.Context, class string) (target string, done func(ids ...strfmt.UUID))
+}
+
+// SetClassRouter sets the router which resolves the class of every read and
+// write. Without a router, classes are used as is.
+func (m *Manager) SetClassRouter(r ClassRouter) {
+	m.router = r
+}
+
+// SetClassRouter sets the router which resolves the class of every write.
+// Without a router, classes are used as is.
+func (b *BatchManager) SetClassRouter(r ClassRouter) {
+	b.router = r
+}
+
+func route(r ClassRouter, class string) string {
+	if r == nil || class == "" {
+		return class
+	}
+	return r.Route(class)
+}
+
+func routeWrite(ctx context.Context, r ClassRouter,
+	class string,
+) (string, func(ids ...strfmt.UUID)) {
+	if r == nil || class == "" {
+		return class, func(...strfmt.UUID) {}
+	}
+	return r.RouteWrite(ctx, class)
+}
+
+// routeWrites routes writes to several classes at once. Classes are routed
+// in a fixed order, so that concurrent batches cannot block each other. done
+// must be called with the ids of the written objects per target class.
+func routeWrites(ctx context.Context, r ClassRouter,
+	classes []string,
+) (targets map[string]string, done func(ids map[string][]strfmt.UUID)) {
+	sorted := make([]string, 0, len(classes))
+	targets = make(map[string]string, len(classes))
+	for _, class := range classes {
+		if _, ok := targets[class]; !ok {
+			targets[class] = class
+			sorted = append(sorted, class)
+		}
+	}
+	sort.Strings(sorted)
+
+	dones := make(map[string][]func(ids ...strfmt.UUID), len(sorted))
+	for _, class := range sorted {
+		target, done := routeWrite(ctx, r, class)
+		targets[class] = target
+		dones[target] = append(dones[target], done)
+	}
+
+	return targets, func(ids map[string][]strfmt.UUID) {
+		for target, fns := range dones {
+			for _, done := range fns {
+				done(ids[target]...)
+			}
+		}
+	}
+}
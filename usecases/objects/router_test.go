@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestClassRouter(t *testing.T) {
+	var (
+		vectorRepo      *fakeVectorRepo
+		modulesProvider *fakeModulesProvider
+		router          *fakeClassRouter
+		manager         *Manager
+		batchManager    *BatchManager
+	)
+	ctx := context.Background()
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		modulesProvider = getFakeModulesProvider()
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Objects: &models.Schema{
+					Classes: []*models.Class{{
+						Class:             "Foo",
+						Vectorizer:        config.VectorizerModuleNone,
+						VectorIndexConfig: hnsw.UserConfig{},
+					}},
+				},
+			},
+		}
+		cfg := &config.WeaviateConfig{}
+		logger, _ := test.NewNullLogger()
+		router = &fakeClassRouter{aliases: map[string]string{"Alias": "Foo"}}
+		manager = NewManager(&fakeLocks{}, schemaManager, cfg, logger,
+			&fakeAuthorizer{}, vectorRepo, modulesProvider, &fakeMetrics{})
+		manager.SetClassRouter(router)
+		batchManager = NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			schemaManager, cfg, logger, &fakeAuthorizer{}, nil)
+		batchManager.SetClassRouter(router)
+	}
+
+	t.Run("add object", func(t *testing.T) {
+		reset()
+		vectorRepo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		res, err := manager.AddObject(ctx, nil, &models.Object{
+			Class:  "Alias",
+			Vector: []float32{0.1, 0.2, 0.3},
+		}, nil)
+		require.Nil(t, err)
+
+		stored := vectorRepo.Calls[0].Arguments.Get(0).(*models.Object)
+		assert.Equal(t, "Foo", stored.Class)
+		assert.Equal(t, []string{"Alias"}, router.writes)
+		assert.Equal(t, []strfmt.UUID{res.ID}, router.written)
+	})
+
+	t.Run("batch add objects", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		res, err := batchManager.AddObjects(ctx, nil, []*models.Object{
+			{Class: "Foo", Vector: []float32{0.1, 0.2, 0.3}},
+			{Class: "Alias", Vector: []float32{0.1, 0.2, 0.3}},
+		}, nil, nil)
+		require.Nil(t, err)
+
+		require.Len(t, res, 2)
+		for _, obj := range res {
+			assert.Nil(t, obj.Err)
+			assert.Equal(t, "Foo", obj.Object.Class)
+		}
+		assert.Equal(t, []string{"Alias", "Foo"}, router.writes)
+		// both routes lead to the same class, each is told about all ids
+		assert.ElementsMatch(t, []strfmt.UUID{
+			res[0].UUID, res[1].UUID, res[0].UUID, res[1].UUID,
+		}, router.written)
+	})
+}
+
+type fakeClassRouter struct {
+	aliases map[string]string
+	writes  []string
+	written []strfmt.UUID
+}
+
+func (f *fakeClassRouter) Route(class string) string {
+	if target, ok := f.aliases[class]; ok {
+		return target
+	}
+	return class
+}
+
+func (f *fakeClassRouter) RouteWrite(ctx context.Context,
+	class string,
+) (string, func(ids ...strfmt.UUID)) {
+	f.writes = append(f.writes, class)
+	return f.Route(class), func(ids ...strfmt.UUID) {
+		f.written = append(f.written, ids...)
+	}
+}
@@ -45,6 +45,13 @@ func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
+	target, done := routeWrite(ctx, m.router, class)
+	defer done(id)
+	if updates != nil && updates.Class == class {
+		updates.Class = target
+	}
+	class = target
+
 	return m.updateObjectToConnectorAndSchema(ctx, principal, class, id, updates, repl)
 }
 
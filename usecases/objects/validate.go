@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
@@ -23,7 +24,11 @@ import (
 func (m *Manager) ValidateObject(ctx context.Context, principal *models.Principal,
 	obj *models.Object, repl *additional.ReplicationProperties,
 ) error {
-	err := m.authorizer.Authorize(principal, "validate", "objects")
+	path := "objects"
+	if obj != nil && obj.Class != "" {
+		path = fmt.Sprintf("objects/%s", obj.Class)
+	}
+	err := m.authorizer.Authorize(principal, "validate", path)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// nestedPropertyVal validates the value of an object, object[] or nested
+// property. Unlike top-level properties, nested values are kept in their JSON
+// representation, e.g. dates and uuids as strings, so that a nested object is
+// the same whether it was just validated or read back from disk.
+func nestedPropertyVal(val interface{}, dataType schema.DataType,
+	nestedProps []*models.NestedProperty,
+) (interface{}, error) {
+	switch dataType {
+	case schema.DataTypeObject:
+		return objectVal(val, nestedProps)
+	case schema.DataTypeObjectArray:
+		return arrayVal(val, "object", func(elem interface{}) (interface{}, error) {
+			return objectVal(elem, nestedProps)
+		})
+	case schema.DataTypeText:
+		return stringVal(val)
+	case schema.DataTypeInt:
+		return intVal(val)
+	case schema.DataTypeNumber:
+		return numberVal(val)
+	case schema.DataTypeBoolean:
+		return boolVal(val)
+	case schema.DataTypeDate:
+		return nestedDateVal(val)
+	case schema.DataTypeUUID:
+		return nestedUUIDVal(val)
+	case schema.DataTypeTextArray:
+		return arrayVal(val, "text", func(elem interface{}) (interface{}, error) {
+			return stringVal(elem)
+		})
+	case schema.DataTypeIntArray:
+		return arrayVal(val, "integer", intVal)
+	case schema.DataTypeNumberArray:
+		return arrayVal(val, "number", numberVal)
+	case schema.DataTypeBooleanArray:
+		return arrayVal(val, "boolean", func(elem interface{}) (interface{}, error) {
+			return boolVal(elem)
+		})
+	case schema.DataTypeDateArray:
+		return arrayVal(val, "date", nestedDateVal)
+	case schema.DataTypeUUIDArray:
+		return arrayVal(val, "uuid", nestedUUIDVal)
+	default:
+		return nil, fmt.Errorf("unrecognized nested data type '%s'", dataType)
+	}
+}
+
+func objectVal(val interface{}, nestedProps []*models.NestedProperty) (map[string]interface{}, error) {
+	typed, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("not an object, but %T", val)
+	}
+
+	out := make(map[string]interface{}, len(typed))
+	for key, value := range typed {
+		if value == nil {
+			continue // nil values are removed and filtered out
+		}
+
+		nestedProp := nestedPropertyByName(nestedProps, key)
+		if nestedProp == nil {
+			return nil, fmt.Errorf("no such nested property '%s'", key)
+		}
+
+		parsed, err := nestedPropertyVal(value, schema.DataType(nestedProp.DataType[0]),
+			nestedProp.NestedProperties)
+		if err != nil {
+			return nil, fmt.Errorf("nested property '%s': %s", key, err)
+		}
+		out[key] = parsed
+	}
+
+	return out, nil
+}
+
+func nestedPropertyByName(nestedProps []*models.NestedProperty,
+	name string,
+) *models.NestedProperty {
+	for _, nestedProp := range nestedProps {
+		if nestedProp.Name == name {
+			return nestedProp
+		}
+	}
+	return nil
+}
+
+func arrayVal(val interface{}, typeName string,
+	elemVal func(interface{}) (interface{}, error),
+) ([]interface{}, error) {
+	typed, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("not a %s array, but %T", typeName, val)
+	}
+
+	out := make([]interface{}, len(typed))
+	for i := range typed {
+		parsed, err := elemVal(typed[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s array value at position %d: %s", typeName, i, err)
+		}
+		out[i] = parsed
+	}
+
+	return out, nil
+}
+
+func nestedDateVal(val interface{}) (interface{}, error) {
+	if _, err := dateVal(val); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+func nestedUUIDVal(val interface{}) (interface{}, error) {
+	asStr, err := stringVal(val)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := uuid.Parse(asStr)
+	if err != nil {
+		return nil, err
+	}
+	return parsed.String(), nil
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestValidator_nestedPropertyVal(t *testing.T) {
+	nestedProps := []*models.NestedProperty{
+		{Name: "city", DataType: schema.DataTypeText.PropString()},
+		{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+		{Name: "since", DataType: schema.DataTypeDate.PropString()},
+		{
+			Name:     "residents",
+			DataType: schema.DataTypeObjectArray.PropString(),
+			NestedProperties: []*models.NestedProperty{
+				{Name: "name", DataType: schema.DataTypeText.PropString()},
+				{Name: "id", DataType: schema.DataTypeUUID.PropString()},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		dataType schema.DataType
+		value    interface{}
+		want     interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "valid object",
+			dataType: schema.DataTypeObject,
+			value: map[string]interface{}{
+				"city":  "Amsterdam",
+				"zip":   json.Number("1011"),
+				"since": "2020-10-02T15:00:00Z",
+				"residents": []interface{}{
+					map[string]interface{}{
+						"name": "Jane",
+						"id":   "5A6D2F4C-13B8-4E0D-BF1A-8E1D9C2B3A4F",
+					},
+				},
+				"country": nil,
+			},
+			want: map[string]interface{}{
+				"city":  "Amsterdam",
+				"zip":   int64(1011),
+				"since": "2020-10-02T15:00:00Z",
+				"residents": []interface{}{
+					map[string]interface{}{
+						"name": "Jane",
+						"id":   "5a6d2f4c-13b8-4e0d-bf1a-8e1d9c2b3a4f",
+					},
+				},
+			},
+		},
+		{
+			name:     "valid object array",
+			dataType: schema.DataTypeObjectArray,
+			value: []interface{}{
+				map[string]interface{}{"city": "Amsterdam"},
+				map[string]interface{}{"city": "Utrecht"},
+			},
+			want: []interface{}{
+				map[string]interface{}{"city": "Amsterdam"},
+				map[string]interface{}{"city": "Utrecht"},
+			},
+		},
+		{
+			name:     "not an object",
+			dataType: schema.DataTypeObject,
+			value:    "Amsterdam",
+			wantErr:  true,
+		},
+		{
+			name:     "object instead of object array",
+			dataType: schema.DataTypeObjectArray,
+			value:    map[string]interface{}{"city": "Amsterdam"},
+			wantErr:  true,
+		},
+		{
+			name:     "unknown nested property",
+			dataType: schema.DataTypeObject,
+			value:    map[string]interface{}{"country": "NL"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid nested value",
+			dataType: schema.DataTypeObject,
+			value:    map[string]interface{}{"zip": "1011AB"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid deeply nested value",
+			dataType: schema.DataTypeObject,
+			value: map[string]interface{}{
+				"residents": []interface{}{
+					map[string]interface{}{"id": "not-a-uuid"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nestedPropertyVal(tt.value, tt.dataType, nestedProps)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
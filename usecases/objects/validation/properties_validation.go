@@ -77,9 +77,23 @@ func (v *Validator) properties(ctx context.Context, object interface{}, class *m
 			return err
 		}
 
-		data, err := v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
-		if err != nil {
-			return err
+		var data interface{}
+		switch *dataType {
+		case schema.DataTypeObject, schema.DataTypeObjectArray:
+			prop, err := schema.GetPropertyByName(class, propertyKeyLowerCase)
+			if err != nil {
+				return err
+			}
+			data, err = nestedPropertyVal(propertyValue, *dataType, prop.NestedProperties)
+			if err != nil {
+				return fmt.Errorf("invalid %s property '%s' on class '%s': %s",
+					*dataType, propertyKeyLowerCase, className, err)
+			}
+		default:
+			data, err = v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
+			if err != nil {
+				return err
+			}
 		}
 
 		returnSchema[propertyKeyLowerCase] = data
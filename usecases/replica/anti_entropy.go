@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"fmt"
+)
+
+// DiffHashTree compares the local hash tree of a shard with the one of its
+// replica on the given node and returns the leaves whose digests differ.
+//
+// Trees are compared top-down, level by level. Only the children of nodes
+// which differ are requested from the remote replica, so replicas which are
+// mostly in sync only exchange a few digests.
+func (f *Finder) DiffHashTree(ctx context.Context,
+	shard, node string, local *HashTree,
+) ([]int, error) {
+	host, ok := f.resolver.NodeHostname(node)
+	if !ok || host == "" {
+		return nil, fmt.Errorf("cannot resolve node name: %s", node)
+	}
+
+	diff := []int{0}
+	for level := 0; level <= local.Height() && len(diff) > 0; level++ {
+		if level > 0 {
+			children := make([]int, 0, 2*len(diff))
+			for _, n := range diff {
+				children = append(children, 2*n, 2*n+1)
+			}
+			diff = children
+		}
+		remote, err := f.client.HashTreeLevel(ctx, host, f.class, shard, level, diff)
+		if err != nil {
+			return nil, fmt.Errorf("node %q: hash tree level %d: %w", node, level, err)
+		}
+		digests, err := local.Level(level, diff)
+		if err != nil {
+			return nil, err
+		}
+		n := 0
+		for i, d := range digests {
+			if d != remote[i] {
+				diff[n] = diff[i]
+				n++
+			}
+		}
+		diff = diff[:n]
+	}
+	return diff, nil
+}
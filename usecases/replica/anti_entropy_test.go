@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinderDiffHashTree(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B"}
+		ctx   = context.Background()
+		ids   = []string{
+			"00000000-0000-0000-0000-000000000001",
+			"40000000-0000-0000-0000-000000000002",
+			"80000000-0000-0000-0000-000000000003",
+			"c0000000-0000-0000-0000-000000000004",
+		}
+	)
+
+	t.Run("Equal", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		local, remote := NewHashTree(4), NewHashTree(4)
+		for i, id := range ids {
+			local.Add(binaryUUID(t, id), int64(i))
+			remote.Add(binaryUUID(t, id), int64(i))
+		}
+		f.RClient.trees = map[string]*HashTree{"B": remote}
+
+		diff, err := f.newFinder("A").DiffHashTree(ctx, shard, "B", local)
+		assert.Nil(t, err)
+		assert.Empty(t, diff)
+	})
+
+	t.Run("Differences", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		local, remote := NewHashTree(4), NewHashTree(4)
+		local.Add(binaryUUID(t, ids[0]), 1)
+		remote.Add(binaryUUID(t, ids[0]), 1)
+		local.Add(binaryUUID(t, ids[1]), 2) // missing on remote
+		local.Add(binaryUUID(t, ids[2]), 3)
+		remote.Add(binaryUUID(t, ids[2]), 4) // outdated locally
+		remote.Add(binaryUUID(t, ids[3]), 5) // missing locally
+		f.RClient.trees = map[string]*HashTree{"B": remote}
+
+		diff, err := f.newFinder("A").DiffHashTree(ctx, shard, "B", local)
+		assert.Nil(t, err)
+		assert.Equal(t, []int{4, 8, 12}, diff)
+	})
+
+	t.Run("RemoteDigestsMissing", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		local, remote := NewHashTree(4), NewHashTree(4)
+		local.Add(binaryUUID(t, ids[0]), 1)
+		f.RClient.trees = map[string]*HashTree{"B": remote}
+		f.RClient.maxDigests = 1
+
+		_, err := f.newFinder("A").DiffHashTree(ctx, shard, "B", local)
+		assert.ErrorContains(t, err, "length expected 2 got 1")
+	})
+
+	t.Run("RemoteError", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		_, err := f.newFinder("A").DiffHashTree(ctx, shard, "B", NewHashTree(4))
+		assert.ErrorContains(t, err, "B")
+	})
+}
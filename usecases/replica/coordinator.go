@@ -37,6 +37,10 @@ type (
 		Class    string
 		Shard    string
 		TxID     string // transaction ID
+
+		// handoff, if set, records hints for replicas which missed a write
+		// that succeeded with the requested consistency level
+		handoff func(nodes []string)
 	}
 )
 
@@ -62,10 +66,14 @@ func newReadCoordinator[T any](f *Finder, shard string) *coordinator[T] {
 	}
 }
 
-// broadcast sends write request to all replicas (first phase of a two-phase commit)
+// broadcast sends write request to all replicas (first phase of a two-phase commit).
+//
+// If the consistency level has been reached and missed is not nil, missed is
+// called with the replicas which did not acknowledge the request.
 func (c *coordinator[T]) broadcast(ctx context.Context,
 	replicas []string,
 	op readyOp, level int,
+	missed func(replicas []string),
 ) <-chan string {
 	// prepare tells replicas to be ready
 	prepare := func() <-chan _Result[string] {
@@ -92,9 +100,11 @@ func (c *coordinator[T]) broadcast(ctx context.Context,
 	go func(level int) {
 		defer close(replicaCh)
 		actives := make([]string, 0, level) // cache for active replicas
+		var failed []string
 		for r := range prepare() {
 			if r.Err != nil { // connection error
 				c.log.WithField("op", "broadcast").Error(r.Err)
+				failed = append(failed, r.Value)
 				continue
 			}
 
@@ -116,6 +126,8 @@ func (c *coordinator[T]) broadcast(ctx context.Context,
 			for _, node := range replicas {
 				c.Abort(ctx, node, c.Class, c.Shard, c.TxID)
 			}
+		} else if missed != nil {
+			missed(failed)
 		}
 	}(level)
 	return replicaCh
@@ -123,23 +135,42 @@ func (c *coordinator[T]) broadcast(ctx context.Context,
 
 // commitAll tells replicas to commit pending updates related to a specific request
 // (second phase of a two-phase commit)
+//
+// If at least one replica committed and missed is not nil, missed is called
+// with the replicas which failed to commit.
 func (c *coordinator[T]) commitAll(ctx context.Context,
 	replicaCh <-chan string,
 	op commitOp[T],
+	missed func(replicas []string),
 ) <-chan _Result[T] {
 	replyCh := make(chan _Result[T], cap(replicaCh))
 	go func() { // tells active replicas to commit
-		wg := sync.WaitGroup{}
+		var (
+			wg        sync.WaitGroup
+			mu        sync.Mutex
+			failed    []string
+			committed bool
+		)
 		for replica := range replicaCh {
 			wg.Add(1)
 			go func(replica string) {
 				defer wg.Done()
 				resp, err := op(ctx, replica, c.TxID)
+				mu.Lock()
+				if err != nil {
+					failed = append(failed, replica)
+				} else {
+					committed = true
+				}
+				mu.Unlock()
 				replyCh <- _Result[T]{resp, err}
 			}(replica)
 		}
 		wg.Wait()
 		close(replyCh)
+		if missed != nil && committed && len(failed) > 0 {
+			missed(failed)
+		}
 	}()
 
 	return replyCh
@@ -156,8 +187,28 @@ func (c *coordinator[T]) Push(ctx context.Context,
 		return nil, 0, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
 	}
 	level := state.Level
-	nodeCh := c.broadcast(ctx, state.Hosts, ask, level)
-	return c.commitAll(context.Background(), nodeCh, com), level, nil
+	nodeCh := c.broadcast(ctx, state.Hosts, ask, level, c.missedNodes(state, true))
+	return c.commitAll(context.Background(), nodeCh, com, c.missedNodes(state, false)), level, nil
+}
+
+// missedNodes returns a function which translates the hosts which missed a
+// write back to node names and passes them to handoff. If unresolved is
+// true, nodes whose host could not be resolved are added.
+func (c *coordinator[T]) missedNodes(state rState, unresolved bool) func(hosts []string) {
+	if c.handoff == nil {
+		return nil
+	}
+	return func(hosts []string) {
+		nodes := make([]string, 0, len(hosts))
+		for name, addr := range state.NodeMap {
+			if (addr == "" && unresolved) || (addr != "" && contains(hosts, addr)) {
+				nodes = append(nodes, name)
+			}
+		}
+		if len(nodes) > 0 {
+			c.handoff(nodes)
+		}
+	}
 }
 
 // Pull data from replica depending on consistency level
@@ -206,3 +257,12 @@ func (c *coordinator[T]) Pull(ctx context.Context,
 
 	return replyCh, state, nil
 }
+
+func contains(xs []string, x string) bool {
+	for _, y := range xs {
+		if y == x {
+			return true
+		}
+	}
+	return false
+}
@@ -224,3 +224,108 @@ func (f *Finder) checkShardConsistency(ctx context.Context,
 	result := <-f.readBatchPart(ctx, batch, ids, replyCh, state)
 	return result.Value, result.Err
 }
+
+// CheckUpToDate makes sure that each of the given nodes holds a version of
+// every object in xs which is at least as recent as the one described by xs.
+// Objects deleted on a node are considered up to date.
+//
+// It is used to make sure a replica can be dropped without losing data
+func (f *Finder) CheckUpToDate(ctx context.Context,
+	shard string, nodes []string, xs []RepairResponse,
+) error {
+	if len(xs) == 0 || len(nodes) == 0 {
+		return nil
+	}
+	ids := make([]strfmt.UUID, len(xs))
+	for i, x := range xs {
+		ids[i] = strfmt.UUID(x.ID)
+	}
+	gr, ctx := errgroup.WithContext(ctx)
+	for _, node := range nodes {
+		node := node
+		host, ok := f.resolver.NodeHostname(node)
+		if !ok || host == "" {
+			return fmt.Errorf("cannot resolve node name: %s", node)
+		}
+		gr.Go(func() error {
+			rs, err := f.client.DigestReads(ctx, host, f.class, shard, ids)
+			if err != nil {
+				return fmt.Errorf("node %q: %w", node, err)
+			}
+			if len(rs) != len(xs) {
+				return fmt.Errorf("node %q: %w: expected %d digests got %d",
+					node, errRead, len(xs), len(rs))
+			}
+			for i, r := range rs {
+				if !r.Deleted && r.UpdateTime < xs[i].UpdateTime {
+					return fmt.Errorf("node %q: object %s is outdated", node, xs[i].ID)
+				}
+			}
+			return nil
+		})
+	}
+	return gr.Wait()
+}
+
+// SyncReplica brings the replica of a shard on the given node up to date
+// with the local objects xs. Objects which are missing or outdated on that node
+// are overwritten.
+//
+// It returns the IDs of objects which have been deleted on the node. It is
+// then up to the caller to delete them locally as well.
+func (f *Finder) SyncReplica(ctx context.Context,
+	shard, node string, xs []*storobj.Object,
+) ([]strfmt.UUID, error) {
+	if len(xs) == 0 {
+		return nil, nil
+	}
+	host, ok := f.resolver.NodeHostname(node)
+	if !ok || host == "" {
+		return nil, fmt.Errorf("cannot resolve node name: %s", node)
+	}
+	ids := make([]strfmt.UUID, len(xs))
+	for i, x := range xs {
+		ids[i] = x.ID()
+	}
+	rs, err := f.client.DigestReads(ctx, host, f.class, shard, ids)
+	if err != nil {
+		return nil, fmt.Errorf("node %q: %w", node, err)
+	}
+	if len(rs) != len(xs) {
+		return nil, fmt.Errorf("node %q: %w: expected %d digests got %d",
+			node, errRead, len(xs), len(rs))
+	}
+
+	var (
+		deleted []strfmt.UUID
+		updates []*objects.VObject
+	)
+	for i, r := range rs {
+		switch {
+		case r.Deleted:
+			deleted = append(deleted, ids[i])
+		case r.UpdateTime < xs[i].LastUpdateTimeUnix():
+			obj := xs[i].Object
+			obj.Vector = xs[i].Vector
+			updates = append(updates, &objects.VObject{
+				LatestObject:    &obj,
+				StaleUpdateTime: r.UpdateTime,
+			})
+		}
+	}
+	if len(updates) == 0 {
+		return deleted, nil
+	}
+	resp, err := f.client.Overwrite(ctx, host, f.class, shard, updates)
+	if err != nil {
+		return nil, fmt.Errorf("node %q: overwrite: %w", node, err)
+	}
+	for _, r := range resp {
+		// a conflict means that the object has been changed in the meantime
+		// by a more recent write which is then present on both nodes
+		if r.Err != "" && r.Err != "conflict" {
+			return nil, fmt.Errorf("node %q: overwrite object %s: %s", node, r.ID, r.Err)
+		}
+	}
+	return deleted, nil
+}
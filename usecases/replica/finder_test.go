@@ -700,3 +700,150 @@ func TestFinderCheckConsistencyOne(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, want, xs)
 }
+
+func TestFinderCheckUpToDate(t *testing.T) {
+	var (
+		ids      = []strfmt.UUID{"1", "2", "3"}
+		cls      = "C1"
+		shard    = "SH1"
+		nodes    = []string{"A", "B", "C"}
+		ctx      = context.Background()
+		nilReply = []RepairResponse(nil)
+		local    = []RepairResponse{
+			{ID: ids[0].String(), UpdateTime: 2},
+			{ID: ids[1].String(), UpdateTime: 3},
+			{ID: ids[2].String(), UpdateTime: 4},
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		var (
+			f       = newFakeFactory(cls, shard, nodes)
+			finder  = f.newFinder("A")
+			digestR = []RepairResponse{
+				{ID: ids[0].String(), UpdateTime: 2},
+				{ID: ids[1].String(), UpdateTime: 5},
+				{ID: ids[2].String(), Deleted: true},
+			}
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(digestR, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, ids).Return(digestR, nil)
+
+		err := finder.CheckUpToDate(ctx, shard, nodes[1:], local)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Outdated", func(t *testing.T) {
+		var (
+			f       = newFakeFactory(cls, shard, nodes)
+			finder  = f.newFinder("A")
+			digestR = []RepairResponse{
+				{ID: ids[0].String(), UpdateTime: 2},
+				{ID: ids[1].String(), UpdateTime: 1},
+				{ID: ids[2].String(), UpdateTime: 4},
+			}
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(digestR, nil)
+
+		err := finder.CheckUpToDate(ctx, shard, nodes[1:2], local)
+		assert.ErrorContains(t, err, "outdated")
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		var (
+			f       = newFakeFactory(cls, shard, nodes)
+			finder  = f.newFinder("A")
+			digestR = []RepairResponse{
+				{ID: ids[0].String(), UpdateTime: 2},
+				{ID: ids[1].String(), UpdateTime: 3},
+				{ID: ids[2].String()},
+			}
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(digestR, nil)
+
+		err := finder.CheckUpToDate(ctx, shard, nodes[1:2], local)
+		assert.ErrorContains(t, err, ids[2].String())
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		var (
+			f      = newFakeFactory(cls, shard, nodes)
+			finder = f.newFinder("A")
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(nilReply, errAny)
+
+		err := finder.CheckUpToDate(ctx, shard, nodes[1:2], local)
+		assert.ErrorIs(t, err, errAny)
+	})
+
+	t.Run("UnresolvedNode", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		err := f.newFinder("A").CheckUpToDate(ctx, shard, []string{"N"}, local)
+		assert.ErrorContains(t, err, "N")
+	})
+}
+
+func TestFinderSyncReplica(t *testing.T) {
+	var (
+		ids      = []strfmt.UUID{"1", "2", "3", "4"}
+		cls      = "C1"
+		shard    = "SH1"
+		nodes    = []string{"A", "B"}
+		ctx      = context.Background()
+		nilReply = []RepairResponse(nil)
+		xs       = []*storobj.Object{
+			object(ids[0], 2),
+			object(ids[1], 3),
+			object(ids[2], 4),
+			object(ids[3], 5),
+		}
+		digestR = []RepairResponse{
+			{ID: ids[0].String(), UpdateTime: 2},
+			{ID: ids[1].String(), UpdateTime: 1},
+			{ID: ids[2].String(), Deleted: true},
+			{ID: ids[3].String()},
+		}
+		updates = []*objects.VObject{
+			{LatestObject: &xs[1].Object, StaleUpdateTime: 1},
+			{LatestObject: &xs[3].Object, StaleUpdateTime: 0},
+		}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		var (
+			f      = newFakeFactory(cls, shard, nodes)
+			finder = f.newFinder("A")
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(digestR, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, updates).
+			Return([]RepairResponse{{ID: ids[3].String(), Err: "conflict"}}, nil)
+
+		deleted, err := finder.SyncReplica(ctx, shard, nodes[1], xs)
+		assert.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{ids[2]}, deleted)
+	})
+
+	t.Run("DigestError", func(t *testing.T) {
+		var (
+			f      = newFakeFactory(cls, shard, nodes)
+			finder = f.newFinder("A")
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(nilReply, errAny)
+
+		_, err := finder.SyncReplica(ctx, shard, nodes[1], xs)
+		assert.ErrorIs(t, err, errAny)
+	})
+
+	t.Run("OverwriteError", func(t *testing.T) {
+		var (
+			f      = newFakeFactory(cls, shard, nodes)
+			finder = f.newFinder("A")
+		)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, ids).Return(digestR, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[1], cls, shard, updates).
+			Return([]RepairResponse{{ID: ids[1].String(), Err: "disk full"}}, nil)
+
+		_, err := finder.SyncReplica(ctx, shard, nodes[1], xs)
+		assert.ErrorContains(t, err, "disk full")
+	})
+}
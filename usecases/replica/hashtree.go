@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/spaolacci/murmur3"
+)
+
+// HashTreeHeight is the height of the hash trees used for anti-entropy. A
+// tree has 2^HashTreeHeight leaves.
+const HashTreeHeight = 12
+
+// HashTree is a binary hash tree over the objects of a shard.
+//
+// Objects are assigned to leaves by the leading bits of their UUID. The digest
+// of a node is the XOR of the digests of all objects below it, where the
+// digest of an object covers its UUID and its last update time. Two replicas
+// holding the same objects in the same versions therefore have equal roots,
+// independent of the order in which objects have been added.
+//
+// Since XOR is its own inverse, an object is removed by adding it again. A
+// tree is safe for concurrent use.
+type HashTree struct {
+	lock   sync.Mutex
+	height int
+	nodes  []uint64 // level l starts at index 2^l-1
+}
+
+// NewHashTree returns an empty tree of the given height
+func NewHashTree(height int) *HashTree {
+	return &HashTree{
+		height: height,
+		nodes:  make([]uint64, (1<<(height+1))-1),
+	}
+}
+
+// Height of the tree, the root is on level 0 and the leaves on level Height
+func (t *HashTree) Height() int {
+	return t.height
+}
+
+// Add adds the object with the given binary UUID and update time
+func (t *HashTree) Add(id []byte, updateTime int64) {
+	var buf [24]byte
+	copy(buf[:16], id)
+	binary.LittleEndian.PutUint64(buf[16:], uint64(updateTime))
+	digest := murmur3.Sum64(buf[:])
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	pos := t.Leaf(id)
+	for level := t.height; level >= 0; level-- {
+		t.nodes[(1<<level)-1+pos] ^= digest
+		pos >>= 1
+	}
+}
+
+// Remove removes the object with the given binary UUID and update time, it
+// must have been added before
+func (t *HashTree) Remove(id []byte, updateTime int64) {
+	t.Add(id, updateTime)
+}
+
+// Root returns the digest of the root node
+func (t *HashTree) Root() uint64 {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.nodes[0]
+}
+
+// Level returns the digests of the given nodes of a level
+func (t *HashTree) Level(level int, nodes []int) ([]uint64, error) {
+	if level < 0 || level > t.height {
+		return nil, fmt.Errorf("level %d out of range [0, %d]", level, t.height)
+	}
+	digests := make([]uint64, len(nodes))
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for i, node := range nodes {
+		if node < 0 || node >= 1<<level {
+			return nil, fmt.Errorf("node %d out of range on level %d", node, level)
+		}
+		digests[i] = t.nodes[(1<<level)-1+node]
+	}
+	return digests, nil
+}
+
+// Leaf returns the leaf an object with the given binary UUID belongs to
+func (t *HashTree) Leaf(id []byte) int {
+	return int(binary.BigEndian.Uint32(id[:4]) >> (32 - t.height))
+}
+
+// LeafPrefix returns the smallest binary UUID belonging to the leaf
+func (t *HashTree) LeafPrefix(leaf int) []byte {
+	id := make([]byte, 16)
+	binary.BigEndian.PutUint32(id[:4], uint32(leaf)<<(32-t.height))
+	return id
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func binaryUUID(t *testing.T, id string) []byte {
+	b, err := uuid.MustParse(id).MarshalBinary()
+	require.Nil(t, err)
+	return b
+}
+
+func TestHashTree(t *testing.T) {
+	var (
+		id1 = "00000000-0000-0000-0000-000000000001"
+		id2 = "80000000-0000-0000-0000-000000000002"
+		id3 = "ffffffff-0000-0000-0000-000000000003"
+	)
+
+	t.Run("OrderIndependent", func(t *testing.T) {
+		a, b := NewHashTree(4), NewHashTree(4)
+		a.Add(binaryUUID(t, id1), 1)
+		a.Add(binaryUUID(t, id2), 2)
+		a.Add(binaryUUID(t, id3), 3)
+		b.Add(binaryUUID(t, id3), 3)
+		b.Add(binaryUUID(t, id1), 1)
+		b.Add(binaryUUID(t, id2), 2)
+		assert.Equal(t, a.Root(), b.Root())
+		assert.NotZero(t, a.Root())
+	})
+
+	t.Run("UpdateTimeChangesDigest", func(t *testing.T) {
+		a, b := NewHashTree(4), NewHashTree(4)
+		a.Add(binaryUUID(t, id2), 2)
+		b.Add(binaryUUID(t, id2), 3)
+		assert.NotEqual(t, a.Root(), b.Root())
+
+		// only the path from the root to the leaf of the object differs
+		for level := 0; level <= 4; level++ {
+			nodes := make([]int, 1<<level)
+			for i := range nodes {
+				nodes[i] = i
+			}
+			da, err := a.Level(level, nodes)
+			require.Nil(t, err)
+			db, err := b.Level(level, nodes)
+			require.Nil(t, err)
+			for i := range nodes {
+				if i == 1<<level/2 {
+					assert.NotEqual(t, da[i], db[i], "level %d node %d", level, i)
+				} else {
+					assert.Equal(t, da[i], db[i], "level %d node %d", level, i)
+				}
+			}
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		a, b := NewHashTree(4), NewHashTree(4)
+		a.Add(binaryUUID(t, id1), 1)
+		a.Add(binaryUUID(t, id2), 2)
+		a.Remove(binaryUUID(t, id2), 2)
+		b.Add(binaryUUID(t, id1), 1)
+		assert.Equal(t, b.Root(), a.Root())
+
+		// an update replaces the previous version of the object
+		a.Remove(binaryUUID(t, id1), 1)
+		a.Add(binaryUUID(t, id1), 3)
+		assert.NotEqual(t, b.Root(), a.Root())
+		a.Remove(binaryUUID(t, id1), 3)
+		assert.Zero(t, a.Root())
+	})
+
+	t.Run("Leaves", func(t *testing.T) {
+		tree := NewHashTree(4)
+		assert.Equal(t, 0, tree.Leaf(binaryUUID(t, id1)))
+		assert.Equal(t, 8, tree.Leaf(binaryUUID(t, id2)))
+		assert.Equal(t, 15, tree.Leaf(binaryUUID(t, id3)))
+		assert.Equal(t, binaryUUID(t, "80000000-0000-0000-0000-000000000000"), tree.LeafPrefix(8))
+		assert.Equal(t, 8, tree.Leaf(tree.LeafPrefix(8)))
+	})
+
+	t.Run("InvalidLevel", func(t *testing.T) {
+		tree := NewHashTree(4)
+		_, err := tree.Level(5, []int{0})
+		assert.NotNil(t, err)
+		_, err = tree.Level(1, []int{2})
+		assert.NotNil(t, err)
+	})
+}
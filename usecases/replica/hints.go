@@ -0,0 +1,321 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// ErrHintStoreFull is returned by a HintStore which reached its capacity
+var ErrHintStoreFull = errors.New("hint store is full")
+
+// Hint is a write which could not be delivered to a replica. The coordinator
+// keeps it until the replica becomes reachable again and then replays it.
+type Hint struct {
+	// Key identifies the hint within the store. It is set by the store.
+	Key     []byte `json:"-"`
+	Node    string `json:"node"`
+	Class   string `json:"class"`
+	Shard   string `json:"shard"`
+	Op      opID   `json:"op"`
+	Payload []byte `json:"payload"`
+	// CreatedAt is the unix time in milliseconds at which the write was accepted
+	CreatedAt int64 `json:"createdAt"`
+}
+
+// HintStore persists hints on the coordinator
+type HintStore interface {
+	// AddHint persists h. It returns ErrHintStoreFull if the
+	// maximum number of pending hints has been reached
+	AddHint(h *Hint) error
+
+	// Hints returns at most limit pending hints of node, oldest first
+	Hints(node string, limit int) ([]*Hint, error)
+
+	// DeleteHint removes a hint which has been replayed
+	DeleteHint(h *Hint) error
+
+	// PendingNodes returns the names of the nodes having pending hints
+	PendingNodes() []string
+}
+
+// hintBatchSize is the number of hints loaded at once during a replay
+const hintBatchSize = 100
+
+// handoff returns a function which records hints for the replicas
+// which missed a write. payloads is only called if at least one replica
+// missed the write. It returns nil if hinted handoff is disabled.
+func (r *Replicator) handoff(shard string, op opID,
+	payloads func() ([][]byte, error),
+) func(nodes []string) {
+	if r.hints == nil {
+		return nil
+	}
+	return func(nodes []string) {
+		xs, err := payloads()
+		if err != nil {
+			r.log.WithField("op", "handoff").WithField("class", r.class).
+				WithField("shard", shard).Errorf("encode hint: %v", err)
+			return
+		}
+		now := time.Now().UnixMilli()
+		for _, node := range nodes {
+			for _, x := range xs {
+				h := &Hint{
+					Node: node, Class: r.class, Shard: shard,
+					Op: op, Payload: x, CreatedAt: now,
+				}
+				if err := r.hints.AddHint(h); err != nil {
+					r.log.WithField("op", "handoff").WithField("class", r.class).
+						WithField("shard", shard).WithField("node", node).
+						Warnf("drop hint: %v", err)
+					return
+				}
+			}
+		}
+	}
+}
+
+// Handoff replays hints to replicas once they become reachable again
+type Handoff struct {
+	nodeName       string
+	store          HintStore
+	client         Client
+	stateGetter    shardingState
+	nodeResolver   nodeResolver
+	log            logrus.FieldLogger
+	requestCounter atomic.Uint64
+}
+
+func NewHandoff(nodeName string, store HintStore, client Client,
+	stateGetter shardingState, nodeResolver nodeResolver, l logrus.FieldLogger,
+) *Handoff {
+	return &Handoff{
+		nodeName:     nodeName,
+		store:        store,
+		client:       client,
+		stateGetter:  stateGetter,
+		nodeResolver: nodeResolver,
+		log:          l,
+	}
+}
+
+// Replay delivers pending hints to all nodes which are members of the
+// cluster. Hints of a node are replayed in the order they were recorded.
+// Replaying stops for a node at the first failure, its remaining hints are
+// retried on the next call.
+//
+// Hints of shards which the node no longer holds, e.g. because the node left
+// the cluster or the shard was moved away, are dropped even if the node is
+// unreachable.
+func (h *Handoff) Replay(ctx context.Context) {
+	for _, node := range h.store.PendingNodes() {
+		host, _ := h.nodeResolver.NodeHostname(node)
+		n, dropped, err := h.replayNode(ctx, node, host)
+		if n > 0 {
+			h.log.WithField("action", "hinted_handoff").WithField("node", node).
+				WithField("hints", n).Info("replayed hints")
+		}
+		if dropped > 0 {
+			h.log.WithField("action", "hinted_handoff").WithField("node", node).
+				WithField("hints", dropped).Info("dropped hints of shards the node no longer holds")
+		}
+		if err != nil {
+			h.log.WithField("action", "hinted_handoff").WithField("node", node).
+				Warnf("replay hints: %v", err)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// replayNode replays all hints of node and returns the number of replayed
+// and dropped hints. An empty host means that node is unreachable, in which
+// case only hints which are no longer needed are removed.
+func (h *Handoff) replayNode(ctx context.Context, node, host string) (n, dropped int, err error) {
+	for {
+		hints, err := h.store.Hints(node, hintBatchSize)
+		if err != nil {
+			return n, dropped, fmt.Errorf("load hints: %w", err)
+		}
+		if len(hints) == 0 {
+			return n, dropped, nil
+		}
+		for _, x := range hints {
+			if err := ctx.Err(); err != nil {
+				return n, dropped, err
+			}
+			if !h.holds(node, x) {
+				dropped++
+			} else if host == "" {
+				return n, dropped, nil
+			} else if err := h.replay(ctx, host, x); err != nil {
+				return n, dropped, fmt.Errorf("class %q shard %q: %w", x.Class, x.Shard, err)
+			} else {
+				n++
+			}
+			if err := h.store.DeleteHint(x); err != nil {
+				return n, dropped, fmt.Errorf("delete hint: %w", err)
+			}
+		}
+	}
+}
+
+// holds returns true if node still holds a replica of the hinted shard
+func (h *Handoff) holds(node string, x *Hint) bool {
+	m, err := h.stateGetter.ResolveParentNodes(x.Class, x.Shard)
+	if err != nil {
+		return false
+	}
+	_, ok := m[node]
+	return ok
+}
+
+// replay executes the hinted write on host unless the replica already holds
+// a more recent version of the object. Objects deleted on the replica are
+// never recreated since the time of their deletion is unknown.
+func (h *Handoff) replay(ctx context.Context, host string, x *Hint) error {
+	var (
+		resp      SimpleResponse
+		err       error
+		requestID = h.requestID(x.Op)
+	)
+	switch x.Op {
+	case opPutObject:
+		// the object is only passed on, the replica parses its properties
+		// according to the schema when writing it
+		obj, uerr := storobj.FromBinary(x.Payload, nil)
+		if uerr != nil {
+			return fmt.Errorf("decode object: %w", uerr)
+		}
+		return h.overwrite(ctx, host, x, obj)
+	case opMergeObject:
+		var doc objects.MergeDocument
+		if uerr := json.Unmarshal(x.Payload, &doc); uerr != nil {
+			return fmt.Errorf("decode merge document: %w", uerr)
+		}
+		stale, derr := h.stale(ctx, host, x, []strfmt.UUID{doc.ID}, doc.UpdateTime)
+		if derr != nil || stale[0] {
+			return derr
+		}
+		resp, err = h.client.MergeObject(ctx, host, x.Class, x.Shard, requestID, &doc)
+	case opDeleteObject:
+		id := strfmt.UUID(x.Payload)
+		stale, derr := h.stale(ctx, host, x, []strfmt.UUID{id}, x.CreatedAt)
+		if derr != nil || stale[0] {
+			return derr
+		}
+		resp, err = h.client.DeleteObject(ctx, host, x.Class, x.Shard, requestID, id)
+	case opAddReferences:
+		var refs []objects.BatchReference
+		if uerr := json.Unmarshal(x.Payload, &refs); uerr != nil {
+			return fmt.Errorf("decode references: %w", uerr)
+		}
+		ids := make([]strfmt.UUID, len(refs))
+		for i, ref := range refs {
+			ids[i] = ref.From.TargetID
+		}
+		stale, derr := h.stale(ctx, host, x, ids, x.CreatedAt)
+		if derr != nil {
+			return derr
+		}
+		fresh := refs[:0]
+		for i, ref := range refs {
+			if !stale[i] {
+				fresh = append(fresh, ref)
+			}
+		}
+		if len(fresh) == 0 {
+			return nil
+		}
+		resp, err = h.client.AddReferences(ctx, host, x.Class, x.Shard, requestID, fresh)
+	default:
+		return fmt.Errorf("unknown operation %d", x.Op)
+	}
+	if err == nil {
+		err = resp.FirstError()
+	}
+	if err != nil {
+		h.client.Abort(ctx, host, x.Class, x.Shard, requestID)
+		return err
+	}
+	resp = SimpleResponse{}
+	if err := h.client.Commit(ctx, host, x.Class, x.Shard, requestID, &resp); err != nil {
+		return err
+	}
+	return resp.FirstError()
+}
+
+// stale reports for each object whether the hinted write made at
+// updateTime is outdated on host, either because the replica holds a more
+// recent version of the object or because the object has been deleted there.
+func (h *Handoff) stale(ctx context.Context, host string, x *Hint,
+	ids []strfmt.UUID, updateTime int64,
+) ([]bool, error) {
+	rs, err := finderClient{h.client}.DigestReads(ctx, host, x.Class, x.Shard, ids)
+	if err != nil {
+		return nil, fmt.Errorf("digest read: %w", err)
+	}
+	stale := make([]bool, len(rs))
+	for i, r := range rs {
+		stale[i] = r.Deleted || r.UpdateTime > updateTime
+	}
+	return stale, nil
+}
+
+// overwrite replaces the object on host with the hinted one if the replica
+// has not seen a more recent write. The replica applies the write only if
+// its version did not change since it has been read.
+func (h *Handoff) overwrite(ctx context.Context, host string, x *Hint, obj *storobj.Object) error {
+	rs, err := finderClient{h.client}.DigestReads(ctx, host, x.Class, x.Shard,
+		[]strfmt.UUID{obj.ID()})
+	if err != nil {
+		return fmt.Errorf("digest read: %w", err)
+	}
+	if r := rs[0]; r.Deleted || r.UpdateTime >= obj.LastUpdateTimeUnix() {
+		return nil
+	}
+	latest := obj.Object
+	latest.Vector = obj.Vector
+	ups := []*objects.VObject{{LatestObject: &latest, StaleUpdateTime: rs[0].UpdateTime}}
+	rs, err = h.client.OverwriteObjects(ctx, host, x.Class, x.Shard, ups)
+	if err != nil {
+		return fmt.Errorf("overwrite: %w", err)
+	}
+	for _, r := range rs {
+		// a conflict means that a more recent write reached the replica
+		if r.Err != "" && r.Err != "conflict" {
+			return fmt.Errorf("overwrite: %s", r.Err)
+		}
+	}
+	return nil
+}
+
+// requestID has the same format as the one used by the Replicator
+func (h *Handoff) requestID(op opID) string {
+	return fmt.Sprintf("%s-%.2x-%x-%x",
+		h.nodeName,
+		op,
+		time.Now().UnixMilli(),
+		h.requestCounter.Add(1))
+}
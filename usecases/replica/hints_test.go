@@ -0,0 +1,324 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestReplicatorHints(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		id    = strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168241")
+		obj   = storobj.FromObject(&models.Object{ID: id, Class: cls}, nil)
+		resp  = SimpleResponse{}
+	)
+
+	t.Run("UnreachableReplica", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		f.hints = store
+		rep := f.newReplicator()
+		for _, n := range nodes[:2] {
+			f.WClient.On("PutObject", ctx, n, cls, shard, anyVal, obj).Return(resp, nil)
+			f.WClient.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(nil)
+		}
+		f.WClient.On("PutObject", ctx, "C", cls, shard, anyVal, obj).Return(resp, errAny)
+
+		err := rep.PutObject(ctx, shard, obj, Quorum)
+		assert.Nil(t, err)
+		assert.Eventually(t, func() bool { return store.Len() == 1 },
+			time.Second, 10*time.Millisecond)
+		hints, _ := store.Hints("C", 10)
+		require.Len(t, hints, 1)
+		assert.Equal(t, cls, hints[0].Class)
+		assert.Equal(t, shard, hints[0].Shard)
+		assert.Equal(t, opPutObject, hints[0].Op)
+		x, err := storobj.FromBinary(hints[0].Payload, nil)
+		require.Nil(t, err)
+		assert.Equal(t, id, x.ID())
+	})
+
+	t.Run("CommitFails", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		f.hints = store
+		rep := f.newReplicator()
+		for _, n := range nodes {
+			f.WClient.On("PutObject", ctx, n, cls, shard, anyVal, obj).Return(resp, nil)
+		}
+		for _, n := range nodes[:2] {
+			f.WClient.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(nil)
+		}
+		f.WClient.On("Commit", ctx, "C", cls, shard, anyVal, anyVal).Return(errAny)
+
+		err := rep.PutObject(ctx, shard, obj, One)
+		assert.Nil(t, err)
+		assert.Eventually(t, func() bool { return store.Len() == 1 },
+			time.Second, 10*time.Millisecond)
+		hints, _ := store.Hints("C", 10)
+		require.Len(t, hints, 1)
+		assert.Equal(t, opPutObject, hints[0].Op)
+	})
+
+	t.Run("NoHintIfNoReplicaCommitted", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		f.hints = store
+		rep := f.newReplicator()
+		for _, n := range nodes {
+			f.WClient.On("PutObject", ctx, n, cls, shard, anyVal, obj).Return(resp, nil)
+			f.WClient.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(errAny)
+		}
+
+		err := rep.PutObject(ctx, shard, obj, All)
+		assert.NotNil(t, err)
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, 0, store.Len())
+	})
+
+	t.Run("UnresolvedReplica", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		f.hints = store
+		rep := f.newReplicator()
+		rep.resolver.nodeResolver.(*fakeNodeResolver).hosts["C"] = ""
+		for _, n := range nodes[:2] {
+			f.WClient.On("DeleteObject", ctx, n, cls, shard, anyVal, id).Return(resp, nil)
+			f.WClient.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(nil)
+		}
+
+		err := rep.DeleteObject(ctx, shard, id, Quorum)
+		assert.Nil(t, err)
+		assert.Eventually(t, func() bool { return store.Len() == 1 },
+			time.Second, 10*time.Millisecond)
+		hints, _ := store.Hints("C", 10)
+		require.Len(t, hints, 1)
+		assert.Equal(t, opDeleteObject, hints[0].Op)
+		assert.Equal(t, string(id), string(hints[0].Payload))
+	})
+
+	t.Run("BatchIsHintedPerObject", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		f.hints = store
+		rep := f.newReplicator()
+		objs := []*storobj.Object{obj, obj}
+		for _, n := range nodes[:2] {
+			f.WClient.On("PutObjects", ctx, n, cls, shard, anyVal, objs).Return(resp, nil)
+			f.WClient.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(nil)
+		}
+		f.WClient.On("PutObjects", ctx, "C", cls, shard, anyVal, objs).Return(resp, errAny)
+
+		errs := rep.PutObjects(ctx, shard, objs, One)
+		assert.Equal(t, []error{nil, nil}, errs)
+		assert.Eventually(t, func() bool { return store.Len() == 2 },
+			time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("NoHintOnFailure", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		f.hints = store
+		rep := f.newReplicator()
+		f.WClient.On("PutObject", ctx, "A", cls, shard, anyVal, obj).Return(resp, nil)
+		for _, n := range nodes[1:] {
+			f.WClient.On("PutObject", ctx, n, cls, shard, anyVal, obj).Return(resp, errAny)
+		}
+		for _, n := range nodes {
+			f.WClient.On("Abort", ctx, n, cls, shard, anyVal).Return(resp, nil)
+		}
+
+		err := rep.PutObject(ctx, shard, obj, Quorum)
+		assert.ErrorIs(t, err, errReplicas)
+		assert.Equal(t, 0, store.Len())
+	})
+
+	t.Run("StoreFull", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{max: 1}
+		f.hints = store
+		rep := f.newReplicator()
+		objs := []*storobj.Object{obj, obj}
+		for _, n := range nodes[:2] {
+			f.WClient.On("PutObjects", ctx, n, cls, shard, anyVal, objs).Return(resp, nil)
+			f.WClient.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(nil)
+		}
+		f.WClient.On("PutObjects", ctx, "C", cls, shard, anyVal, objs).Return(resp, errAny)
+
+		errs := rep.PutObjects(ctx, shard, objs, One)
+		assert.Equal(t, []error{nil, nil}, errs)
+		assert.Eventually(t, func() bool {
+			entry := f.hook.LastEntry()
+			return entry != nil && entry.Message == "drop hint: "+ErrHintStoreFull.Error()
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, 1, store.Len())
+	})
+}
+
+func TestHandoffReplay(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		id    = strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168241")
+		id2   = strfmt.UUID("a3f2eb5f-5abf-447a-81ca-74b1dd168241")
+		obj   = storobj.FromObject(&models.Object{ID: id, Class: cls, LastUpdateTimeUnix: 3}, nil)
+		ids   = []strfmt.UUID{id}
+		resp  = SimpleResponse{}
+	)
+	payload, err := obj.MarshalBinary()
+	require.Nil(t, err)
+	putHint := &Hint{Node: "B", Class: cls, Shard: shard, Op: opPutObject, Payload: payload}
+	deleteHint := &Hint{Node: "B", Class: cls, Shard: shard, Op: opDeleteObject, Payload: []byte(id), CreatedAt: 3}
+
+	t.Run("Success", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		store.AddHint(putHint)
+		store.AddHint(deleteHint)
+		f.RClient.On("DigestObjects", ctx, "B", cls, shard, ids).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 1}}, nil)
+		f.RClient.On("OverwriteObjects", ctx, "B", cls, shard, anyVal).Return([]RepairResponse(nil), nil).RunFn = func(a mock.Arguments) {
+			ups := a[4].([]*objects.VObject)
+			require.Len(t, ups, 1)
+			assert.Equal(t, id, ups[0].LatestObject.ID)
+			assert.Equal(t, int64(1), ups[0].StaleUpdateTime)
+		}
+		f.WClient.On("DeleteObject", ctx, "B", cls, shard, anyVal, id).Return(resp, nil)
+		f.WClient.On("Commit", ctx, "B", cls, shard, anyVal, anyVal).Return(nil)
+
+		f.newHandoff(store).Replay(ctx)
+		assert.Equal(t, 0, store.Len())
+		f.RClient.AssertNumberOfCalls(t, "OverwriteObjects", 1)
+		f.WClient.AssertNumberOfCalls(t, "Commit", 1)
+	})
+
+	t.Run("NewerWriteOnReplica", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		doc, _ := json.Marshal(&objects.MergeDocument{ID: id, Class: cls, UpdateTime: 3})
+		store.AddHint(putHint)
+		store.AddHint(&Hint{Node: "B", Class: cls, Shard: shard, Op: opMergeObject, Payload: doc})
+		store.AddHint(deleteHint)
+		f.RClient.On("DigestObjects", ctx, "B", cls, shard, ids).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 4}}, nil)
+
+		f.newHandoff(store).Replay(ctx)
+		assert.Equal(t, 0, store.Len())
+		f.RClient.AssertNotCalled(t, "OverwriteObjects")
+		f.WClient.AssertNotCalled(t, "MergeObject")
+		f.WClient.AssertNotCalled(t, "DeleteObject")
+	})
+
+	t.Run("DeletedOnReplica", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		store.AddHint(putHint)
+		f.RClient.On("DigestObjects", ctx, "B", cls, shard, ids).
+			Return([]RepairResponse{{ID: id.String(), Deleted: true}}, nil)
+
+		f.newHandoff(store).Replay(ctx)
+		assert.Equal(t, 0, store.Len())
+		f.RClient.AssertNotCalled(t, "OverwriteObjects")
+	})
+
+	t.Run("ConcurrentWrite", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		store.AddHint(putHint)
+		f.RClient.On("DigestObjects", ctx, "B", cls, shard, ids).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 1}}, nil)
+		f.RClient.On("OverwriteObjects", ctx, "B", cls, shard, anyVal).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 4, Err: "conflict"}}, nil)
+
+		f.newHandoff(store).Replay(ctx)
+		assert.Equal(t, 0, store.Len())
+	})
+
+	t.Run("StaleReferences", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		refs := []objects.BatchReference{
+			{From: &crossref.RefSource{TargetID: id}, To: &crossref.Ref{TargetID: id2}},
+			{From: &crossref.RefSource{TargetID: id2}, To: &crossref.Ref{TargetID: id}},
+		}
+		payload, _ := json.Marshal(refs)
+		store.AddHint(&Hint{Node: "B", Class: cls, Shard: shard, Op: opAddReferences, Payload: payload, CreatedAt: 3})
+		f.RClient.On("DigestObjects", ctx, "B", cls, shard, []strfmt.UUID{id, id2}).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 4}, {ID: id2.String(), UpdateTime: 2}}, nil)
+		f.WClient.On("AddReferences", ctx, "B", cls, shard, anyVal, anyVal).Return(resp, nil).RunFn = func(a mock.Arguments) {
+			xs := a[5].([]objects.BatchReference)
+			require.Len(t, xs, 1)
+			assert.Equal(t, id2, xs[0].From.TargetID)
+		}
+		f.WClient.On("Commit", ctx, "B", cls, shard, anyVal, anyVal).Return(nil)
+
+		f.newHandoff(store).Replay(ctx)
+		assert.Equal(t, 0, store.Len())
+		f.WClient.AssertNumberOfCalls(t, "AddReferences", 1)
+	})
+
+	t.Run("UnreachableNode", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		store.AddHint(&Hint{Node: "C", Class: cls, Shard: shard, Op: opPutObject, Payload: payload})
+		handoff := f.newHandoff(store)
+		handoff.nodeResolver.(*fakeNodeResolver).hosts["C"] = ""
+
+		handoff.Replay(ctx)
+		assert.Equal(t, 1, store.Len())
+		f.RClient.AssertNotCalled(t, "DigestObjects")
+	})
+
+	t.Run("NodeLeftCluster", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		store.AddHint(&Hint{Node: "D", Class: cls, Shard: shard, Op: opPutObject, Payload: payload})
+		store.AddHint(&Hint{Node: "D", Class: "Deleted", Shard: shard, Op: opPutObject, Payload: payload})
+
+		f.newHandoff(store).Replay(ctx)
+		assert.Equal(t, 0, store.Len())
+		f.RClient.AssertNotCalled(t, "DigestObjects")
+	})
+
+	t.Run("StopAtFirstFailure", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		store := &fakeHintStore{}
+		store.AddHint(putHint)
+		store.AddHint(deleteHint)
+		f.RClient.On("DigestObjects", ctx, "B", cls, shard, ids).
+			Return([]RepairResponse{{ID: id.String(), UpdateTime: 1}}, nil)
+		f.RClient.On("OverwriteObjects", ctx, "B", cls, shard, anyVal).
+			Return([]RepairResponse(nil), errAny)
+
+		f.newHandoff(store).Replay(ctx)
+		assert.Equal(t, 2, store.Len())
+		f.WClient.AssertNotCalled(t, "DeleteObject")
+	})
+}
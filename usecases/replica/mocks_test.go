@@ -14,6 +14,7 @@ package replica
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/mock"
@@ -25,6 +26,8 @@ import (
 
 type fakeRClient struct {
 	mock.Mock
+	trees      map[string]*HashTree // hash trees of remote shards by host
+	maxDigests int                  // truncates hash tree levels if set
 }
 
 func (f *fakeRClient) FetchObject(ctx context.Context, host, index, shard string,
@@ -56,6 +59,20 @@ func (f *fakeRClient) DigestObjects(ctx context.Context, host, index, shard stri
 	return args.Get(0).([]RepairResponse), args.Error(1)
 }
 
+func (f *fakeRClient) HashTreeLevel(ctx context.Context, host, index, shard string,
+	level int, nodes []int,
+) ([]uint64, error) {
+	tree, ok := f.trees[host]
+	if !ok {
+		return nil, fmt.Errorf("host %q not found", host)
+	}
+	digests, err := tree.Level(level, nodes)
+	if f.maxDigests > 0 && len(digests) > f.maxDigests {
+		digests = digests[:f.maxDigests]
+	}
+	return digests, err
+}
+
 type fakeClient struct {
 	mock.Mock
 }
@@ -163,3 +180,64 @@ func newFakeNodeResolver(nodes []string) *fakeNodeResolver {
 	}
 	return &fakeNodeResolver{hosts: hosts}
 }
+
+type fakeHintStore struct {
+	sync.Mutex
+	max   int
+	seq   int
+	hints []*Hint
+}
+
+func (f *fakeHintStore) AddHint(h *Hint) error {
+	f.Lock()
+	defer f.Unlock()
+	if f.max > 0 && len(f.hints) >= f.max {
+		return ErrHintStoreFull
+	}
+	f.seq++
+	h.Key = []byte(fmt.Sprint(f.seq))
+	f.hints = append(f.hints, h)
+	return nil
+}
+
+func (f *fakeHintStore) Hints(node string, limit int) ([]*Hint, error) {
+	f.Lock()
+	defer f.Unlock()
+	var xs []*Hint
+	for _, h := range f.hints {
+		if h.Node == node && len(xs) < limit {
+			xs = append(xs, h)
+		}
+	}
+	return xs, nil
+}
+
+func (f *fakeHintStore) DeleteHint(h *Hint) error {
+	f.Lock()
+	defer f.Unlock()
+	for i, x := range f.hints {
+		if string(x.Key) == string(h.Key) {
+			f.hints = append(f.hints[:i], f.hints[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (f *fakeHintStore) PendingNodes() []string {
+	f.Lock()
+	defer f.Unlock()
+	var nodes []string
+	for _, h := range f.hints {
+		if !contains(nodes, h.Node) {
+			nodes = append(nodes, h.Node)
+		}
+	}
+	return nodes
+}
+
+func (f *fakeHintStore) Len() int {
+	f.Lock()
+	defer f.Unlock()
+	return len(f.hints)
+}
@@ -46,6 +46,8 @@ type RemoteIncomingRepo interface {
 		shardName string, ids []strfmt.UUID) ([]objects.Replica, error)
 	DigestObjects(ctx context.Context, class, shardName string,
 		ids []strfmt.UUID) (result []RepairResponse, err error)
+	HashTreeLevel(ctx context.Context, class, shardName string,
+		level int, nodes []int) ([]uint64, error)
 }
 
 type RemoteReplicaIncoming struct {
@@ -129,3 +131,9 @@ func (rri *RemoteReplicaIncoming) DigestObjects(ctx context.Context,
 ) (result []RepairResponse, err error) {
 	return rri.repo.DigestObjects(ctx, indexName, shardName, ids)
 }
+
+func (rri *RemoteReplicaIncoming) HashTreeLevel(ctx context.Context,
+	indexName, shardName string, level int, nodes []int,
+) ([]uint64, error) {
+	return rri.repo.HashTreeLevel(ctx, indexName, shardName, level, nodes)
+}
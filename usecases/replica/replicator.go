@@ -13,6 +13,7 @@ package replica
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync/atomic"
 	"time"
@@ -61,6 +62,7 @@ type Replicator struct {
 	log            logrus.FieldLogger
 	requestCounter atomic.Uint64
 	stream         replicatorStream
+	hints          HintStore // optional, nil if hinted handoff is disabled
 	*Finder
 }
 
@@ -68,6 +70,7 @@ func NewReplicator(className string,
 	stateGetter shardingState,
 	nodeResolver nodeResolver,
 	client Client,
+	hints HintStore,
 	l logrus.FieldLogger,
 ) *Replicator {
 	resolver := &resolver{
@@ -81,6 +84,7 @@ func NewReplicator(className string,
 		stateGetter: stateGetter,
 		client:      client,
 		resolver:    resolver,
+		hints:       hints,
 		log:         l,
 		Finder:      NewFinder(className, resolver, client, l),
 	}
@@ -92,6 +96,10 @@ func (r *Replicator) PutObject(ctx context.Context,
 	l ConsistencyLevel,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opPutObject), r.log)
+	coord.handoff = r.handoff(shard, opPutObject, func() ([][]byte, error) {
+		b, err := obj.MarshalBinary()
+		return [][]byte{b}, err
+	})
 	isReady := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.PutObject(ctx, host, r.class, shard, requestID, obj)
 		if err == nil {
@@ -123,6 +131,10 @@ func (r *Replicator) MergeObject(ctx context.Context,
 	l ConsistencyLevel,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opMergeObject), r.log)
+	coord.handoff = r.handoff(shard, opMergeObject, func() ([][]byte, error) {
+		b, err := json.Marshal(doc)
+		return [][]byte{b}, err
+	})
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.MergeObject(ctx, host, r.class, shard, requestID, doc)
 		if err == nil {
@@ -153,6 +165,9 @@ func (r *Replicator) DeleteObject(ctx context.Context,
 	l ConsistencyLevel,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opDeleteObject), r.log)
+	coord.handoff = r.handoff(shard, opDeleteObject, func() ([][]byte, error) {
+		return [][]byte{[]byte(id)}, nil
+	})
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.DeleteObject(ctx, host, r.class, shard, requestID, id)
 		if err == nil {
@@ -183,6 +198,18 @@ func (r *Replicator) PutObjects(ctx context.Context,
 	l ConsistencyLevel,
 ) []error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opPutObjects), r.log)
+	// objects are hinted one by one so that they can be replayed independently
+	coord.handoff = r.handoff(shard, opPutObject, func() ([][]byte, error) {
+		xs := make([][]byte, len(objs))
+		for i, obj := range objs {
+			b, err := obj.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+			xs[i] = b
+		}
+		return xs, nil
+	})
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.PutObjects(ctx, host, r.class, shard, requestID, objs)
 		if err == nil {
@@ -219,6 +246,8 @@ func (r *Replicator) DeleteObjects(ctx context.Context,
 	dryRun bool,
 	l ConsistencyLevel,
 ) []objects.BatchSimpleObject {
+	// Batch deletions are not hinted since they address objects by doc ids,
+	// which are local to each replica. Missed deletions are left to repairs.
 	coord := newCoordinator[DeleteBatchResponse](r, shard, r.requestID(opDeleteObjects), r.log)
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.DeleteObjects(
@@ -268,6 +297,10 @@ func (r *Replicator) AddReferences(ctx context.Context,
 	l ConsistencyLevel,
 ) []error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opAddReferences), r.log)
+	coord.handoff = r.handoff(shard, opAddReferences, func() ([][]byte, error) {
+		b, err := json.Marshal(refs)
+		return [][]byte{b}, err
+	})
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.AddReferences(ctx, host, r.class, shard, requestID, refs)
 		if err == nil {
@@ -677,6 +677,7 @@ type fakeFactory struct {
 	Shard2replicas map[string][]string
 	WClient        *fakeClient
 	RClient        *fakeRClient
+	hints          HintStore
 	log            *logrus.Logger
 	hook           *test.Hook
 }
@@ -709,7 +710,17 @@ func (f fakeFactory) newReplicator() *Replicator {
 		struct {
 			rClient
 			wClient
-		}{f.RClient, f.WClient}, f.log)
+		}{f.RClient, f.WClient}, f.hints, f.log)
+}
+
+func (f fakeFactory) newHandoff(store HintStore) *Handoff {
+	nodeResolver := newFakeNodeResolver(f.Nodes)
+	shardingState := newFakeShardingState("A", f.Shard2replicas, nodeResolver)
+	return NewHandoff("A", store,
+		struct {
+			rClient
+			wClient
+		}{f.RClient, f.WClient}, shardingState, nodeResolver, f.log)
 }
 
 func (f fakeFactory) newFinder(thisNode string) *Finder {
@@ -202,6 +202,11 @@ type rClient interface {
 	// object
 	DigestObjects(ctx context.Context, host, index, shard string,
 		ids []strfmt.UUID) ([]RepairResponse, error)
+
+	// HashTreeLevel returns the digests of the given nodes of one level of
+	// the hash tree of a shard. It is used for anti-entropy.
+	HashTreeLevel(ctx context.Context, host, index, shard string,
+		level int, nodes []int) ([]uint64, error)
 }
 
 // finderClient extends RClient with consistency checks
@@ -252,3 +257,15 @@ func (fc finderClient) Overwrite(ctx context.Context,
 ) ([]RepairResponse, error) {
 	return fc.cl.OverwriteObjects(ctx, host, index, shard, xs)
 }
+
+// HashTreeLevel reads the digests of the given nodes of a hash tree level
+func (fc finderClient) HashTreeLevel(ctx context.Context,
+	host, index, shard string,
+	level int, nodes []int,
+) ([]uint64, error) {
+	rs, err := fc.cl.HashTreeLevel(ctx, host, index, shard, level, nodes)
+	if m := len(rs); err == nil && len(nodes) != m {
+		err = fmt.Errorf("malformed hash tree response: length expected %d got %d", len(nodes), m)
+	}
+	return rs, err
+}
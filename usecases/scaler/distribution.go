@@ -45,6 +45,46 @@ func distributions(before, after *sharding.State) (ShardDist, nodeShardDist) {
 	return localDist, nodeDist
 }
 
+// removals returns for the local node as well as for remote nodes the shards
+// which are removed from them. Each shard is mapped to its remaining replicas.
+func removals(before, after *sharding.State, localNode string) (ShardDist, nodeShardDist) {
+	localDist := make(ShardDist)
+	nodeDist := make(map[string]ShardDist)
+	for name := range before.Physical {
+		remaining := after.Physical[name].BelongsToNodes
+		for _, node := range difference(before.Physical[name].BelongsToNodes, remaining) {
+			if node == localNode {
+				localDist[name] = remaining
+				continue
+			}
+			dist := nodeDist[node]
+			if dist == nil {
+				dist = make(map[string][]string)
+				nodeDist[node] = dist
+			}
+			dist[name] = remaining
+		}
+	}
+	return localDist, nodeDist
+}
+
+// preferNodes moves preferred nodes to the front of xs while keeping
+// the relative order of the remaining elements
+func preferNodes(xs []string, preferred map[string]bool) []string {
+	rs := make([]string, 0, len(xs))
+	for _, x := range xs {
+		if preferred[x] {
+			rs = append(rs, x)
+		}
+	}
+	for _, x := range xs {
+		if !preferred[x] {
+			rs = append(rs, x)
+		}
+	}
+	return rs
+}
+
 // nodes return node names
 func (m nodeShardDist) nodes() []string {
 	ns := make([]string, 0, len(m))
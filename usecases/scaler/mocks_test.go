@@ -21,6 +21,8 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -79,6 +81,9 @@ func (f *fakeFactory) Scaler(dataPath string) *Scaler {
 type fakeShardingState struct {
 	LocalNode string
 	M         map[string][]string
+	// Updates records the replicas of all updated sharding states
+	Updates   []map[string][]string
+	UpdateErr error
 }
 
 func (f *fakeShardingState) ShardingState(class string) *sharding.State {
@@ -88,12 +93,33 @@ func (f *fakeShardingState) ShardingState(class string) *sharding.State {
 	state := sharding.State{}
 	state.Physical = make(map[string]sharding.Physical)
 	for shard, nodes := range f.M {
-		state.Physical[shard] = sharding.Physical{BelongsToNodes: nodes}
+		state.Physical[shard] = sharding.Physical{Name: shard, BelongsToNodes: nodes}
 	}
 	state.SetLocalName(f.LocalNode)
 	return &state
 }
 
+func (f *fakeShardingState) UpdateShardingState(ctx context.Context,
+	class string, ss *sharding.State,
+) error {
+	if f.UpdateErr != nil {
+		return f.UpdateErr
+	}
+	m := make(map[string][]string, len(ss.Physical))
+	for name, shard := range ss.Physical {
+		m[name] = shard.BelongsToNodes
+	}
+	f.M = m
+	f.Updates = append(f.Updates, m)
+	return nil
+}
+
+func (f *fakeShardingState) GetSchemaSkipAuth() schema.Schema {
+	return schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{{Class: "C"}},
+	}}
+}
+
 // func newShardingState(nShard, rf int, localNode string) fakeShardingState {
 // 	m := make(map[string][]string)
 // 	for i := 0; i < nShard; i++ {
@@ -150,6 +176,26 @@ func (s *fakeSource) ShardsBackup(
 	return args.Get(0).(backup.ClassDescriptor), args.Error(1)
 }
 
+func (s *fakeSource) SyncShardReplica(ctx context.Context, class, shard, node string) error {
+	args := s.Called(ctx, class, shard, node)
+	return args.Error(0)
+}
+
+func (s *fakeSource) LocalShardStatus(ctx context.Context, class, shard string) (string, error) {
+	args := s.Called(ctx, class, shard)
+	return args.String(0), args.Error(1)
+}
+
+func (s *fakeSource) UpdateLocalShardStatus(ctx context.Context, class, shard, status string) error {
+	args := s.Called(ctx, class, shard, status)
+	return args.Error(0)
+}
+
+func (s *fakeSource) VerifyShardReplicas(ctx context.Context, class, shard string, nodes []string) error {
+	args := s.Called(ctx, class, shard, nodes)
+	return args.Error(0)
+}
+
 type fakeClient struct {
 	mock.Mock
 }
@@ -178,3 +224,31 @@ func (f *fakeClient) IncreaseReplicationFactor(ctx context.Context,
 	args := f.Called(ctx, host, class, dist)
 	return args.Error(0)
 }
+
+func (f *fakeClient) DecreaseReplicationFactor(ctx context.Context,
+	host, class string, dist ShardDist,
+) error {
+	args := f.Called(ctx, host, class, dist)
+	return args.Error(0)
+}
+
+func (f *fakeClient) SyncShards(ctx context.Context,
+	host, class string, dist ShardDist,
+) error {
+	args := f.Called(ctx, host, class, dist)
+	return args.Error(0)
+}
+
+func (f *fakeClient) GetShardStatus(ctx context.Context,
+	host, class, shard string,
+) (string, error) {
+	args := f.Called(ctx, host, class, shard)
+	return args.String(0), args.Error(1)
+}
+
+func (f *fakeClient) UpdateShardStatus(ctx context.Context,
+	host, class, shard, status string,
+) error {
+	args := f.Called(ctx, host, class, shard, status)
+	return args.Error(0)
+}
@@ -0,0 +1,501 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+var (
+	// ErrInvalidMove the requested shard move cannot be performed
+	ErrInvalidMove = errors.New("invalid shard move")
+	// ErrMoveNotFound no shard move with the given id exists on this node
+	ErrMoveNotFound = errors.New("shard move not found")
+)
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// Mover moves physical shards between nodes.
+//
+// A shard is moved from a source to a target node in the following steps:
+//   - The shard is copied to the target node using the same path as scaling out
+//   - Writes which happened during the copy are caught up by syncing the source
+//     and target replica with each other. The target is not a replica yet, so
+//     it serves no reads while it is incomplete.
+//   - The source replica is made read-only, so that no write gets lost, and
+//     it is synced with the target once more. Writes to the shard are rejected
+//     with a read-only error until the move is done.
+//   - The source node is replaced by the target node within a single update of
+//     the sharding state. The source node then deletes its copy of the shard.
+//
+// If the move fails after the copy, the copy is left behind on the target
+// node, just like after a failed copy.
+//
+// Moves are tracked in memory by the node which started them.
+type Mover struct {
+	scaler     *Scaler
+	authorizer authorizer
+	logger     logrus.FieldLogger
+
+	sync.Mutex
+	moves   map[string]*models.ShardMove
+	running map[string]bool // shards being moved
+}
+
+// NewMover returns a new instance of Mover
+func NewMover(s *Scaler, authorizer authorizer, logger logrus.FieldLogger) *Mover {
+	return &Mover{
+		scaler:     s,
+		authorizer: authorizer,
+		logger:     logger,
+		moves:      make(map[string]*models.ShardMove),
+		running:    make(map[string]bool),
+	}
+}
+
+// Move starts moving a shard in the background and returns its status
+func (m *Mover) Move(ctx context.Context, principal *models.Principal,
+	move *models.ShardMove,
+) (*models.ShardMove, error) {
+	if move == nil {
+		return nil, fmt.Errorf("%w: empty request", ErrInvalidMove)
+	}
+	path := fmt.Sprintf("schema/%s/shards/%s", move.Class, move.Shard)
+	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
+		return nil, err
+	}
+	return m.start(move.Class, move.Shard, move.SourceNode, move.TargetNode)
+}
+
+// Get returns the status of a shard move
+func (m *Mover) Get(ctx context.Context, principal *models.Principal,
+	id string,
+) (*models.ShardMove, error) {
+	m.Lock()
+	move, ok := m.moves[id]
+	var status models.ShardMove
+	if ok {
+		status = *move
+	}
+	m.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrMoveNotFound, id)
+	}
+	path := fmt.Sprintf("schema/%s/shards", status.Class)
+	if err := m.authorizer.Authorize(principal, "list", path); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// List returns the status of all shard moves, most recent first
+func (m *Mover) List(ctx context.Context, principal *models.Principal,
+) ([]*models.ShardMove, error) {
+	if err := m.authorizer.Authorize(principal, "list", "schema/*"); err != nil {
+		return nil, err
+	}
+	m.Lock()
+	defer m.Unlock()
+	moves := make([]*models.ShardMove, 0, len(m.moves))
+	for _, move := range m.moves {
+		status := *move
+		moves = append(moves, &status)
+	}
+	sort.Slice(moves, func(i, j int) bool {
+		if moves[i].StartTimeUnix != moves[j].StartTimeUnix {
+			return moves[i].StartTimeUnix > moves[j].StartTimeUnix
+		}
+		return moves[i].ID < moves[j].ID
+	})
+	return moves, nil
+}
+
+// Running returns true if any shard is currently being moved
+func (m *Mover) Running() bool {
+	m.Lock()
+	defer m.Unlock()
+	return len(m.running) > 0
+}
+
+// start validates the move and runs it in the background
+func (m *Mover) start(class, shard, source, target string) (*models.ShardMove, error) {
+	move, key, err := m.register(class, shard, source, target)
+	if err != nil {
+		return nil, err
+	}
+	m.Lock()
+	status := *move
+	m.Unlock()
+
+	go func() {
+		m.finish(move, key, m.run(context.Background(), move, source))
+	}()
+
+	return &status, nil
+}
+
+// Replace replaces the replica of a shard on the source node by a new
+// replica on the target node and waits until it is done. The shard is copied
+// from node from, which differs from source if source is not reachable.
+func (m *Mover) Replace(ctx context.Context, class, shard, source, target, from string) error {
+	move, key, err := m.register(class, shard, source, target)
+	if err != nil {
+		return err
+	}
+	err = m.run(ctx, move, from)
+	m.finish(move, key, err)
+	return err
+}
+
+// register validates the move and tracks it as running
+func (m *Mover) register(class, shard, source, target string) (*models.ShardMove, string, error) {
+	if err := m.validate(class, shard, source, target); err != nil {
+		return nil, "", err
+	}
+
+	key := class + "/" + shard
+	m.Lock()
+	defer m.Unlock()
+	if m.running[key] {
+		return nil, "", fmt.Errorf("%w: shard %q of class %q is already being moved",
+			ErrInvalidMove, shard, class)
+	}
+	move := &models.ShardMove{
+		ID:            uuid.New().String(),
+		Class:         class,
+		Shard:         shard,
+		SourceNode:    source,
+		TargetNode:    target,
+		Status:        models.ShardMoveStatusSTARTED,
+		StartTimeUnix: time.Now().UnixMilli(),
+	}
+	m.moves[move.ID] = move
+	m.running[key] = true
+	return move, key, nil
+}
+
+// finish records the outcome of a move
+func (m *Mover) finish(move *models.ShardMove, key string, err error) {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.running, key)
+	move.CompletionTimeUnix = time.Now().UnixMilli()
+	if err != nil {
+		move.Status = models.ShardMoveStatusFAILED
+		move.Error = err.Error()
+		m.logger.WithField("action", "shard_move").WithField("class", move.Class).
+			WithField("shard", move.Shard).Error(err)
+		return
+	}
+	move.Status = models.ShardMoveStatusSUCCESS
+}
+
+func (m *Mover) validate(class, shard, source, target string) error {
+	if class == "" || shard == "" || source == "" || target == "" {
+		return fmt.Errorf("%w: class, shard, sourceNode and targetNode are required", ErrInvalidMove)
+	}
+	if source == target {
+		return fmt.Errorf("%w: source and target node are the same", ErrInvalidMove)
+	}
+	ss := m.scaler.schema.ShardingState(class)
+	if ss == nil {
+		return fmt.Errorf("%w: class %q not found", ErrInvalidMove, class)
+	}
+	phys, ok := ss.Physical[shard]
+	if !ok {
+		return fmt.Errorf("%w: shard %q not found", ErrInvalidMove, shard)
+	}
+	if !contains(phys.BelongsToNodes, source) {
+		return fmt.Errorf("%w: shard %q does not belong to node %q", ErrInvalidMove, shard, source)
+	}
+	if contains(phys.BelongsToNodes, target) {
+		return fmt.Errorf("%w: shard %q already belongs to node %q", ErrInvalidMove, shard, target)
+	}
+	if !contains(m.scaler.cluster.Candidates(), target) {
+		return fmt.Errorf("%w: target node %q is not part of the cluster", ErrInvalidMove, target)
+	}
+	return nil
+}
+
+func (m *Mover) setStatus(move *models.ShardMove, status string) {
+	m.Lock()
+	defer m.Unlock()
+	move.Status = status
+}
+
+// run performs the actual move, copying the shard from node from
+func (m *Mover) run(ctx context.Context, move *models.ShardMove, from string) error {
+	var (
+		class, shard   = move.Class, move.Shard
+		source, target = move.SourceNode, move.TargetNode
+	)
+
+	m.setStatus(move, models.ShardMoveStatusCOPYING)
+	dist := ShardDist{shard: {target}}
+	if err := m.onNode(ctx, from, class, dist, m.scaler.LocalScaleOut,
+		m.scaler.client.IncreaseReplicationFactor); err != nil {
+		return fmt.Errorf("copy shard: %w", err)
+	}
+
+	m.setStatus(move, models.ShardMoveStatusCATCHINGUP)
+	if err := m.catchUp(ctx, class, shard, from, target); err != nil {
+		return fmt.Errorf("catch up: %w", err)
+	}
+	thaw, err := m.freeze(ctx, class, shard, from)
+	if err != nil {
+		return fmt.Errorf("make source read-only: %w", err)
+	}
+	if err := m.catchUp(ctx, class, shard, from, target); err != nil {
+		thaw()
+		return fmt.Errorf("final catch up: %w", err)
+	}
+
+	m.setStatus(move, models.ShardMoveStatusSWITCHING)
+	err = m.updateReplicas(ctx, class, shard, source, func(nodes []string) []string {
+		for i, node := range nodes {
+			if node == source {
+				nodes[i] = target
+			}
+		}
+		return nodes
+	})
+	if err != nil {
+		thaw()
+		return fmt.Errorf("switch replicas: %w", err)
+	}
+	if from != source {
+		// the shard was copied from another replica which remains in place
+		thaw()
+	}
+
+	return nil
+}
+
+// freeze makes the replica of a shard on node read-only and waits for the
+// writes in flight to complete. thaw restores the status the replica had
+// before, it is restored right away if the writes don't complete in time.
+func (m *Mover) freeze(ctx context.Context, class, shard, node string) (thaw func(), err error) {
+	var (
+		status string
+		local  = node == m.scaler.cluster.LocalName()
+	)
+	host, ok := m.scaler.cluster.NodeHostname(node)
+	if !local && !ok {
+		return nil, fmt.Errorf("%w, %q", ErrUnresolvedName, node)
+	}
+	update := func(ctx context.Context, status string) error {
+		if local {
+			return m.scaler.source.UpdateLocalShardStatus(ctx, class, shard, status)
+		}
+		return m.scaler.client.UpdateShardStatus(ctx, host, class, shard, status)
+	}
+
+	if local {
+		status, err = m.scaler.source.LocalShardStatus(ctx, class, shard)
+	} else {
+		status, err = m.scaler.client.GetShardStatus(ctx, host, class, shard)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get status: %w", err)
+	}
+	thaw = func() {
+		if err := update(context.Background(), status); err != nil {
+			m.logger.WithField("action", "shard_move").WithField("class", class).
+				WithField("shard", shard).Errorf("restore status on node %q: %v", node, err)
+		}
+	}
+	if err := update(ctx, storagestate.StatusReadOnly.String()); err != nil {
+		thaw()
+		return nil, fmt.Errorf("update status: %w", err)
+	}
+
+	return thaw, nil
+}
+
+// catchUp syncs source and target replicas with each other
+func (m *Mover) catchUp(ctx context.Context, class, shard, source, target string) error {
+	err := m.onNode(ctx, source, class, ShardDist{shard: {target}},
+		m.scaler.LocalSyncShards, m.scaler.client.SyncShards)
+	if err != nil {
+		return err
+	}
+	return m.onNode(ctx, target, class, ShardDist{shard: {source}},
+		m.scaler.LocalSyncShards, m.scaler.client.SyncShards)
+}
+
+// onNode runs local if node is the local node and otherwise delegates to remote
+func (m *Mover) onNode(ctx context.Context, node, class string, dist ShardDist,
+	local func(ctx context.Context, class string, dist ShardDist) error,
+	remote func(ctx context.Context, host, class string, dist ShardDist) error,
+) error {
+	if node == m.scaler.cluster.LocalName() {
+		return local(ctx, class, dist)
+	}
+	host, ok := m.scaler.cluster.NodeHostname(node)
+	if !ok {
+		return fmt.Errorf("%w, %q", ErrUnresolvedName, node)
+	}
+	return remote(ctx, host, class, dist)
+}
+
+// updateReplicas changes the replicas of a shard and commits the updated
+// sharding state. It makes sure the source node still holds the shard.
+func (m *Mover) updateReplicas(ctx context.Context, class, shard, source string,
+	update func(nodes []string) []string,
+) error {
+	current := m.scaler.schema.ShardingState(class)
+	if current == nil {
+		return fmt.Errorf("class %q not found", class)
+	}
+	ss := current.DeepCopy()
+	phys, ok := ss.Physical[shard]
+	if !ok || !contains(phys.BelongsToNodes, source) {
+		return fmt.Errorf("shard %q no longer belongs to node %q", shard, source)
+	}
+	phys.BelongsToNodes = update(phys.BelongsToNodes)
+	ss.Physical[shard] = phys
+	return m.scaler.schema.UpdateShardingState(ctx, class, &ss)
+}
+
+// Rebalance moves shards between nodes every interval until ctx is cancelled,
+// so that each node holds roughly the same number of shard replicas. It is
+// meant to be started on every node, only the coordinator will move shards.
+func (m *Mover) Rebalance(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if m.isCoordinator() && !m.Running() {
+				m.rebalance()
+			}
+		}
+	}
+}
+
+// isCoordinator returns true if the local node is the one responsible for
+// rebalancing shards
+func (m *Mover) isCoordinator() bool {
+	names := m.scaler.cluster.Candidates()
+	if len(names) == 0 {
+		return false
+	}
+	sort.Strings(names)
+	return names[0] == m.scaler.cluster.LocalName()
+}
+
+// rebalance starts a single shard move if the cluster is unbalanced
+func (m *Mover) rebalance() {
+	sch := m.scaler.schema.GetSchemaSkipAuth()
+	if sch.Objects == nil {
+		return
+	}
+	states := make(map[string]*sharding.State, len(sch.Objects.Classes))
+	for _, class := range sch.Objects.Classes {
+		if ss := m.scaler.schema.ShardingState(class.Class); ss != nil {
+			states[class.Class] = ss
+		}
+	}
+	move := nextMove(states, m.scaler.cluster.Candidates())
+	if move == nil {
+		return
+	}
+	status, err := m.start(move.Class, move.Shard, move.SourceNode, move.TargetNode)
+	if err != nil {
+		m.logger.WithField("action", "shard_rebalance").Error(err)
+		return
+	}
+	m.logger.WithField("action", "shard_rebalance").WithField("id", status.ID).
+		WithField("class", status.Class).WithField("shard", status.Shard).
+		Infof("moving shard from node %q to node %q", status.SourceNode, status.TargetNode)
+}
+
+// nextMove proposes to move a shard replica from the node holding the most
+// replicas to the node holding the least. It returns nil if the difference
+// between both is at most one.
+func nextMove(states map[string]*sharding.State, nodes []string) *models.ShardMove {
+	if len(nodes) < 2 {
+		return nil
+	}
+	nodes = append([]string{}, nodes...)
+	sort.Strings(nodes)
+	counts := make(map[string]int, len(nodes))
+	for _, node := range nodes {
+		counts[node] = 0
+	}
+	classes := make([]string, 0, len(states))
+	for class, ss := range states {
+		classes = append(classes, class)
+		for _, phys := range ss.Physical {
+			for _, node := range phys.BelongsToNodes {
+				if _, ok := counts[node]; ok {
+					counts[node]++
+				}
+			}
+		}
+	}
+	sort.Strings(classes)
+
+	most, least := nodes[0], nodes[0]
+	for _, node := range nodes {
+		if counts[node] > counts[most] {
+			most = node
+		}
+		if counts[node] < counts[least] {
+			least = node
+		}
+	}
+	if counts[most]-counts[least] <= 1 {
+		return nil
+	}
+
+	for _, class := range classes {
+		ss := states[class]
+		for _, shard := range ss.AllPhysicalShards() {
+			replicas := ss.Physical[shard].BelongsToNodes
+			if contains(replicas, most) && !contains(replicas, least) {
+				return &models.ShardMove{
+					Class:      class,
+					Shard:      shard,
+					SourceNode: most,
+					TargetNode: least,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func contains(xs []string, x string) bool {
+	for _, y := range xs {
+		if x == y {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,347 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return a.err
+}
+
+func (f *fakeFactory) Mover() *Mover {
+	return NewMover(f.Scaler(""), &fakeAuthorizer{}, f.logger)
+}
+
+// waitForMove waits until the move has either succeeded or failed
+func waitForMove(t *testing.T, m *Mover, id string) *models.ShardMove {
+	var move *models.ShardMove
+	require.Eventually(t, func() bool {
+		var err error
+		move, err = m.Get(context.Background(), nil, id)
+		require.Nil(t, err)
+		return move.Status == models.ShardMoveStatusSUCCESS ||
+			move.Status == models.ShardMoveStatusFAILED
+	}, 5*time.Second, 5*time.Millisecond)
+	return move
+}
+
+func TestMoverMove(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cls = "C"
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, ShardDist{"S3": {"N2"}}).Return(nil)
+		f.Client.On("SyncShards", anyVal, "H3", cls, ShardDist{"S3": {"N2"}}).Return(nil).Twice()
+		f.Client.On("SyncShards", anyVal, "H2", cls, ShardDist{"S3": {"N3"}}).Return(nil).Twice()
+		f.Client.On("GetShardStatus", anyVal, "H3", cls, "S3").Return("READY", nil)
+		f.Client.On("UpdateShardStatus", anyVal, "H3", cls, "S3", "READONLY").Return(nil)
+		m := f.Mover()
+
+		started, err := m.Move(ctx, nil, &models.ShardMove{
+			Class: cls, Shard: "S3", SourceNode: "N3", TargetNode: "N2",
+		})
+		require.Nil(t, err)
+		assert.NotEmpty(t, started.ID)
+		assert.Equal(t, models.ShardMoveStatusSTARTED, started.Status)
+
+		move := waitForMove(t, m, started.ID)
+		assert.Equal(t, models.ShardMoveStatusSUCCESS, move.Status, move.Error)
+		assert.NotZero(t, move.CompletionTimeUnix)
+		want := []map[string][]string{
+			{"S1": {"N1"}, "S3": {"N2", "N4"}},
+		}
+		assert.Equal(t, want, f.ShardingState.Updates)
+		f.Client.AssertExpectations(t)
+
+		moves, err := m.List(ctx, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []*models.ShardMove{move}, moves)
+	})
+
+	t.Run("ReplaceFromOtherReplica", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H4", cls, ShardDist{"S3": {"N2"}}).Return(nil)
+		f.Client.On("SyncShards", anyVal, "H4", cls, ShardDist{"S3": {"N2"}}).Return(nil).Twice()
+		f.Client.On("SyncShards", anyVal, "H2", cls, ShardDist{"S3": {"N4"}}).Return(nil).Twice()
+		f.Client.On("GetShardStatus", anyVal, "H4", cls, "S3").Return("READY", nil)
+		f.Client.On("UpdateShardStatus", anyVal, "H4", cls, "S3", "READONLY").Return(nil).Once()
+		// the replica the shard was copied from remains in place
+		f.Client.On("UpdateShardStatus", anyVal, "H4", cls, "S3", "READY").Return(nil).Once()
+		m := f.Mover()
+
+		err := m.Replace(ctx, cls, "S3", "N3", "N2", "N4")
+		require.Nil(t, err)
+		want := []map[string][]string{
+			{"S1": {"N1"}, "S3": {"N2", "N4"}},
+		}
+		assert.Equal(t, want, f.ShardingState.Updates)
+		f.Client.AssertExpectations(t)
+		assert.False(t, m.Running())
+	})
+
+	t.Run("CopyFails", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, anyVal).Return(errAny)
+		m := f.Mover()
+
+		started, err := m.Move(ctx, nil, &models.ShardMove{
+			Class: cls, Shard: "S3", SourceNode: "N3", TargetNode: "N2",
+		})
+		require.Nil(t, err)
+		move := waitForMove(t, m, started.ID)
+		assert.Equal(t, models.ShardMoveStatusFAILED, move.Status)
+		assert.Contains(t, move.Error, errAny.Error())
+		assert.Empty(t, f.ShardingState.Updates)
+	})
+
+	t.Run("CatchUpFails", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, anyVal).Return(nil)
+		f.Client.On("SyncShards", anyVal, "H3", cls, anyVal).Return(nil)
+		f.Client.On("SyncShards", anyVal, "H2", cls, anyVal).Return(errAny)
+		m := f.Mover()
+
+		started, err := m.Move(ctx, nil, &models.ShardMove{
+			Class: cls, Shard: "S3", SourceNode: "N3", TargetNode: "N2",
+		})
+		require.Nil(t, err)
+		move := waitForMove(t, m, started.ID)
+		assert.Equal(t, models.ShardMoveStatusFAILED, move.Status)
+		// the target never became a replica
+		assert.Empty(t, f.ShardingState.Updates)
+	})
+
+	t.Run("SwitchFails", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.UpdateErr = errAny
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, anyVal).Return(nil)
+		f.Client.On("SyncShards", anyVal, anyVal, cls, anyVal).Return(nil)
+		f.Client.On("GetShardStatus", anyVal, "H3", cls, "S3").Return("READY", nil)
+		f.Client.On("UpdateShardStatus", anyVal, "H3", cls, "S3", "READONLY").Return(nil).Once()
+		f.Client.On("UpdateShardStatus", anyVal, "H3", cls, "S3", "READY").Return(nil).Once()
+		m := f.Mover()
+
+		started, err := m.Move(ctx, nil, &models.ShardMove{
+			Class: cls, Shard: "S3", SourceNode: "N3", TargetNode: "N2",
+		})
+		require.Nil(t, err)
+		move := waitForMove(t, m, started.ID)
+		assert.Equal(t, models.ShardMoveStatusFAILED, move.Status)
+		// writes to the source replica are accepted again
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("FreezeFails", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, anyVal).Return(nil)
+		f.Client.On("SyncShards", anyVal, anyVal, cls, anyVal).Return(nil)
+		f.Client.On("GetShardStatus", anyVal, "H3", cls, "S3").Return("READY", nil)
+		// the writes in flight did not complete in time
+		f.Client.On("UpdateShardStatus", anyVal, "H3", cls, "S3", "READONLY").Return(context.DeadlineExceeded).Once()
+		f.Client.On("UpdateShardStatus", anyVal, "H3", cls, "S3", "READY").Return(nil).Once()
+		m := f.Mover()
+
+		started, err := m.Move(ctx, nil, &models.ShardMove{
+			Class: cls, Shard: "S3", SourceNode: "N3", TargetNode: "N2",
+		})
+		require.Nil(t, err)
+		move := waitForMove(t, m, started.ID)
+		assert.Equal(t, models.ShardMoveStatusFAILED, move.Status)
+		assert.Empty(t, f.ShardingState.Updates)
+		f.Client.AssertExpectations(t)
+	})
+
+	t.Run("WritesDuringMove", func(t *testing.T) {
+		// the shard has a single replica, writes only go to the source
+		f := newFakeFactory()
+		f.ShardingState.M = map[string][]string{"S1": {"N1"}, "S3": {"N3"}}
+		var (
+			mu           sync.Mutex
+			source       = map[string]bool{"a": true}
+			target       = map[string]bool{}
+			readOnly     bool
+			acknowledged []string
+		)
+		write := func(id string) {
+			mu.Lock()
+			defer mu.Unlock()
+			if !readOnly {
+				source[id] = true
+				acknowledged = append(acknowledged, id)
+			}
+		}
+		copyObjects := func(mock.Arguments) {
+			mu.Lock()
+			defer mu.Unlock()
+			for id := range source {
+				target[id] = true
+			}
+		}
+		syncs := 0
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, ShardDist{"S3": {"N2"}}).
+			Run(func(args mock.Arguments) {
+				copyObjects(args)
+				write("b") // written during the copy
+			}).Return(nil)
+		f.Client.On("SyncShards", anyVal, "H3", cls, ShardDist{"S3": {"N2"}}).
+			Run(func(args mock.Arguments) {
+				copyObjects(args)
+				if syncs++; syncs == 1 {
+					write("c") // written after the first sync
+				}
+			}).Return(nil)
+		f.Client.On("SyncShards", anyVal, "H2", cls, ShardDist{"S3": {"N3"}}).Return(nil)
+		f.Client.On("GetShardStatus", anyVal, "H3", cls, "S3").Return("READY", nil)
+		f.Client.On("UpdateShardStatus", anyVal, "H3", cls, "S3", "READONLY").
+			Run(func(mock.Arguments) {
+				mu.Lock()
+				readOnly = true
+				mu.Unlock()
+				write("d") // held back
+			}).Return(nil)
+		m := f.Mover()
+
+		err := m.Replace(ctx, cls, "S3", "N3", "N2", "N3")
+		require.Nil(t, err)
+		assert.Equal(t, 2, syncs)
+		for _, id := range acknowledged {
+			assert.True(t, target[id], "object %q is missing on the target", id)
+		}
+		assert.ElementsMatch(t, []string{"b", "c"}, acknowledged)
+		// the target only became a replica once it had caught up
+		want := []map[string][]string{
+			{"S1": {"N1"}, "S3": {"N2"}},
+		}
+		assert.Equal(t, want, f.ShardingState.Updates)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		tests := []struct {
+			name string
+			move *models.ShardMove
+		}{
+			{"empty", &models.ShardMove{}},
+			{"same node", &models.ShardMove{Class: cls, Shard: "S3", SourceNode: "N3", TargetNode: "N3"}},
+			{"unknown class", &models.ShardMove{Class: "D", Shard: "S3", SourceNode: "N3", TargetNode: "N2"}},
+			{"unknown shard", &models.ShardMove{Class: cls, Shard: "S2", SourceNode: "N3", TargetNode: "N2"}},
+			{"not on source", &models.ShardMove{Class: cls, Shard: "S3", SourceNode: "N1", TargetNode: "N2"}},
+			{"already on target", &models.ShardMove{Class: cls, Shard: "S3", SourceNode: "N3", TargetNode: "N4"}},
+			{"unknown target", &models.ShardMove{Class: cls, Shard: "S3", SourceNode: "N3", TargetNode: "N9"}},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				f := newFakeFactory()
+				f.ShardingState.M = nil
+				if test.move.Class == cls {
+					f.ShardingState.M = map[string][]string{"S1": {"N1"}, "S3": {"N3", "N4"}}
+				}
+				_, err := f.Mover().Move(ctx, nil, test.move)
+				assert.ErrorIs(t, err, ErrInvalidMove)
+			})
+		}
+	})
+
+	t.Run("AlreadyMoving", func(t *testing.T) {
+		f := newFakeFactory()
+		block := make(chan struct{})
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, anyVal).
+			Run(func(_ mock.Arguments) { <-block }).Return(errAny)
+		m := f.Mover()
+
+		move := &models.ShardMove{Class: cls, Shard: "S3", SourceNode: "N3", TargetNode: "N2"}
+		started, err := m.Move(ctx, nil, move)
+		require.Nil(t, err)
+		assert.True(t, m.Running())
+		_, err = m.Move(ctx, nil, move)
+		assert.ErrorIs(t, err, ErrInvalidMove)
+		close(block)
+		waitForMove(t, m, started.ID)
+		assert.False(t, m.Running())
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		f := newFakeFactory()
+		m := NewMover(f.Scaler(""), &fakeAuthorizer{autherrs.NewForbidden(nil, "update", "x")}, f.logger)
+		_, err := m.Move(ctx, nil, &models.ShardMove{
+			Class: cls, Shard: "S3", SourceNode: "N3", TargetNode: "N2",
+		})
+		assert.IsType(t, autherrs.Forbidden{}, err)
+		_, err = m.List(ctx, nil)
+		assert.IsType(t, autherrs.Forbidden{}, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := newFakeFactory().Mover().Get(ctx, nil, "123")
+		assert.ErrorIs(t, err, ErrMoveNotFound)
+	})
+}
+
+func TestNextMove(t *testing.T) {
+	newState := func(m map[string][]string) *sharding.State {
+		ss := &sharding.State{Physical: make(map[string]sharding.Physical)}
+		for name, nodes := range m {
+			ss.Physical[name] = sharding.Physical{Name: name, BelongsToNodes: nodes}
+		}
+		return ss
+	}
+	nodes := []string{"N1", "N2", "N3"}
+
+	t.Run("Balanced", func(t *testing.T) {
+		states := map[string]*sharding.State{
+			"A": newState(map[string][]string{"S1": {"N1"}, "S2": {"N2"}}),
+			"B": newState(map[string][]string{"S1": {"N3", "N1"}}),
+		}
+		assert.Nil(t, nextMove(states, nodes))
+	})
+
+	t.Run("NewNode", func(t *testing.T) {
+		states := map[string]*sharding.State{
+			"A": newState(map[string][]string{"S1": {"N1"}, "S2": {"N2"}}),
+			"B": newState(map[string][]string{"S1": {"N1", "N2"}, "S2": {"N2", "N1"}}),
+		}
+		want := &models.ShardMove{Class: "A", Shard: "S1", SourceNode: "N1", TargetNode: "N3"}
+		assert.Equal(t, want, nextMove(states, nodes))
+	})
+
+	t.Run("UnknownNodesAreIgnored", func(t *testing.T) {
+		states := map[string]*sharding.State{
+			"A": newState(map[string][]string{"S1": {"N9"}, "S2": {"N9"}, "S3": {"N1"}}),
+		}
+		assert.Nil(t, nextMove(states, []string{"N1", "N2"}))
+	})
+
+	t.Run("SingleNode", func(t *testing.T) {
+		states := map[string]*sharding.State{
+			"A": newState(map[string][]string{"S1": {"N1"}, "S2": {"N1"}}),
+		}
+		assert.Nil(t, nextMove(states, []string{"N1"}))
+	})
+}
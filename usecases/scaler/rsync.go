@@ -38,6 +38,20 @@ type client interface {
 	ReInitShard(ctx context.Context,
 		hostName, indexName, shardName string) error
 	IncreaseReplicationFactor(ctx context.Context, host, class string, dist ShardDist) error
+
+	// DecreaseReplicationFactor asks the remote node to verify its replicas
+	// before they get dropped
+	DecreaseReplicationFactor(ctx context.Context, host, class string, dist ShardDist) error
+
+	// SyncShards asks the remote node to bring the replicas of its shards
+	// on other nodes up to date
+	SyncShards(ctx context.Context, host, class string, dist ShardDist) error
+
+	// GetShardStatus returns the status of a shard on the remote node
+	GetShardStatus(ctx context.Context, host, class, shard string) (string, error)
+
+	// UpdateShardStatus changes the status of a shard on the remote node
+	UpdateShardStatus(ctx context.Context, host, class, shard, status string) error
 }
 
 // rsync synchronizes shards with remote nodes
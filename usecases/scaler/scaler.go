@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"golang.org/x/sync/errgroup"
 )
@@ -29,26 +30,30 @@ import (
 // We could concurrently sync same files to different nodes  while avoiding overlapping
 //
 // 2. To fail fast, we might consider creating all shards at once and re-initialize them in the final step
-//
-// 3. implement scaler.scaleIn
 
 // ErrUnresolvedName cannot resolve the host address of a node
 var ErrUnresolvedName = errors.New("cannot resolve node name")
 
+// ErrUnreachableNode is returned when replicas to be removed are held by a
+// node which is not reachable, so they cannot be verified
+var ErrUnreachableNode = errors.New("node is not reachable")
+
 // Scaler scales out/in class replicas.
 //
-// It scales out a class by replicating its shards on new replicas
+// It scales out a class by replicating its shards on new replicas.
+// It scales in a class by dropping replicas once the remaining ones are
+// known to hold all of their data
 type Scaler struct {
 	schema          SchemaManager
 	cluster         cluster
-	source          BackUpper // data source
-	client          client    // client for remote nodes
+	source          Source // data source
+	client          client // client for remote nodes
 	logger          logrus.FieldLogger
 	persistenceRoot string
 }
 
 // New returns a new instance of Scaler
-func New(cl cluster, source BackUpper,
+func New(cl cluster, source Source,
 	c client, logger logrus.FieldLogger, persistenceRoot string,
 ) *Scaler {
 	return &Scaler{
@@ -68,6 +73,38 @@ type BackUpper interface {
 	ReleaseBackup(ctx context.Context, id, className string) error
 }
 
+// ReplicaVerifier is used to compare local shards with their replicas
+type ReplicaVerifier interface {
+	// VerifyShardReplicas makes sure that every object of a local shard is
+	// also present on each of the given nodes in an equal or more recent version
+	VerifyShardReplicas(ctx context.Context, class, shard string, nodes []string) error
+}
+
+// ReplicaSyncer is used to bring replicas of a local shard up to date
+type ReplicaSyncer interface {
+	// SyncShardReplica brings the replica of a local shard on node up to date.
+	// Local objects which have been deleted on node are deleted as well.
+	SyncShardReplica(ctx context.Context, class, shard, node string) error
+}
+
+// ShardStatusUpdater is used to reject writes to a local shard while it is
+// being moved
+type ShardStatusUpdater interface {
+	// LocalShardStatus returns the status of a local shard
+	LocalShardStatus(ctx context.Context, class, shard string) (string, error)
+	// UpdateLocalShardStatus changes the status of a local shard. Making it
+	// read-only returns once the writes in flight have completed.
+	UpdateLocalShardStatus(ctx context.Context, class, shard, status string) error
+}
+
+// Source is the local data source of the scaler
+type Source interface {
+	BackUpper
+	ReplicaVerifier
+	ReplicaSyncer
+	ShardStatusUpdater
+}
+
 // cluster is used by the scaler to query cluster
 type cluster interface {
 	// Candidates returns list of existing nodes in the cluster
@@ -81,6 +118,10 @@ type cluster interface {
 // SchemaManager is used by the scaler to get and update sharding states
 type SchemaManager interface {
 	ShardingState(class string) *sharding.State
+	// UpdateShardingState broadcasts the sharding state of a class to the
+	// cluster and applies it locally
+	UpdateShardingState(ctx context.Context, class string, ss *sharding.State) error
+	GetSchemaSkipAuth() schema.Schema
 }
 
 func (s *Scaler) SetSchemaManager(sm SchemaManager) {
@@ -107,7 +148,7 @@ func (s *Scaler) Scale(ctx context.Context, className string,
 	}
 
 	if newReplFactor < prevReplFactor {
-		return s.scaleIn(ctx, className, updated)
+		return s.scaleIn(ctx, className, ssBefore, updated, newReplFactor)
 	}
 
 	return nil, nil
@@ -209,8 +250,112 @@ func (s *Scaler) LocalScaleOut(ctx context.Context,
 	return rsync.Push(ctx, bak.Shards, dist, className)
 }
 
-func (s *Scaler) scaleIn(ctx context.Context, className string,
-	updated sharding.Config,
+// scaleIn removes class shards from replicas (nodes) which are no longer needed:
+//
+// * It calculates new sharding state, keeping replicas on live nodes if possible
+// * It makes sure that each replica to be dropped holds no data missing
+// on the remaining replicas. Scaling in is aborted if a node holding such a
+// replica is not reachable, since its replicas cannot be verified.
+// * It delegates this verification of remote replicas to their nodes
+//
+// Shards are not deleted here. Each node drops its removed replicas only
+// after the updated sharding state has been committed by the schema manager.
+func (s *Scaler) scaleIn(ctx context.Context, className string, ssBefore *sharding.State,
+	updated sharding.Config, replFactor int64,
 ) (*sharding.State, error) {
-	return nil, errors.Errorf("scaling in not supported yet")
+	ssAfter := ssBefore.DeepCopy()
+	ssAfter.Config = updated
+
+	alive := make(map[string]bool)
+	for _, node := range s.cluster.Candidates() {
+		alive[node] = true
+	}
+	for name, shard := range ssAfter.Physical {
+		shard.BelongsToNodes = preferNodes(shard.BelongsToNodes, alive)
+		if err := shard.AdjustReplicas(int(replFactor), s.cluster); err != nil {
+			return nil, err
+		}
+		ssAfter.Physical[name] = shard
+	}
+
+	lDist, nodeDist := removals(ssBefore, &ssAfter, s.cluster.LocalName())
+	for _, node := range nodeDist.nodes() {
+		if !alive[node] {
+			return nil, fmt.Errorf("verify replicas of class %q on node %q: %w",
+				className, node, ErrUnreachableNode)
+		}
+	}
+	g, ctx := errgroup.WithContext(ctx)
+	// resolve hosts beforehand
+	nodes := nodeDist.nodes()
+	hosts, err := hosts(nodes, s.cluster)
+	if err != nil {
+		return nil, err
+	}
+	for i, node := range nodes {
+		dist := nodeDist[node]
+		i := i
+		g.Go(func() error {
+			err := s.client.DecreaseReplicationFactor(ctx, hosts[i], className, dist)
+			if err != nil {
+				return fmt.Errorf("decrease replication factor for class %q on node %q: %w", className, nodes[i], err)
+			}
+			return nil
+		})
+	}
+
+	g.Go(func() error {
+		if err := s.LocalScaleIn(ctx, className, lDist); err != nil {
+			return fmt.Errorf("decrease local replication factor: %w", err)
+		}
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	// The schema manager broadcasts the updated state, removed replicas stop
+	// serving traffic and are deleted by their nodes.
+	return &ssAfter, nil
+}
+
+// LocalScaleIn makes sure that local shards, which are about to be dropped,
+// don't hold any data missing on the remaining replicas.
+//
+// dist maps each shard to the nodes which keep a replica of it
+func (s *Scaler) LocalScaleIn(ctx context.Context,
+	className string, dist ShardDist,
+) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for shard, nodes := range dist {
+		shard, nodes := shard, nodes
+		g.Go(func() error {
+			if err := s.source.VerifyShardReplicas(ctx, className, shard, nodes); err != nil {
+				return fmt.Errorf("verify replicas of shard %q: %w", shard, err)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// LocalSyncShards brings remote replicas of local shards up to date.
+//
+// dist maps each shard to the nodes whose replicas are synced
+func (s *Scaler) LocalSyncShards(ctx context.Context,
+	className string, dist ShardDist,
+) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for shard, nodes := range dist {
+		for _, node := range nodes {
+			shard, node := shard, node
+			g.Go(func() error {
+				if err := s.source.SyncShardReplica(ctx, className, shard, node); err != nil {
+					return fmt.Errorf("sync shard %q with node %q: %w", shard, node, err)
+				}
+				return nil
+			})
+		}
+	}
+	return g.Wait()
 }
@@ -40,12 +40,72 @@ func TestScalerScale(t *testing.T) {
 		_, err := scaler.Scale(ctx, "C", old, 2, 2)
 		assert.Nil(t, err)
 	})
-	t.Run("ScaleInNotSupported", func(t *testing.T) {
-		scaler := newFakeFactory().Scaler("")
-		old := sharding.Config{}
-		_, err := scaler.Scale(ctx, "C", old, 2, 1)
-		assert.NotNil(t, err)
-		assert.Contains(t, err.Error(), "not supported")
+}
+
+func TestScalerScaleIn(t *testing.T) {
+	var (
+		ctx = context.Background()
+		cls = "C"
+		old = sharding.Config{}
+	)
+	newFactory := func() *fakeFactory {
+		f := newFakeFactory()
+		f.ShardingState.M = map[string][]string{
+			"S1": {"N1", "N2"},
+			"S2": {"N2", "N1"},
+			"S3": {"N3", "N4"},
+		}
+		return f
+	}
+	replicas := func(ss *sharding.State) map[string][]string {
+		m := make(map[string][]string, len(ss.Physical))
+		for name, shard := range ss.Physical {
+			m[name] = shard.BelongsToNodes
+		}
+		return m
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		f := newFactory()
+		f.Source.On("VerifyShardReplicas", anyVal, cls, "S2", []string{"N2"}).Return(nil)
+		f.Client.On("DecreaseReplicationFactor", anyVal, "H2", cls,
+			ShardDist{"S1": {"N1"}}).Return(nil)
+		f.Client.On("DecreaseReplicationFactor", anyVal, "H4", cls,
+			ShardDist{"S3": {"N3"}}).Return(nil)
+		ss, err := f.Scaler("").Scale(ctx, cls, old, 2, 1)
+		assert.Nil(t, err)
+		want := map[string][]string{"S1": {"N1"}, "S2": {"N2"}, "S3": {"N3"}}
+		assert.Equal(t, want, replicas(ss))
+		f.Client.AssertExpectations(t)
+		f.Source.AssertExpectations(t)
+	})
+
+	t.Run("LocalReplicaOutdated", func(t *testing.T) {
+		f := newFactory()
+		f.Source.On("VerifyShardReplicas", anyVal, cls, "S2", []string{"N2"}).Return(errAny)
+		f.Client.On("DecreaseReplicationFactor", anyVal, anyVal, cls, anyVal).Return(nil)
+		_, err := f.Scaler("").Scale(ctx, cls, old, 2, 1)
+		assert.ErrorIs(t, err, errAny)
+	})
+
+	t.Run("RemoteReplicaOutdated", func(t *testing.T) {
+		f := newFactory()
+		f.Source.On("VerifyShardReplicas", anyVal, cls, "S2", []string{"N2"}).Return(nil)
+		f.Client.On("DecreaseReplicationFactor", anyVal, "H2", cls, anyVal).Return(nil)
+		f.Client.On("DecreaseReplicationFactor", anyVal, "H4", cls, anyVal).Return(errAny)
+		_, err := f.Scaler("").Scale(ctx, cls, old, 2, 1)
+		assert.ErrorIs(t, err, errAny)
+		assert.Contains(t, err.Error(), "N4")
+	})
+
+	t.Run("UnreachableNode", func(t *testing.T) {
+		f := newFactory()
+		delete(f.NodeHostMap, "N2")
+		_, err := f.Scaler("").Scale(ctx, cls, old, 2, 1)
+		assert.ErrorIs(t, err, ErrUnreachableNode)
+		assert.Contains(t, err.Error(), "N2")
+		f.Client.AssertNotCalled(t, "DecreaseReplicationFactor", anyVal, anyVal, anyVal, anyVal)
+		f.Source.AssertNotCalled(t, "VerifyShardReplicas", anyVal, anyVal, anyVal, anyVal)
 	})
 }
 
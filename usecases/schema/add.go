@@ -42,6 +42,10 @@ func (m *Manager) AddClass(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return err
 	}
+	if m.schemaLog != nil {
+		// the index has been created while applying the schema log
+		return nil
+	}
 
 	// call to migrator needs to be outside the lock that is set in addClass
 	return m.migrator.AddClass(ctx, class, shardState)
@@ -154,6 +158,10 @@ func (m *Manager) addClass(ctx context.Context, class *models.Class,
 		return nil, errors.Wrap(err, "init sharding state")
 	}
 
+	if m.schemaLog != nil {
+		return shardState, m.replicate(ctx, AddClass, AddClassPayload{class, shardState})
+	}
+
 	tx, err := m.cluster.BeginTransaction(ctx, AddClass,
 		AddClassPayload{class, shardState}, DefaultTxTTL)
 	if err != nil {
@@ -226,6 +234,38 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 func (m *Manager) setPropertyDefaults(prop *models.Property) {
 	m.setPropertyDefaultTokenization(prop)
 	m.setPropertyDefaultIndexing(prop)
+	m.setNestedPropertiesDefaults(prop.NestedProperties)
+}
+
+// setNestedPropertiesDefaults applies the defaults of top-level properties to
+// nested properties: word tokenization for text, and both indexes where the
+// data type supports them
+func (m *Manager) setNestedPropertiesDefaults(nestedProps []*models.NestedProperty) {
+	for _, nestedProp := range nestedProps {
+		if _, ok := schema.AsNested(nestedProp.DataType); ok {
+			m.setNestedPropertiesDefaults(nestedProp.NestedProperties)
+			continue
+		}
+
+		isText := false
+		switch dataType, _ := schema.AsPrimitive(nestedProp.DataType); dataType {
+		case schema.DataTypeText, schema.DataTypeTextArray:
+			isText = true
+			if nestedProp.Tokenization == "" {
+				nestedProp.Tokenization = models.PropertyTokenizationWord
+			}
+		default:
+			// tokenization not supported for other data types
+		}
+
+		if nestedProp.IndexFilterable == nil {
+			vTrue := true
+			nestedProp.IndexFilterable = &vTrue
+		}
+		if nestedProp.IndexSearchable == nil {
+			nestedProp.IndexSearchable = &isText
+		}
+	}
 }
 
 func (m *Manager) setPropertyDefaultTokenization(prop *models.Property) {
@@ -398,6 +438,15 @@ func (m *Manager) validateProperty(
 		return err
 	}
 
+	if propertyDataType.IsNested() {
+		if err := m.validateNestedProperties(property.NestedProperties, property.Name); err != nil {
+			return err
+		}
+	} else if len(property.NestedProperties) > 0 {
+		return fmt.Errorf("property '%s': nestedProperties are only allowed for data types object/object[]",
+			property.Name)
+	}
+
 	// all is fine!
 	return nil
 }
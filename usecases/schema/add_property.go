@@ -60,6 +60,10 @@ func (m *Manager) addClassProperty(ctx context.Context,
 	// migrate only after validation in completed
 	m.migratePropertySettings(prop)
 
+	if m.schemaLog != nil {
+		return m.replicate(ctx, AddProperty, AddPropertyPayload{className, prop})
+	}
+
 	tx, err := m.cluster.BeginTransaction(ctx, AddProperty,
 		AddPropertyPayload{className, prop}, DefaultTxTTL)
 	if err != nil {
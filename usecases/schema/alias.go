@@ -0,0 +1,201 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ResolveAlias returns the name of the class name refers to. If name is not
+// an alias, it is returned as is.
+func (m *Manager) ResolveAlias(name string) string {
+	m.RLock()
+	defer m.RUnlock()
+	return m.resolveAlias(name)
+}
+
+func (m *Manager) resolveAlias(name string) string {
+	if target, ok := m.state.ObjectSchema.Aliases[name]; ok {
+		return target
+	}
+	return name
+}
+
+// GetAliases returns all aliases and the classes they point to
+func (m *Manager) GetAliases(ctx context.Context,
+	principal *models.Principal,
+) (map[string]string, error) {
+	if err := m.Authorizer.Authorize(principal, "list", "schema/*"); err != nil {
+		return nil, err
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+	aliases := make(map[string]string, len(m.state.ObjectSchema.Aliases))
+	for alias, class := range m.state.ObjectSchema.Aliases {
+		aliases[alias] = class
+	}
+	return aliases, nil
+}
+
+// AddAlias makes class available under the name alias
+func (m *Manager) AddAlias(ctx context.Context, principal *models.Principal,
+	alias, class string,
+) error {
+	if err := m.Authorizer.Authorize(principal, "create", "schema/objects"); err != nil {
+		return err
+	}
+	if _, err := schema.ValidateClassName(alias); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	for existing := range m.state.ObjectSchema.Aliases {
+		if strings.EqualFold(alias, existing) {
+			return fmt.Errorf("alias %q already exists", existing)
+		}
+	}
+	return m.swapClass(ctx, alias, class, "")
+}
+
+// UpdateAlias points an existing alias to another class. Reads and writes
+// using the alias are applied to the new class once the change is committed.
+func (m *Manager) UpdateAlias(ctx context.Context, principal *models.Principal,
+	alias, class string,
+) error {
+	if err := m.Authorizer.Authorize(principal, "update", "schema/objects"); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.state.ObjectSchema.Aliases[alias]; !ok {
+		return ErrNotFound
+	}
+	return m.swapClass(ctx, alias, class, "")
+}
+
+// DeleteAlias removes an alias. The class it points to is not affected.
+func (m *Manager) DeleteAlias(ctx context.Context, principal *models.Principal,
+	alias string,
+) error {
+	if err := m.Authorizer.Authorize(principal, "delete", "schema/objects"); err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.state.ObjectSchema.Aliases[alias]; !ok {
+		return ErrNotFound
+	}
+	pl := DeleteAliasPayload{Alias: alias}
+
+	if m.schemaLog != nil {
+		return m.replicate(ctx, DeleteAlias, pl)
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, DeleteAlias, pl, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.deleteAliasApplyChanges(ctx, alias)
+}
+
+// SwapClass makes class available under the name alias and deletes the class
+// drop within a single schema change. drop is typically the class previously
+// known under the name alias. It is not user facing, class migrations use it
+// to let the migrated class take the place of the original one.
+func (m *Manager) SwapClass(ctx context.Context, alias, class, drop string) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.swapClass(ctx, alias, class, drop)
+}
+
+// swapClass must be called with the manager's lock held
+func (m *Manager) swapClass(ctx context.Context, alias, class, drop string) error {
+	if err := m.validateSwapClass(alias, class, drop); err != nil {
+		return err
+	}
+	pl := SwapClassPayload{Alias: alias, ClassName: class, Drop: drop}
+
+	if m.schemaLog != nil {
+		return m.replicate(ctx, SwapClass, pl)
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, SwapClass, pl, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.swapClassApplyChanges(ctx, alias, class, drop)
+}
+
+func (m *Manager) validateSwapClass(alias, class, drop string) error {
+	if m.getClassByName(class) == nil {
+		return fmt.Errorf("%w: class %q", ErrNotFound, class)
+	}
+	if strings.EqualFold(alias, class) {
+		return fmt.Errorf("class %q cannot be an alias of itself", class)
+	}
+	for _, other := range m.state.ObjectSchema.Classes {
+		if strings.EqualFold(alias, other.Class) && other.Class != drop {
+			return fmt.Errorf("alias %q is the name of an existing class", alias)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) swapClassApplyChanges(ctx context.Context,
+	alias, class, drop string,
+) error {
+	if drop != "" {
+		if err := m.deleteClassApplyChanges(ctx, drop, true); err != nil {
+			return errors.Wrapf(err, "delete class %q", drop)
+		}
+	}
+
+	if m.state.ObjectSchema.Aliases == nil {
+		m.state.ObjectSchema.Aliases = map[string]string{}
+	}
+	m.state.ObjectSchema.Aliases[alias] = class
+	return m.saveSchema(ctx)
+}
+
+func (m *Manager) deleteAliasApplyChanges(ctx context.Context, alias string) error {
+	delete(m.state.ObjectSchema.Aliases, alias)
+	return m.saveSchema(ctx)
+}
+
+// removeAliasesOf removes all aliases of a deleted class
+func (m *Manager) removeAliasesOf(className string) {
+	for alias, target := range m.state.ObjectSchema.Aliases {
+		if target == className {
+			delete(m.state.ObjectSchema.Aliases, alias)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestSwapClass(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	for _, name := range []string{"Article", "Article_1"} {
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class:           name,
+			VectorIndexType: "hnsw",
+		}))
+	}
+
+	t.Run("alias must not shadow another class", func(t *testing.T) {
+		err := sm.SwapClass(ctx, "Article", "Article_1", "")
+		assert.EqualError(t, err, `alias "Article" is the name of an existing class`)
+	})
+
+	t.Run("class must exist", func(t *testing.T) {
+		err := sm.SwapClass(ctx, "Article", "Article_2", "Article")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("swap in the new class", func(t *testing.T) {
+		require.Nil(t, sm.SwapClass(ctx, "Article", "Article_1", "Article"))
+
+		assert.ElementsMatch(t, []string{"Article_1"}, testGetClassNames(sm))
+		assert.Equal(t, "Article_1", sm.ResolveAlias("Article"))
+		assert.Equal(t, "Other", sm.ResolveAlias("Other"))
+	})
+
+	t.Run("class name cannot be an alias", func(t *testing.T) {
+		err := sm.AddClass(ctx, nil, &models.Class{
+			Class:           "Article",
+			VectorIndexType: "hnsw",
+		})
+		assert.EqualError(t, err, `class name "Article" already exists as an alias`)
+	})
+
+	t.Run("swap again replaces the alias", func(t *testing.T) {
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class:           "Article_2",
+			VectorIndexType: "hnsw",
+		}))
+		require.Nil(t, sm.SwapClass(ctx, "Article", "Article_2", "Article_1"))
+
+		assert.ElementsMatch(t, []string{"Article_2"}, testGetClassNames(sm))
+		assert.Equal(t, "Article_2", sm.ResolveAlias("Article"))
+	})
+
+	t.Run("deleting the class removes its aliases", func(t *testing.T) {
+		require.Nil(t, sm.DeleteClass(ctx, nil, "Article_2", false))
+		assert.Equal(t, "Article", sm.ResolveAlias("Article"))
+	})
+}
+
+func TestAliases(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	for _, name := range []string{"Products_v1", "Products_v2"} {
+		require.Nil(t, sm.AddClass(ctx, nil, &models.Class{
+			Class:           name,
+			VectorIndexType: "hnsw",
+		}))
+	}
+
+	t.Run("add alias", func(t *testing.T) {
+		require.Nil(t, sm.AddAlias(ctx, nil, "Products", "Products_v1"))
+		assert.Equal(t, "Products_v1", sm.ResolveAlias("Products"))
+	})
+
+	t.Run("alias already exists", func(t *testing.T) {
+		err := sm.AddAlias(ctx, nil, "Products", "Products_v2")
+		assert.EqualError(t, err, `alias "Products" already exists`)
+	})
+
+	t.Run("alias must be a valid class name", func(t *testing.T) {
+		err := sm.AddAlias(ctx, nil, "not valid", "Products_v2")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("repoint alias", func(t *testing.T) {
+		require.Nil(t, sm.UpdateAlias(ctx, nil, "Products", "Products_v2"))
+		assert.Equal(t, "Products_v2", sm.ResolveAlias("Products"))
+		assert.ElementsMatch(t, []string{"Products_v1", "Products_v2"}, testGetClassNames(sm))
+
+		aliases, err := sm.GetAliases(ctx, nil)
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{"Products": "Products_v2"}, aliases)
+	})
+
+	t.Run("repoint unknown alias", func(t *testing.T) {
+		err := sm.UpdateAlias(ctx, nil, "Unknown", "Products_v2")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("delete alias", func(t *testing.T) {
+		require.Nil(t, sm.DeleteAlias(ctx, nil, "Products"))
+		assert.Equal(t, "Products", sm.ResolveAlias("Products"))
+		assert.ErrorIs(t, sm.DeleteAlias(ctx, nil, "Products"), ErrNotFound)
+	})
+}
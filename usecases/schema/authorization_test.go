@@ -42,6 +42,11 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "list",
 			expectedResource: "schema/*",
 		},
+		{
+			methodName:       "GetConsistentSchema",
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
 		{
 			methodName:       "GetClass",
 			additionalArgs:   []interface{}{"classname"},
@@ -84,6 +89,12 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/objects",
 		},
+		{
+			methodName:       "MergeClassObjectProperty",
+			additionalArgs:   []interface{}{"somename", &models.Property{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
 		{
 			methodName:       "DeleteClassProperty",
 			additionalArgs:   []interface{}{"somename", "someprop"},
@@ -96,6 +107,29 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/className/shards/shardName",
 		},
+		{
+			methodName:       "GetAliases",
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "AddAlias",
+			additionalArgs:   []interface{}{"Alias", "className"},
+			expectedVerb:     "create",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "UpdateAlias",
+			additionalArgs:   []interface{}{"Alias", "className"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "DeleteAlias",
+			additionalArgs:   []interface{}{"Alias"},
+			expectedVerb:     "delete",
+			expectedResource: "schema/objects",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -110,7 +144,9 @@ func Test_Schema_Authorization(t *testing.T) {
 				"UpdateMeta", "GetSchemaSkipAuth", "IndexedInverted", "RLock", "RUnlock", "Lock", "Unlock",
 				"TryLock", "RLocker", "TryRLock", // introduced by sync.Mutex in go 1.18
 				"Nodes", "NodeName", "ClusterHealthScore", "ResolveParentNodes",
-				"ShardingState", "TxManager", "RestoreClass":
+				"ShardingState", "UpdateShardingState", "TxManager", "RestoreClass",
+				"SetSchemaLog", "ApplyCommand", "Snapshot", "Restore",
+				"ResolveAlias", "SwapClass":
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
 				// but aren't user facing
@@ -33,6 +33,10 @@ func (m *Manager) deleteClass(ctx context.Context, className string, force bool)
 	m.Lock()
 	defer m.Unlock()
 
+	if m.schemaLog != nil {
+		return m.replicate(ctx, DeleteClass, DeleteClassPayload{className, force})
+	}
+
 	tx, err := m.cluster.BeginTransaction(ctx, DeleteClass,
 		DeleteClassPayload{className, force}, DefaultTxTTL)
 	if err != nil {
@@ -84,6 +88,7 @@ func (m *Manager) deleteClassApplyChanges(ctx context.Context,
 		sch.Classes[len(sch.Classes)-1] = nil // to prevent leaking this pointer.
 		sch.Classes = sch.Classes[:len(sch.Classes)-1]
 	}
+	m.removeAliasesOf(className)
 
 	err := m.saveSchema(ctx)
 	if err != nil {
@@ -89,17 +89,27 @@ func (m *Manager) ShardingState(className string) *sharding.State {
 //
 // it returns map[node_name] node_address where node_address = "" if can't resolve node_name
 func (m *Manager) ResolveParentNodes(class, shardName string) (map[string]string, error) {
-	shard, ok := m.ShardingState(class).Physical[shardName]
+	var nodes []string
+	m.shardingStateLock.RLock()
+	ss := m.state.ShardingState[class]
+	var ok bool
+	if ss != nil {
+		var shard sharding.Physical
+		if shard, ok = ss.Physical[shardName]; ok {
+			nodes = append(nodes, shard.BelongsToNodes...)
+		}
+	}
+	m.shardingStateLock.RUnlock()
 	if !ok {
 		return nil, fmt.Errorf("sharding state not found")
 	}
 
-	if len(shard.BelongsToNodes) == 0 {
+	if len(nodes) == 0 {
 		return nil, nil
 	}
 
-	name2Addr := make(map[string]string, len(shard.BelongsToNodes))
-	for _, node := range shard.BelongsToNodes {
+	name2Addr := make(map[string]string, len(nodes))
+	for _, node := range nodes {
 		host, _ := m.clusterState.NodeHostname(node)
 		name2Addr[node] = host
 	}
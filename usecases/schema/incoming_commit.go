@@ -24,10 +24,16 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleAddClassCommit(ctx, tx)
 	case AddProperty:
 		return m.handleAddPropertyCommit(ctx, tx)
+	case MergeObjectProperty:
+		return m.handleMergeObjectPropertyCommit(ctx, tx)
 	case DeleteClass:
 		return m.handleDeleteClassCommit(ctx, tx)
 	case UpdateClass:
 		return m.handleUpdateClassCommit(ctx, tx)
+	case SwapClass:
+		return m.handleSwapClassCommit(ctx, tx)
+	case DeleteAlias:
+		return m.handleDeleteAliasCommit(ctx, tx)
 	default:
 		return errors.Errorf("unrecognized commit type %q", tx.Type)
 	}
@@ -99,6 +105,21 @@ func (m *Manager) handleAddPropertyCommit(ctx context.Context,
 	return m.addClassPropertyApplyChanges(ctx, pl.ClassName, pl.Property)
 }
 
+func (m *Manager) handleMergeObjectPropertyCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(MergeObjectPropertyPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be MergeObjectPropertyPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.mergeClassObjectPropertyApplyChanges(ctx, pl.ClassName, pl.Property)
+}
+
 func (m *Manager) handleDeleteClassCommit(ctx context.Context,
 	tx *cluster.Transaction,
 ) error {
@@ -136,3 +157,33 @@ func (m *Manager) handleUpdateClassCommit(ctx context.Context,
 
 	return m.updateClassApplyChanges(ctx, pl.ClassName, pl.Class, pl.State)
 }
+
+func (m *Manager) handleSwapClassCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(SwapClassPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be SwapClassPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.swapClassApplyChanges(ctx, pl.Alias, pl.ClassName, pl.Drop)
+}
+
+func (m *Manager) handleDeleteAliasCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(DeleteAliasPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be DeleteAliasPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.deleteAliasApplyChanges(ctx, pl.Alias)
+}
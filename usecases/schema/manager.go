@@ -45,6 +45,7 @@ type Manager struct {
 	hnswConfigParser        VectorConfigParser
 	invertedConfigValidator InvertedConfigValidator
 	scaleOut                scaleOut
+	schemaLog               schemaLog
 	RestoreStatus           sync.Map
 	RestoreError            sync.Map
 	sync.RWMutex
@@ -159,6 +160,10 @@ type authorizer interface {
 type State struct {
 	ObjectSchema  *models.Schema `json:"object"`
 	ShardingState map[string]*sharding.State
+
+	// AppliedIndex is the index of the last command of the schema log which
+	// is part of this state. It is zero if there is no schema log.
+	AppliedIndex uint64 `json:"appliedIndex,omitempty"`
 }
 
 func (m *Manager) saveSchema(ctx context.Context) error {
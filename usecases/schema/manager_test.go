@@ -52,6 +52,10 @@ func (n *NilMigrator) UpdateShardStatus(ctx context.Context, className, shardNam
 	return nil
 }
 
+func (n *NilMigrator) DropShards(ctx context.Context, className string, shards []string) error {
+	return nil
+}
+
 func (n *NilMigrator) AddProperty(ctx context.Context, className string, prop *models.Property) error {
 	return nil
 }
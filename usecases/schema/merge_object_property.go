@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// MergeClassObjectProperty adds the nested properties of the given object or
+// object[] property to the existing property of the same name. Nested
+// properties already present are left untouched.
+func (m *Manager) MergeClassObjectProperty(ctx context.Context, principal *models.Principal,
+	class string, property *models.Property,
+) error {
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	return m.mergeClassObjectProperty(ctx, class, property)
+}
+
+func (m *Manager) mergeClassObjectProperty(ctx context.Context,
+	className string, prop *models.Property,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	class, err := schema.GetClassByName(m.state.ObjectSchema, className)
+	if err != nil {
+		return err
+	}
+	prop.Name = schema.LowercaseFirstLetter(prop.Name)
+
+	existing, err := schema.GetPropertyByName(class, prop.Name)
+	if err != nil {
+		return err
+	}
+	if _, ok := schema.AsNested(existing.DataType); !ok {
+		return fmt.Errorf("property '%s' of class '%s' is not of data type object/object[]",
+			prop.Name, className)
+	}
+
+	merged, changed := schema.MergeNestedProperties(existing.NestedProperties,
+		prop.NestedProperties)
+	if !changed {
+		return nil
+	}
+
+	m.setNestedPropertiesDefaults(prop.NestedProperties)
+	if err := m.validateNestedProperties(merged, prop.Name); err != nil {
+		return err
+	}
+
+	if m.schemaLog != nil {
+		return m.replicate(ctx, MergeObjectProperty, MergeObjectPropertyPayload{className, prop})
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, MergeObjectProperty,
+		MergeObjectPropertyPayload{className, prop}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally. See
+		// addClassProperty for details.
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.mergeClassObjectPropertyApplyChanges(ctx, className, prop)
+}
+
+func (m *Manager) mergeClassObjectPropertyApplyChanges(ctx context.Context,
+	className string, prop *models.Property,
+) error {
+	class, err := schema.GetClassByName(m.state.ObjectSchema, className)
+	if err != nil {
+		return err
+	}
+
+	existing, err := schema.GetPropertyByName(class, prop.Name)
+	if err != nil {
+		return err
+	}
+
+	existing.NestedProperties, _ = schema.MergeNestedProperties(existing.NestedProperties,
+		prop.NestedProperties)
+	err = m.saveSchema(ctx)
+	if err != nil {
+		return err
+	}
+
+	// creating the buckets of the added nested properties, existing buckets
+	// are kept as they are
+	return m.migrator.AddProperty(ctx, className, existing)
+}
@@ -30,6 +30,7 @@ type Migrator interface {
 		newClassName *string) error
 	GetShardsStatus(ctx context.Context, className string) (map[string]string, error)
 	UpdateShardStatus(ctx context.Context, className, shardName, targetStatus string) error
+	DropShards(ctx context.Context, className string, shards []string) error
 	AddProperty(ctx context.Context, className string,
 		prop *models.Property) error
 	UpdateProperty(ctx context.Context, className string,
@@ -0,0 +1,245 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/cluster"
+)
+
+// schemaLog orders schema changes through consensus, see cluster.Raft. If it
+// is set, it replaces the two-phase schema transactions.
+type schemaLog interface {
+	// Execute submits a command and returns once it has been applied locally
+	Execute(ctx context.Context, cmd []byte) error
+
+	// Barrier returns once all commands committed before have been applied locally
+	Barrier(ctx context.Context) error
+}
+
+// command is a schema change stored in the schema log. It reuses the
+// payloads of the schema transactions.
+type command struct {
+	Type    cluster.TransactionType `json:"type"`
+	Payload json.RawMessage         `json:"payload"`
+}
+
+// SetSchemaLog makes the manager submit schema changes to l. The manager
+// must be the state machine of l, see ApplyCommand.
+func (m *Manager) SetSchemaLog(l schemaLog) {
+	m.Lock()
+	defer m.Unlock()
+	m.schemaLog = l
+}
+
+// replicate submits a schema change to the schema log. It must be called
+// with the manager's lock held. The lock is released until the change has
+// been applied, since applying it requires the lock.
+func (m *Manager) replicate(ctx context.Context, txType cluster.TransactionType,
+	payload interface{},
+) error {
+	pl, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "marshal %s payload", txType)
+	}
+	cmd, err := json.Marshal(command{Type: txType, Payload: pl})
+	if err != nil {
+		return errors.Wrapf(err, "marshal %s command", txType)
+	}
+
+	m.Unlock()
+	defer m.Lock()
+	return m.schemaLog.Execute(ctx, cmd)
+}
+
+// GetConsistentSchema is like GetSchema, but if schema changes are ordered
+// through consensus it waits until all changes committed before the call
+// have been applied locally.
+func (m *Manager) GetConsistentSchema(ctx context.Context,
+	principal *models.Principal,
+) (schema.Schema, error) {
+	if err := m.Authorizer.Authorize(principal, "list", "schema/*"); err != nil {
+		return schema.Schema{}, err
+	}
+
+	m.RLock()
+	l := m.schemaLog
+	m.RUnlock()
+	if l != nil {
+		if err := l.Barrier(ctx); err != nil {
+			return schema.Schema{}, errors.Wrap(err, "read schema log")
+		}
+	}
+
+	return m.getSchema(), nil
+}
+
+// ApplyCommand applies a committed schema change. It is called in the same
+// order on every node, changes are therefore validated once more against
+// the current state, so that all nodes reject the same ones.
+func (m *Manager) ApplyCommand(index uint64, cmd []byte) error {
+	var c command
+	if err := json.Unmarshal(cmd, &c); err != nil {
+		return errors.Wrap(err, "unmarshal command")
+	}
+	payload, err := UnmarshalTransaction(c.Type, c.Payload)
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	if index <= m.state.AppliedIndex {
+		// already part of the persisted schema
+		m.Unlock()
+		return nil
+	}
+	m.state.AppliedIndex = index
+	err = m.validateCommand(c.Type, payload)
+	m.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return m.handleCommit(context.Background(),
+		&cluster.Transaction{Type: c.Type, Payload: payload})
+}
+
+func (m *Manager) validateCommand(txType cluster.TransactionType,
+	payload interface{},
+) error {
+	switch pl := payload.(type) {
+	case AddClassPayload:
+		if m.getClassByName(pl.Class.Class) != nil {
+			return fmt.Errorf("class name %q already exists", pl.Class.Class)
+		}
+	case AddPropertyPayload:
+		class := m.getClassByName(pl.ClassName)
+		if class == nil {
+			return ErrNotFound
+		}
+		if prop, _ := schema.GetPropertyByName(class, pl.Property.Name); prop != nil {
+			return fmt.Errorf("class %q: property %q already exists",
+				pl.ClassName, pl.Property.Name)
+		}
+	case MergeObjectPropertyPayload:
+		class := m.getClassByName(pl.ClassName)
+		if class == nil {
+			return ErrNotFound
+		}
+		if prop, _ := schema.GetPropertyByName(class, pl.Property.Name); prop == nil {
+			return fmt.Errorf("class %q: property %q does not exist",
+				pl.ClassName, pl.Property.Name)
+		}
+	case DeleteClassPayload:
+		if !pl.Force && m.getClassByName(pl.ClassName) == nil {
+			return ErrNotFound
+		}
+	case UpdateClassPayload:
+		if m.getClassByName(pl.ClassName) == nil {
+			return ErrNotFound
+		}
+	case SwapClassPayload:
+		return m.validateSwapClass(pl.Alias, pl.ClassName, pl.Drop)
+	case DeleteAliasPayload:
+		if _, ok := m.state.ObjectSchema.Aliases[pl.Alias]; !ok {
+			return ErrNotFound
+		}
+	default:
+		return errors.Errorf("unsupported command %q", txType)
+	}
+	return nil
+}
+
+// Snapshot returns the schema and sharding state including the index of the
+// last applied command
+func (m *Manager) Snapshot() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	m.shardingStateLock.RLock()
+	defer m.shardingStateLock.RUnlock()
+
+	return json.Marshal(m.state)
+}
+
+// Restore brings the local schema to the state of a snapshot, unless the
+// local schema is already more recent. Classes are added, updated and deleted
+// through the same commit handlers as individual commands.
+func (m *Manager) Restore(snapshot []byte) error {
+	var snap State
+	if err := json.Unmarshal(snapshot, &snap); err != nil {
+		return errors.Wrap(err, "unmarshal snapshot")
+	}
+	if snap.ObjectSchema == nil {
+		snap.ObjectSchema = &models.Schema{}
+	}
+
+	m.RLock()
+	if snap.AppliedIndex <= m.state.AppliedIndex {
+		m.RUnlock()
+		return nil
+	}
+	var txs []*cluster.Transaction
+	for _, class := range m.state.ObjectSchema.Classes {
+		if _, err := schema.GetClassByName(snap.ObjectSchema, class.Class); err != nil {
+			txs = append(txs, &cluster.Transaction{
+				Type: DeleteClass, Payload: DeleteClassPayload{ClassName: class.Class},
+			})
+		}
+	}
+	for _, class := range snap.ObjectSchema.Classes {
+		state := snap.ShardingState[class.Class]
+		local := m.getClassByName(class.Class)
+		if local == nil {
+			txs = append(txs, &cluster.Transaction{
+				Type: AddClass, Payload: AddClassPayload{Class: class, State: state},
+			})
+			continue
+		}
+		for _, prop := range class.Properties {
+			if p, _ := schema.GetPropertyByName(local, prop.Name); p == nil {
+				txs = append(txs, &cluster.Transaction{
+					Type:    AddProperty,
+					Payload: AddPropertyPayload{ClassName: class.Class, Property: prop},
+				})
+			} else if _, ok := schema.AsNested(p.DataType); ok {
+				txs = append(txs, &cluster.Transaction{
+					Type:    MergeObjectProperty,
+					Payload: MergeObjectPropertyPayload{ClassName: class.Class, Property: prop},
+				})
+			}
+		}
+		txs = append(txs, &cluster.Transaction{
+			Type:    UpdateClass,
+			Payload: UpdateClassPayload{ClassName: class.Class, Class: class, State: state},
+		})
+	}
+	m.RUnlock()
+
+	ctx := context.Background()
+	for _, tx := range txs {
+		if err := m.handleCommit(ctx, tx); err != nil {
+			return errors.Wrapf(err, "restore snapshot: %s", tx.Type)
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.state.ObjectSchema.Aliases = snap.ObjectSchema.Aliases
+	m.state.AppliedIndex = snap.AppliedIndex
+	return m.saveSchema(ctx)
+}
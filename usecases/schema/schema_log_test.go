@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestSchemaLog(t *testing.T) {
+	ctx := context.Background()
+	log := &fakeSchemaLog{}
+	sm1, sm2 := newSchemaManager(), newSchemaManager()
+	log.managers = []*Manager{sm1, sm2}
+	sm1.SetSchemaLog(log)
+	sm2.SetSchemaLog(log)
+
+	t.Run("add class on one node", func(t *testing.T) {
+		err := sm1.AddClass(ctx, nil, &models.Class{
+			Class:           "Foo",
+			VectorIndexType: "hnsw",
+		})
+		require.Nil(t, err)
+
+		for _, sm := range log.managers {
+			class, err := sm.GetClass(ctx, nil, "Foo")
+			require.Nil(t, err)
+			require.NotNil(t, class)
+			assert.Equal(t, uint64(1), sm.state.AppliedIndex)
+		}
+	})
+
+	t.Run("add property on the other node", func(t *testing.T) {
+		err := sm2.AddClassProperty(ctx, nil, "Foo", &models.Property{
+			Name:     "name",
+			DataType: []string{"text"},
+		})
+		require.Nil(t, err)
+
+		for _, sm := range log.managers {
+			class, err := sm.GetClass(ctx, nil, "Foo")
+			require.Nil(t, err)
+			require.Len(t, class.Properties, 1)
+			assert.Equal(t, "name", class.Properties[0].Name)
+		}
+	})
+
+	t.Run("conflicting command is rejected by all nodes", func(t *testing.T) {
+		cmd := []byte(`{"type":"add_class","payload":{"class":{"class":"Foo"}}}`)
+		for _, sm := range log.managers {
+			err := sm.ApplyCommand(3, cmd)
+			assert.EqualError(t, err, `class name "Foo" already exists`)
+			assert.Equal(t, uint64(3), sm.state.AppliedIndex)
+		}
+	})
+
+	t.Run("applied commands are skipped", func(t *testing.T) {
+		cmd := []byte(`{"type":"delete_class","payload":{"className":"Foo"}}`)
+		require.Nil(t, sm1.ApplyCommand(2, cmd))
+
+		class, err := sm1.GetClass(ctx, nil, "Foo")
+		require.Nil(t, err)
+		assert.NotNil(t, class)
+	})
+
+	t.Run("restore snapshot on a new node", func(t *testing.T) {
+		snap, err := sm1.Snapshot()
+		require.Nil(t, err)
+
+		sm3 := newSchemaManager()
+		require.Nil(t, sm3.AddClass(ctx, nil, &models.Class{
+			Class:           "Stale",
+			VectorIndexType: "hnsw",
+		}))
+		require.Nil(t, sm3.Restore(snap))
+
+		assert.ElementsMatch(t, []string{"Foo"}, testGetClassNames(sm3))
+		class, err := sm3.GetClass(ctx, nil, "Foo")
+		require.Nil(t, err)
+		require.Len(t, class.Properties, 1)
+		assert.Equal(t, uint64(3), sm3.state.AppliedIndex)
+	})
+
+	t.Run("older snapshot is ignored", func(t *testing.T) {
+		snap := []byte(`{"object":{"classes":[]},"appliedIndex":1}`)
+		require.Nil(t, sm2.Restore(snap))
+		assert.ElementsMatch(t, []string{"Foo"}, testGetClassNames(sm2))
+	})
+}
+
+// fakeSchemaLog applies each command to all managers in the same order
+type fakeSchemaLog struct {
+	managers []*Manager
+	index    uint64
+}
+
+func (l *fakeSchemaLog) Execute(ctx context.Context, cmd []byte) error {
+	l.index++
+	var err error
+	for i, m := range l.managers {
+		if applyErr := m.ApplyCommand(l.index, cmd); i == 0 {
+			err = applyErr
+		}
+	}
+	return err
+}
+
+func (l *fakeSchemaLog) Barrier(ctx context.Context) error {
+	return nil
+}
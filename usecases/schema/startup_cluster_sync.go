@@ -40,6 +40,12 @@ import (
 func (m *Manager) startupClusterSync(ctx context.Context,
 	localSchema *State,
 ) error {
+	if m.config.Cluster.RaftEnabled {
+		// missing changes are received through the schema log once the node
+		// is part of the raft cluster
+		return nil
+	}
+
 	nodes := m.clusterState.AllNames()
 	if len(nodes) <= 1 {
 		return m.startupHandleSingleNode(ctx, nodes)
@@ -27,6 +27,10 @@ const (
 	AddProperty cluster.TransactionType = "add_property"
 	DeleteClass cluster.TransactionType = "delete_class"
 	UpdateClass cluster.TransactionType = "update_class"
+	SwapClass   cluster.TransactionType = "swap_class"
+	DeleteAlias cluster.TransactionType = "delete_alias"
+
+	MergeObjectProperty cluster.TransactionType = "merge_object_property"
 
 	// read-only
 	ReadSchema cluster.TransactionType = "read_schema"
@@ -44,6 +48,11 @@ type AddPropertyPayload struct {
 	Property  *models.Property `json:"property"`
 }
 
+type MergeObjectPropertyPayload struct {
+	ClassName string           `json:"className"`
+	Property  *models.Property `json:"property"`
+}
+
 type DeleteClassPayload struct {
 	ClassName string `json:"className"`
 	Force     bool   `json:"force"`
@@ -59,6 +68,16 @@ type UpdateClassPayload struct {
 	State *sharding.State `json:"state"`
 }
 
+type SwapClassPayload struct {
+	Alias     string `json:"alias"`
+	ClassName string `json:"className"`
+	Drop      string `json:"drop"`
+}
+
+type DeleteAliasPayload struct {
+	Alias string `json:"alias"`
+}
+
 type ReadSchemaPayload struct {
 	Schema *State `json:"schema"`
 }
@@ -74,12 +93,21 @@ func UnmarshalTransaction(txType cluster.TransactionType,
 	case AddProperty:
 		return unmarshalAddProperty(payload)
 
+	case MergeObjectProperty:
+		return unmarshalMergeObjectProperty(payload)
+
 	case DeleteClass:
 		return unmarshalDeleteClass(payload)
 
 	case UpdateClass:
 		return unmarshalUpdateClass(payload)
 
+	case SwapClass:
+		return unmarshalSwapClass(payload)
+
+	case DeleteAlias:
+		return unmarshalDeleteAlias(payload)
+
 	case ReadSchema:
 		return unmarshalReadSchema(payload)
 
@@ -107,6 +135,15 @@ func unmarshalAddProperty(payload json.RawMessage) (interface{}, error) {
 	return pl, nil
 }
 
+func unmarshalMergeObjectProperty(payload json.RawMessage) (interface{}, error) {
+	var pl MergeObjectPropertyPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
 func unmarshalDeleteClass(payload json.RawMessage) (interface{}, error) {
 	var pl DeleteClassPayload
 	if err := json.Unmarshal(payload, &pl); err != nil {
@@ -125,6 +162,24 @@ func unmarshalUpdateClass(payload json.RawMessage) (interface{}, error) {
 	return pl, nil
 }
 
+func unmarshalSwapClass(payload json.RawMessage) (interface{}, error) {
+	var pl SwapClassPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
+func unmarshalDeleteAlias(payload json.RawMessage) (interface{}, error) {
+	var pl DeleteAliasPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
 func unmarshalReadSchema(payload json.RawMessage) (interface{}, error) {
 	var pl ReadSchemaPayload
 	if err := json.Unmarshal(payload, &pl); err != nil {
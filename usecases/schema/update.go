@@ -82,12 +82,17 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	if initialRF != updatedRF {
 		uss, err := m.scaleOut.Scale(ctx, className, updatedSharding, initialRF, updatedRF)
 		if err != nil {
-			return errors.Wrapf(err, "scale out from %d to %d replicas",
+			return errors.Wrapf(err, "scale from %d to %d replicas",
 				initialRF, updatedRF)
 		}
 		updatedState = uss
 	}
 
+	if m.schemaLog != nil {
+		return m.replicate(ctx, UpdateClass,
+			UpdateClassPayload{className, updated, updatedState})
+	}
+
 	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
 		UpdateClassPayload{className, updated, updatedState}, DefaultTxTTL)
 	if err != nil {
@@ -104,6 +109,37 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	return m.updateClassApplyChanges(ctx, className, updated, updatedState)
 }
 
+// UpdateShardingState broadcasts an updated sharding state of a class to the
+// cluster and applies it locally. Shards which no longer belong to this node
+// are dropped. It is used to move shards between nodes.
+func (m *Manager) UpdateShardingState(ctx context.Context, className string,
+	ss *sharding.State,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	class := m.getClassByName(className)
+	if class == nil {
+		return ErrNotFound
+	}
+
+	if m.schemaLog != nil {
+		return m.replicate(ctx, UpdateClass, UpdateClassPayload{className, class, ss})
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateClass,
+		UpdateClassPayload{className, class, ss}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		return errors.Wrap(err, "commit cluster-wide transaction")
+	}
+
+	return m.updateClassApplyChanges(ctx, className, class, ss)
+}
+
 func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 	updated *models.Class, updatedShardingState *sharding.State,
 ) error {
@@ -124,6 +160,7 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 
 	*initial = *updated
 
+	var removedShards []string
 	if updatedShardingState != nil {
 		// do not override if transaction does not contain an updated state
 
@@ -131,11 +168,37 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 		// explicitly now.
 		updatedShardingState.SetLocalName(m.clusterState.LocalName())
 		m.shardingStateLock.Lock()
+		if ss := m.state.ShardingState[className]; ss != nil {
+			removedShards = removedLocalShards(ss, updatedShardingState)
+		}
 		m.state.ShardingState[className] = updatedShardingState
 		m.shardingStateLock.Unlock()
 	}
 
-	return m.saveSchema(ctx)
+	if err := m.saveSchema(ctx); err != nil {
+		return err
+	}
+
+	// Replicas which no longer belong to this node (scale in) can only be
+	// deleted once the updated sharding state is in place
+	if len(removedShards) > 0 {
+		if err := m.migrator.DropShards(ctx, className, removedShards); err != nil {
+			return errors.Wrap(err, "drop removed replicas")
+		}
+	}
+
+	return nil
+}
+
+// removedLocalShards returns shards which are local in before but not in after
+func removedLocalShards(before, after *sharding.State) []string {
+	var names []string
+	for _, name := range before.AllLocalPhysicalShards() {
+		if !after.IsShardLocal(name) {
+			names = append(names, name)
+		}
+	}
+	return names
 }
 
 func (m *Manager) validateImmutableFields(initial, updated *models.Class) error {
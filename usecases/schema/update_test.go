@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 // As of now, most class settings are immutable, but we need to allow some
@@ -380,6 +381,29 @@ func TestClassUpdates(t *testing.T) {
 	})
 }
 
+func TestRemovedLocalShards(t *testing.T) {
+	newState := func(m map[string][]string) *sharding.State {
+		ss := &sharding.State{Physical: make(map[string]sharding.Physical)}
+		for name, nodes := range m {
+			ss.Physical[name] = sharding.Physical{Name: name, BelongsToNodes: nodes}
+		}
+		ss.SetLocalName("N1")
+		return ss
+	}
+	before := newState(map[string][]string{
+		"S1": {"N1", "N2"},
+		"S2": {"N2", "N1"},
+		"S3": {"N2", "N3"},
+	})
+	after := newState(map[string][]string{
+		"S1": {"N1"},
+		"S2": {"N2"},
+		"S3": {"N2"},
+	})
+	assert.Equal(t, []string{"S2"}, removedLocalShards(before, after))
+	assert.Empty(t, removedLocalShards(after, before))
+}
+
 type configMigrator struct {
 	NilMigrator
 	vectorConfigValidationError    error
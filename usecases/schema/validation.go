@@ -34,6 +34,11 @@ func (m *Manager) validateClassNameUniqueness(className string) error {
 			return fmt.Errorf("class name %q already exists", className)
 		}
 	}
+	for alias := range m.state.ObjectSchema.Aliases {
+		if strings.EqualFold(className, alias) {
+			return fmt.Errorf("class name %q already exists as an alias", className)
+		}
+	}
 
 	return nil
 }
@@ -73,6 +78,9 @@ func (m *Manager) validatePropertyTokenization(tokenization string, propertyData
 	if tokenization == "" {
 		return nil
 	}
+	if propertyDataType.IsNested() {
+		return fmt.Errorf("Tokenization is not allowed for object/object[] data types")
+	}
 	return fmt.Errorf("Tokenization is not allowed for reference data type")
 }
 
@@ -102,6 +110,86 @@ func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 	return nil
 }
 
+// validateNestedProperties validates the nested properties of an object or
+// object[] property. Nested properties can be primitives or nested objects
+// again, but neither references nor geoCoordinates, phoneNumber or blob.
+func (m *Manager) validateNestedProperties(nestedProps []*models.NestedProperty,
+	path string,
+) error {
+	if len(nestedProps) == 0 {
+		return fmt.Errorf("property '%s': nestedProperties must be set for data types object/object[]", path)
+	}
+
+	existingNames := map[string]bool{}
+	for _, nestedProp := range nestedProps {
+		nestedPath := path + "." + nestedProp.Name
+		if _, err := schema.ValidatePropertyName(nestedProp.Name); err != nil {
+			return fmt.Errorf("property '%s': %v", path, err)
+		}
+		if existingNames[strings.ToLower(nestedProp.Name)] {
+			return fmt.Errorf("property '%s': conflict for nested property %q: provided multiple times",
+				path, nestedProp.Name)
+		}
+		existingNames[strings.ToLower(nestedProp.Name)] = true
+
+		if _, ok := schema.AsNested(nestedProp.DataType); ok {
+			if nestedProp.Tokenization != "" {
+				return fmt.Errorf("property '%s': Tokenization is not allowed for object/object[] data types", nestedPath)
+			}
+			if err := m.validateNestedProperties(nestedProp.NestedProperties, nestedPath); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if len(nestedProp.NestedProperties) > 0 {
+			return fmt.Errorf("property '%s': nestedProperties are only allowed for data types object/object[]", nestedPath)
+		}
+
+		dataType, ok := nestedPrimitiveDataType(nestedProp.DataType)
+		if !ok {
+			return fmt.Errorf("property '%s': invalid dataType %v: nested properties can be of "+
+				"type text, int, number, boolean, date, uuid, their arrays or object/object[]",
+				nestedPath, nestedProp.DataType)
+		}
+
+		sch := m.getSchema()
+		propertyDataType, err := (&sch).FindPropertyDataType(nestedProp.DataType)
+		if err != nil {
+			return fmt.Errorf("property '%s': invalid dataType: %v", nestedPath, err)
+		}
+		if err := m.validatePropertyTokenization(nestedProp.Tokenization, propertyDataType); err != nil {
+			return fmt.Errorf("property '%s': %v", nestedPath, err)
+		}
+
+		if nestedProp.IndexSearchable != nil && *nestedProp.IndexSearchable {
+			switch dataType {
+			case schema.DataTypeText, schema.DataTypeTextArray:
+			default:
+				return fmt.Errorf("property '%s': `indexSearchable` is allowed only for text/text[] data types. "+
+					"For other data types set false or leave empty", nestedPath)
+			}
+		}
+	}
+
+	return nil
+}
+
+func nestedPrimitiveDataType(dataType []string) (schema.DataType, bool) {
+	if len(dataType) != 1 {
+		return "", false
+	}
+	switch dt := schema.DataType(dataType[0]); dt {
+	case schema.DataTypeText, schema.DataTypeInt, schema.DataTypeNumber,
+		schema.DataTypeBoolean, schema.DataTypeDate, schema.DataTypeUUID,
+		schema.DataTypeTextArray, schema.DataTypeIntArray, schema.DataTypeNumberArray,
+		schema.DataTypeBooleanArray, schema.DataTypeDateArray, schema.DataTypeUUIDArray:
+		return dt, true
+	default:
+		return "", false
+	}
+}
+
 func (m *Manager) validateVectorSettings(ctx context.Context, class *models.Class) error {
 	if err := m.validateVectorizer(ctx, class); err != nil {
 		return err
@@ -635,3 +635,11 @@ func (pdt *fakePropertyDataType) Classes() []schema.ClassName {
 func (pdt *fakePropertyDataType) ContainsClass(name schema.ClassName) bool {
 	return false
 }
+
+func (pdt *fakePropertyDataType) IsNested() bool {
+	return false
+}
+
+func (pdt *fakePropertyDataType) AsNested() schema.DataType {
+	return ""
+}
@@ -75,12 +75,19 @@ type RemoteIndexClient interface {
 		searchVector []float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
-		additional additional.Properties,
+		recommend *searchparams.Recommend, additional additional.Properties,
 	) ([]*storobj.Object, []float32, error)
 	Aggregate(ctx context.Context, hostname, indexName, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
 	FindDocIDs(ctx context.Context, hostName, indexName, shardName string,
 		filters *filters.LocalFilter) ([]uint64, error)
+	Facets(ctx context.Context, hostName, indexName, shardName string,
+		filters *filters.LocalFilter, ids []strfmt.UUID,
+		params searchparams.Facets) ([]search.Facet, error)
+	FindDuplicates(ctx context.Context, hostName, indexName, shardName string,
+		maxDistance float32) ([]search.DuplicatePair, error)
+	SearchDuplicates(ctx context.Context, hostName, indexName, shardName string,
+		vectors [][]float32, maxDistance float32) ([][]search.Duplicate, error)
 	DeleteObjectBatch(ctx context.Context, hostName, indexName, shardName string,
 		docIDs []uint64, dryRun bool) objects.BatchSimpleObjects
 	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
@@ -238,7 +245,7 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shardName string,
 	searchVector []float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
-	additional additional.Properties, replEnabled bool,
+	recommend *searchparams.Recommend, additional additional.Properties, replEnabled bool,
 ) ([]*storobj.Object, []float32, error) {
 	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
 	if !ok {
@@ -251,7 +258,7 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shardName string,
 	}
 
 	objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shardName, searchVector, limit,
-		filters, keywordRanking, sort, cursor, groupBy, additional)
+		filters, keywordRanking, sort, cursor, groupBy, recommend, additional)
 	if replEnabled {
 		storobj.AddOwnership(objs, shard.BelongsToNode(), shard.Name)
 	}
@@ -274,6 +281,54 @@ func (ri *RemoteIndex) Aggregate(ctx context.Context, shardName string,
 	return ri.client.Aggregate(ctx, host, ri.class, shardName, params)
 }
 
+func (ri *RemoteIndex) Facets(ctx context.Context, shardName string,
+	filters *filters.LocalFilter, ids []strfmt.UUID, params searchparams.Facets,
+) ([]search.Facet, error) {
+	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
+	if !ok {
+		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode())
+	if !ok {
+		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode())
+	}
+
+	return ri.client.Facets(ctx, host, ri.class, shardName, filters, ids, params)
+}
+
+func (ri *RemoteIndex) FindDuplicates(ctx context.Context, shardName string,
+	maxDistance float32,
+) ([]search.DuplicatePair, error) {
+	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
+	if !ok {
+		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode())
+	if !ok {
+		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode())
+	}
+
+	return ri.client.FindDuplicates(ctx, host, ri.class, shardName, maxDistance)
+}
+
+func (ri *RemoteIndex) SearchDuplicates(ctx context.Context, shardName string,
+	vectors [][]float32, maxDistance float32,
+) ([][]search.Duplicate, error) {
+	shard, ok := ri.stateGetter.ShardingState(ri.class).Physical[shardName]
+	if !ok {
+		return nil, errors.Errorf("class %s has no physical shard %q", ri.class, shardName)
+	}
+
+	host, ok := ri.nodeResolver.NodeHostname(shard.BelongsToNode())
+	if !ok {
+		return nil, errors.Errorf("resolve node name %q to host", shard.BelongsToNode())
+	}
+
+	return ri.client.SearchDuplicates(ctx, host, ri.class, shardName, vectors, maxDistance)
+}
+
 func (ri *RemoteIndex) FindDocIDs(ctx context.Context, shardName string,
 	filters *filters.LocalFilter,
 ) ([]uint64, error) {
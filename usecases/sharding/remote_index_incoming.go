@@ -55,12 +55,19 @@ type RemoteIndexIncomingRepo interface {
 		vector []float32, distance float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
-		additional additional.Properties,
+		recommend *searchparams.Recommend, additional additional.Properties,
 	) ([]*storobj.Object, []float32, error)
 	IncomingAggregate(ctx context.Context, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
 	IncomingFindDocIDs(ctx context.Context, shardName string,
 		filters *filters.LocalFilter) ([]uint64, error)
+	IncomingFacets(ctx context.Context, shardName string,
+		filters *filters.LocalFilter, ids []strfmt.UUID,
+		params searchparams.Facets) ([]search.Facet, error)
+	IncomingFindDuplicates(ctx context.Context, shardName string,
+		maxDistance float32) ([]search.DuplicatePair, error)
+	IncomingSearchDuplicates(ctx context.Context, shardName string,
+		vectors [][]float32, maxDistance float32) ([][]search.Duplicate, error)
 	IncomingDeleteObjectBatch(ctx context.Context, shardName string,
 		docIDs []uint64, dryRun bool) objects.BatchSimpleObjects
 	IncomingGetShardStatus(ctx context.Context, shardName string) (string, error)
@@ -181,15 +188,16 @@ func (rii *RemoteIndexIncoming) MultiGetObjects(ctx context.Context, indexName,
 func (rii *RemoteIndexIncoming) Search(ctx context.Context, indexName, shardName string,
 	vector []float32, distance float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
-	groupBy *searchparams.GroupBy, additional additional.Properties,
+	groupBy *searchparams.GroupBy, recommend *searchparams.Recommend,
+	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
 	if index == nil {
 		return nil, nil, errors.Errorf("local index %q not found", indexName)
 	}
 
-	return index.IncomingSearch(
-		ctx, shardName, vector, distance, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
+	return index.IncomingSearch(ctx, shardName, vector, distance, limit, filters,
+		keywordRanking, sort, cursor, groupBy, recommend, additional)
 }
 
 func (rii *RemoteIndexIncoming) Aggregate(ctx context.Context, indexName, shardName string,
@@ -203,6 +211,39 @@ func (rii *RemoteIndexIncoming) Aggregate(ctx context.Context, indexName, shardN
 	return index.IncomingAggregate(ctx, shardName, params)
 }
 
+func (rii *RemoteIndexIncoming) Facets(ctx context.Context, indexName, shardName string,
+	filters *filters.LocalFilter, ids []strfmt.UUID, params searchparams.Facets,
+) ([]search.Facet, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingFacets(ctx, shardName, filters, ids, params)
+}
+
+func (rii *RemoteIndexIncoming) FindDuplicates(ctx context.Context, indexName, shardName string,
+	maxDistance float32,
+) ([]search.DuplicatePair, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingFindDuplicates(ctx, shardName, maxDistance)
+}
+
+func (rii *RemoteIndexIncoming) SearchDuplicates(ctx context.Context, indexName, shardName string,
+	vectors [][]float32, maxDistance float32,
+) ([][]search.Duplicate, error) {
+	index := rii.repo.GetIndexForIncoming(schema.ClassName(indexName))
+	if index == nil {
+		return nil, errors.Errorf("local index %q not found", indexName)
+	}
+
+	return index.IncomingSearchDuplicates(ctx, shardName, vectors, maxDistance)
+}
+
 func (rii *RemoteIndexIncoming) FindDocIDs(ctx context.Context, indexName, shardName string,
 	filters *filters.LocalFilter,
 ) ([]uint64, error) {
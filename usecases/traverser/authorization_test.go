@@ -23,7 +23,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -46,6 +48,13 @@ func Test_Traverser_Authorization(t *testing.T) {
 			expectedResource: "traversal/*",
 		},
 
+		{
+			methodName:       "GetClass",
+			additionalArgs:   []interface{}{dto.GetParams{ClassName: "Foo"}},
+			expectedVerb:     "get",
+			expectedResource: "traversal/Foo",
+		},
+
 		{
 			methodName:       "Aggregate",
 			additionalArgs:   []interface{}{&aggregation.Params{}},
@@ -53,6 +62,20 @@ func Test_Traverser_Authorization(t *testing.T) {
 			expectedResource: "traversal/*",
 		},
 
+		{
+			methodName:       "Aggregate",
+			additionalArgs:   []interface{}{&aggregation.Params{ClassName: "Foo"}},
+			expectedVerb:     "get",
+			expectedResource: "traversal/Foo",
+		},
+
+		{
+			methodName:       "BatchGetClass",
+			additionalArgs:   []interface{}{[]dto.GetParams{{ClassName: "Foo"}}},
+			expectedVerb:     "get",
+			expectedResource: "traversal/Foo",
+		},
+
 		{
 			methodName:       "Explore",
 			additionalArgs:   []interface{}{ExploreParams{}},
@@ -83,13 +106,19 @@ func Test_Traverser_Authorization(t *testing.T) {
 			schemaGetter := &fakeSchemaGetter{}
 
 			manager := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
-				vectorRepo, explorer, schemaGetter, nil, nil, -1)
+				vectorRepo, explorer, schemaGetter, nil, nil, -1, nil)
 
 			args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
 			out, _ := callFuncByName(manager, test.methodName, args...)
 
+			err := out[len(out)-1].Interface()
+			if batch, ok := out[0].Interface().([]BatchGetResult); ok && len(batch) > 0 {
+				// a batch reports the errors of its queries individually
+				err = batch[0].Err
+			}
+
 			require.Len(t, authorizer.calls, 1, "authorizer must be called")
-			assert.Equal(t, errors.New("just a test fake"), out[len(out)-1].Interface(),
+			assert.Equal(t, errors.New("just a test fake"), err,
 				"execution must abort with authorizer error")
 			assert.Equal(t, authorizeCall{principal, test.expectedVerb, test.expectedResource},
 				authorizer.calls[0], "correct parameters must have been used on authorizer")
@@ -139,3 +168,66 @@ func allExportedMethods(subject interface{}) []string {
 
 	return methods
 }
+
+func Test_Traverser_AuthorizationOfReferences(t *testing.T) {
+	params := dto.GetParams{
+		ClassName: "Foo",
+		Properties: search.SelectProperties{{
+			Name: "hasBar",
+			Refs: []search.SelectClass{{
+				ClassName: "Bar",
+				RefProperties: search.SelectProperties{{
+					Name: "hasBaz",
+					Refs: []search.SelectClass{{ClassName: "Baz"}},
+				}},
+			}},
+		}},
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{{
+				Operator: filters.OperatorEqual,
+				On: &filters.Path{
+					Class:    "Foo",
+					Property: "hasQux",
+					Child:    &filters.Path{Class: "Qux", Property: "name"},
+				},
+			}},
+		}},
+	}
+
+	logger, _ := test.NewNullLogger()
+	for _, denied := range []string{"traversal/Bar", "traversal/Baz", "traversal/Qux"} {
+		t.Run(denied, func(t *testing.T) {
+			authorizer := &resourceDenier{denied: denied}
+			manager := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+				authorizer, &fakeVectorRepo{}, &fakeExplorer{}, &fakeSchemaGetter{},
+				nil, nil, -1, nil)
+
+			_, err := manager.GetClass(context.Background(), nil, params)
+			assert.EqualError(t, err, "forbidden: "+denied)
+		})
+	}
+
+	t.Run("aggregate filters", func(t *testing.T) {
+		authorizer := &resourceDenier{denied: "traversal/Qux"}
+		manager := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			authorizer, &fakeVectorRepo{}, &fakeExplorer{}, &fakeSchemaGetter{},
+			nil, nil, -1, nil)
+
+		_, err := manager.Aggregate(context.Background(), nil,
+			&aggregation.Params{ClassName: "Foo", Filters: params.Filters})
+		assert.EqualError(t, err, "forbidden: traversal/Qux")
+	})
+}
+
+// resourceDenier denies access to a single resource only
+type resourceDenier struct {
+	denied string
+}
+
+func (a *resourceDenier) Authorize(principal *models.Principal, verb, resource string) error {
+	if resource == a.denied {
+		return fmt.Errorf("forbidden: %s", resource)
+	}
+	return nil
+}
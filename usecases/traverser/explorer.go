@@ -19,6 +19,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	invertedrepo "github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
@@ -30,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 	uc "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/traverser/grouper"
@@ -46,6 +48,7 @@ type Explorer struct {
 	schemaGetter     uc.SchemaGetter
 	nearParamsVector *nearParamsVector
 	metrics          explorerMetrics
+	maxResults       int64
 }
 
 type explorerMetrics interface {
@@ -87,6 +90,8 @@ type vectorClassSearch interface {
 		props search.SelectProperties, groupBy *searchparams.GroupBy,
 		additional additional.Properties,
 	) (search.Results, error)
+	Facets(ctx context.Context, params dto.GetParams,
+		ids []strfmt.UUID) ([]search.Facet, error)
 }
 
 // NewExplorer with search and connector repo
@@ -107,6 +112,12 @@ func (e *Explorer) SetSchemaGetter(sg uc.SchemaGetter) {
 	e.schemaGetter = sg
 }
 
+// SetQueryMaximumResults sets the maximum number of objects a keyword search
+// matches when computing facets
+func (e *Explorer) SetQueryMaximumResults(maxResults int64) {
+	e.maxResults = maxResults
+}
+
 // GetClass from search and connector repo
 func (e *Explorer) GetClass(ctx context.Context,
 	params dto.GetParams,
@@ -130,19 +141,150 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
-	if params.KeywordRanking != nil {
-		return e.getClassKeywordBased(ctx, params)
+	if err := e.validateMMR(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'mmr' parameter")
 	}
 
-	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
-		return e.getClassVectorSearch(ctx, params)
+	if params.AdditionalProperties.Highlight != nil {
+		if params.KeywordRanking == nil && params.HybridSearch == nil {
+			return nil, errors.Errorf("highlight requires a bm25 or hybrid search")
+		}
+		// the highlighted properties are needed, even if none are selected
+		params.AdditionalProperties.NoProps = false
 	}
 
-	return e.getClassList(ctx, params)
+	var res []interface{}
+	var err error
+	switch {
+	case params.KeywordRanking != nil:
+		res, err = e.getClassKeywordBased(ctx, params)
+	case params.NearVector != nil || params.NearObject != nil || params.Recommend != nil ||
+		len(params.ModuleParams) > 0:
+		res, err = e.getClassVectorSearch(ctx, params)
+	default:
+		res, err = e.getClassList(ctx, params)
+	}
+	if err != nil || params.Facets == nil {
+		return res, err
+	}
+
+	return e.addFacets(ctx, params, res)
+}
+
+// addFacets counts the terms of the requested properties across all matched
+// objects and adds them to every result, see facetIDs for which objects are
+// matched.
+func (e *Explorer) addFacets(ctx context.Context, params dto.GetParams,
+	results []interface{},
+) ([]interface{}, error) {
+	ids, err := e.facetIDs(ctx, params)
+	if err != nil {
+		return nil, errors.Errorf("explorer: get class: facets: %v", err)
+	}
+
+	facets, err := e.search.Facets(ctx, params, ids)
+	if err != nil {
+		return nil, errors.Errorf("explorer: get class: facets: %v", err)
+	}
+
+	for _, res := range results {
+		asMap, ok := res.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		additionalProperties, ok := asMap["_additional"].(map[string]interface{})
+		if !ok {
+			additionalProperties = map[string]interface{}{}
+			asMap["_additional"] = additionalProperties
+		}
+		additionalProperties["facets"] = facets
+	}
+
+	return results, nil
+}
+
+// facetIDs returns the ids of the objects the facets are computed over. It
+// returns nil for a listing, which matches every object that passes the
+// filters. Searches with a threshold, i.e. keyword searches and vector
+// searches with a certainty or distance, match all objects within it
+// regardless of the pagination, keyword searches at most the configured
+// maximum number of results. Vector searches without a threshold and hybrid
+// searches with a vector component only rank the objects, so they match the
+// objects of the returned page.
+func (e *Explorer) facetIDs(ctx context.Context, params dto.GetParams) ([]strfmt.UUID, error) {
+	matched := params
+	matched.Properties = nil
+	matched.AdditionalProperties = additional.Properties{ID: true}
+	matched.Sort = nil
+	matched.Cursor = nil
+	matched.Group = nil
+	matched.GroupBy = nil
+	matched.MMR = nil
+	matched.Facets = nil
+
+	maxResults := e.maxResults
+	if maxResults <= 0 {
+		maxResults = config.DefaultQueryMaximumResults
+	}
+
+	var res []interface{}
+	var err error
+	switch {
+	case params.KeywordRanking != nil:
+		matched.Pagination = &filters.Pagination{Limit: int(maxResults)}
+		res, err = e.getClassKeywordBased(ctx, matched)
+	case params.HybridSearch != nil:
+		if params.HybridSearch.Alpha > 0 || params.HybridSearch.SubSearches != nil ||
+			params.HybridSearch.Query == "" {
+			matched.Pagination = params.Pagination
+			matched.MMR = params.MMR
+			res, err = e.getClassList(ctx, matched)
+			break
+		}
+		// without a vector component a hybrid search is a keyword search
+		matched.HybridSearch = nil
+		matched.KeywordRanking = &searchparams.KeywordRanking{
+			Query:      params.HybridSearch.Query,
+			Type:       "bm25",
+			Properties: params.HybridSearch.Properties,
+		}
+		matched.Pagination = &filters.Pagination{Limit: int(maxResults)}
+		res, err = e.getClassKeywordBased(ctx, matched)
+	case params.NearVector != nil || params.NearObject != nil || params.Recommend != nil ||
+		len(params.ModuleParams) > 0:
+		if _, withDistance := ExtractDistanceFromParams(params); !withDistance &&
+			ExtractCertaintyFromParams(params) == 0 {
+			matched.Pagination = params.Pagination
+			matched.MMR = params.MMR
+		} else {
+			matched.Pagination = &filters.Pagination{Limit: filters.LimitFlagSearchByDist}
+		}
+		res, err = e.getClassVectorSearch(ctx, matched)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]strfmt.UUID, 0, len(res))
+	for _, r := range res {
+		asMap, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if additionalProps, ok := asMap["_additional"].(map[string]interface{}); ok {
+			if id, ok := additionalProps["id"].(strfmt.UUID); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids, nil
 }
 
 func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParams) ([]interface{}, error) {
-	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
+	if params.NearVector != nil || params.NearObject != nil || params.Recommend != nil ||
+		len(params.ModuleParams) > 0 {
 		return nil, errors.Errorf("conflict: both near<Media> and keyword-based (bm25) arguments present, choose one")
 	}
 
@@ -189,7 +331,13 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 func (e *Explorer) getClassVectorSearch(ctx context.Context,
 	params dto.GetParams,
 ) ([]interface{}, error) {
-	searchVector, err := e.vectorFromParams(ctx, params)
+	var searchVector []float32
+	var err error
+	if params.Recommend != nil {
+		searchVector, params.Recommend, err = e.vectorFromRecommend(ctx, params)
+	} else {
+		searchVector, err = e.vectorFromParams(ctx, params)
+	}
 	if err != nil {
 		return nil, errors.Errorf("explorer: get class: vectorize params: %v", err)
 	}
@@ -204,7 +352,14 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 		params.AdditionalProperties.Vector = true
 	}
 
-	res, err := e.search.VectorClassSearch(ctx, params)
+	var res []search.Result
+	if params.MMR != nil {
+		res, err = e.mmrSearch(ctx, params, searchVector, func(params dto.GetParams) ([]search.Result, error) {
+			return e.search.VectorClassSearch(ctx, params)
+		})
+	} else {
+		res, err = e.search.VectorClassSearch(ctx, params)
+	}
 	if err != nil {
 		return nil, errors.Errorf("explorer: get class: vector search: %v", err)
 	}
@@ -307,7 +462,18 @@ func (e *Explorer) getClassList(ctx context.Context,
 	}
 	var res []search.Result
 	var err error
-	if params.HybridSearch != nil {
+	if params.HybridSearch != nil && params.MMR != nil {
+		searchVector, err := e.hybridSearchVector(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		res, err = e.mmrSearch(ctx, params, searchVector, func(params dto.GetParams) ([]search.Result, error) {
+			return e.Hybrid(ctx, params)
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else if params.HybridSearch != nil {
 		res, err = e.Hybrid(ctx, params)
 		if err != nil {
 			return nil, err
@@ -356,6 +522,10 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 	if err != nil {
 		return nil, fmt.Errorf("search results to get response: %w", err)
 	}
+	highlighter, err := e.highlighter(params)
+	if err != nil {
+		return nil, errors.Errorf("additional: highlight: %v", err)
+	}
 	for _, res := range input {
 		additionalProperties := make(map[string]interface{})
 
@@ -426,6 +596,12 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 			additionalProperties["isConsistent"] = res.IsConsistent
 		}
 
+		if highlighter != nil {
+			if props, ok := res.Schema.(map[string]interface{}); ok {
+				additionalProperties["highlight"] = highlighter.Highlight(props)
+			}
+		}
+
 		if len(additionalProperties) > 0 {
 			if additionalProperties["group"] != nil {
 				e.extractAdditionalPropertiesFromGroupRefs(additionalProperties["group"], params.Properties)
@@ -441,6 +617,36 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 	return output, nil
 }
 
+// highlighter prepares the highlighting of the query of a bm25 or hybrid
+// search. It returns nil if no highlighting was requested.
+func (e *Explorer) highlighter(params dto.GetParams) (*invertedrepo.Highlighter, error) {
+	if params.AdditionalProperties.Highlight == nil {
+		return nil, nil
+	}
+
+	var query string
+	var searchProperties []string
+	switch {
+	case params.KeywordRanking != nil:
+		query = params.KeywordRanking.Query
+		searchProperties = params.KeywordRanking.Properties
+	case params.HybridSearch != nil:
+		query = params.HybridSearch.Query
+		searchProperties = params.HybridSearch.Properties
+	default:
+		return nil, nil
+	}
+
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(params.ClassName))
+	if class == nil {
+		return nil, fmt.Errorf("class not found in schema: %q", params.ClassName)
+	}
+
+	return invertedrepo.NewHighlighter(class, query, searchProperties,
+		*params.AdditionalProperties.Highlight)
+}
+
 func (e *Explorer) extractAdditionalPropertiesFromGroupRefs(
 	additionalGroup interface{},
 	params search.SelectProperties,
@@ -593,6 +799,17 @@ func (e *Explorer) vectorFromParams(ctx context.Context,
 		params.NearObject, params.ModuleParams, params.ClassName)
 }
 
+func (e *Explorer) vectorFromRecommend(ctx context.Context,
+	params dto.GetParams,
+) ([]float32, *searchparams.Recommend, error) {
+	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
+		return nil, nil, errors.Errorf("found both 'recommend' and near<Media> parameters " +
+			"which are conflicting, choose one instead")
+	}
+
+	return e.nearParamsVector.vectorFromRecommend(ctx, params.ClassName, params.Recommend)
+}
+
 func (e *Explorer) vectorFromExploreParams(ctx context.Context,
 	params ExploreParams,
 ) ([]float32, error) {
@@ -688,6 +905,12 @@ func ExtractDistanceFromParams(params dto.GetParams) (distance float64, withDist
 		return
 	}
 
+	if params.Recommend != nil {
+		distance = params.Recommend.Distance
+		withDistance = params.Recommend.WithDistance
+		return
+	}
+
 	if len(params.ModuleParams) == 1 {
 		distance, withDistance = extractDistanceFromModuleParams(params.ModuleParams)
 	}
@@ -706,6 +929,11 @@ func ExtractCertaintyFromParams(params dto.GetParams) (certainty float64) {
 		return
 	}
 
+	if params.Recommend != nil {
+		certainty = params.Recommend.Certainty
+		return
+	}
+
 	if len(params.ModuleParams) == 1 {
 		certainty = extractCertaintyFromModuleParams(params.ModuleParams)
 		return
@@ -810,6 +1038,10 @@ func (e *Explorer) usageOperationFromGetParams(params dto.GetParams) string {
 		return "nearVector"
 	}
 
+	if params.Recommend != nil {
+		return "recommend"
+	}
+
 	// there is at most one module param, so we can return the first we find
 	for param := range params.ModuleParams {
 		return param
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/traverser/mmr"
+)
+
+func (e *Explorer) validateMMR(params dto.GetParams) error {
+	if params.MMR == nil {
+		return nil
+	}
+
+	if params.KeywordRanking != nil || (params.NearVector == nil && params.NearObject == nil &&
+		params.Recommend == nil && len(params.ModuleParams) == 0 && params.HybridSearch == nil) {
+		return errors.Errorf("mmr requires a near<Media> or hybrid search")
+	}
+	if params.GroupBy != nil {
+		return errors.Errorf("mmr cannot be combined with groupBy")
+	}
+	if params.MMR.Lambda < 0 || params.MMR.Lambda > 1 {
+		return errors.Errorf("lambda must be between 0 and 1, got %v", params.MMR.Lambda)
+	}
+	if params.Pagination == nil || params.Pagination.Limit < 0 {
+		return errors.Errorf("mmr requires a limit")
+	}
+	if params.MMR.FetchLimit < 0 {
+		return errors.Errorf("fetchLimit must be positive, got %d", params.MMR.FetchLimit)
+	}
+	if params.MMR.FetchLimit > 0 &&
+		params.MMR.FetchLimit < params.Pagination.Offset+params.Pagination.Limit {
+		return errors.Errorf("fetchLimit must be at least offset + limit (%d), got %d",
+			params.Pagination.Offset+params.Pagination.Limit, params.MMR.FetchLimit)
+	}
+
+	return nil
+}
+
+// mmrSearch over-fetches the candidates of the vector or hybrid search with
+// their vectors and picks the requested page out of them by maximal marginal
+// relevance to the search vector.
+func (e *Explorer) mmrSearch(ctx context.Context, params dto.GetParams,
+	searchVector []float32,
+	searchFn func(dto.GetParams) ([]search.Result, error),
+) ([]search.Result, error) {
+	distancer, err := e.classDistancer(params.ClassName)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, limit := params.Pagination.Offset, params.Pagination.Limit
+	fetchLimit := params.MMR.FetchLimit
+	if fetchLimit == 0 {
+		fetchLimit = 4 * (offset + limit)
+	}
+
+	params.Pagination = &filters.Pagination{Limit: fetchLimit}
+	params.AdditionalProperties.Vector = true
+	if params.HybridSearch != nil {
+		hybridSearch := *params.HybridSearch
+		hybridSearch.Limit = fetchLimit
+		hybridSearch.Vector = searchVector
+		params.HybridSearch = &hybridSearch
+	}
+
+	candidates, err := searchFn(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := mmr.New(distancer, params.MMR.Lambda).Rerank(searchVector,
+		candidates, offset+limit)
+	if err != nil {
+		return nil, err
+	}
+	if offset >= len(res) {
+		return nil, nil
+	}
+	return res[offset:], nil
+}
+
+// hybridSearchVector is the vector the dense part of a hybrid search runs
+// with, either set by the user or vectorized from the query.
+func (e *Explorer) hybridSearchVector(ctx context.Context,
+	params dto.GetParams,
+) ([]float32, error) {
+	if len(params.HybridSearch.Vector) > 0 {
+		return params.HybridSearch.Vector, nil
+	}
+	if e.modulesProvider == nil || params.HybridSearch.Query == "" {
+		return nil, errors.Errorf("mmr requires the vector of the hybrid search, " +
+			"set a vector or a query with a vectorizer configured")
+	}
+	vector, err := e.modulesProvider.VectorFromInput(ctx, params.ClassName,
+		params.HybridSearch.Query)
+	if err != nil {
+		return nil, errors.Errorf("vectorize hybrid query: %v", err)
+	}
+	return vector, nil
+}
+
+// classDistancer returns the distancer the vector index of the class is
+// configured with
+func (e *Explorer) classDistancer(className string) (distancer.Provider, error) {
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(className))
+	if class == nil {
+		return nil, fmt.Errorf("failed to find class '%s' in schema", className)
+	}
+
+	hnswConfig, err := typeAssertVectorIndex(class)
+	if err != nil {
+		return nil, err
+	}
+
+	switch hnswConfig.Distance {
+	case "", hnsw.DistanceCosine:
+		return distancer.NewCosineDistanceProvider(), nil
+	case hnsw.DistanceDot:
+		return distancer.NewDotProductProvider(), nil
+	case hnsw.DistanceL2Squared:
+		return distancer.NewL2SquaredProvider(), nil
+	case hnsw.DistanceManhattan:
+		return distancer.NewManhattanProvider(), nil
+	case hnsw.DistanceHamming:
+		return distancer.NewHammingProvider(), nil
+	default:
+		return nil, fmt.Errorf("unsupported distance type '%s'", hnswConfig.Distance)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
@@ -2878,3 +2879,287 @@ func getFakeModulesProviderWithCustomExtenders(
 func getFakeModulesProvider() ModulesProvider {
 	return &fakeModulesProvider{}
 }
+
+func Test_Explorer_GetClass_Facets(t *testing.T) {
+	facets := []search.Facet{
+		{
+			Property: "brand",
+			Values:   []search.FacetValue{{Value: "acme", Count: 7}},
+		},
+	}
+
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", "BestClass", "get_graphql", "nearVector", 0)
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "BestClass"},
+			}}},
+		})
+		return explorer, search
+	}
+
+	t.Run("a listing counts all objects matching the filters", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 100},
+			Facets:     &searchparams.Facets{Properties: []string{"brand"}},
+		}
+
+		explorer, searcher := newExplorer()
+		searcher.On("ClassSearch", params).Return([]search.Result{
+			{ID: "id1", Schema: map[string]interface{}{"id": strfmt.UUID("id1")}},
+		}, nil)
+		searcher.On("Facets", params, []strfmt.UUID(nil)).Return(facets, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+
+		require.Len(t, res, 1)
+		assert.Equal(t, facets, res[0].(map[string]interface{})["_additional"].(map[string]interface{})["facets"])
+	})
+
+	t.Run("a vector search without certainty counts the returned page", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.8, 0.2, 0.7},
+			},
+			Pagination: &filters.Pagination{Offset: 1, Limit: 2},
+			Properties: search.SelectProperties{{Name: "name", IsPrimitive: true}},
+			Facets:     &searchparams.Facets{Properties: []string{"brand"}},
+		}
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{0.8, 0.2, 0.7}
+		// the matched objects are searched with the same pagination
+		expectedMatchedParams := expectedParamsToSearch
+		expectedMatchedParams.Properties = nil
+		expectedMatchedParams.Facets = nil
+		expectedMatchedParams.AdditionalProperties = additional.Properties{ID: true}
+
+		explorer, searcher := newExplorer()
+		searcher.On("VectorClassSearch", expectedParamsToSearch).Return([]search.Result{
+			{ID: "id2", Schema: map[string]interface{}{"name": "b"}},
+			{ID: "id3", Schema: map[string]interface{}{"name": "c"}},
+		}, nil)
+		searcher.On("VectorClassSearch", expectedMatchedParams).Return([]search.Result{
+			{ID: "id2", Schema: map[string]interface{}{}},
+			{ID: "id3", Schema: map[string]interface{}{}},
+		}, nil)
+		searcher.On("Facets", params, []strfmt.UUID{"id2", "id3"}).Return(facets, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+
+		require.Len(t, res, 2)
+		assert.Equal(t, facets, res[0].(map[string]interface{})["_additional"].(map[string]interface{})["facets"])
+	})
+
+	t.Run("a vector search with certainty counts all objects within it", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			NearVector: &searchparams.NearVector{
+				Vector:    []float32{0.8, 0.2, 0.7},
+				Certainty: 0.8,
+			},
+			Pagination: &filters.Pagination{Limit: 1},
+			Properties: search.SelectProperties{{Name: "name", IsPrimitive: true}},
+			Facets:     &searchparams.Facets{Properties: []string{"brand"}},
+		}
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{0.8, 0.2, 0.7}
+		// the matched objects are searched without pagination
+		expectedMatchedParams := expectedParamsToSearch
+		expectedMatchedParams.Properties = nil
+		expectedMatchedParams.Facets = nil
+		expectedMatchedParams.AdditionalProperties = additional.Properties{ID: true}
+		expectedMatchedParams.Pagination = &filters.Pagination{Limit: filters.LimitFlagSearchByDist}
+
+		explorer, searcher := newExplorer()
+		searcher.On("VectorClassSearch", expectedParamsToSearch).Return([]search.Result{
+			{ID: "id1", Schema: map[string]interface{}{"name": "a"}},
+		}, nil)
+		searcher.On("VectorClassSearch", expectedMatchedParams).Return([]search.Result{
+			{ID: "id1", Schema: map[string]interface{}{}},
+			{ID: "id2", Schema: map[string]interface{}{}},
+			{ID: "id3", Schema: map[string]interface{}{}},
+		}, nil)
+		searcher.On("Facets", params, []strfmt.UUID{"id1", "id2", "id3"}).Return(facets, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+
+		require.Len(t, res, 1)
+		assert.Equal(t, facets, res[0].(map[string]interface{})["_additional"].(map[string]interface{})["facets"])
+	})
+
+	t.Run("a keyword search counts all matched objects", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:      "BestClass",
+			KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: "shoe"},
+			Pagination:     &filters.Pagination{Limit: 1},
+			Facets:         &searchparams.Facets{Properties: []string{"brand"}},
+		}
+		expectedMatchedParams := params
+		expectedMatchedParams.Facets = nil
+		expectedMatchedParams.AdditionalProperties = additional.Properties{ID: true}
+		expectedMatchedParams.Pagination = &filters.Pagination{Limit: 500}
+
+		explorer, searcher := newExplorer()
+		explorer.SetQueryMaximumResults(500)
+		searcher.On("ClassSearch", params).Return([]search.Result{
+			{ID: "id1", Schema: map[string]interface{}{"name": "a"}},
+		}, nil)
+		searcher.On("ClassSearch", expectedMatchedParams).Return([]search.Result{
+			{ID: "id1", Schema: map[string]interface{}{}},
+			{ID: "id2", Schema: map[string]interface{}{}},
+		}, nil)
+		searcher.On("Facets", params, []strfmt.UUID{"id1", "id2"}).Return(facets, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+
+		require.Len(t, res, 1)
+		assert.Equal(t, facets, res[0].(map[string]interface{})["_additional"].(map[string]interface{})["facets"])
+	})
+}
+
+func Test_Explorer_GetClass_Highlight(t *testing.T) {
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		search := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), nil)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{
+					Class: "BestClass",
+					Properties: []*models.Property{
+						{
+							Name:         "title",
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWord,
+						},
+					},
+				},
+			}}},
+		})
+		return explorer, search
+	}
+
+	t.Run("a bm25 search highlights the query terms", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:      "BestClass",
+			Pagination:     &filters.Pagination{Limit: 100},
+			KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: "apple"},
+			AdditionalProperties: additional.Properties{
+				Highlight: &additional.HighlightParams{},
+			},
+		}
+
+		explorer, searcher := newExplorer()
+		searcher.On("ClassSearch", params).Return([]search.Result{
+			{ID: "id1", Schema: map[string]interface{}{"title": "An apple a day"}},
+		}, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+
+		require.Len(t, res, 1)
+		assert.Equal(t, []additional.Highlight{
+			{Property: "title", Fragments: []string{"An <em>apple</em> a day"}},
+		}, res[0].(map[string]interface{})["_additional"].(map[string]interface{})["highlight"])
+	})
+
+	t.Run("highlighting requires a keyword search", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 100},
+			AdditionalProperties: additional.Properties{
+				Highlight: &additional.HighlightParams{},
+			},
+		}
+
+		explorer, _ := newExplorer()
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.EqualError(t, err, "highlight requires a bm25 or hybrid search")
+	})
+}
+
+func Test_Explorer_GetClass_MMR(t *testing.T) {
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		search := &fakeVectorSearcher{}
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics)
+		schemaGetter := newFakeSchemaGetter("BestClass")
+		schemaGetter.SetVectorIndexConfig(hnsw.UserConfig{Distance: hnsw.DistanceL2Squared})
+		explorer.SetSchemaGetter(schemaGetter)
+		return explorer, search
+	}
+
+	t.Run("a nearVector search picks diverse results", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 2},
+			NearVector: &searchparams.NearVector{Vector: []float32{0, 0}},
+			MMR:        &searchparams.MMR{Lambda: 0.5},
+		}
+
+		expectedParamsToSearch := params
+		expectedParamsToSearch.Pagination = &filters.Pagination{Limit: 8}
+		expectedParamsToSearch.SearchVector = []float32{0, 0}
+		expectedParamsToSearch.AdditionalProperties.Vector = true
+
+		explorer, searcher := newExplorer()
+		searcher.On("VectorClassSearch", expectedParamsToSearch).Return([]search.Result{
+			{ID: "a", Vector: []float32{1, 0}, Schema: map[string]interface{}{"name": "a"}},
+			{ID: "a-twin", Vector: []float32{1.1, 0}, Schema: map[string]interface{}{"name": "a-twin"}},
+			{ID: "b", Vector: []float32{0, 1.5}, Schema: map[string]interface{}{"name": "b"}},
+		}, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"name": "a"},
+			map[string]interface{}{"name": "b"},
+		}, res)
+	})
+
+	t.Run("mmr requires a vector or hybrid search", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Limit: 2},
+			MMR:        &searchparams.MMR{Lambda: 0.5},
+		}
+
+		explorer, _ := newExplorer()
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.EqualError(t, err,
+			"invalid 'mmr' parameter: mmr requires a near<Media> or hybrid search")
+	})
+
+	t.Run("fetchLimit must cover the page", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  "BestClass",
+			Pagination: &filters.Pagination{Offset: 2, Limit: 2},
+			NearVector: &searchparams.NearVector{Vector: []float32{0, 0}},
+			MMR:        &searchparams.MMR{Lambda: 0.5, FetchLimit: 3},
+		}
+
+		explorer, _ := newExplorer()
+		_, err := explorer.GetClass(context.Background(), params)
+		assert.EqualError(t, err,
+			"invalid 'mmr' parameter: fetchLimit must be at least offset + limit (4), got 3")
+	})
+}
@@ -158,6 +158,13 @@ func (f *fakeVectorSearcher) ResolveReferences(ctx context.Context, objs search.
 	return nil, nil
 }
 
+func (f *fakeVectorSearcher) Facets(ctx context.Context, params dto.GetParams,
+	ids []strfmt.UUID,
+) ([]search.Facet, error) {
+	args := f.Called(params, ids)
+	return args.Get(0).([]search.Facet), args.Error(1)
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
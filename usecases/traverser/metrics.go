@@ -22,6 +22,9 @@ type Metrics struct {
 	queriesCount     *prometheus.GaugeVec
 	queriesDurations *prometheus.HistogramVec
 	dimensions       *prometheus.CounterVec
+	cacheHits        *prometheus.CounterVec
+	cacheMisses      *prometheus.CounterVec
+	cacheSize        prometheus.Gauge
 }
 
 func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
@@ -33,6 +36,9 @@ func NewMetrics(prom *monitoring.PrometheusMetrics) *Metrics {
 		queriesCount:     prom.QueriesCount,
 		queriesDurations: prom.QueriesDurations,
 		dimensions:       prom.QueryDimensions,
+		cacheHits:        prom.QueryCacheHits,
+		cacheMisses:      prom.QueryCacheMisses,
+		cacheSize:        prom.QueryCacheSize,
 	}
 }
 
@@ -104,3 +110,33 @@ func (m *Metrics) AddUsageDimensions(className, queryType, operation string, dim
 		"query_type": queryType,
 	}).Add(float64(dims))
 }
+
+func (m *Metrics) QueryCacheHit(className, queryType string) {
+	if m == nil {
+		return
+	}
+
+	m.cacheHits.With(prometheus.Labels{
+		"class_name": className,
+		"query_type": queryType,
+	}).Inc()
+}
+
+func (m *Metrics) QueryCacheMiss(className, queryType string) {
+	if m == nil {
+		return
+	}
+
+	m.cacheMisses.With(prometheus.Labels{
+		"class_name": className,
+		"query_type": queryType,
+	}).Inc()
+}
+
+func (m *Metrics) QueryCacheSize(bytes int64) {
+	if m == nil {
+		return
+	}
+
+	m.cacheSize.Set(float64(bytes))
+}
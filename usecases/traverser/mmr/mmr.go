@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package mmr
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// Reranker picks search results by maximal marginal relevance, i.e. it
+// balances the relevance of a result, its distance to the query, against its
+// novelty, its distance to the closest result already picked.
+type Reranker struct {
+	distancer distancer.Provider
+	lambda    float64
+}
+
+// New creates a Reranker which compares vectors with the given distancer.
+// Lambda weighs the relevance, 1 - lambda the novelty of a result.
+func New(distancer distancer.Provider, lambda float64) *Reranker {
+	return &Reranker{distancer: distancer, lambda: lambda}
+}
+
+// Rerank greedily picks up to limit results out of the candidates. The first
+// pick is always the candidate closest to the query, every following pick the
+// candidate with the best trade-off between both distances. All candidates
+// need their vector set. Cosine distances are computed on normalized copies
+// of the vectors, the results keep their vectors as they are.
+func (r *Reranker) Rerank(query []float32, candidates []search.Result,
+	limit int,
+) ([]search.Result, error) {
+	if limit > len(candidates) {
+		limit = len(candidates)
+	}
+
+	normalize := r.distancer.Type() == "cosine-dot"
+	if normalize {
+		// cosine-dot requires normalized vectors, see hnsw.SearchByVector
+		query = distancer.Normalize(query)
+	}
+
+	vectors := make([][]float32, len(candidates))
+	queryDists := make([]float64, len(candidates))
+	// distance of every candidate to the closest picked result
+	pickedDists := make([]float64, len(candidates))
+	for i, candidate := range candidates {
+		if len(candidate.Vector) == 0 {
+			return nil, fmt.Errorf("mmr: result %s has no vector", candidate.ID)
+		}
+		vectors[i] = candidate.Vector
+		if normalize {
+			vectors[i] = distancer.Normalize(candidate.Vector)
+		}
+		dist, _, err := r.distancer.SingleDist(query, vectors[i])
+		if err != nil {
+			return nil, fmt.Errorf("mmr: result %s: %w", candidate.ID, err)
+		}
+		queryDists[i] = float64(dist)
+		pickedDists[i] = math.Inf(1)
+	}
+
+	picked := make([]bool, len(candidates))
+	out := make([]search.Result, 0, limit)
+	for len(out) < limit {
+		best := -1
+		bestScore := math.Inf(-1)
+		for i := range candidates {
+			if picked[i] {
+				continue
+			}
+			score := -queryDists[i]
+			if len(out) > 0 {
+				score = -r.lambda*queryDists[i] + (1-r.lambda)*pickedDists[i]
+			}
+			if best == -1 || score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+
+		picked[best] = true
+		out = append(out, candidates[best])
+
+		for i := range candidates {
+			if picked[i] {
+				continue
+			}
+			dist, _, err := r.distancer.SingleDist(vectors[best], vectors[i])
+			if err != nil {
+				return nil, fmt.Errorf("mmr: result %s: %w", candidates[i].ID, err)
+			}
+			pickedDists[i] = math.Min(pickedDists[i], float64(dist))
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package mmr
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestReranker(t *testing.T) {
+	query := []float32{0, 0}
+	candidates := []search.Result{
+		{ID: "a", Vector: []float32{1, 0}},
+		{ID: "a-twin", Vector: []float32{1.1, 0}},
+		{ID: "b", Vector: []float32{0, 1.5}},
+	}
+
+	ids := func(in []search.Result) []strfmt.UUID {
+		out := make([]strfmt.UUID, len(in))
+		for i := range in {
+			out[i] = in[i].ID
+		}
+		return out
+	}
+
+	t.Run("only relevance", func(t *testing.T) {
+		res, err := New(distancer.NewL2SquaredProvider(), 1).Rerank(query, candidates, 3)
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"a", "a-twin", "b"}, ids(res))
+	})
+
+	t.Run("balanced", func(t *testing.T) {
+		res, err := New(distancer.NewL2SquaredProvider(), 0.5).Rerank(query, candidates, 3)
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"a", "b", "a-twin"}, ids(res))
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		res, err := New(distancer.NewL2SquaredProvider(), 0).Rerank(query, candidates, 2)
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"a", "b"}, ids(res))
+	})
+
+	t.Run("limit exceeds candidates", func(t *testing.T) {
+		res, err := New(distancer.NewL2SquaredProvider(), 0.5).Rerank(query, candidates, 10)
+		require.Nil(t, err)
+		assert.Len(t, res, 3)
+	})
+
+	t.Run("cosine with non-unit vectors", func(t *testing.T) {
+		query := []float32{2, 0}
+		candidates := []search.Result{
+			{ID: "a", Vector: []float32{3, 0}},
+			{ID: "a-twin", Vector: []float32{10, 0.5}},
+			{ID: "b", Vector: []float32{0.5, 0.4}},
+		}
+
+		res, err := New(distancer.NewCosineDistanceProvider(), 1).Rerank(query, candidates, 3)
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"a", "a-twin", "b"}, ids(res))
+
+		res, err = New(distancer.NewCosineDistanceProvider(), 0.3).Rerank(query, candidates, 3)
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"a", "b", "a-twin"}, ids(res))
+		assert.Equal(t, []float32{3, 0}, res[0].Vector, "results keep their vector")
+	})
+
+	t.Run("missing vector", func(t *testing.T) {
+		_, err := New(distancer.NewL2SquaredProvider(), 0.5).Rerank(query,
+			[]search.Result{{ID: "c"}}, 1)
+		assert.NotNil(t, err)
+	})
+}
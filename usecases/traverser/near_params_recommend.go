@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
+)
+
+// vectorFromRecommend resolves the example objects of the recommendation to
+// their vectors and returns the vector to search with, i.e. the centroid of
+// the positive examples, moved away from the centroid of the negative ones by
+// the distance between both. The recommendation is returned with all
+// examples resolved to vectors, which the vector index needs for a best
+// score search.
+func (v *nearParamsVector) vectorFromRecommend(ctx context.Context,
+	className string, recommend *searchparams.Recommend,
+) ([]float32, *searchparams.Recommend, error) {
+	if err := validateRecommend(recommend); err != nil {
+		return nil, nil, err
+	}
+
+	resolve := func(ids []string, vectors [][]float32) ([][]float32, error) {
+		out := make([][]float32, 0, len(ids)+len(vectors))
+		out = append(out, vectors...)
+		for _, id := range ids {
+			vector, err := v.findVector(ctx, className, strfmt.UUID(id))
+			if err != nil {
+				return nil, errors.Errorf("example %s: %v", id, err)
+			}
+			out = append(out, vector)
+		}
+		return out, nil
+	}
+
+	resolved := *recommend
+	if resolved.Strategy == "" {
+		resolved.Strategy = searchparams.RecommendStrategyCentroid
+	}
+
+	var err error
+	resolved.PositiveVectors, err = resolve(recommend.Positive, recommend.PositiveVectors)
+	if err != nil {
+		return nil, nil, errors.Errorf("recommend: positive %v", err)
+	}
+	resolved.NegativeVectors, err = resolve(recommend.Negative, recommend.NegativeVectors)
+	if err != nil {
+		return nil, nil, errors.Errorf("recommend: negative %v", err)
+	}
+
+	dims := len(resolved.PositiveVectors[0])
+	for _, vectors := range [][][]float32{resolved.PositiveVectors, resolved.NegativeVectors} {
+		for _, vector := range vectors {
+			if len(vector) != dims {
+				return nil, nil, errors.Errorf("recommend: examples have different "+
+					"dimensions: %d and %d", dims, len(vector))
+			}
+		}
+	}
+
+	centroid := libvectorizer.CombineVectors(resolved.PositiveVectors)
+	if len(resolved.NegativeVectors) > 0 {
+		negative := libvectorizer.CombineVectors(resolved.NegativeVectors)
+		for i := range centroid {
+			centroid[i] += centroid[i] - negative[i]
+		}
+	}
+
+	return centroid, &resolved, nil
+}
+
+func validateRecommend(recommend *searchparams.Recommend) error {
+	switch recommend.Strategy {
+	case "", searchparams.RecommendStrategyCentroid, searchparams.RecommendStrategyBestScore:
+	default:
+		return errors.Errorf("recommend: unknown strategy %q, choose one of [%q, %q]",
+			recommend.Strategy, searchparams.RecommendStrategyCentroid,
+			searchparams.RecommendStrategyBestScore)
+	}
+
+	if len(recommend.Positive) == 0 && len(recommend.PositiveVectors) == 0 {
+		return errors.Errorf("recommend: at least one positive example is required")
+	}
+
+	if recommend.Certainty != 0 && recommend.Distance != 0 {
+		return errors.Errorf("found 'certainty' and 'distance' set in recommend " +
+			"which are conflicting, choose one instead")
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
@@ -282,6 +283,73 @@ func Test_nearParamsVector_vectorFromParams(t *testing.T) {
 	}
 }
 
+func Test_nearParamsVector_vectorFromRecommend(t *testing.T) {
+	tests := []struct {
+		name         string
+		recommend    *searchparams.Recommend
+		want         []float32
+		wantStrategy string
+		wantErr      string
+	}{
+		{
+			name: "Should average the positive examples",
+			recommend: &searchparams.Recommend{
+				PositiveVectors: [][]float32{{1, 0, 0}, {0, 1, 0}},
+			},
+			want:         []float32{0.5, 0.5, 0},
+			wantStrategy: searchparams.RecommendStrategyCentroid,
+		},
+		{
+			name: "Should move away from the negative examples",
+			recommend: &searchparams.Recommend{
+				Positive:        []string{"uuid"},
+				NegativeVectors: [][]float32{{0, 1, 1}},
+				Strategy:        searchparams.RecommendStrategyBestScore,
+			},
+			want:         []float32{2, 1, 1},
+			wantStrategy: searchparams.RecommendStrategyBestScore,
+		},
+		{
+			name:      "Should fail without positive examples",
+			recommend: &searchparams.Recommend{Negative: []string{"uuid"}},
+			wantErr:   "recommend: at least one positive example is required",
+		},
+		{
+			name: "Should fail on an unknown strategy",
+			recommend: &searchparams.Recommend{
+				Positive: []string{"uuid"},
+				Strategy: "average",
+			},
+			wantErr: `recommend: unknown strategy "average", choose one of ["centroid", "bestScore"]`,
+		},
+		{
+			name: "Should fail on mismatching dimensions",
+			recommend: &searchparams.Recommend{
+				Positive:        []string{"uuid"},
+				PositiveVectors: [][]float32{{1, 0}},
+			},
+			wantErr: "recommend: examples have different dimensions: 2 and 3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &nearParamsVector{
+				modulesProvider: &fakeModulesProvider{},
+				search:          &fakeNearParamsSearcher{},
+			}
+			got, resolved, err := e.vectorFromRecommend(context.Background(), "Class", tt.recommend)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantStrategy, resolved.Strategy)
+			assert.Len(t, resolved.PositiveVectors, len(tt.recommend.Positive)+len(tt.recommend.PositiveVectors))
+		})
+	}
+}
+
 func Test_nearParamsVector_extractCertaintyFromParams(t *testing.T) {
 	type args struct {
 		nearVector   *searchparams.NearVector
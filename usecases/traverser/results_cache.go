@@ -0,0 +1,306 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	queryTypeGet       = "get_graphql"
+	queryTypeAggregate = "aggregate"
+)
+
+// ClassVersioner reports a version per class that changes whenever data of
+// that class is written. The second return value is false if no version can
+// be reported for the class, in which case its results must not be cached.
+type ClassVersioner interface {
+	ClassVersion(className string) (uint64, bool)
+}
+
+// ResultsCache is a size-bounded LRU cache for query results. An entry is
+// only served as long as the versions of all classes it depends on are
+// unchanged since the entry was computed.
+//
+// The size of an entry is estimated from its JSON representation, results
+// which can not be marshalled are never cached. Every caller gets its own
+// copy of a cached result, so callers are free to mutate it.
+type ResultsCache struct {
+	sync.Mutex
+	versioner ClassVersioner
+	metrics   *Metrics
+	maxSize   int64
+	size      int64
+	entries   map[string]*list.Element
+	lru       *list.List
+}
+
+type resultsCacheEntry struct {
+	key      string
+	versions []uint64
+	value    interface{}
+	size     int64
+}
+
+// NewResultsCache with a maximum total size of maxSize bytes.
+func NewResultsCache(versioner ClassVersioner, maxSize int64,
+	metrics *Metrics,
+) *ResultsCache {
+	return &ResultsCache{
+		versioner: versioner,
+		metrics:   metrics,
+		maxSize:   maxSize,
+		entries:   map[string]*list.Element{},
+		lru:       list.New(),
+	}
+}
+
+// key normalizes the query params into a cache key. The second return value
+// is false if the params can not be represented as a key, in which case the
+// query must not be cached.
+func (c *ResultsCache) key(queryType string, params interface{}) (string, bool) {
+	bytes, err := json.Marshal(params)
+	if err != nil {
+		return "", false
+	}
+
+	h := sha256.New()
+	h.Write([]byte(queryType))
+	h.Write([]byte{0})
+	h.Write(bytes)
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+// versions returns the current version of each of the given classes. The
+// second return value is false if the version of any class is unknown.
+func (c *ResultsCache) versions(classNames []string) ([]uint64, bool) {
+	out := make([]uint64, len(classNames))
+	for i, className := range classNames {
+		version, ok := c.versioner.ClassVersion(className)
+		if !ok {
+			return nil, false
+		}
+		out[i] = version
+	}
+	return out, true
+}
+
+func (c *ResultsCache) get(key string, className, queryType string,
+	versions []uint64,
+) (interface{}, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.metrics.QueryCacheMiss(className, queryType)
+		return nil, false
+	}
+
+	entry := elem.Value.(*resultsCacheEntry)
+	if !equalVersions(entry.versions, versions) {
+		c.remove(elem)
+		c.metrics.QueryCacheMiss(className, queryType)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	c.metrics.QueryCacheHit(className, queryType)
+	return deepCopy(entry.value), true
+}
+
+func (c *ResultsCache) put(key string, versions []uint64, value interface{}) {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	size := int64(len(key) + len(bytes))
+	if size > c.maxSize {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+
+	for c.size+size > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+
+	c.entries[key] = c.lru.PushFront(&resultsCacheEntry{
+		key:      key,
+		versions: versions,
+		value:    deepCopy(value),
+		size:     size,
+	})
+	c.size += size
+	c.metrics.QueryCacheSize(c.size)
+}
+
+// remove must be called with the lock held
+func (c *ResultsCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*resultsCacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+	c.metrics.QueryCacheSize(c.size)
+}
+
+func equalVersions(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// cached serves the query from the results cache, if a cache is set and it
+// contains an up-to-date result for the params. Otherwise the query is run
+// and its result cached. The versions are determined before running the
+// query, so that writes happening concurrently to the query invalidate the
+// result.
+func (t *Traverser) cached(className, queryType string, params interface{},
+	query func() (interface{}, error),
+) (interface{}, error) {
+	if t.resultsCache == nil {
+		return query()
+	}
+
+	key, ok := t.resultsCache.key(queryType, params)
+	if !ok {
+		return query()
+	}
+
+	versions, ok := t.resultsCache.versions(t.dependentClasses(className))
+	if !ok {
+		return query()
+	}
+	if res, ok := t.resultsCache.get(key, className, queryType, versions); ok {
+		return res, nil
+	}
+
+	res, err := query()
+	if err != nil {
+		return nil, err
+	}
+
+	t.resultsCache.put(key, versions, res)
+	return res, nil
+}
+
+// dependentClasses are all classes whose data can be part of a query result
+// for the given class, i.e. the class itself and all classes it references,
+// directly or through other referenced classes
+func (t *Traverser) dependentClasses(className string) []string {
+	sch := t.schemaGetter.GetSchemaSkipAuth()
+
+	classNames := []string{className}
+	seen := map[string]struct{}{className: {}}
+	for i := 0; i < len(classNames); i++ {
+		class := sch.FindClassByName(schema.ClassName(classNames[i]))
+		if class == nil {
+			continue
+		}
+		for _, prop := range class.Properties {
+			if len(prop.DataType) == 0 || !schema.IsRefDataType(prop.DataType) {
+				continue
+			}
+			for _, ref := range prop.DataType {
+				if _, ok := seen[ref]; ok {
+					continue
+				}
+				seen[ref] = struct{}{}
+				classNames = append(classNames, ref)
+			}
+		}
+	}
+
+	return classNames
+}
+
+// deepCopy copies maps, slices and pointers of value recursively, so that
+// the copy shares no mutable state with value. Unexported struct fields are
+// copied shallowly.
+func deepCopy(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return copyValue(reflect.ValueOf(value)).Interface()
+}
+
+func copyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.New(v.Type()).Elem()
+		out.Set(copyValue(v.Elem()))
+		return out
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.New(v.Type().Elem())
+		out.Elem().Set(copyValue(v.Elem()))
+		return out
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out.SetMapIndex(iter.Key(), copyValue(iter.Value()))
+		}
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return out
+	case reflect.Array:
+		out := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return out
+	case reflect.Struct:
+		out := reflect.New(v.Type()).Elem()
+		out.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if out.Field(i).CanSet() {
+				out.Field(i).Set(copyValue(v.Field(i)))
+			}
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeClassVersioner struct {
+	versions map[string]uint64
+}
+
+func (f *fakeClassVersioner) ClassVersion(className string) (uint64, bool) {
+	version, ok := f.versions[className]
+	return version, ok
+}
+
+func TestResultsCache(t *testing.T) {
+	versioner := &fakeClassVersioner{versions: map[string]uint64{"MyClass": 1}}
+
+	t.Run("same params produce the same key", func(t *testing.T) {
+		c := NewResultsCache(versioner, 1024, nil)
+		params := aggregation.Params{ClassName: "MyClass", IncludeMetaCount: true}
+
+		key1, ok := c.key(queryTypeAggregate, params)
+		require.True(t, ok)
+		key2, ok := c.key(queryTypeAggregate, params)
+		require.True(t, ok)
+		assert.Equal(t, key1, key2)
+
+		key3, ok := c.key(queryTypeGet, params)
+		require.True(t, ok)
+		assert.NotEqual(t, key1, key3)
+
+		params.IncludeMetaCount = false
+		key4, ok := c.key(queryTypeAggregate, params)
+		require.True(t, ok)
+		assert.NotEqual(t, key1, key4)
+	})
+
+	t.Run("params which can't be marshalled are not cached", func(t *testing.T) {
+		c := NewResultsCache(versioner, 1024, nil)
+		_, ok := c.key(queryTypeGet, map[string]interface{}{"fn": func() {}})
+		assert.False(t, ok)
+	})
+
+	t.Run("entries are invalidated by a version change", func(t *testing.T) {
+		c := NewResultsCache(versioner, 1024, nil)
+		c.put("key", []uint64{1}, "result")
+
+		res, ok := c.get("key", "MyClass", queryTypeGet, []uint64{1})
+		require.True(t, ok)
+		assert.Equal(t, "result", res)
+
+		_, ok = c.get("key", "MyClass", queryTypeGet, []uint64{2})
+		assert.False(t, ok)
+		assert.Empty(t, c.entries)
+		assert.Equal(t, int64(0), c.size)
+	})
+
+	t.Run("least recently used entries are evicted", func(t *testing.T) {
+		// each entry is 1 byte key + 3 bytes json ("a")
+		c := NewResultsCache(versioner, 8, nil)
+		c.put("1", nil, "a")
+		c.put("2", nil, "b")
+
+		_, ok := c.get("1", "MyClass", queryTypeGet, nil)
+		require.True(t, ok)
+
+		c.put("3", nil, "c")
+
+		_, ok = c.get("1", "MyClass", queryTypeGet, nil)
+		assert.True(t, ok)
+		_, ok = c.get("2", "MyClass", queryTypeGet, nil)
+		assert.False(t, ok)
+		_, ok = c.get("3", "MyClass", queryTypeGet, nil)
+		assert.True(t, ok)
+		assert.Equal(t, int64(8), c.size)
+	})
+
+	t.Run("callers get their own copy of a cached result", func(t *testing.T) {
+		c := NewResultsCache(versioner, 1024, nil)
+		value := []interface{}{map[string]interface{}{"name": "a"}}
+		c.put("key", nil, value)
+		value[0].(map[string]interface{})["name"] = "changed after put"
+
+		res, ok := c.get("key", "MyClass", queryTypeGet, nil)
+		require.True(t, ok)
+		res.([]interface{})[0].(map[string]interface{})["name"] = "changed after get"
+
+		res, ok = c.get("key", "MyClass", queryTypeGet, nil)
+		require.True(t, ok)
+		assert.Equal(t, []interface{}{map[string]interface{}{"name": "a"}}, res)
+	})
+
+	t.Run("unknown versions can't be cached", func(t *testing.T) {
+		c := NewResultsCache(versioner, 1024, nil)
+		_, ok := c.versions([]string{"MyClass", "RemoteClass"})
+		assert.False(t, ok)
+	})
+
+	t.Run("entries larger than the cache are not cached", func(t *testing.T) {
+		c := NewResultsCache(versioner, 8, nil)
+		c.put("1", nil, "too large")
+
+		_, ok := c.get("1", "MyClass", queryTypeGet, nil)
+		assert.False(t, ok)
+		assert.Equal(t, int64(0), c.size)
+	})
+}
+
+func Test_Traverser_Aggregate_ResultsCache(t *testing.T) {
+	principal := &models.Principal{}
+	logger, _ := test.NewNullLogger()
+	vectorRepo := &fakeVectorRepo{}
+	versioner := &fakeClassVersioner{versions: map[string]uint64{
+		"MyClass": 1, "AnotherClass": 1,
+	}}
+
+	traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+		&fakeAuthorizer{}, vectorRepo, &fakeExplorer{},
+		&fakeSchemaGetter{aggregateTestSchema}, nil, nil, -1,
+		NewResultsCache(versioner, 1024*1024, nil))
+
+	params := aggregation.Params{ClassName: "MyClass", IncludeMetaCount: true}
+	agg := aggregation.Result{
+		Groups: []aggregation.Group{{Count: 10}},
+	}
+	vectorRepo.On("Aggregate", params).Return(&agg, nil)
+
+	t.Run("first query hits the repo", func(t *testing.T) {
+		res, err := traverser.Aggregate(context.Background(), principal, &params)
+		require.Nil(t, err)
+		assert.Equal(t, &agg, res)
+		vectorRepo.AssertNumberOfCalls(t, "Aggregate", 1)
+	})
+
+	t.Run("repeated query is served from the cache", func(t *testing.T) {
+		res, err := traverser.Aggregate(context.Background(), principal, &params)
+		require.Nil(t, err)
+		assert.Equal(t, &agg, res)
+		vectorRepo.AssertNumberOfCalls(t, "Aggregate", 1)
+	})
+
+	t.Run("a write to the class invalidates the cached result", func(t *testing.T) {
+		versioner.versions["MyClass"] = 2
+
+		res, err := traverser.Aggregate(context.Background(), principal, &params)
+		require.Nil(t, err)
+		assert.Equal(t, &agg, res)
+		vectorRepo.AssertNumberOfCalls(t, "Aggregate", 2)
+	})
+}
+
+func Test_Traverser_DependentClasses(t *testing.T) {
+	ref := func(name, target string) *models.Property {
+		return &models.Property{Name: name, DataType: []string{target}}
+	}
+	sch := schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+		{Class: "A", Properties: []*models.Property{ref("toB", "B")}},
+		{Class: "B", Properties: []*models.Property{ref("toC", "C"), ref("toA", "A")}},
+		{Class: "C", Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString()},
+		}},
+	}}}
+
+	traverser := &Traverser{schemaGetter: &fakeSchemaGetter{sch}}
+	assert.Equal(t, []string{"A", "B", "C"}, traverser.dependentClasses("A"))
+	assert.Equal(t, []string{"C"}, traverser.dependentClasses("C"))
+}
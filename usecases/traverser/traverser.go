@@ -13,6 +13,7 @@ package traverser
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
@@ -36,6 +37,80 @@ type authorizer interface {
 	Authorize(principal *models.Principal, verb, resource string) error
 }
 
+// traversalPath scopes the authorization of a query to its class. Queries
+// which span all classes are authorized on the wildcard path.
+func traversalPath(className string) string {
+	if className == "" {
+		return "traversal/*"
+	}
+	return fmt.Sprintf("traversal/%s", className)
+}
+
+// authorizeClasses authorizes a query on className which reads the given
+// referenced classes as well. Every class is authorized on its own, so that a
+// query cannot read a class through a cross-reference the principal has no
+// access to.
+func (t *Traverser) authorizeClasses(principal *models.Principal,
+	className string, referenced []string,
+) error {
+	if err := t.authorizer.Authorize(principal, "get", traversalPath(className)); err != nil {
+		return err
+	}
+	seen := map[string]struct{}{className: {}}
+	for _, class := range referenced {
+		if _, ok := seen[class]; ok {
+			continue
+		}
+		seen[class] = struct{}{}
+		if err := t.authorizer.Authorize(principal, "get", traversalPath(class)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// referencedClasses are all classes reached through cross-references, either
+// in the selected properties or in the filter paths
+func referencedClasses(props search.SelectProperties, filter *filters.LocalFilter) []string {
+	var classes []string
+	var walkProps func(props search.SelectProperties)
+	walkProps = func(props search.SelectProperties) {
+		for _, prop := range props {
+			for _, ref := range prop.Refs {
+				classes = append(classes, ref.ClassName)
+				walkProps(ref.RefProperties)
+			}
+		}
+	}
+	walkProps(props)
+
+	var walkClause func(clause *filters.Clause)
+	walkClause = func(clause *filters.Clause) {
+		if clause == nil {
+			return
+		}
+		if clause.On != nil {
+			for path := clause.On.Child; path != nil; path = path.Child {
+				classes = append(classes, path.Class.String())
+			}
+		}
+		for i := range clause.Operands {
+			walkClause(&clause.Operands[i])
+		}
+	}
+	if filter != nil {
+		walkClause(filter.Root)
+	}
+
+	return classes
+}
+
+// resolveAlias returns the class a query on className is applied to
+func (t *Traverser) resolveAlias(className string) string {
+	sch := t.schemaGetter.GetSchemaSkipAuth()
+	return sch.ResolveAlias(className)
+}
+
 // Traverser can be used to dynamically traverse the knowledge graph
 type Traverser struct {
 	config           *config.WeaviateConfig
@@ -48,6 +123,7 @@ type Traverser struct {
 	nearParamsVector *nearParamsVector
 	metrics          *Metrics
 	ratelimiter      *ratelimiter.Limiter
+	resultsCache     *ResultsCache
 }
 
 type VectorSearcher interface {
@@ -72,7 +148,7 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 	vectorSearcher VectorSearcher,
 	explorer explorer, schemaGetter schema.SchemaGetter,
 	modulesProvider ModulesProvider,
-	metrics *Metrics, maxGetRequests int,
+	metrics *Metrics, maxGetRequests int, resultsCache *ResultsCache,
 ) *Traverser {
 	return &Traverser{
 		config:           config,
@@ -85,6 +161,7 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 		nearParamsVector: newNearParamsVector(modulesProvider, vectorSearcher),
 		metrics:          metrics,
 		ratelimiter:      ratelimiter.New(maxGetRequests),
+		resultsCache:     resultsCache,
 	}
 }
 
@@ -16,22 +16,29 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/audit"
 )
 
 // Aggregate resolves meta queries
 func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	params *aggregation.Params,
 ) (interface{}, error) {
+	params.ClassName = schema.ClassName(t.resolveAlias(params.ClassName.String()))
+
 	t.metrics.QueriesAggregateInc(params.ClassName.String())
 	defer t.metrics.QueriesAggregateDec(params.ClassName.String())
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	referenced := referencedClasses(nil, params.Filters)
+	err := t.authorizeClasses(principal, params.ClassName.String(), referenced)
 	if err != nil {
 		return nil, err
 	}
+	audit.AnnotateClasses(ctx, append([]string{params.ClassName.String()}, referenced...)...)
 
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
@@ -39,11 +46,20 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
+	return t.cached(params.ClassName.String(), queryTypeAggregate, params,
+		func() (interface{}, error) {
+			return t.aggregate(ctx, params)
+		})
+}
+
+func (t *Traverser) aggregate(ctx context.Context,
+	params *aggregation.Params,
+) (interface{}, error) {
 	inspector := newTypeInspector(t.schemaGetter)
 
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
 		className := params.ClassName.String()
-		err = t.nearParamsVector.validateNearParams(params.NearVector,
+		err := t.nearParamsVector.validateNearParams(params.NearVector,
 			params.NearObject, params.ModuleParams, className)
 		if err != nil {
 			return nil, err
@@ -63,6 +79,29 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		params.Certainty = certainty
 	}
 
+	if params.Recommend != nil {
+		if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
+			return nil, errors.Errorf("found both 'recommend' and near<Media> parameters " +
+				"which are conflicting, choose one instead")
+		}
+		searchVector, recommend, err := t.nearParamsVector.vectorFromRecommend(ctx,
+			params.ClassName.String(), params.Recommend)
+		if err != nil {
+			return nil, err
+		}
+		params.SearchVector = searchVector
+		params.Recommend = recommend
+
+		certainty := params.Recommend.Certainty
+		if certainty == 0 && params.Recommend.WithDistance {
+			certainty = additional.DistToCertainty(params.Recommend.Distance)
+		}
+		if certainty == 0 && params.ObjectLimit == nil {
+			return nil, fmt.Errorf("must provide certainty or objectLimit with vector search")
+		}
+		params.Certainty = certainty
+	}
+
 	if params.Hybrid != nil && params.Hybrid.Vector == nil && params.Hybrid.Query != "" {
 		vec, err := t.nearParamsVector.modulesProvider.
 			VectorFromInput(ctx, params.ClassName.String(), params.Hybrid.Query)
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// MaxBatchGetQueries is the maximum number of queries in a single batch. All
+// queries of a batch are executed concurrently, larger batches have to be
+// split by the client.
+const MaxBatchGetQueries = 100
+
+// ValidateBatchGetSize rejects batches with too many queries. Transports call
+// it with the number of queries they received, before invalid ones are sorted
+// out, BatchGetClass with the remaining ones.
+func ValidateBatchGetSize(queries int) error {
+	if queries > MaxBatchGetQueries {
+		return fmt.Errorf("batch contains %d queries, at most %d are allowed",
+			queries, MaxBatchGetQueries)
+	}
+	return nil
+}
+
+// BatchGetResult is the outcome of a single query of a batch. A failing query
+// does not affect the other queries of the same batch.
+type BatchGetResult struct {
+	Results []interface{}
+	Err     error
+}
+
+// BatchGetClass runs independent Get queries concurrently. All queries share
+// the deadline of ctx, a query which has not started when it expires fails
+// with the context's error. The results are returned in the order of the
+// queries.
+//
+// Queries are dispatched sorted by class, with the vector searches of a class
+// next to each other, so that the workers traverse the same vector indexes at
+// the same time rather than competing for the cache with searches on other
+// classes. They are not grouped by shard, as a search of a class generally
+// involves all of its shards.
+func (t *Traverser) BatchGetClass(ctx context.Context, principal *models.Principal,
+	params []dto.GetParams,
+) ([]BatchGetResult, error) {
+	if len(params) == 0 {
+		return nil, fmt.Errorf("batch search requires at least one query")
+	}
+	if err := ValidateBatchGetSize(len(params)); err != nil {
+		return nil, err
+	}
+
+	order := batchGetOrder(params)
+	next := make(chan int, len(order))
+	for _, i := range order {
+		next <- i
+	}
+	close(next)
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(params) {
+		workers = len(params)
+	}
+
+	out := make([]BatchGetResult, len(params))
+	wg := sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				if err := ctx.Err(); err != nil {
+					out[i] = BatchGetResult{Err: err}
+					continue
+				}
+				res, err := t.GetClass(ctx, principal, params[i])
+				out[i] = BatchGetResult{Results: res, Err: err}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return out, nil
+}
+
+// batchGetOrder returns the indexes of the queries in the order in which
+// they should be dispatched
+func batchGetOrder(params []dto.GetParams) []int {
+	order := make([]int, len(params))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		pa, pb := params[order[a]], params[order[b]]
+		if pa.ClassName != pb.ClassName {
+			return pa.ClassName < pb.ClassName
+		}
+		return isVectorSearch(pa) && !isVectorSearch(pb)
+	})
+
+	return order
+}
+
+func isVectorSearch(params dto.GetParams) bool {
+	return params.NearVector != nil || params.NearObject != nil ||
+		params.Recommend != nil || params.HybridSearch != nil ||
+		len(params.ModuleParams) > 0
+}
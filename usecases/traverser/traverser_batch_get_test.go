@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeBatchExplorer struct {
+	fakeExplorer
+}
+
+func (f *fakeBatchExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interface{}, error) {
+	if p.ClassName == "BrokenClass" {
+		return nil, errors.New("broken")
+	}
+	return []interface{}{p.ClassName}, nil
+}
+
+func Test_Traverser_BatchGetClass(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+		&fakeAuthorizer{}, &fakeVectorRepo{}, &fakeBatchExplorer{},
+		newFakeSchemaGetter("MyClass"), nil, nil, -1, nil)
+
+	t.Run("every query gets its own result or error", func(t *testing.T) {
+		res, err := traverser.BatchGetClass(context.Background(), &models.Principal{},
+			[]dto.GetParams{
+				{ClassName: "MyClass"},
+				{ClassName: "BrokenClass"},
+				{ClassName: "OtherClass"},
+			})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+
+		assert.Equal(t, []interface{}{"MyClass"}, res[0].Results)
+		assert.Nil(t, res[0].Err)
+		assert.EqualError(t, res[1].Err, "broken")
+		assert.Equal(t, []interface{}{"OtherClass"}, res[2].Results)
+	})
+
+	t.Run("queries after the deadline fail", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		res, err := traverser.BatchGetClass(ctx, &models.Principal{},
+			[]dto.GetParams{{ClassName: "MyClass"}, {ClassName: "MyClass"}})
+		require.Nil(t, err)
+		for _, r := range res {
+			assert.ErrorIs(t, r.Err, context.Canceled)
+		}
+	})
+
+	t.Run("an empty batch is rejected", func(t *testing.T) {
+		_, err := traverser.BatchGetClass(context.Background(), &models.Principal{}, nil)
+		assert.EqualError(t, err, "batch search requires at least one query")
+	})
+
+	t.Run("a batch with too many queries is rejected", func(t *testing.T) {
+		params := make([]dto.GetParams, MaxBatchGetQueries+1)
+		_, err := traverser.BatchGetClass(context.Background(), &models.Principal{}, params)
+		assert.EqualError(t, err, "batch contains 101 queries, at most 100 are allowed")
+	})
+}
+
+func Test_batchGetOrder(t *testing.T) {
+	nearVector := &searchparams.NearVector{Vector: []float32{1}}
+	params := []dto.GetParams{
+		{ClassName: "B"},
+		{ClassName: "A"},
+		{ClassName: "B", NearVector: nearVector},
+		{ClassName: "A", NearVector: nearVector},
+		{ClassName: "B", NearVector: nearVector},
+	}
+
+	assert.Equal(t, []int{3, 1, 2, 4, 0}, batchGetOrder(params))
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
@@ -35,14 +36,17 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 
 	defer t.ratelimiter.Dec()
 
+	params.ClassName = t.resolveAlias(params.ClassName)
+
 	t.metrics.QueriesGetInc(params.ClassName)
 	defer t.metrics.QueriesGetDec(params.ClassName)
 	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
-	if err != nil {
+	referenced := referencedClasses(params.Properties, params.Filters)
+	if err := t.authorizeClasses(principal, params.ClassName, referenced); err != nil {
 		return nil, err
 	}
+	audit.AnnotateClasses(ctx, append([]string{params.ClassName}, referenced...)...)
 
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
@@ -60,5 +64,14 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		}
 	}
 
-	return t.explorer.GetClass(ctx, params)
+	res, err := t.cached(params.ClassName, queryTypeGet, params,
+		func() (interface{}, error) {
+			return t.explorer.GetClass(ctx, params)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	results, _ := res.([]interface{})
+	return results, nil
 }